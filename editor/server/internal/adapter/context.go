@@ -14,13 +14,14 @@ import (
 type ContextKey string
 
 const (
-	contextUser        ContextKey = "user"
-	contextOperator    ContextKey = "operator"
-	ContextAuthInfo    ContextKey = "authinfo"
-	contextUsecases    ContextKey = "usecases"
-	contextMockAuth    ContextKey = "mockauth"
-	contextCurrentHost ContextKey = "currenthost"
-	contextLang        ContextKey = "lang"
+	contextUser         ContextKey = "user"
+	contextOperator     ContextKey = "operator"
+	ContextAuthInfo     ContextKey = "authinfo"
+	contextUsecases     ContextKey = "usecases"
+	contextMockAuth     ContextKey = "mockauth"
+	contextCurrentHost  ContextKey = "currenthost"
+	contextLang         ContextKey = "lang"
+	contextSceneVersion ContextKey = "sceneversion"
 )
 
 var defaultLang = language.English
@@ -51,6 +52,11 @@ func AttachUsecases(ctx context.Context, u *interfaces.Container) context.Contex
 	return ctx
 }
 
+// AttachSceneVersion attaches the version of the scene that the client expects. A change is rejected when the scene has been changed since the version.
+func AttachSceneVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, contextSceneVersion, version)
+}
+
 func AttachMockAuth(ctx context.Context, mockAuth bool) context.Context {
 	return context.WithValue(ctx, contextMockAuth, mockAuth)
 }
@@ -140,3 +146,12 @@ func CurrentHost(ctx context.Context) string {
 	}
 	return ""
 }
+
+func SceneVersion(ctx context.Context) *int {
+	if v := ctx.Value(contextSceneVersion); v != nil {
+		if version, ok := v.(int); ok {
+			return &version
+		}
+	}
+	return nil
+}
@@ -49,6 +49,14 @@ func getAcOperator(ctx context.Context) *accountusecase.Operator {
 	return nil
 }
 
+// withSceneVersion attaches the version of the scene in the mutation input, so that the change is rejected when the scene has been changed since the version.
+func withSceneVersion(ctx context.Context, version *int) context.Context {
+	if version == nil {
+		return ctx
+	}
+	return adapter.AttachSceneVersion(ctx, *version)
+}
+
 func usecases(ctx context.Context) *interfaces.Container {
 	return adapter.Usecases(ctx)
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 	"sync"
@@ -66,12 +67,16 @@ type ResolverRoot interface {
 	PropertySchemaGroup() PropertySchemaGroupResolver
 	Query() QueryResolver
 	Scene() SceneResolver
+	SceneEvent() SceneEventResolver
 	ScenePlugin() ScenePluginResolver
+	ScenePresence() ScenePresenceResolver
+	SceneRevision() SceneRevisionResolver
 	SceneWidget() SceneWidgetResolver
 	Story() StoryResolver
 	StoryBlock() StoryBlockResolver
 	StoryPage() StoryPageResolver
 	Style() StyleResolver
+	Subscription() SubscriptionResolver
 	Team() TeamResolver
 	TeamMember() TeamMemberResolver
 }
@@ -160,6 +165,10 @@ type ComplexityRoot struct {
 		Story func(childComplexity int) int
 	}
 
+	CreateStylePresetPayload struct {
+		StylePreset func(childComplexity int) int
+	}
+
 	CreateTeamPayload struct {
 		Team func(childComplexity int) int
 	}
@@ -218,6 +227,10 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	ImportNLSLayerFilePayload struct {
+		Layer func(childComplexity int) int
+	}
+
 	ImportProjectPayload struct {
 		ProjectData func(childComplexity int) int
 	}
@@ -352,6 +365,7 @@ type ComplexityRoot struct {
 		CreateStory               func(childComplexity int, input gqlmodel.CreateStoryInput) int
 		CreateStoryBlock          func(childComplexity int, input gqlmodel.CreateStoryBlockInput) int
 		CreateStoryPage           func(childComplexity int, input gqlmodel.CreateStoryPageInput) int
+		CreateStylePreset         func(childComplexity int, input gqlmodel.CreateStylePresetInput) int
 		CreateTeam                func(childComplexity int, input gqlmodel.CreateTeamInput) int
 		DeleteGeoJSONFeature      func(childComplexity int, input gqlmodel.DeleteGeoJSONFeatureInput) int
 		DeleteMe                  func(childComplexity int, input gqlmodel.DeleteMeInput) int
@@ -362,6 +376,7 @@ type ComplexityRoot struct {
 		DuplicateStoryPage        func(childComplexity int, input gqlmodel.DuplicateStoryPageInput) int
 		DuplicateStyle            func(childComplexity int, input gqlmodel.DuplicateStyleInput) int
 		ExportProject             func(childComplexity int, input gqlmodel.ExportProjectInput) int
+		ImportNLSLayerFile        func(childComplexity int, input gqlmodel.ImportNLSLayerFileInput) int
 		ImportProject             func(childComplexity int, input gqlmodel.ImportProjectInput) int
 		InstallPlugin             func(childComplexity int, input gqlmodel.InstallPluginInput) int
 		MoveNLSInfoboxBlock       func(childComplexity int, input gqlmodel.MoveNLSInfoboxBlockInput) int
@@ -385,7 +400,9 @@ type ComplexityRoot struct {
 		RemoveStoryBlock          func(childComplexity int, input gqlmodel.RemoveStoryBlockInput) int
 		RemoveStoryPage           func(childComplexity int, input gqlmodel.DeleteStoryPageInput) int
 		RemoveStyle               func(childComplexity int, input gqlmodel.RemoveStyleInput) int
+		RemoveStylePreset         func(childComplexity int, input gqlmodel.RemoveStylePresetInput) int
 		RemoveWidget              func(childComplexity int, input gqlmodel.RemoveWidgetInput) int
+		RestoreSceneRevision      func(childComplexity int, input gqlmodel.RestoreSceneRevisionInput) int
 		Signup                    func(childComplexity int, input gqlmodel.SignupInput) int
 		UninstallPlugin           func(childComplexity int, input gqlmodel.UninstallPluginInput) int
 		UnlinkPropertyValue       func(childComplexity int, input gqlmodel.UnlinkPropertyValueInput) int
@@ -399,9 +416,11 @@ type ComplexityRoot struct {
 		UpdateProject             func(childComplexity int, input gqlmodel.UpdateProjectInput) int
 		UpdatePropertyItems       func(childComplexity int, input gqlmodel.UpdatePropertyItemInput) int
 		UpdatePropertyValue       func(childComplexity int, input gqlmodel.UpdatePropertyValueInput) int
+		UpdateScenePresence       func(childComplexity int, input gqlmodel.UpdateScenePresenceInput) int
 		UpdateStory               func(childComplexity int, input gqlmodel.UpdateStoryInput) int
 		UpdateStoryPage           func(childComplexity int, input gqlmodel.UpdateStoryPageInput) int
 		UpdateStyle               func(childComplexity int, input gqlmodel.UpdateStyleInput) int
+		UpdateStylePreset         func(childComplexity int, input gqlmodel.UpdateStylePresetInput) int
 		UpdateTeam                func(childComplexity int, input gqlmodel.UpdateTeamInput) int
 		UpdateWidget              func(childComplexity int, input gqlmodel.UpdateWidgetInput) int
 		UpdateWidgetAlignSystem   func(childComplexity int, input gqlmodel.UpdateWidgetAlignSystemInput) int
@@ -707,8 +726,12 @@ type ComplexityRoot struct {
 		PropertySchema    func(childComplexity int, id gqlmodel.ID) int
 		PropertySchemas   func(childComplexity int, id []gqlmodel.ID) int
 		Scene             func(childComplexity int, projectID gqlmodel.ID) int
+		SceneRevisionDiff func(childComplexity int, from gqlmodel.ID, to *gqlmodel.ID) int
+		SceneRevisions    func(childComplexity int, sceneID gqlmodel.ID, pagination *gqlmodel.Pagination) int
 		SearchUser        func(childComplexity int, nameOrEmail string) int
 		StarredProjects   func(childComplexity int, teamID gqlmodel.ID) int
+		StylePresets      func(childComplexity int, teamID gqlmodel.ID) int
+		ValidateStyle     func(childComplexity int, layerID gqlmodel.ID, value gqlmodel.JSON) int
 	}
 
 	Rect struct {
@@ -753,11 +776,19 @@ type ComplexityRoot struct {
 		StyleID func(childComplexity int) int
 	}
 
+	RemoveStylePresetPayload struct {
+		PresetID func(childComplexity int) int
+	}
+
 	RemoveWidgetPayload struct {
 		Scene    func(childComplexity int) int
 		WidgetID func(childComplexity int) int
 	}
 
+	RestoreSceneRevisionPayload struct {
+		Revision func(childComplexity int) int
+	}
+
 	Scene struct {
 		CreatedAt         func(childComplexity int) int
 		ID                func(childComplexity int) int
@@ -772,10 +803,21 @@ type ComplexityRoot struct {
 		Team              func(childComplexity int) int
 		TeamID            func(childComplexity int) int
 		UpdatedAt         func(childComplexity int) int
+		Version           func(childComplexity int) int
 		WidgetAlignSystem func(childComplexity int) int
 		Widgets           func(childComplexity int) int
 	}
 
+	SceneEvent struct {
+		Action     func(childComplexity int) int
+		Changes    func(childComplexity int) int
+		RevisionID func(childComplexity int) int
+		SceneID    func(childComplexity int) int
+		User       func(childComplexity int) int
+		UserID     func(childComplexity int) int
+		Version    func(childComplexity int) int
+	}
+
 	ScenePlugin struct {
 		Plugin     func(childComplexity int) int
 		PluginID   func(childComplexity int) int
@@ -783,6 +825,41 @@ type ComplexityRoot struct {
 		PropertyID func(childComplexity int) int
 	}
 
+	ScenePresence struct {
+		LayerID   func(childComplexity int) int
+		SceneID   func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	SceneRevision struct {
+		Action    func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		SceneID   func(childComplexity int) int
+		User      func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
+	SceneRevisionChange struct {
+		ID         func(childComplexity int) int
+		ObjectType func(childComplexity int) int
+		Type       func(childComplexity int) int
+	}
+
+	SceneRevisionConnection struct {
+		Edges      func(childComplexity int) int
+		Nodes      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	SceneRevisionEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	SceneWidget struct {
 		Enabled     func(childComplexity int) int
 		Extended    func(childComplexity int) int
@@ -872,11 +949,36 @@ type ComplexityRoot struct {
 	}
 
 	Style struct {
-		ID      func(childComplexity int) int
-		Name    func(childComplexity int) int
-		Scene   func(childComplexity int) int
-		SceneID func(childComplexity int) int
-		Value   func(childComplexity int) int
+		ID       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Preset   func(childComplexity int) int
+		PresetID func(childComplexity int) int
+		Scene    func(childComplexity int) int
+		SceneID  func(childComplexity int) int
+		Value    func(childComplexity int) int
+	}
+
+	StyleIssue struct {
+		Attribute  func(childComplexity int) int
+		Message    func(childComplexity int) int
+		Path       func(childComplexity int) int
+		Suggestion func(childComplexity int) int
+		Type       func(childComplexity int) int
+	}
+
+	StylePreset struct {
+		CreatedAt   func(childComplexity int) int
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		TeamID      func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+		Value       func(childComplexity int) int
+	}
+
+	Subscription struct {
+		SceneEvents    func(childComplexity int, sceneID gqlmodel.ID) int
+		ScenePresences func(childComplexity int, sceneID gqlmodel.ID) int
 	}
 
 	Team struct {
@@ -939,10 +1041,18 @@ type ComplexityRoot struct {
 		Layers func(childComplexity int) int
 	}
 
+	UpdateScenePresencePayload struct {
+		Presences func(childComplexity int) int
+	}
+
 	UpdateStylePayload struct {
 		Style func(childComplexity int) int
 	}
 
+	UpdateStylePresetPayload struct {
+		StylePreset func(childComplexity int) int
+	}
+
 	UpdateTeamPayload struct {
 		Team func(childComplexity int) int
 	}
@@ -1061,10 +1171,12 @@ type MutationResolver interface {
 	CreateAsset(ctx context.Context, input gqlmodel.CreateAssetInput) (*gqlmodel.CreateAssetPayload, error)
 	UpdateAsset(ctx context.Context, input gqlmodel.UpdateAssetInput) (*gqlmodel.UpdateAssetPayload, error)
 	RemoveAsset(ctx context.Context, input gqlmodel.RemoveAssetInput) (*gqlmodel.RemoveAssetPayload, error)
+	UpdateScenePresence(ctx context.Context, input gqlmodel.UpdateScenePresenceInput) (*gqlmodel.UpdateScenePresencePayload, error)
 	AddGeoJSONFeature(ctx context.Context, input gqlmodel.AddGeoJSONFeatureInput) (*gqlmodel.Feature, error)
 	UpdateGeoJSONFeature(ctx context.Context, input gqlmodel.UpdateGeoJSONFeatureInput) (*gqlmodel.Feature, error)
 	DeleteGeoJSONFeature(ctx context.Context, input gqlmodel.DeleteGeoJSONFeatureInput) (*gqlmodel.DeleteGeoJSONFeaturePayload, error)
 	AddNLSLayerSimple(ctx context.Context, input gqlmodel.AddNLSLayerSimpleInput) (*gqlmodel.AddNLSLayerSimplePayload, error)
+	ImportNLSLayerFile(ctx context.Context, input gqlmodel.ImportNLSLayerFileInput) (*gqlmodel.ImportNLSLayerFilePayload, error)
 	RemoveNLSLayer(ctx context.Context, input gqlmodel.RemoveNLSLayerInput) (*gqlmodel.RemoveNLSLayerPayload, error)
 	UpdateNLSLayer(ctx context.Context, input gqlmodel.UpdateNLSLayerInput) (*gqlmodel.UpdateNLSLayerPayload, error)
 	UpdateNLSLayers(ctx context.Context, input gqlmodel.UpdateNLSLayersInput) (*gqlmodel.UpdateNLSLayersPayload, error)
@@ -1097,6 +1209,7 @@ type MutationResolver interface {
 	MovePropertyItem(ctx context.Context, input gqlmodel.MovePropertyItemInput) (*gqlmodel.PropertyItemPayload, error)
 	RemovePropertyItem(ctx context.Context, input gqlmodel.RemovePropertyItemInput) (*gqlmodel.PropertyItemPayload, error)
 	UpdatePropertyItems(ctx context.Context, input gqlmodel.UpdatePropertyItemInput) (*gqlmodel.PropertyItemPayload, error)
+	RestoreSceneRevision(ctx context.Context, input gqlmodel.RestoreSceneRevisionInput) (*gqlmodel.RestoreSceneRevisionPayload, error)
 	CreateScene(ctx context.Context, input gqlmodel.CreateSceneInput) (*gqlmodel.CreateScenePayload, error)
 	CreateStory(ctx context.Context, input gqlmodel.CreateStoryInput) (*gqlmodel.StoryPayload, error)
 	UpdateStory(ctx context.Context, input gqlmodel.UpdateStoryInput) (*gqlmodel.StoryPayload, error)
@@ -1117,6 +1230,9 @@ type MutationResolver interface {
 	UpdateStyle(ctx context.Context, input gqlmodel.UpdateStyleInput) (*gqlmodel.UpdateStylePayload, error)
 	RemoveStyle(ctx context.Context, input gqlmodel.RemoveStyleInput) (*gqlmodel.RemoveStylePayload, error)
 	DuplicateStyle(ctx context.Context, input gqlmodel.DuplicateStyleInput) (*gqlmodel.DuplicateStylePayload, error)
+	CreateStylePreset(ctx context.Context, input gqlmodel.CreateStylePresetInput) (*gqlmodel.CreateStylePresetPayload, error)
+	UpdateStylePreset(ctx context.Context, input gqlmodel.UpdateStylePresetInput) (*gqlmodel.UpdateStylePresetPayload, error)
+	RemoveStylePreset(ctx context.Context, input gqlmodel.RemoveStylePresetInput) (*gqlmodel.RemoveStylePresetPayload, error)
 	Signup(ctx context.Context, input gqlmodel.SignupInput) (*gqlmodel.SignupPayload, error)
 	UpdateMe(ctx context.Context, input gqlmodel.UpdateMeInput) (*gqlmodel.UpdateMePayload, error)
 	RemoveMyAuth(ctx context.Context, input gqlmodel.RemoveMyAuthInput) (*gqlmodel.UpdateMePayload, error)
@@ -1209,7 +1325,11 @@ type QueryResolver interface {
 	DeletedProjects(ctx context.Context, teamID gqlmodel.ID) (*gqlmodel.ProjectConnection, error)
 	PropertySchema(ctx context.Context, id gqlmodel.ID) (*gqlmodel.PropertySchema, error)
 	PropertySchemas(ctx context.Context, id []gqlmodel.ID) ([]*gqlmodel.PropertySchema, error)
+	SceneRevisions(ctx context.Context, sceneID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.SceneRevisionConnection, error)
+	SceneRevisionDiff(ctx context.Context, from gqlmodel.ID, to *gqlmodel.ID) ([]*gqlmodel.SceneRevisionChange, error)
 	Scene(ctx context.Context, projectID gqlmodel.ID) (*gqlmodel.Scene, error)
+	ValidateStyle(ctx context.Context, layerID gqlmodel.ID, value gqlmodel.JSON) ([]*gqlmodel.StyleIssue, error)
+	StylePresets(ctx context.Context, teamID gqlmodel.ID) ([]*gqlmodel.StylePreset, error)
 	Me(ctx context.Context) (*gqlmodel.Me, error)
 	SearchUser(ctx context.Context, nameOrEmail string) (*gqlmodel.User, error)
 }
@@ -1221,10 +1341,19 @@ type SceneResolver interface {
 	Stories(ctx context.Context, obj *gqlmodel.Scene) ([]*gqlmodel.Story, error)
 	Styles(ctx context.Context, obj *gqlmodel.Scene) ([]*gqlmodel.Style, error)
 }
+type SceneEventResolver interface {
+	User(ctx context.Context, obj *gqlmodel.SceneEvent) (*gqlmodel.User, error)
+}
 type ScenePluginResolver interface {
 	Plugin(ctx context.Context, obj *gqlmodel.ScenePlugin) (*gqlmodel.Plugin, error)
 	Property(ctx context.Context, obj *gqlmodel.ScenePlugin) (*gqlmodel.Property, error)
 }
+type ScenePresenceResolver interface {
+	User(ctx context.Context, obj *gqlmodel.ScenePresence) (*gqlmodel.User, error)
+}
+type SceneRevisionResolver interface {
+	User(ctx context.Context, obj *gqlmodel.SceneRevision) (*gqlmodel.User, error)
+}
 type SceneWidgetResolver interface {
 	Plugin(ctx context.Context, obj *gqlmodel.SceneWidget) (*gqlmodel.Plugin, error)
 	Extension(ctx context.Context, obj *gqlmodel.SceneWidget) (*gqlmodel.PluginExtension, error)
@@ -1247,6 +1376,12 @@ type StoryPageResolver interface {
 }
 type StyleResolver interface {
 	Scene(ctx context.Context, obj *gqlmodel.Style) (*gqlmodel.Scene, error)
+
+	Preset(ctx context.Context, obj *gqlmodel.Style) (*gqlmodel.StylePreset, error)
+}
+type SubscriptionResolver interface {
+	SceneEvents(ctx context.Context, sceneID gqlmodel.ID) (<-chan *gqlmodel.SceneEvent, error)
+	ScenePresences(ctx context.Context, sceneID gqlmodel.ID) (<-chan []*gqlmodel.ScenePresence, error)
 }
 type TeamResolver interface {
 	Policy(ctx context.Context, obj *gqlmodel.Team) (*gqlmodel.Policy, error)
@@ -1542,6 +1677,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CreateStoryBlockPayload.Story(childComplexity), true
 
+	case "CreateStylePresetPayload.stylePreset":
+		if e.complexity.CreateStylePresetPayload.StylePreset == nil {
+			break
+		}
+
+		return e.complexity.CreateStylePresetPayload.StylePreset(childComplexity), true
+
 	case "CreateTeamPayload.team":
 		if e.complexity.CreateTeamPayload.Team == nil {
 			break
@@ -1675,6 +1817,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GeometryCollection.Type(childComplexity), true
 
+	case "ImportNLSLayerFilePayload.layer":
+		if e.complexity.ImportNLSLayerFilePayload.Layer == nil {
+			break
+		}
+
+		return e.complexity.ImportNLSLayerFilePayload.Layer(childComplexity), true
+
 	case "ImportProjectPayload.projectData":
 		if e.complexity.ImportProjectPayload.ProjectData == nil {
 			break
@@ -2376,6 +2525,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreateStoryPage(childComplexity, args["input"].(gqlmodel.CreateStoryPageInput)), true
 
+	case "Mutation.createStylePreset":
+		if e.complexity.Mutation.CreateStylePreset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createStylePreset_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateStylePreset(childComplexity, args["input"].(gqlmodel.CreateStylePresetInput)), true
+
 	case "Mutation.createTeam":
 		if e.complexity.Mutation.CreateTeam == nil {
 			break
@@ -2496,6 +2657,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ExportProject(childComplexity, args["input"].(gqlmodel.ExportProjectInput)), true
 
+	case "Mutation.importNLSLayerFile":
+		if e.complexity.Mutation.ImportNLSLayerFile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_importNLSLayerFile_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportNLSLayerFile(childComplexity, args["input"].(gqlmodel.ImportNLSLayerFileInput)), true
+
 	case "Mutation.importProject":
 		if e.complexity.Mutation.ImportProject == nil {
 			break
@@ -2772,6 +2945,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RemoveStyle(childComplexity, args["input"].(gqlmodel.RemoveStyleInput)), true
 
+	case "Mutation.removeStylePreset":
+		if e.complexity.Mutation.RemoveStylePreset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeStylePreset_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveStylePreset(childComplexity, args["input"].(gqlmodel.RemoveStylePresetInput)), true
+
 	case "Mutation.removeWidget":
 		if e.complexity.Mutation.RemoveWidget == nil {
 			break
@@ -2784,6 +2969,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RemoveWidget(childComplexity, args["input"].(gqlmodel.RemoveWidgetInput)), true
 
+	case "Mutation.restoreSceneRevision":
+		if e.complexity.Mutation.RestoreSceneRevision == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreSceneRevision_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreSceneRevision(childComplexity, args["input"].(gqlmodel.RestoreSceneRevisionInput)), true
+
 	case "Mutation.signup":
 		if e.complexity.Mutation.Signup == nil {
 			break
@@ -2940,6 +3137,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdatePropertyValue(childComplexity, args["input"].(gqlmodel.UpdatePropertyValueInput)), true
 
+	case "Mutation.updateScenePresence":
+		if e.complexity.Mutation.UpdateScenePresence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateScenePresence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateScenePresence(childComplexity, args["input"].(gqlmodel.UpdateScenePresenceInput)), true
+
 	case "Mutation.updateStory":
 		if e.complexity.Mutation.UpdateStory == nil {
 			break
@@ -2976,6 +3185,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateStyle(childComplexity, args["input"].(gqlmodel.UpdateStyleInput)), true
 
+	case "Mutation.updateStylePreset":
+		if e.complexity.Mutation.UpdateStylePreset == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateStylePreset_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateStylePreset(childComplexity, args["input"].(gqlmodel.UpdateStylePresetInput)), true
+
 	case "Mutation.updateTeam":
 		if e.complexity.Mutation.UpdateTeam == nil {
 			break
@@ -4663,6 +4884,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Scene(childComplexity, args["projectId"].(gqlmodel.ID)), true
 
+	case "Query.sceneRevisionDiff":
+		if e.complexity.Query.SceneRevisionDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sceneRevisionDiff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SceneRevisionDiff(childComplexity, args["from"].(gqlmodel.ID), args["to"].(*gqlmodel.ID)), true
+
+	case "Query.sceneRevisions":
+		if e.complexity.Query.SceneRevisions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sceneRevisions_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SceneRevisions(childComplexity, args["sceneId"].(gqlmodel.ID), args["pagination"].(*gqlmodel.Pagination)), true
+
 	case "Query.searchUser":
 		if e.complexity.Query.SearchUser == nil {
 			break
@@ -4687,6 +4932,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.StarredProjects(childComplexity, args["teamId"].(gqlmodel.ID)), true
 
+	case "Query.stylePresets":
+		if e.complexity.Query.StylePresets == nil {
+			break
+		}
+
+		args, err := ec.field_Query_stylePresets_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StylePresets(childComplexity, args["teamId"].(gqlmodel.ID)), true
+
+	case "Query.validateStyle":
+		if e.complexity.Query.ValidateStyle == nil {
+			break
+		}
+
+		args, err := ec.field_Query_validateStyle_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ValidateStyle(childComplexity, args["layerId"].(gqlmodel.ID), args["value"].(gqlmodel.JSON)), true
+
 	case "Rect.east":
 		if e.complexity.Rect.East == nil {
 			break
@@ -4792,6 +5061,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RemoveStylePayload.StyleID(childComplexity), true
 
+	case "RemoveStylePresetPayload.presetId":
+		if e.complexity.RemoveStylePresetPayload.PresetID == nil {
+			break
+		}
+
+		return e.complexity.RemoveStylePresetPayload.PresetID(childComplexity), true
+
 	case "RemoveWidgetPayload.scene":
 		if e.complexity.RemoveWidgetPayload.Scene == nil {
 			break
@@ -4806,6 +5082,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RemoveWidgetPayload.WidgetID(childComplexity), true
 
+	case "RestoreSceneRevisionPayload.revision":
+		if e.complexity.RestoreSceneRevisionPayload.Revision == nil {
+			break
+		}
+
+		return e.complexity.RestoreSceneRevisionPayload.Revision(childComplexity), true
+
 	case "Scene.createdAt":
 		if e.complexity.Scene.CreatedAt == nil {
 			break
@@ -4897,6 +5180,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Scene.UpdatedAt(childComplexity), true
 
+	case "Scene.version":
+		if e.complexity.Scene.Version == nil {
+			break
+		}
+
+		return e.complexity.Scene.Version(childComplexity), true
+
 	case "Scene.widgetAlignSystem":
 		if e.complexity.Scene.WidgetAlignSystem == nil {
 			break
@@ -4911,6 +5201,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Scene.Widgets(childComplexity), true
 
+	case "SceneEvent.action":
+		if e.complexity.SceneEvent.Action == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.Action(childComplexity), true
+
+	case "SceneEvent.changes":
+		if e.complexity.SceneEvent.Changes == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.Changes(childComplexity), true
+
+	case "SceneEvent.revisionId":
+		if e.complexity.SceneEvent.RevisionID == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.RevisionID(childComplexity), true
+
+	case "SceneEvent.sceneId":
+		if e.complexity.SceneEvent.SceneID == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.SceneID(childComplexity), true
+
+	case "SceneEvent.user":
+		if e.complexity.SceneEvent.User == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.User(childComplexity), true
+
+	case "SceneEvent.userId":
+		if e.complexity.SceneEvent.UserID == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.UserID(childComplexity), true
+
+	case "SceneEvent.version":
+		if e.complexity.SceneEvent.Version == nil {
+			break
+		}
+
+		return e.complexity.SceneEvent.Version(childComplexity), true
+
 	case "ScenePlugin.plugin":
 		if e.complexity.ScenePlugin.Plugin == nil {
 			break
@@ -4939,6 +5278,146 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScenePlugin.PropertyID(childComplexity), true
 
+	case "ScenePresence.layerId":
+		if e.complexity.ScenePresence.LayerID == nil {
+			break
+		}
+
+		return e.complexity.ScenePresence.LayerID(childComplexity), true
+
+	case "ScenePresence.sceneId":
+		if e.complexity.ScenePresence.SceneID == nil {
+			break
+		}
+
+		return e.complexity.ScenePresence.SceneID(childComplexity), true
+
+	case "ScenePresence.updatedAt":
+		if e.complexity.ScenePresence.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ScenePresence.UpdatedAt(childComplexity), true
+
+	case "ScenePresence.user":
+		if e.complexity.ScenePresence.User == nil {
+			break
+		}
+
+		return e.complexity.ScenePresence.User(childComplexity), true
+
+	case "ScenePresence.userId":
+		if e.complexity.ScenePresence.UserID == nil {
+			break
+		}
+
+		return e.complexity.ScenePresence.UserID(childComplexity), true
+
+	case "SceneRevision.action":
+		if e.complexity.SceneRevision.Action == nil {
+			break
+		}
+
+		return e.complexity.SceneRevision.Action(childComplexity), true
+
+	case "SceneRevision.createdAt":
+		if e.complexity.SceneRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SceneRevision.CreatedAt(childComplexity), true
+
+	case "SceneRevision.id":
+		if e.complexity.SceneRevision.ID == nil {
+			break
+		}
+
+		return e.complexity.SceneRevision.ID(childComplexity), true
+
+	case "SceneRevision.sceneId":
+		if e.complexity.SceneRevision.SceneID == nil {
+			break
+		}
+
+		return e.complexity.SceneRevision.SceneID(childComplexity), true
+
+	case "SceneRevision.user":
+		if e.complexity.SceneRevision.User == nil {
+			break
+		}
+
+		return e.complexity.SceneRevision.User(childComplexity), true
+
+	case "SceneRevision.userId":
+		if e.complexity.SceneRevision.UserID == nil {
+			break
+		}
+
+		return e.complexity.SceneRevision.UserID(childComplexity), true
+
+	case "SceneRevisionChange.id":
+		if e.complexity.SceneRevisionChange.ID == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionChange.ID(childComplexity), true
+
+	case "SceneRevisionChange.objectType":
+		if e.complexity.SceneRevisionChange.ObjectType == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionChange.ObjectType(childComplexity), true
+
+	case "SceneRevisionChange.type":
+		if e.complexity.SceneRevisionChange.Type == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionChange.Type(childComplexity), true
+
+	case "SceneRevisionConnection.edges":
+		if e.complexity.SceneRevisionConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionConnection.Edges(childComplexity), true
+
+	case "SceneRevisionConnection.nodes":
+		if e.complexity.SceneRevisionConnection.Nodes == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionConnection.Nodes(childComplexity), true
+
+	case "SceneRevisionConnection.pageInfo":
+		if e.complexity.SceneRevisionConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionConnection.PageInfo(childComplexity), true
+
+	case "SceneRevisionConnection.totalCount":
+		if e.complexity.SceneRevisionConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionConnection.TotalCount(childComplexity), true
+
+	case "SceneRevisionEdge.cursor":
+		if e.complexity.SceneRevisionEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionEdge.Cursor(childComplexity), true
+
+	case "SceneRevisionEdge.node":
+		if e.complexity.SceneRevisionEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SceneRevisionEdge.Node(childComplexity), true
+
 	case "SceneWidget.enabled":
 		if e.complexity.SceneWidget.Enabled == nil {
 			break
@@ -5380,6 +5859,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Style.Name(childComplexity), true
 
+	case "Style.preset":
+		if e.complexity.Style.Preset == nil {
+			break
+		}
+
+		return e.complexity.Style.Preset(childComplexity), true
+
+	case "Style.presetId":
+		if e.complexity.Style.PresetID == nil {
+			break
+		}
+
+		return e.complexity.Style.PresetID(childComplexity), true
+
 	case "Style.scene":
 		if e.complexity.Style.Scene == nil {
 			break
@@ -5401,6 +5894,114 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Style.Value(childComplexity), true
 
+	case "StyleIssue.attribute":
+		if e.complexity.StyleIssue.Attribute == nil {
+			break
+		}
+
+		return e.complexity.StyleIssue.Attribute(childComplexity), true
+
+	case "StyleIssue.message":
+		if e.complexity.StyleIssue.Message == nil {
+			break
+		}
+
+		return e.complexity.StyleIssue.Message(childComplexity), true
+
+	case "StyleIssue.path":
+		if e.complexity.StyleIssue.Path == nil {
+			break
+		}
+
+		return e.complexity.StyleIssue.Path(childComplexity), true
+
+	case "StyleIssue.suggestion":
+		if e.complexity.StyleIssue.Suggestion == nil {
+			break
+		}
+
+		return e.complexity.StyleIssue.Suggestion(childComplexity), true
+
+	case "StyleIssue.type":
+		if e.complexity.StyleIssue.Type == nil {
+			break
+		}
+
+		return e.complexity.StyleIssue.Type(childComplexity), true
+
+	case "StylePreset.createdAt":
+		if e.complexity.StylePreset.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.CreatedAt(childComplexity), true
+
+	case "StylePreset.description":
+		if e.complexity.StylePreset.Description == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.Description(childComplexity), true
+
+	case "StylePreset.id":
+		if e.complexity.StylePreset.ID == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.ID(childComplexity), true
+
+	case "StylePreset.name":
+		if e.complexity.StylePreset.Name == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.Name(childComplexity), true
+
+	case "StylePreset.teamId":
+		if e.complexity.StylePreset.TeamID == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.TeamID(childComplexity), true
+
+	case "StylePreset.updatedAt":
+		if e.complexity.StylePreset.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.UpdatedAt(childComplexity), true
+
+	case "StylePreset.value":
+		if e.complexity.StylePreset.Value == nil {
+			break
+		}
+
+		return e.complexity.StylePreset.Value(childComplexity), true
+
+	case "Subscription.sceneEvents":
+		if e.complexity.Subscription.SceneEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_sceneEvents_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SceneEvents(childComplexity, args["sceneId"].(gqlmodel.ID)), true
+
+	case "Subscription.scenePresences":
+		if e.complexity.Subscription.ScenePresences == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_scenePresences_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ScenePresences(childComplexity, args["sceneId"].(gqlmodel.ID)), true
+
 	case "Team.assets":
 		if e.complexity.Team.Assets == nil {
 			break
@@ -5621,6 +6222,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateNLSLayersPayload.Layers(childComplexity), true
 
+	case "UpdateScenePresencePayload.presences":
+		if e.complexity.UpdateScenePresencePayload.Presences == nil {
+			break
+		}
+
+		return e.complexity.UpdateScenePresencePayload.Presences(childComplexity), true
+
 	case "UpdateStylePayload.style":
 		if e.complexity.UpdateStylePayload.Style == nil {
 			break
@@ -5628,6 +6236,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.UpdateStylePayload.Style(childComplexity), true
 
+	case "UpdateStylePresetPayload.stylePreset":
+		if e.complexity.UpdateStylePresetPayload.StylePreset == nil {
+			break
+		}
+
+		return e.complexity.UpdateStylePresetPayload.StylePreset(childComplexity), true
+
 	case "UpdateTeamPayload.team":
 		if e.complexity.UpdateTeamPayload.Team == nil {
 			break
@@ -5933,6 +6548,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCreateStoryBlockInput,
 		ec.unmarshalInputCreateStoryInput,
 		ec.unmarshalInputCreateStoryPageInput,
+		ec.unmarshalInputCreateStylePresetInput,
 		ec.unmarshalInputCreateTeamInput,
 		ec.unmarshalInputDeleteGeoJSONFeatureInput,
 		ec.unmarshalInputDeleteMeInput,
@@ -5944,6 +6560,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputDuplicateStoryPageInput,
 		ec.unmarshalInputDuplicateStyleInput,
 		ec.unmarshalInputExportProjectInput,
+		ec.unmarshalInputImportNLSLayerFileInput,
 		ec.unmarshalInputImportProjectInput,
 		ec.unmarshalInputInstallPluginInput,
 		ec.unmarshalInputMoveNLSInfoboxBlockInput,
@@ -5968,7 +6585,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputRemovePropertyItemInput,
 		ec.unmarshalInputRemoveStoryBlockInput,
 		ec.unmarshalInputRemoveStyleInput,
+		ec.unmarshalInputRemoveStylePresetInput,
 		ec.unmarshalInputRemoveWidgetInput,
+		ec.unmarshalInputRestoreSceneRevisionInput,
 		ec.unmarshalInputSignupInput,
 		ec.unmarshalInputUninstallPluginInput,
 		ec.unmarshalInputUnlinkPropertyValueInput,
@@ -5983,9 +6602,11 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputUpdatePropertyItemInput,
 		ec.unmarshalInputUpdatePropertyItemOperationInput,
 		ec.unmarshalInputUpdatePropertyValueInput,
+		ec.unmarshalInputUpdateScenePresenceInput,
 		ec.unmarshalInputUpdateStoryInput,
 		ec.unmarshalInputUpdateStoryPageInput,
 		ec.unmarshalInputUpdateStyleInput,
+		ec.unmarshalInputUpdateStylePresetInput,
 		ec.unmarshalInputUpdateTeamInput,
 		ec.unmarshalInputUpdateWidgetAlignSystemInput,
 		ec.unmarshalInputUpdateWidgetInput,
@@ -6039,6 +6660,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -6213,9 +6851,12 @@ type Query {
 
 type Mutation
 
+type Subscription
+
 schema {
   query: Query
   mutation: Mutation
+  subscription: Subscription
 }
 `, BuiltIn: false},
 	{Name: "../../../gql/asset.graphql", Input: `type Asset implements Node {
@@ -6304,6 +6945,49 @@ extend type Mutation {
   updateAsset(input: UpdateAssetInput!): UpdateAssetPayload
   removeAsset(input: RemoveAssetInput!): RemoveAssetPayload
 }
+`, BuiltIn: false},
+	{Name: "../../../gql/collaboration.graphql", Input: `type SceneEvent {
+  sceneId: ID!
+  version: Int!
+  revisionId: ID!
+  action: String!
+  userId: ID
+  user: User
+  changes: [SceneRevisionChange!]!
+}
+
+type ScenePresence {
+  sceneId: ID!
+  userId: ID!
+  user: User
+  layerId: ID
+  updatedAt: DateTime!
+}
+
+# InputType
+
+input UpdateScenePresenceInput {
+  sceneId: ID!
+  # the layer the user is editing, or null when the user is not editing any layer
+  layerId: ID
+}
+
+# Payload
+
+type UpdateScenePresencePayload {
+  presences: [ScenePresence!]!
+}
+
+extend type Mutation {
+  # the user must be subscribing scenePresences of the scene
+  updateScenePresence(input: UpdateScenePresenceInput!): UpdateScenePresencePayload
+}
+
+extend type Subscription {
+  sceneEvents(sceneId: ID!): SceneEvent!
+  # the user is present in the scene while subscribing
+  scenePresences(sceneId: ID!): [ScenePresence!]!
+}
 `, BuiltIn: false},
 	{Name: "../../../gql/featureCollection.graphql", Input: `type Point {
     type: String!
@@ -6349,6 +7033,7 @@ input AddGeoJSONFeatureInput {
     geometry: JSON!
     properties: JSON
     layerId: ID!
+    version: Int
 }
 
 input UpdateGeoJSONFeatureInput {
@@ -6356,11 +7041,13 @@ input UpdateGeoJSONFeatureInput {
     geometry: JSON
     properties: JSON
     layerId: ID!
+    version: Int
 }
 
 input DeleteGeoJSONFeatureInput {
     featureId: ID!
     layerId: ID!
+    version: Int
 }
 
 type DeleteGeoJSONFeaturePayload {
@@ -6466,10 +7153,22 @@ input AddNLSLayerSimpleInput {
   index: Int
   visible: Boolean
   schema: JSON
+  version: Int
+}
+
+# file must be a KML, KMZ, zipped Shapefile or CZML file
+input ImportNLSLayerFileInput {
+  sceneId: ID!
+  file: Upload!
+  title: String
+  index: Int
+  visible: Boolean
+  version: Int
 }
 
 input RemoveNLSLayerInput {
   layerId: ID!
+  version: Int
 }
 
 input UpdateNLSLayerInput {
@@ -6478,26 +7177,33 @@ input UpdateNLSLayerInput {
   name: String
   visible: Boolean
   config: JSON
+  version: Int
 }
 
 input UpdateNLSLayersInput {
+  # versions of the layers are ignored
   layers: [UpdateNLSLayerInput!]!
+  version: Int
 }
 
 input CreateNLSInfoboxInput {
   layerId: ID!
+  version: Int
 }
 
 input RemoveNLSInfoboxInput {
   layerId: ID!
+  version: Int
 }
 
 input CreateNLSPhotoOverlayInput {
   layerId: ID!
+  version: Int
 }
 
 input RemoveNLSPhotoOverlayInput {
   layerId: ID!
+  version: Int
 }
 
 input AddNLSInfoboxBlockInput {
@@ -6505,26 +7211,31 @@ input AddNLSInfoboxBlockInput {
   pluginId: ID!
   extensionId: ID!
   index: Int
+  version: Int
 }
 
 input MoveNLSInfoboxBlockInput {
   layerId: ID!
   infoboxBlockId: ID!
   index: Int!
+  version: Int
 }
 
 input RemoveNLSInfoboxBlockInput {
   layerId: ID!
   infoboxBlockId: ID!
+  version: Int
 }
 
 input DuplicateNLSLayerInput {
   layerId: ID!
+  version: Int
 }
 
 input UpdateCustomPropertySchemaInput {
   layerId: ID!
   schema: JSON
+  version: Int
 }
 
 input ChangeCustomPropertyTitleInput {
@@ -6532,12 +7243,14 @@ input ChangeCustomPropertyTitleInput {
   schema: JSON
   oldTitle: String!
   newTitle: String!
+  version: Int
 }
 
 input RemoveCustomPropertyInput {
   layerId: ID!
   schema: JSON
   removedTitle: String!
+  version: Int
 }
 
 # Payload
@@ -6546,6 +7259,10 @@ type AddNLSLayerSimplePayload {
   layers: NLSLayerSimple!
 }
 
+type ImportNLSLayerFilePayload {
+  layer: NLSLayerSimple!
+}
+
 type RemoveNLSLayerPayload {
   layerId: ID!
 }
@@ -6596,6 +7313,9 @@ type DuplicateNLSLayerPayload {
 
 extend type Mutation {
   addNLSLayerSimple(input: AddNLSLayerSimpleInput!): AddNLSLayerSimplePayload!
+  importNLSLayerFile(
+    input: ImportNLSLayerFileInput!
+  ): ImportNLSLayerFilePayload!
   removeNLSLayer(input: RemoveNLSLayerInput!): RemoveNLSLayerPayload!
   updateNLSLayer(input: UpdateNLSLayerInput!): UpdateNLSLayerPayload!
   updateNLSLayers(input: UpdateNLSLayersInput!): UpdateNLSLayersPayload!
@@ -7095,6 +7815,7 @@ input UpdatePropertyValueInput {
   fieldId: ID!
   value: Any
   type: ValueType!
+  version: Int
 }
 
 input RemovePropertyFieldInput {
@@ -7102,6 +7823,7 @@ input RemovePropertyFieldInput {
   schemaGroupId: ID
   itemId: ID
   fieldId: ID!
+  version: Int
 }
 
 input UploadFileToPropertyInput {
@@ -7110,6 +7832,7 @@ input UploadFileToPropertyInput {
   itemId: ID
   fieldId: ID!
   file: Upload!
+  version: Int
 }
 
 input UnlinkPropertyValueInput {
@@ -7117,6 +7840,7 @@ input UnlinkPropertyValueInput {
   schemaGroupId: ID
   itemId: ID
   fieldId: ID!
+  version: Int
 }
 
 input AddPropertyItemInput {
@@ -7125,6 +7849,7 @@ input AddPropertyItemInput {
   index: Int
   nameFieldValue: Any
   nameFieldType: ValueType
+  version: Int
 }
 
 input MovePropertyItemInput {
@@ -7132,18 +7857,21 @@ input MovePropertyItemInput {
   schemaGroupId: ID!
   itemId: ID!
   index: Int!
+  version: Int
 }
 
 input RemovePropertyItemInput {
   propertyId: ID!
   schemaGroupId: ID!
   itemId: ID!
+  version: Int
 }
 
 input UpdatePropertyItemInput {
   propertyId: ID!
   schemaGroupId: ID!
   operations: [UpdatePropertyItemOperationInput!]!
+  version: Int
 }
 
 input UpdatePropertyItemOperationInput {
@@ -7181,6 +7909,73 @@ extend type Mutation {
   removePropertyItem(input: RemovePropertyItemInput!): PropertyItemPayload
   updatePropertyItems(input: UpdatePropertyItemInput!): PropertyItemPayload
 }
+`, BuiltIn: false},
+	{Name: "../../../gql/revision.graphql", Input: `type SceneRevision {
+  id: ID!
+  sceneId: ID!
+  action: String!
+  userId: ID
+  user: User
+  createdAt: DateTime!
+}
+
+type SceneRevisionChange {
+  objectType: SceneRevisionObjectType!
+  id: ID!
+  type: SceneRevisionChangeType!
+}
+
+enum SceneRevisionObjectType {
+  SCENE
+  NLS_LAYER
+  STYLE
+  STORY
+  PROPERTY
+}
+
+enum SceneRevisionChangeType {
+  ADDED
+  REMOVED
+  MODIFIED
+}
+
+# InputType
+
+input RestoreSceneRevisionInput {
+  revisionId: ID!
+  version: Int
+}
+
+# Connection
+
+type SceneRevisionConnection {
+  edges: [SceneRevisionEdge!]!
+  nodes: [SceneRevision]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+type SceneRevisionEdge {
+  cursor: Cursor!
+  node: SceneRevision
+}
+
+# Payload
+
+type RestoreSceneRevisionPayload {
+  revision: SceneRevision!
+}
+
+extend type Query {
+  # revisions are listed from the newest one
+  sceneRevisions(sceneId: ID!, pagination: Pagination): SceneRevisionConnection!
+  # the current state of the scene is compared when "to" is omitted
+  sceneRevisionDiff(from: ID!, to: ID): [SceneRevisionChange!]!
+}
+
+extend type Mutation {
+  restoreSceneRevision(input: RestoreSceneRevisionInput!): RestoreSceneRevisionPayload
+}
 `, BuiltIn: false},
 	{Name: "../../../gql/scene.graphql", Input: `type Scene implements Node {
   id: ID!
@@ -7189,6 +7984,8 @@ extend type Mutation {
   propertyId: ID!
   createdAt: DateTime!
   updatedAt: DateTime!
+  # incremented on every change of the scene, its layers, styles and stories
+  version: Int!
   widgets: [SceneWidget!]!
   plugins: [ScenePlugin!]!
   widgetAlignSystem: WidgetAlignSystem
@@ -7300,6 +8097,7 @@ input CreateStoryInput {
   sceneId: ID!
   title: String!
   index: Int
+  version: Int
 }
 
 input UpdateStoryInput {
@@ -7324,23 +8122,27 @@ input UpdateStoryInput {
   # Google Analytics
   enableGa: Boolean
   trackingId: String
+  version: Int
 }
 
 input MoveStoryInput {
   sceneId: ID!
   storyId: ID!
   index: Int!
+  version: Int
 }
 
 input DeleteStoryInput {
   sceneId: ID!
   storyId: ID!
+  version: Int
 }
 
 input PublishStoryInput {
   storyId: ID!
   alias: String
   status: PublishmentStatus!
+  version: Int
 }
 
 input CreateStoryPageInput {
@@ -7351,6 +8153,7 @@ input CreateStoryPageInput {
   layers: [ID!]
   swipeableLayers: [ID!]
   index: Int
+  version: Int
 }
 
 input UpdateStoryPageInput {
@@ -7362,24 +8165,28 @@ input UpdateStoryPageInput {
   layers: [ID!]
   swipeableLayers: [ID!]
   index: Int
+  version: Int
 }
 
 input MoveStoryPageInput {
   storyId: ID!
   pageId: ID!
   index: Int!
+  version: Int
 }
 
 input DuplicateStoryPageInput {
   sceneId: ID!
   storyId: ID!
   pageId: ID!
+  version: Int
 }
 
 input DeleteStoryPageInput {
   sceneId: ID!
   storyId: ID!
   pageId: ID!
+  version: Int
 }
 
 input PageLayerInput {
@@ -7388,6 +8195,7 @@ input PageLayerInput {
   pageId: ID!
   swipeable: Boolean
   layerId: ID!
+  version: Int
 }
 
 input CreateStoryBlockInput {
@@ -7396,6 +8204,7 @@ input CreateStoryBlockInput {
   pluginId: ID!
   extensionId: ID!
   index: Int
+  version: Int
 }
 
 input MoveStoryBlockInput {
@@ -7403,12 +8212,14 @@ input MoveStoryBlockInput {
   pageId: ID!
   blockId: ID!
   index: Int!
+  version: Int
 }
 
 input RemoveStoryBlockInput {
   storyId: ID!
   pageId: ID!
   blockId: ID!
+  version: Int
 }
 
 # Payload
@@ -7490,6 +8301,24 @@ extend type Mutation {
   value: JSON!
   sceneId: ID!
   scene: Scene
+  # the preset that the style is linked to, whose value is applied to the style when it is updated
+  presetId: ID
+  preset: StylePreset
+}
+
+# StyleIssue is a problem of an expression in a style value.
+type StyleIssue {
+  # location of the expression such as "3dtiles.color.expression.conditions[0][0]"
+  path: String!
+  type: StyleIssueType!
+  message: String!
+  attribute: String
+  suggestion: String
+}
+
+enum StyleIssueType {
+  SYNTAX
+  UNKNOWN_ATTRIBUTE
 }
 
 # InputType
@@ -7497,21 +8326,29 @@ extend type Mutation {
 input AddStyleInput {
   sceneId: ID!
   name: String!
-  value: JSON!
+  # either value or presetId is required
+  value: JSON
+  # links the style to the preset, and value is ignored
+  presetId: ID
+  version: Int
 }
 
 input UpdateStyleInput {
   styleId: ID!
   name: String
+  # unlinks the style from its preset
   value: JSON
+  version: Int
 }
 
 input RemoveStyleInput {
   styleId: ID!
+  version: Int
 }
 
 input DuplicateStyleInput {
   styleId: ID!
+  version: Int
 }
 
 # Payload
@@ -7532,7 +8369,14 @@ type DuplicateStylePayload {
   style: Style!
 }
 
-#extend type Query{ }
+extend type Query {
+  """
+  Returns issues of expressions in the style value.
+  Attributes referred by the expressions are checked only when the layer is a sketch layer, including layers imported from files.
+  Attributes of other layers are not checked, as the properties of their external data are unknown to the server.
+  """
+  validateStyle(layerId: ID!, value: JSON!): [StyleIssue!]!
+}
 
 extend type Mutation {
   addStyle(input: AddStyleInput!): AddStylePayload
@@ -7540,6 +8384,62 @@ extend type Mutation {
   removeStyle(input: RemoveStyleInput!): RemoveStylePayload
   duplicateStyle(input: DuplicateStyleInput!): DuplicateStylePayload
 }
+`, BuiltIn: false},
+	{Name: "../../../gql/style_preset.graphql", Input: `type StylePreset {
+  id: ID!
+  teamId: ID!
+  name: String!
+  description: String!
+  value: JSON!
+  createdAt: DateTime!
+  updatedAt: DateTime!
+}
+
+# InputType
+
+input CreateStylePresetInput {
+  teamId: ID!
+  name: String!
+  description: String
+  value: JSON!
+}
+
+input UpdateStylePresetInput {
+  presetId: ID!
+  name: String
+  description: String
+  # also applied to styles linked to the preset
+  value: JSON
+}
+
+input RemoveStylePresetInput {
+  presetId: ID!
+}
+
+# Payload
+
+type CreateStylePresetPayload {
+  stylePreset: StylePreset!
+}
+
+type UpdateStylePresetPayload {
+  stylePreset: StylePreset!
+}
+
+type RemoveStylePresetPayload {
+  presetId: ID!
+}
+
+extend type Query {
+  stylePresets(teamId: ID!): [StylePreset!]!
+}
+
+extend type Mutation {
+  createStylePreset(input: CreateStylePresetInput!): CreateStylePresetPayload
+  updateStylePreset(input: UpdateStylePresetInput!): UpdateStylePresetPayload
+  # styles linked to the preset keep its value
+  removeStylePreset(input: RemoveStylePresetInput!): RemoveStylePresetPayload
+}
 `, BuiltIn: false},
 	{Name: "../../../gql/user.graphql", Input: `type User implements Node {
   id: ID!
@@ -7706,6 +8606,7 @@ input AddWidgetInput {
   sceneId: ID!
   pluginId: ID!
   extensionId: ID!
+  version: Int
 }
 
 input UpdateWidgetInput {
@@ -7715,6 +8616,7 @@ input UpdateWidgetInput {
   location: WidgetLocationInput
   extended: Boolean
   index: Int
+  version: Int
 }
 
 input UpdateWidgetAlignSystemInput {
@@ -7725,6 +8627,7 @@ input UpdateWidgetAlignSystemInput {
   gap: Int
   centered: Boolean
   background: String
+  version: Int
 }
 
 input WidgetAreaPaddingInput {
@@ -7737,6 +8640,7 @@ input WidgetAreaPaddingInput {
 input RemoveWidgetInput {
   sceneId: ID!
   widgetId: ID!
+  version: Int
 }
 
 # Payload
@@ -8375,6 +9279,34 @@ func (ec *executionContext) field_Mutation_createStory_argsInput(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_createStylePreset_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_createStylePreset_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_createStylePreset_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.CreateStylePresetInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal gqlmodel.CreateStylePresetInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNCreateStylePresetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐCreateStylePresetInput(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.CreateStylePresetInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_createTeam_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -8655,6 +9587,34 @@ func (ec *executionContext) field_Mutation_exportProject_argsInput(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_importNLSLayerFile_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_importNLSLayerFile_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_importNLSLayerFile_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ImportNLSLayerFileInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal gqlmodel.ImportNLSLayerFileInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNImportNLSLayerFileInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐImportNLSLayerFileInput(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ImportNLSLayerFileInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_importProject_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -9271,6 +10231,34 @@ func (ec *executionContext) field_Mutation_removeStoryPage_argsInput(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_removeStylePreset_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_removeStylePreset_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_removeStylePreset_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.RemoveStylePresetInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal gqlmodel.RemoveStylePresetInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNRemoveStylePresetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRemoveStylePresetInput(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.RemoveStylePresetInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_removeStyle_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -9327,6 +10315,34 @@ func (ec *executionContext) field_Mutation_removeWidget_argsInput(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_restoreSceneRevision_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_restoreSceneRevision_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_restoreSceneRevision_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.RestoreSceneRevisionInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal gqlmodel.RestoreSceneRevisionInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNRestoreSceneRevisionInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRestoreSceneRevisionInput(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.RestoreSceneRevisionInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_signup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -9691,6 +10707,34 @@ func (ec *executionContext) field_Mutation_updatePropertyValue_argsInput(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_updateScenePresence_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateScenePresence_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateScenePresence_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.UpdateScenePresenceInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal gqlmodel.UpdateScenePresenceInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNUpdateScenePresenceInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateScenePresenceInput(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.UpdateScenePresenceInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_updateStoryPage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -9747,6 +10791,34 @@ func (ec *executionContext) field_Mutation_updateStory_argsInput(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_updateStylePreset_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Mutation_updateStylePreset_argsInput(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_updateStylePreset_argsInput(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.UpdateStylePresetInput, error) {
+	if _, ok := rawArgs["input"]; !ok {
+		var zeroVal gqlmodel.UpdateStylePresetInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+	if tmp, ok := rawArgs["input"]; ok {
+		return ec.unmarshalNUpdateStylePresetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateStylePresetInput(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.UpdateStylePresetInput
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_updateStyle_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -10766,6 +11838,108 @@ func (ec *executionContext) field_Query_propertySchemas_argsID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_sceneRevisionDiff_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_sceneRevisionDiff_argsFrom(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg0
+	arg1, err := ec.field_Query_sceneRevisionDiff_argsTo(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_sceneRevisionDiff_argsFrom(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ID, error) {
+	if _, ok := rawArgs["from"]; !ok {
+		var zeroVal gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+	if tmp, ok := rawArgs["from"]; ok {
+		return ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_sceneRevisionDiff_argsTo(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*gqlmodel.ID, error) {
+	if _, ok := rawArgs["to"]; !ok {
+		var zeroVal *gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+	if tmp, ok := rawArgs["to"]; ok {
+		return ec.unmarshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal *gqlmodel.ID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_sceneRevisions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_sceneRevisions_argsSceneID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["sceneId"] = arg0
+	arg1, err := ec.field_Query_sceneRevisions_argsPagination(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["pagination"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_sceneRevisions_argsSceneID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ID, error) {
+	if _, ok := rawArgs["sceneId"]; !ok {
+		var zeroVal gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+	if tmp, ok := rawArgs["sceneId"]; ok {
+		return ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_sceneRevisions_argsPagination(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (*gqlmodel.Pagination, error) {
+	if _, ok := rawArgs["pagination"]; !ok {
+		var zeroVal *gqlmodel.Pagination
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pagination"))
+	if tmp, ok := rawArgs["pagination"]; ok {
+		return ec.unmarshalOPagination2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPagination(ctx, tmp)
+	}
+
+	var zeroVal *gqlmodel.Pagination
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_scene_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -10850,6 +12024,141 @@ func (ec *executionContext) field_Query_starredProjects_argsTeamID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_stylePresets_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_stylePresets_argsTeamID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_stylePresets_argsTeamID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ID, error) {
+	if _, ok := rawArgs["teamId"]; !ok {
+		var zeroVal gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+	if tmp, ok := rawArgs["teamId"]; ok {
+		return ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_validateStyle_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Query_validateStyle_argsLayerID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["layerId"] = arg0
+	arg1, err := ec.field_Query_validateStyle_argsValue(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["value"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_validateStyle_argsLayerID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ID, error) {
+	if _, ok := rawArgs["layerId"]; !ok {
+		var zeroVal gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("layerId"))
+	if tmp, ok := rawArgs["layerId"]; ok {
+		return ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_validateStyle_argsValue(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.JSON, error) {
+	if _, ok := rawArgs["value"]; !ok {
+		var zeroVal gqlmodel.JSON
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+	if tmp, ok := rawArgs["value"]; ok {
+		return ec.unmarshalNJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.JSON
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_sceneEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Subscription_sceneEvents_argsSceneID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["sceneId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_sceneEvents_argsSceneID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ID, error) {
+	if _, ok := rawArgs["sceneId"]; !ok {
+		var zeroVal gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+	if tmp, ok := rawArgs["sceneId"]; ok {
+		return ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ID
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_scenePresences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := ec.field_Subscription_scenePresences_argsSceneID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["sceneId"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_scenePresences_argsSceneID(
+	ctx context.Context,
+	rawArgs map[string]any,
+) (gqlmodel.ID, error) {
+	if _, ok := rawArgs["sceneId"]; !ok {
+		var zeroVal gqlmodel.ID
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+	if tmp, ok := rawArgs["sceneId"]; ok {
+		return ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, tmp)
+	}
+
+	var zeroVal gqlmodel.ID
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Team_assets_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -11501,6 +12810,10 @@ func (ec *executionContext) fieldContext_AddStylePayload_style(_ context.Context
 				return ec.fieldContext_Style_sceneId(ctx, field)
 			case "scene":
 				return ec.fieldContext_Style_scene(ctx, field)
+			case "presetId":
+				return ec.fieldContext_Style_presetId(ctx, field)
+			case "preset":
+				return ec.fieldContext_Style_preset(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Style", field.Name)
 		},
@@ -11559,6 +12872,8 @@ func (ec *executionContext) fieldContext_AddWidgetPayload_scene(_ context.Contex
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -12934,6 +14249,8 @@ func (ec *executionContext) fieldContext_CreateScenePayload_scene(_ context.Cont
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -13223,6 +14540,66 @@ func (ec *executionContext) fieldContext_CreateStoryBlockPayload_index(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _CreateStylePresetPayload_stylePreset(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.CreateStylePresetPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CreateStylePresetPayload_stylePreset(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StylePreset, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.StylePreset)
+	fc.Result = res
+	return ec.marshalNStylePreset2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePreset(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CreateStylePresetPayload_stylePreset(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateStylePresetPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StylePreset_id(ctx, field)
+			case "teamId":
+				return ec.fieldContext_StylePreset_teamId(ctx, field)
+			case "name":
+				return ec.fieldContext_StylePreset_name(ctx, field)
+			case "description":
+				return ec.fieldContext_StylePreset_description(ctx, field)
+			case "value":
+				return ec.fieldContext_StylePreset_value(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StylePreset_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StylePreset_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StylePreset", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CreateTeamPayload_team(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.CreateTeamPayload) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CreateTeamPayload_team(ctx, field)
 	if err != nil {
@@ -13734,6 +15111,10 @@ func (ec *executionContext) fieldContext_DuplicateStylePayload_style(_ context.C
 				return ec.fieldContext_Style_sceneId(ctx, field)
 			case "scene":
 				return ec.fieldContext_Style_scene(ctx, field)
+			case "presetId":
+				return ec.fieldContext_Style_presetId(ctx, field)
+			case "preset":
+				return ec.fieldContext_Style_preset(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Style", field.Name)
 		},
@@ -14144,6 +15525,76 @@ func (ec *executionContext) fieldContext_GeometryCollection_geometries(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _ImportNLSLayerFilePayload_layer(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ImportNLSLayerFilePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ImportNLSLayerFilePayload_layer(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Layer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.NLSLayerSimple)
+	fc.Result = res
+	return ec.marshalNNLSLayerSimple2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐNLSLayerSimple(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ImportNLSLayerFilePayload_layer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ImportNLSLayerFilePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_NLSLayerSimple_id(ctx, field)
+			case "index":
+				return ec.fieldContext_NLSLayerSimple_index(ctx, field)
+			case "layerType":
+				return ec.fieldContext_NLSLayerSimple_layerType(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_NLSLayerSimple_sceneId(ctx, field)
+			case "config":
+				return ec.fieldContext_NLSLayerSimple_config(ctx, field)
+			case "title":
+				return ec.fieldContext_NLSLayerSimple_title(ctx, field)
+			case "visible":
+				return ec.fieldContext_NLSLayerSimple_visible(ctx, field)
+			case "infobox":
+				return ec.fieldContext_NLSLayerSimple_infobox(ctx, field)
+			case "photoOverlay":
+				return ec.fieldContext_NLSLayerSimple_photoOverlay(ctx, field)
+			case "scene":
+				return ec.fieldContext_NLSLayerSimple_scene(ctx, field)
+			case "isSketch":
+				return ec.fieldContext_NLSLayerSimple_isSketch(ctx, field)
+			case "sketch":
+				return ec.fieldContext_NLSLayerSimple_sketch(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NLSLayerSimple", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _ImportProjectPayload_projectData(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ImportProjectPayload) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_ImportProjectPayload_projectData(ctx, field)
 	if err != nil {
@@ -14705,6 +16156,8 @@ func (ec *executionContext) fieldContext_InfoboxBlock_scene(_ context.Context, f
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -14781,6 +16234,8 @@ func (ec *executionContext) fieldContext_InstallPluginPayload_scene(_ context.Co
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -17962,6 +19417,62 @@ func (ec *executionContext) fieldContext_Mutation_removeAsset(ctx context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_updateScenePresence(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateScenePresence(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateScenePresence(rctx, fc.Args["input"].(gqlmodel.UpdateScenePresenceInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.UpdateScenePresencePayload)
+	fc.Result = res
+	return ec.marshalOUpdateScenePresencePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateScenePresencePayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateScenePresence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "presences":
+				return ec.fieldContext_UpdateScenePresencePayload_presences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdateScenePresencePayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateScenePresence_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_addGeoJSONFeature(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_addGeoJSONFeature(ctx, field)
 	if err != nil {
@@ -18210,6 +19721,65 @@ func (ec *executionContext) fieldContext_Mutation_addNLSLayerSimple(ctx context.
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_importNLSLayerFile(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_importNLSLayerFile(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ImportNLSLayerFile(rctx, fc.Args["input"].(gqlmodel.ImportNLSLayerFileInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.ImportNLSLayerFilePayload)
+	fc.Result = res
+	return ec.marshalNImportNLSLayerFilePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐImportNLSLayerFilePayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_importNLSLayerFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "layer":
+				return ec.fieldContext_ImportNLSLayerFilePayload_layer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ImportNLSLayerFilePayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_importNLSLayerFile_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_removeNLSLayer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_removeNLSLayer(ctx, field)
 	if err != nil {
@@ -20057,6 +21627,62 @@ func (ec *executionContext) fieldContext_Mutation_updatePropertyItems(ctx contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_restoreSceneRevision(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_restoreSceneRevision(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RestoreSceneRevision(rctx, fc.Args["input"].(gqlmodel.RestoreSceneRevisionInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.RestoreSceneRevisionPayload)
+	fc.Result = res
+	return ec.marshalORestoreSceneRevisionPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRestoreSceneRevisionPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_restoreSceneRevision(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "revision":
+				return ec.fieldContext_RestoreSceneRevisionPayload_revision(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RestoreSceneRevisionPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_restoreSceneRevision_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_createScene(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_createScene(ctx, field)
 	if err != nil {
@@ -21258,6 +22884,174 @@ func (ec *executionContext) fieldContext_Mutation_duplicateStyle(ctx context.Con
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_createStylePreset(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createStylePreset(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateStylePreset(rctx, fc.Args["input"].(gqlmodel.CreateStylePresetInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.CreateStylePresetPayload)
+	fc.Result = res
+	return ec.marshalOCreateStylePresetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐCreateStylePresetPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createStylePreset(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stylePreset":
+				return ec.fieldContext_CreateStylePresetPayload_stylePreset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateStylePresetPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createStylePreset_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateStylePreset(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateStylePreset(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateStylePreset(rctx, fc.Args["input"].(gqlmodel.UpdateStylePresetInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.UpdateStylePresetPayload)
+	fc.Result = res
+	return ec.marshalOUpdateStylePresetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateStylePresetPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateStylePreset(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stylePreset":
+				return ec.fieldContext_UpdateStylePresetPayload_stylePreset(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UpdateStylePresetPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateStylePreset_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_removeStylePreset(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removeStylePreset(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveStylePreset(rctx, fc.Args["input"].(gqlmodel.RemoveStylePresetInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.RemoveStylePresetPayload)
+	fc.Result = res
+	return ec.marshalORemoveStylePresetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRemoveStylePresetPayload(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_removeStylePreset(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "presetId":
+				return ec.fieldContext_RemoveStylePresetPayload_presetId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RemoveStylePresetPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeStylePreset_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_signup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_signup(ctx, field)
 	if err != nil {
@@ -22393,6 +24187,8 @@ func (ec *executionContext) fieldContext_NLSInfobox_scene(_ context.Context, fie
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -22968,6 +24764,8 @@ func (ec *executionContext) fieldContext_NLSLayerGroup_scene(_ context.Context,
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -23546,6 +25344,8 @@ func (ec *executionContext) fieldContext_NLSLayerSimple_scene(_ context.Context,
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -23939,6 +25739,8 @@ func (ec *executionContext) fieldContext_NLSPhotoOverlay_scene(_ context.Context
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -24752,6 +26554,8 @@ func (ec *executionContext) fieldContext_Plugin_scene(_ context.Context, field g
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -27294,6 +29098,8 @@ func (ec *executionContext) fieldContext_Project_scene(_ context.Context, field
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -32683,6 +34489,134 @@ func (ec *executionContext) fieldContext_Query_propertySchemas(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_sceneRevisions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sceneRevisions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SceneRevisions(rctx, fc.Args["sceneId"].(gqlmodel.ID), fc.Args["pagination"].(*gqlmodel.Pagination))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.SceneRevisionConnection)
+	fc.Result = res
+	return ec.marshalNSceneRevisionConnection2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sceneRevisions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_SceneRevisionConnection_edges(ctx, field)
+			case "nodes":
+				return ec.fieldContext_SceneRevisionConnection_nodes(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SceneRevisionConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_SceneRevisionConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevisionConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sceneRevisions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sceneRevisionDiff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sceneRevisionDiff(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SceneRevisionDiff(rctx, fc.Args["from"].(gqlmodel.ID), fc.Args["to"].(*gqlmodel.ID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqlmodel.SceneRevisionChange)
+	fc.Result = res
+	return ec.marshalNSceneRevisionChange2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChangeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sceneRevisionDiff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "objectType":
+				return ec.fieldContext_SceneRevisionChange_objectType(ctx, field)
+			case "id":
+				return ec.fieldContext_SceneRevisionChange_id(ctx, field)
+			case "type":
+				return ec.fieldContext_SceneRevisionChange_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevisionChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sceneRevisionDiff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_scene(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_scene(ctx, field)
 	if err != nil {
@@ -32731,6 +34665,8 @@ func (ec *executionContext) fieldContext_Query_scene(ctx context.Context, field
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -32767,6 +34703,144 @@ func (ec *executionContext) fieldContext_Query_scene(ctx context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_validateStyle(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_validateStyle(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ValidateStyle(rctx, fc.Args["layerId"].(gqlmodel.ID), fc.Args["value"].(gqlmodel.JSON))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqlmodel.StyleIssue)
+	fc.Result = res
+	return ec.marshalNStyleIssue2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_validateStyle(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_StyleIssue_path(ctx, field)
+			case "type":
+				return ec.fieldContext_StyleIssue_type(ctx, field)
+			case "message":
+				return ec.fieldContext_StyleIssue_message(ctx, field)
+			case "attribute":
+				return ec.fieldContext_StyleIssue_attribute(ctx, field)
+			case "suggestion":
+				return ec.fieldContext_StyleIssue_suggestion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StyleIssue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_validateStyle_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_stylePresets(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_stylePresets(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().StylePresets(rctx, fc.Args["teamId"].(gqlmodel.ID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqlmodel.StylePreset)
+	fc.Result = res
+	return ec.marshalNStylePreset2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePresetᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_stylePresets(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StylePreset_id(ctx, field)
+			case "teamId":
+				return ec.fieldContext_StylePreset_teamId(ctx, field)
+			case "name":
+				return ec.fieldContext_StylePreset_name(ctx, field)
+			case "description":
+				return ec.fieldContext_StylePreset_description(ctx, field)
+			case "value":
+				return ec.fieldContext_StylePreset_value(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StylePreset_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StylePreset_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StylePreset", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_stylePresets_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_me(ctx, field)
 	if err != nil {
@@ -33771,6 +35845,50 @@ func (ec *executionContext) fieldContext_RemoveStylePayload_styleId(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _RemoveStylePresetPayload_presetId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.RemoveStylePresetPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RemoveStylePresetPayload_presetId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PresetID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RemoveStylePresetPayload_presetId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RemoveStylePresetPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _RemoveWidgetPayload_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.RemoveWidgetPayload) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_RemoveWidgetPayload_scene(ctx, field)
 	if err != nil {
@@ -33822,6 +35940,8 @@ func (ec *executionContext) fieldContext_RemoveWidgetPayload_scene(_ context.Con
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -33891,6 +36011,64 @@ func (ec *executionContext) fieldContext_RemoveWidgetPayload_widgetId(_ context.
 	return fc, nil
 }
 
+func (ec *executionContext) _RestoreSceneRevisionPayload_revision(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.RestoreSceneRevisionPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RestoreSceneRevisionPayload_revision(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revision, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.SceneRevision)
+	fc.Result = res
+	return ec.marshalNSceneRevision2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RestoreSceneRevisionPayload_revision(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RestoreSceneRevisionPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SceneRevision_id(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_SceneRevision_sceneId(ctx, field)
+			case "action":
+				return ec.fieldContext_SceneRevision_action(ctx, field)
+			case "userId":
+				return ec.fieldContext_SceneRevision_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_SceneRevision_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SceneRevision_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevision", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Scene_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Scene) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Scene_id(ctx, field)
 	if err != nil {
@@ -34155,6 +36333,50 @@ func (ec *executionContext) fieldContext_Scene_updatedAt(_ context.Context, fiel
 	return fc, nil
 }
 
+func (ec *executionContext) _Scene_version(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Scene) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Scene_version(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Scene_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Scene_widgets(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Scene) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Scene_widgets(ctx, field)
 	if err != nil {
@@ -34712,6 +36934,10 @@ func (ec *executionContext) fieldContext_Scene_styles(_ context.Context, field g
 				return ec.fieldContext_Style_sceneId(ctx, field)
 			case "scene":
 				return ec.fieldContext_Style_scene(ctx, field)
+			case "presetId":
+				return ec.fieldContext_Style_presetId(ctx, field)
+			case "preset":
+				return ec.fieldContext_Style_preset(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Style", field.Name)
 		},
@@ -34719,8 +36945,8 @@ func (ec *executionContext) fieldContext_Scene_styles(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _ScenePlugin_pluginId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ScenePlugin_pluginId(ctx, field)
+func (ec *executionContext) _SceneEvent_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_sceneId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -34733,7 +36959,7 @@ func (ec *executionContext) _ScenePlugin_pluginId(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PluginID, nil
+		return obj.SceneID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -34750,9 +36976,9 @@ func (ec *executionContext) _ScenePlugin_pluginId(ctx context.Context, field gra
 	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ScenePlugin_pluginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneEvent_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ScenePlugin",
+		Object:     "SceneEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -34763,8 +36989,8 @@ func (ec *executionContext) fieldContext_ScenePlugin_pluginId(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ScenePlugin_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ScenePlugin_propertyId(ctx, field)
+func (ec *executionContext) _SceneEvent_version(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -34777,23 +37003,70 @@ func (ec *executionContext) _ScenePlugin_propertyId(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PropertyID, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.ID)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ScenePlugin_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneEvent_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ScenePlugin",
+		Object:     "SceneEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SceneEvent_revisionId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_revisionId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RevisionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SceneEvent_revisionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SceneEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -34804,8 +37077,8 @@ func (ec *executionContext) fieldContext_ScenePlugin_propertyId(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _ScenePlugin_plugin(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ScenePlugin_plugin(ctx, field)
+func (ec *executionContext) _SceneEvent_action(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_action(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -34818,69 +37091,38 @@ func (ec *executionContext) _ScenePlugin_plugin(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ScenePlugin().Plugin(rctx, obj)
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Plugin)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOPlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPlugin(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ScenePlugin_plugin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneEvent_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ScenePlugin",
+		Object:     "SceneEvent",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Plugin_id(ctx, field)
-			case "sceneId":
-				return ec.fieldContext_Plugin_sceneId(ctx, field)
-			case "name":
-				return ec.fieldContext_Plugin_name(ctx, field)
-			case "version":
-				return ec.fieldContext_Plugin_version(ctx, field)
-			case "description":
-				return ec.fieldContext_Plugin_description(ctx, field)
-			case "author":
-				return ec.fieldContext_Plugin_author(ctx, field)
-			case "repositoryUrl":
-				return ec.fieldContext_Plugin_repositoryUrl(ctx, field)
-			case "propertySchemaId":
-				return ec.fieldContext_Plugin_propertySchemaId(ctx, field)
-			case "extensions":
-				return ec.fieldContext_Plugin_extensions(ctx, field)
-			case "scenePlugin":
-				return ec.fieldContext_Plugin_scenePlugin(ctx, field)
-			case "allTranslatedDescription":
-				return ec.fieldContext_Plugin_allTranslatedDescription(ctx, field)
-			case "allTranslatedName":
-				return ec.fieldContext_Plugin_allTranslatedName(ctx, field)
-			case "scene":
-				return ec.fieldContext_Plugin_scene(ctx, field)
-			case "translatedName":
-				return ec.fieldContext_Plugin_translatedName(ctx, field)
-			case "translatedDescription":
-				return ec.fieldContext_Plugin_translatedDescription(ctx, field)
-			case "propertySchema":
-				return ec.fieldContext_Plugin_propertySchema(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Plugin", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ScenePlugin_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ScenePlugin_property(ctx, field)
+func (ec *executionContext) _SceneEvent_userId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -34893,7 +37135,7 @@ func (ec *executionContext) _ScenePlugin_property(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ScenePlugin().Property(rctx, obj)
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -34902,38 +37144,77 @@ func (ec *executionContext) _ScenePlugin_property(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Property)
+	res := resTmp.(*gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ScenePlugin_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneEvent_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ScenePlugin",
+		Object:     "SceneEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SceneEvent_user(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_user(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SceneEvent().User(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SceneEvent_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SceneEvent",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Property_id(ctx, field)
-			case "schemaId":
-				return ec.fieldContext_Property_schemaId(ctx, field)
-			case "items":
-				return ec.fieldContext_Property_items(ctx, field)
-			case "schema":
-				return ec.fieldContext_Property_schema(ctx, field)
-			case "merged":
-				return ec.fieldContext_Property_merged(ctx, field)
+				return ec.fieldContext_User_id(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "host":
+				return ec.fieldContext_User_host(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_id(ctx, field)
+func (ec *executionContext) _SceneEvent_changes(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneEvent_changes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -34946,7 +37227,7 @@ func (ec *executionContext) _SceneWidget_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Changes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -34958,26 +37239,34 @@ func (ec *executionContext) _SceneWidget_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.([]*gqlmodel.SceneRevisionChange)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNSceneRevisionChange2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneEvent_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "SceneEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "objectType":
+				return ec.fieldContext_SceneRevisionChange_objectType(ctx, field)
+			case "id":
+				return ec.fieldContext_SceneRevisionChange_id(ctx, field)
+			case "type":
+				return ec.fieldContext_SceneRevisionChange_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevisionChange", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_pluginId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_pluginId(ctx, field)
+func (ec *executionContext) _ScenePlugin_pluginId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePlugin_pluginId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35007,9 +37296,9 @@ func (ec *executionContext) _SceneWidget_pluginId(ctx context.Context, field gra
 	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_pluginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePlugin_pluginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePlugin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -35020,8 +37309,8 @@ func (ec *executionContext) fieldContext_SceneWidget_pluginId(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_extensionId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_extensionId(ctx, field)
+func (ec *executionContext) _ScenePlugin_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePlugin_propertyId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35034,26 +37323,23 @@ func (ec *executionContext) _SceneWidget_extensionId(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExtensionID, nil
+		return obj.PropertyID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(*gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_extensionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePlugin_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePlugin",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -35064,8 +37350,8 @@ func (ec *executionContext) fieldContext_SceneWidget_extensionId(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_propertyId(ctx, field)
+func (ec *executionContext) _ScenePlugin_plugin(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePlugin_plugin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35078,38 +37364,69 @@ func (ec *executionContext) _SceneWidget_propertyId(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PropertyID, nil
+		return ec.resolvers.ScenePlugin().Plugin(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(*gqlmodel.Plugin)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalOPlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPlugin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePlugin_plugin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePlugin",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Plugin_id(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_Plugin_sceneId(ctx, field)
+			case "name":
+				return ec.fieldContext_Plugin_name(ctx, field)
+			case "version":
+				return ec.fieldContext_Plugin_version(ctx, field)
+			case "description":
+				return ec.fieldContext_Plugin_description(ctx, field)
+			case "author":
+				return ec.fieldContext_Plugin_author(ctx, field)
+			case "repositoryUrl":
+				return ec.fieldContext_Plugin_repositoryUrl(ctx, field)
+			case "propertySchemaId":
+				return ec.fieldContext_Plugin_propertySchemaId(ctx, field)
+			case "extensions":
+				return ec.fieldContext_Plugin_extensions(ctx, field)
+			case "scenePlugin":
+				return ec.fieldContext_Plugin_scenePlugin(ctx, field)
+			case "allTranslatedDescription":
+				return ec.fieldContext_Plugin_allTranslatedDescription(ctx, field)
+			case "allTranslatedName":
+				return ec.fieldContext_Plugin_allTranslatedName(ctx, field)
+			case "scene":
+				return ec.fieldContext_Plugin_scene(ctx, field)
+			case "translatedName":
+				return ec.fieldContext_Plugin_translatedName(ctx, field)
+			case "translatedDescription":
+				return ec.fieldContext_Plugin_translatedDescription(ctx, field)
+			case "propertySchema":
+				return ec.fieldContext_Plugin_propertySchema(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Plugin", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_enabled(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_enabled(ctx, field)
+func (ec *executionContext) _ScenePlugin_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePlugin) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePlugin_property(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35122,38 +37439,47 @@ func (ec *executionContext) _SceneWidget_enabled(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Enabled, nil
+		return ec.resolvers.ScenePlugin().Property(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*gqlmodel.Property)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePlugin_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePlugin",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Property_id(ctx, field)
+			case "schemaId":
+				return ec.fieldContext_Property_schemaId(ctx, field)
+			case "items":
+				return ec.fieldContext_Property_items(ctx, field)
+			case "schema":
+				return ec.fieldContext_Property_schema(ctx, field)
+			case "merged":
+				return ec.fieldContext_Property_merged(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_extended(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_extended(ctx, field)
+func (ec *executionContext) _ScenePresence_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePresence) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePresence_sceneId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35166,7 +37492,7 @@ func (ec *executionContext) _SceneWidget_extended(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Extended, nil
+		return obj.SceneID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35178,26 +37504,26 @@ func (ec *executionContext) _SceneWidget_extended(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_extended(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePresence_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePresence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_plugin(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_plugin(ctx, field)
+func (ec *executionContext) _ScenePresence_userId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePresence) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePresence_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35210,69 +37536,38 @@ func (ec *executionContext) _SceneWidget_plugin(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SceneWidget().Plugin(rctx, obj)
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Plugin)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOPlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPlugin(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_plugin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePresence_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePresence",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Plugin_id(ctx, field)
-			case "sceneId":
-				return ec.fieldContext_Plugin_sceneId(ctx, field)
-			case "name":
-				return ec.fieldContext_Plugin_name(ctx, field)
-			case "version":
-				return ec.fieldContext_Plugin_version(ctx, field)
-			case "description":
-				return ec.fieldContext_Plugin_description(ctx, field)
-			case "author":
-				return ec.fieldContext_Plugin_author(ctx, field)
-			case "repositoryUrl":
-				return ec.fieldContext_Plugin_repositoryUrl(ctx, field)
-			case "propertySchemaId":
-				return ec.fieldContext_Plugin_propertySchemaId(ctx, field)
-			case "extensions":
-				return ec.fieldContext_Plugin_extensions(ctx, field)
-			case "scenePlugin":
-				return ec.fieldContext_Plugin_scenePlugin(ctx, field)
-			case "allTranslatedDescription":
-				return ec.fieldContext_Plugin_allTranslatedDescription(ctx, field)
-			case "allTranslatedName":
-				return ec.fieldContext_Plugin_allTranslatedName(ctx, field)
-			case "scene":
-				return ec.fieldContext_Plugin_scene(ctx, field)
-			case "translatedName":
-				return ec.fieldContext_Plugin_translatedName(ctx, field)
-			case "translatedDescription":
-				return ec.fieldContext_Plugin_translatedDescription(ctx, field)
-			case "propertySchema":
-				return ec.fieldContext_Plugin_propertySchema(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Plugin", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_extension(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_extension(ctx, field)
+func (ec *executionContext) _ScenePresence_user(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePresence) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePresence_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35285,7 +37580,7 @@ func (ec *executionContext) _SceneWidget_extension(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SceneWidget().Extension(rctx, obj)
+		return ec.resolvers.ScenePresence().User(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35294,62 +37589,36 @@ func (ec *executionContext) _SceneWidget_extension(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.PluginExtension)
+	res := resTmp.(*gqlmodel.User)
 	fc.Result = res
-	return ec.marshalOPluginExtension2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPluginExtension(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_extension(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePresence_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePresence",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "extensionId":
-				return ec.fieldContext_PluginExtension_extensionId(ctx, field)
-			case "pluginId":
-				return ec.fieldContext_PluginExtension_pluginId(ctx, field)
-			case "type":
-				return ec.fieldContext_PluginExtension_type(ctx, field)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
 			case "name":
-				return ec.fieldContext_PluginExtension_name(ctx, field)
-			case "description":
-				return ec.fieldContext_PluginExtension_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_PluginExtension_icon(ctx, field)
-			case "singleOnly":
-				return ec.fieldContext_PluginExtension_singleOnly(ctx, field)
-			case "widgetLayout":
-				return ec.fieldContext_PluginExtension_widgetLayout(ctx, field)
-			case "visualizer":
-				return ec.fieldContext_PluginExtension_visualizer(ctx, field)
-			case "propertySchemaId":
-				return ec.fieldContext_PluginExtension_propertySchemaId(ctx, field)
-			case "allTranslatedName":
-				return ec.fieldContext_PluginExtension_allTranslatedName(ctx, field)
-			case "allTranslatedDescription":
-				return ec.fieldContext_PluginExtension_allTranslatedDescription(ctx, field)
-			case "plugin":
-				return ec.fieldContext_PluginExtension_plugin(ctx, field)
-			case "sceneWidget":
-				return ec.fieldContext_PluginExtension_sceneWidget(ctx, field)
-			case "propertySchema":
-				return ec.fieldContext_PluginExtension_propertySchema(ctx, field)
-			case "translatedName":
-				return ec.fieldContext_PluginExtension_translatedName(ctx, field)
-			case "translatedDescription":
-				return ec.fieldContext_PluginExtension_translatedDescription(ctx, field)
+				return ec.fieldContext_User_name(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "host":
+				return ec.fieldContext_User_host(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PluginExtension", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SceneWidget_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SceneWidget_property(ctx, field)
+func (ec *executionContext) _ScenePresence_layerId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePresence) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePresence_layerId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35362,7 +37631,7 @@ func (ec *executionContext) _SceneWidget_property(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SceneWidget().Property(rctx, obj)
+		return obj.LayerID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35371,38 +37640,26 @@ func (ec *executionContext) _SceneWidget_property(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Property)
+	res := resTmp.(*gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SceneWidget_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePresence_layerId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SceneWidget",
+		Object:     "ScenePresence",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Property_id(ctx, field)
-			case "schemaId":
-				return ec.fieldContext_Property_schemaId(ctx, field)
-			case "items":
-				return ec.fieldContext_Property_items(ctx, field)
-			case "schema":
-				return ec.fieldContext_Property_schema(ctx, field)
-			case "merged":
-				return ec.fieldContext_Property_merged(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SignupPayload_user(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SignupPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SignupPayload_user(ctx, field)
+func (ec *executionContext) _ScenePresence_updatedAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.ScenePresence) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ScenePresence_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35415,7 +37672,7 @@ func (ec *executionContext) _SignupPayload_user(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.User, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35427,36 +37684,26 @@ func (ec *executionContext) _SignupPayload_user(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.User)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNUser2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUser(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SignupPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ScenePresence_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SignupPayload",
+		Object:     "ScenePresence",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "name":
-				return ec.fieldContext_User_name(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "host":
-				return ec.fieldContext_User_host(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SignupPayload_team(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SignupPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SignupPayload_team(ctx, field)
+func (ec *executionContext) _SceneRevision_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevision_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35469,7 +37716,7 @@ func (ec *executionContext) _SignupPayload_team(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Team, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35481,44 +37728,26 @@ func (ec *executionContext) _SignupPayload_team(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Team)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalNTeam2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐTeam(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SignupPayload_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevision_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SignupPayload",
+		Object:     "SceneRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Team_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Team_name(ctx, field)
-			case "members":
-				return ec.fieldContext_Team_members(ctx, field)
-			case "personal":
-				return ec.fieldContext_Team_personal(ctx, field)
-			case "policyId":
-				return ec.fieldContext_Team_policyId(ctx, field)
-			case "policy":
-				return ec.fieldContext_Team_policy(ctx, field)
-			case "assets":
-				return ec.fieldContext_Team_assets(ctx, field)
-			case "projects":
-				return ec.fieldContext_Team_projects(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SketchInfo_customPropertySchema(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SketchInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SketchInfo_customPropertySchema(ctx, field)
+func (ec *executionContext) _SceneRevision_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevision_sceneId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35531,35 +37760,38 @@ func (ec *executionContext) _SketchInfo_customPropertySchema(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CustomPropertySchema, nil
+		return obj.SceneID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.JSON)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SketchInfo_customPropertySchema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevision_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SketchInfo",
+		Object:     "SceneRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JSON does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SketchInfo_featureCollection(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SketchInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SketchInfo_featureCollection(ctx, field)
+func (ec *executionContext) _SceneRevision_action(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevision_action(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35572,41 +37804,38 @@ func (ec *executionContext) _SketchInfo_featureCollection(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeatureCollection, nil
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.FeatureCollection)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFeatureCollection2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐFeatureCollection(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SketchInfo_featureCollection(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevision_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SketchInfo",
+		Object:     "SceneRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "type":
-				return ec.fieldContext_FeatureCollection_type(ctx, field)
-			case "features":
-				return ec.fieldContext_FeatureCollection_features(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FeatureCollection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Spacing_top(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Spacing_top(ctx, field)
+func (ec *executionContext) _SceneRevision_userId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevision_userId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35619,38 +37848,35 @@ func (ec *executionContext) _Spacing_top(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Top, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Spacing_top(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevision_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Spacing",
+		Object:     "SceneRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Spacing_bottom(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Spacing_bottom(ctx, field)
+func (ec *executionContext) _SceneRevision_user(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevision_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35663,38 +37889,45 @@ func (ec *executionContext) _Spacing_bottom(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Bottom, nil
+		return ec.resolvers.SceneRevision().User(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*gqlmodel.User)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOUser2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Spacing_bottom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevision_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Spacing",
+		Object:     "SceneRevision",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "host":
+				return ec.fieldContext_User_host(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Spacing_left(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Spacing_left(ctx, field)
+func (ec *executionContext) _SceneRevision_createdAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevision) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevision_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35707,7 +37940,7 @@ func (ec *executionContext) _Spacing_left(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Left, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35719,26 +37952,26 @@ func (ec *executionContext) _Spacing_left(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Spacing_left(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevision_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Spacing",
+		Object:     "SceneRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Spacing_right(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Spacing_right(ctx, field)
+func (ec *executionContext) _SceneRevisionChange_objectType(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionChange_objectType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35751,7 +37984,7 @@ func (ec *executionContext) _Spacing_right(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Right, nil
+		return obj.ObjectType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35763,26 +37996,26 @@ func (ec *executionContext) _Spacing_right(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(gqlmodel.SceneRevisionObjectType)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNSceneRevisionObjectType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionObjectType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Spacing_right(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionChange_objectType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Spacing",
+		Object:     "SceneRevisionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type SceneRevisionObjectType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_id(ctx, field)
+func (ec *executionContext) _SceneRevisionChange_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionChange_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35812,9 +38045,9 @@ func (ec *executionContext) _Story_id(ctx context.Context, field graphql.Collect
 	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionChange_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -35825,8 +38058,8 @@ func (ec *executionContext) fieldContext_Story_id(_ context.Context, field graph
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_title(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_title(ctx, field)
+func (ec *executionContext) _SceneRevisionChange_type(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionChange_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35839,7 +38072,7 @@ func (ec *executionContext) _Story_title(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35851,26 +38084,26 @@ func (ec *executionContext) _Story_title(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gqlmodel.SceneRevisionChangeType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSceneRevisionChangeType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChangeType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionChange_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type SceneRevisionChangeType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_alias(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_alias(ctx, field)
+func (ec *executionContext) _SceneRevisionConnection_edges(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35883,7 +38116,7 @@ func (ec *executionContext) _Story_alias(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Alias, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35895,26 +38128,32 @@ func (ec *executionContext) _Story_alias(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*gqlmodel.SceneRevisionEdge)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSceneRevisionEdge2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_alias(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_SceneRevisionEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_SceneRevisionEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevisionEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_propertyId(ctx, field)
+func (ec *executionContext) _SceneRevisionConnection_nodes(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionConnection_nodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35927,7 +38166,7 @@ func (ec *executionContext) _Story_propertyId(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PropertyID, nil
+		return obj.Nodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -35939,26 +38178,40 @@ func (ec *executionContext) _Story_propertyId(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.([]*gqlmodel.SceneRevision)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNSceneRevision2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionConnection_nodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SceneRevision_id(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_SceneRevision_sceneId(ctx, field)
+			case "action":
+				return ec.fieldContext_SceneRevision_action(ctx, field)
+			case "userId":
+				return ec.fieldContext_SceneRevision_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_SceneRevision_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SceneRevision_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevision", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_property(ctx, field)
+func (ec *executionContext) _SceneRevisionConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -35971,47 +38224,48 @@ func (ec *executionContext) _Story_property(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Story().Property(rctx, obj)
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Property)
+	res := resTmp.(*gqlmodel.PageInfo)
 	fc.Result = res
-	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Property_id(ctx, field)
-			case "schemaId":
-				return ec.fieldContext_Property_schemaId(ctx, field)
-			case "items":
-				return ec.fieldContext_Property_items(ctx, field)
-			case "schema":
-				return ec.fieldContext_Property_schema(ctx, field)
-			case "merged":
-				return ec.fieldContext_Property_merged(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_pages(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_pages(ctx, field)
+func (ec *executionContext) _SceneRevisionConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36024,7 +38278,7 @@ func (ec *executionContext) _Story_pages(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Pages, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36036,50 +38290,26 @@ func (ec *executionContext) _Story_pages(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*gqlmodel.StoryPage)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNStoryPage2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPageᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_pages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_StoryPage_id(ctx, field)
-			case "title":
-				return ec.fieldContext_StoryPage_title(ctx, field)
-			case "blocks":
-				return ec.fieldContext_StoryPage_blocks(ctx, field)
-			case "swipeable":
-				return ec.fieldContext_StoryPage_swipeable(ctx, field)
-			case "layersIds":
-				return ec.fieldContext_StoryPage_layersIds(ctx, field)
-			case "swipeableLayersIds":
-				return ec.fieldContext_StoryPage_swipeableLayersIds(ctx, field)
-			case "propertyId":
-				return ec.fieldContext_StoryPage_propertyId(ctx, field)
-			case "property":
-				return ec.fieldContext_StoryPage_property(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_StoryPage_createdAt(ctx, field)
-			case "sceneId":
-				return ec.fieldContext_StoryPage_sceneId(ctx, field)
-			case "scene":
-				return ec.fieldContext_StoryPage_scene(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StoryPage", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_publishmentStatus(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_publishmentStatus(ctx, field)
+func (ec *executionContext) _SceneRevisionEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36092,7 +38322,7 @@ func (ec *executionContext) _Story_publishmentStatus(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublishmentStatus, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36104,26 +38334,26 @@ func (ec *executionContext) _Story_publishmentStatus(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.PublishmentStatus)
+	res := resTmp.(usecasex.Cursor)
 	fc.Result = res
-	return ec.marshalNPublishmentStatus2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPublishmentStatus(ctx, field.Selections, res)
+	return ec.marshalNCursor2githubᚗcomᚋreearthᚋreearthxᚋusecasexᚐCursor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_publishmentStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PublishmentStatus does not have child fields")
+			return nil, errors.New("field of type Cursor does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_createdAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_createdAt(ctx, field)
+func (ec *executionContext) _SceneRevisionEdge_node(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneRevisionEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneRevisionEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36136,38 +38366,49 @@ func (ec *executionContext) _Story_createdAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*gqlmodel.SceneRevision)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOSceneRevision2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneRevisionEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneRevisionEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SceneRevision_id(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_SceneRevision_sceneId(ctx, field)
+			case "action":
+				return ec.fieldContext_SceneRevision_action(ctx, field)
+			case "userId":
+				return ec.fieldContext_SceneRevision_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_SceneRevision_user(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SceneRevision_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneRevision", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_updatedAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_updatedAt(ctx, field)
+func (ec *executionContext) _SceneWidget_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36180,7 +38421,7 @@ func (ec *executionContext) _Story_updatedAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36192,26 +38433,26 @@ func (ec *executionContext) _Story_updatedAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_publishedAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_publishedAt(ctx, field)
+func (ec *executionContext) _SceneWidget_pluginId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_pluginId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36224,35 +38465,38 @@ func (ec *executionContext) _Story_publishedAt(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublishedAt, nil
+		return obj.PluginID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_publishedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_pluginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_sceneId(ctx, field)
+func (ec *executionContext) _SceneWidget_extensionId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_extensionId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36265,7 +38509,7 @@ func (ec *executionContext) _Story_sceneId(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SceneID, nil
+		return obj.ExtensionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36282,9 +38526,9 @@ func (ec *executionContext) _Story_sceneId(ctx context.Context, field graphql.Co
 	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_extensionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -36295,8 +38539,8 @@ func (ec *executionContext) fieldContext_Story_sceneId(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_scene(ctx, field)
+func (ec *executionContext) _SceneWidget_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_propertyId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36309,67 +38553,38 @@ func (ec *executionContext) _Story_scene(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Story().Scene(rctx, obj)
+		return obj.PropertyID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Scene)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_scene(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Scene_id(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Scene_projectId(ctx, field)
-			case "teamId":
-				return ec.fieldContext_Scene_teamId(ctx, field)
-			case "propertyId":
-				return ec.fieldContext_Scene_propertyId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Scene_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Scene_updatedAt(ctx, field)
-			case "widgets":
-				return ec.fieldContext_Scene_widgets(ctx, field)
-			case "plugins":
-				return ec.fieldContext_Scene_plugins(ctx, field)
-			case "widgetAlignSystem":
-				return ec.fieldContext_Scene_widgetAlignSystem(ctx, field)
-			case "project":
-				return ec.fieldContext_Scene_project(ctx, field)
-			case "team":
-				return ec.fieldContext_Scene_team(ctx, field)
-			case "property":
-				return ec.fieldContext_Scene_property(ctx, field)
-			case "newLayers":
-				return ec.fieldContext_Scene_newLayers(ctx, field)
-			case "stories":
-				return ec.fieldContext_Scene_stories(ctx, field)
-			case "styles":
-				return ec.fieldContext_Scene_styles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Scene", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_panelPosition(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_panelPosition(ctx, field)
+func (ec *executionContext) _SceneWidget_enabled(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_enabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36382,7 +38597,7 @@ func (ec *executionContext) _Story_panelPosition(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PanelPosition, nil
+		return obj.Enabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36394,26 +38609,26 @@ func (ec *executionContext) _Story_panelPosition(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.Position)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNPosition2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPosition(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_panelPosition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Position does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_bgColor(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_bgColor(ctx, field)
+func (ec *executionContext) _SceneWidget_extended(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_extended(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36426,35 +38641,38 @@ func (ec *executionContext) _Story_bgColor(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BgColor, nil
+		return obj.Extended, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_bgColor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_extended(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_isBasicAuthActive(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_isBasicAuthActive(ctx, field)
+func (ec *executionContext) _SceneWidget_plugin(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_plugin(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36467,38 +38685,69 @@ func (ec *executionContext) _Story_isBasicAuthActive(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsBasicAuthActive, nil
+		return ec.resolvers.SceneWidget().Plugin(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*gqlmodel.Plugin)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOPlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPlugin(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_isBasicAuthActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_plugin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Plugin_id(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_Plugin_sceneId(ctx, field)
+			case "name":
+				return ec.fieldContext_Plugin_name(ctx, field)
+			case "version":
+				return ec.fieldContext_Plugin_version(ctx, field)
+			case "description":
+				return ec.fieldContext_Plugin_description(ctx, field)
+			case "author":
+				return ec.fieldContext_Plugin_author(ctx, field)
+			case "repositoryUrl":
+				return ec.fieldContext_Plugin_repositoryUrl(ctx, field)
+			case "propertySchemaId":
+				return ec.fieldContext_Plugin_propertySchemaId(ctx, field)
+			case "extensions":
+				return ec.fieldContext_Plugin_extensions(ctx, field)
+			case "scenePlugin":
+				return ec.fieldContext_Plugin_scenePlugin(ctx, field)
+			case "allTranslatedDescription":
+				return ec.fieldContext_Plugin_allTranslatedDescription(ctx, field)
+			case "allTranslatedName":
+				return ec.fieldContext_Plugin_allTranslatedName(ctx, field)
+			case "scene":
+				return ec.fieldContext_Plugin_scene(ctx, field)
+			case "translatedName":
+				return ec.fieldContext_Plugin_translatedName(ctx, field)
+			case "translatedDescription":
+				return ec.fieldContext_Plugin_translatedDescription(ctx, field)
+			case "propertySchema":
+				return ec.fieldContext_Plugin_propertySchema(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Plugin", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_basicAuthUsername(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_basicAuthUsername(ctx, field)
+func (ec *executionContext) _SceneWidget_extension(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_extension(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36511,38 +38760,71 @@ func (ec *executionContext) _Story_basicAuthUsername(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BasicAuthUsername, nil
+		return ec.resolvers.SceneWidget().Extension(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqlmodel.PluginExtension)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOPluginExtension2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPluginExtension(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_basicAuthUsername(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_extension(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "extensionId":
+				return ec.fieldContext_PluginExtension_extensionId(ctx, field)
+			case "pluginId":
+				return ec.fieldContext_PluginExtension_pluginId(ctx, field)
+			case "type":
+				return ec.fieldContext_PluginExtension_type(ctx, field)
+			case "name":
+				return ec.fieldContext_PluginExtension_name(ctx, field)
+			case "description":
+				return ec.fieldContext_PluginExtension_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_PluginExtension_icon(ctx, field)
+			case "singleOnly":
+				return ec.fieldContext_PluginExtension_singleOnly(ctx, field)
+			case "widgetLayout":
+				return ec.fieldContext_PluginExtension_widgetLayout(ctx, field)
+			case "visualizer":
+				return ec.fieldContext_PluginExtension_visualizer(ctx, field)
+			case "propertySchemaId":
+				return ec.fieldContext_PluginExtension_propertySchemaId(ctx, field)
+			case "allTranslatedName":
+				return ec.fieldContext_PluginExtension_allTranslatedName(ctx, field)
+			case "allTranslatedDescription":
+				return ec.fieldContext_PluginExtension_allTranslatedDescription(ctx, field)
+			case "plugin":
+				return ec.fieldContext_PluginExtension_plugin(ctx, field)
+			case "sceneWidget":
+				return ec.fieldContext_PluginExtension_sceneWidget(ctx, field)
+			case "propertySchema":
+				return ec.fieldContext_PluginExtension_propertySchema(ctx, field)
+			case "translatedName":
+				return ec.fieldContext_PluginExtension_translatedName(ctx, field)
+			case "translatedDescription":
+				return ec.fieldContext_PluginExtension_translatedDescription(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PluginExtension", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_basicAuthPassword(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_basicAuthPassword(ctx, field)
+func (ec *executionContext) _SceneWidget_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SceneWidget) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SceneWidget_property(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36555,38 +38837,47 @@ func (ec *executionContext) _Story_basicAuthPassword(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BasicAuthPassword, nil
+		return ec.resolvers.SceneWidget().Property(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqlmodel.Property)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_basicAuthPassword(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SceneWidget_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SceneWidget",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Property_id(ctx, field)
+			case "schemaId":
+				return ec.fieldContext_Property_schemaId(ctx, field)
+			case "items":
+				return ec.fieldContext_Property_items(ctx, field)
+			case "schema":
+				return ec.fieldContext_Property_schema(ctx, field)
+			case "merged":
+				return ec.fieldContext_Property_merged(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_publicTitle(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_publicTitle(ctx, field)
+func (ec *executionContext) _SignupPayload_user(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SignupPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignupPayload_user(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36599,7 +38890,7 @@ func (ec *executionContext) _Story_publicTitle(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublicTitle, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36611,26 +38902,36 @@ func (ec *executionContext) _Story_publicTitle(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqlmodel.User)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUser2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUser(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_publicTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SignupPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SignupPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "host":
+				return ec.fieldContext_User_host(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_publicDescription(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_publicDescription(ctx, field)
+func (ec *executionContext) _SignupPayload_team(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SignupPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SignupPayload_team(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36643,7 +38944,7 @@ func (ec *executionContext) _Story_publicDescription(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublicDescription, nil
+		return obj.Team, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36655,26 +38956,44 @@ func (ec *executionContext) _Story_publicDescription(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*gqlmodel.Team)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTeam2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐTeam(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_publicDescription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SignupPayload_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SignupPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Team_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "members":
+				return ec.fieldContext_Team_members(ctx, field)
+			case "personal":
+				return ec.fieldContext_Team_personal(ctx, field)
+			case "policyId":
+				return ec.fieldContext_Team_policyId(ctx, field)
+			case "policy":
+				return ec.fieldContext_Team_policy(ctx, field)
+			case "assets":
+				return ec.fieldContext_Team_assets(ctx, field)
+			case "projects":
+				return ec.fieldContext_Team_projects(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_publicImage(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_publicImage(ctx, field)
+func (ec *executionContext) _SketchInfo_customPropertySchema(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SketchInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SketchInfo_customPropertySchema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36687,38 +39006,35 @@ func (ec *executionContext) _Story_publicImage(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublicImage, nil
+		return obj.CustomPropertySchema, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gqlmodel.JSON)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_publicImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SketchInfo_customPropertySchema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SketchInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type JSON does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_publicNoIndex(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_publicNoIndex(ctx, field)
+func (ec *executionContext) _SketchInfo_featureCollection(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.SketchInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SketchInfo_featureCollection(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36731,38 +39047,41 @@ func (ec *executionContext) _Story_publicNoIndex(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PublicNoIndex, nil
+		return obj.FeatureCollection, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*gqlmodel.FeatureCollection)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOFeatureCollection2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐFeatureCollection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_publicNoIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SketchInfo_featureCollection(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "SketchInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_FeatureCollection_type(ctx, field)
+			case "features":
+				return ec.fieldContext_FeatureCollection_features(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FeatureCollection", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_enableGa(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_enableGa(ctx, field)
+func (ec *executionContext) _Spacing_top(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Spacing_top(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36775,7 +39094,7 @@ func (ec *executionContext) _Story_enableGa(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnableGa, nil
+		return obj.Top, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36787,26 +39106,26 @@ func (ec *executionContext) _Story_enableGa(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_enableGa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Spacing_top(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "Spacing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Story_trackingId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Story_trackingId(ctx, field)
+func (ec *executionContext) _Spacing_bottom(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Spacing_bottom(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36819,7 +39138,7 @@ func (ec *executionContext) _Story_trackingId(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TrackingID, nil
+		return obj.Bottom, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36831,26 +39150,26 @@ func (ec *executionContext) _Story_trackingId(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Story_trackingId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Spacing_bottom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Story",
+		Object:     "Spacing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_id(ctx, field)
+func (ec *executionContext) _Spacing_left(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Spacing_left(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36863,7 +39182,7 @@ func (ec *executionContext) _StoryBlock_id(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Left, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36875,26 +39194,26 @@ func (ec *executionContext) _StoryBlock_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Spacing_left(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Spacing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_pluginId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_pluginId(ctx, field)
+func (ec *executionContext) _Spacing_right(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Spacing) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Spacing_right(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36907,7 +39226,7 @@ func (ec *executionContext) _StoryBlock_pluginId(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PluginID, nil
+		return obj.Right, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -36919,26 +39238,26 @@ func (ec *executionContext) _StoryBlock_pluginId(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_pluginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Spacing_right(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Spacing",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_plugin(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_plugin(ctx, field)
+func (ec *executionContext) _Story_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -36951,69 +39270,38 @@ func (ec *executionContext) _StoryBlock_plugin(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StoryBlock().Plugin(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Plugin)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOPlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPlugin(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_plugin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Story",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Plugin_id(ctx, field)
-			case "sceneId":
-				return ec.fieldContext_Plugin_sceneId(ctx, field)
-			case "name":
-				return ec.fieldContext_Plugin_name(ctx, field)
-			case "version":
-				return ec.fieldContext_Plugin_version(ctx, field)
-			case "description":
-				return ec.fieldContext_Plugin_description(ctx, field)
-			case "author":
-				return ec.fieldContext_Plugin_author(ctx, field)
-			case "repositoryUrl":
-				return ec.fieldContext_Plugin_repositoryUrl(ctx, field)
-			case "propertySchemaId":
-				return ec.fieldContext_Plugin_propertySchemaId(ctx, field)
-			case "extensions":
-				return ec.fieldContext_Plugin_extensions(ctx, field)
-			case "scenePlugin":
-				return ec.fieldContext_Plugin_scenePlugin(ctx, field)
-			case "allTranslatedDescription":
-				return ec.fieldContext_Plugin_allTranslatedDescription(ctx, field)
-			case "allTranslatedName":
-				return ec.fieldContext_Plugin_allTranslatedName(ctx, field)
-			case "scene":
-				return ec.fieldContext_Plugin_scene(ctx, field)
-			case "translatedName":
-				return ec.fieldContext_Plugin_translatedName(ctx, field)
-			case "translatedDescription":
-				return ec.fieldContext_Plugin_translatedDescription(ctx, field)
-			case "propertySchema":
-				return ec.fieldContext_Plugin_propertySchema(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Plugin", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_extensionId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_extensionId(ctx, field)
+func (ec *executionContext) _Story_title(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37026,7 +39314,7 @@ func (ec *executionContext) _StoryBlock_extensionId(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExtensionID, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37038,26 +39326,26 @@ func (ec *executionContext) _StoryBlock_extensionId(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_extensionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_extension(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_extension(ctx, field)
+func (ec *executionContext) _Story_alias(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_alias(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37070,71 +39358,38 @@ func (ec *executionContext) _StoryBlock_extension(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Extension, nil
+		return obj.Alias, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.PluginExtension)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOPluginExtension2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPluginExtension(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_extension(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_alias(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "extensionId":
-				return ec.fieldContext_PluginExtension_extensionId(ctx, field)
-			case "pluginId":
-				return ec.fieldContext_PluginExtension_pluginId(ctx, field)
-			case "type":
-				return ec.fieldContext_PluginExtension_type(ctx, field)
-			case "name":
-				return ec.fieldContext_PluginExtension_name(ctx, field)
-			case "description":
-				return ec.fieldContext_PluginExtension_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_PluginExtension_icon(ctx, field)
-			case "singleOnly":
-				return ec.fieldContext_PluginExtension_singleOnly(ctx, field)
-			case "widgetLayout":
-				return ec.fieldContext_PluginExtension_widgetLayout(ctx, field)
-			case "visualizer":
-				return ec.fieldContext_PluginExtension_visualizer(ctx, field)
-			case "propertySchemaId":
-				return ec.fieldContext_PluginExtension_propertySchemaId(ctx, field)
-			case "allTranslatedName":
-				return ec.fieldContext_PluginExtension_allTranslatedName(ctx, field)
-			case "allTranslatedDescription":
-				return ec.fieldContext_PluginExtension_allTranslatedDescription(ctx, field)
-			case "plugin":
-				return ec.fieldContext_PluginExtension_plugin(ctx, field)
-			case "sceneWidget":
-				return ec.fieldContext_PluginExtension_sceneWidget(ctx, field)
-			case "propertySchema":
-				return ec.fieldContext_PluginExtension_propertySchema(ctx, field)
-			case "translatedName":
-				return ec.fieldContext_PluginExtension_translatedName(ctx, field)
-			case "translatedDescription":
-				return ec.fieldContext_PluginExtension_translatedDescription(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PluginExtension", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_propertyId(ctx, field)
+func (ec *executionContext) _Story_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_propertyId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37164,9 +39419,9 @@ func (ec *executionContext) _StoryBlock_propertyId(ctx context.Context, field gr
 	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -37177,8 +39432,8 @@ func (ec *executionContext) fieldContext_StoryBlock_propertyId(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryBlock_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryBlock_property(ctx, field)
+func (ec *executionContext) _Story_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_property(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37191,7 +39446,7 @@ func (ec *executionContext) _StoryBlock_property(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StoryBlock().Property(rctx, obj)
+		return ec.resolvers.Story().Property(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37205,9 +39460,9 @@ func (ec *executionContext) _StoryBlock_property(ctx context.Context, field grap
 	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryBlock_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryBlock",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -37230,8 +39485,8 @@ func (ec *executionContext) fieldContext_StoryBlock_property(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_id(ctx, field)
+func (ec *executionContext) _Story_pages(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_pages(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37244,7 +39499,7 @@ func (ec *executionContext) _StoryPage_id(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Pages, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37256,26 +39511,50 @@ func (ec *executionContext) _StoryPage_id(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.([]*gqlmodel.StoryPage)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNStoryPage2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPageᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_pages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StoryPage_id(ctx, field)
+			case "title":
+				return ec.fieldContext_StoryPage_title(ctx, field)
+			case "blocks":
+				return ec.fieldContext_StoryPage_blocks(ctx, field)
+			case "swipeable":
+				return ec.fieldContext_StoryPage_swipeable(ctx, field)
+			case "layersIds":
+				return ec.fieldContext_StoryPage_layersIds(ctx, field)
+			case "swipeableLayersIds":
+				return ec.fieldContext_StoryPage_swipeableLayersIds(ctx, field)
+			case "propertyId":
+				return ec.fieldContext_StoryPage_propertyId(ctx, field)
+			case "property":
+				return ec.fieldContext_StoryPage_property(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StoryPage_createdAt(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_StoryPage_sceneId(ctx, field)
+			case "scene":
+				return ec.fieldContext_StoryPage_scene(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StoryPage", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_title(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_title(ctx, field)
+func (ec *executionContext) _Story_publishmentStatus(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_publishmentStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37288,7 +39567,7 @@ func (ec *executionContext) _StoryPage_title(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.PublishmentStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37300,26 +39579,26 @@ func (ec *executionContext) _StoryPage_title(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(gqlmodel.PublishmentStatus)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPublishmentStatus2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPublishmentStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_publishmentStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type PublishmentStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_blocks(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_blocks(ctx, field)
+func (ec *executionContext) _Story_createdAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37332,7 +39611,7 @@ func (ec *executionContext) _StoryPage_blocks(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Blocks, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37344,42 +39623,26 @@ func (ec *executionContext) _StoryPage_blocks(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*gqlmodel.StoryBlock)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNStoryBlock2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlockᚄ(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_blocks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_StoryBlock_id(ctx, field)
-			case "pluginId":
-				return ec.fieldContext_StoryBlock_pluginId(ctx, field)
-			case "plugin":
-				return ec.fieldContext_StoryBlock_plugin(ctx, field)
-			case "extensionId":
-				return ec.fieldContext_StoryBlock_extensionId(ctx, field)
-			case "extension":
-				return ec.fieldContext_StoryBlock_extension(ctx, field)
-			case "propertyId":
-				return ec.fieldContext_StoryBlock_propertyId(ctx, field)
-			case "property":
-				return ec.fieldContext_StoryBlock_property(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StoryBlock", field.Name)
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_swipeable(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_swipeable(ctx, field)
+func (ec *executionContext) _Story_updatedAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37392,7 +39655,7 @@ func (ec *executionContext) _StoryPage_swipeable(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Swipeable, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37404,26 +39667,26 @@ func (ec *executionContext) _StoryPage_swipeable(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_swipeable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_layersIds(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_layersIds(ctx, field)
+func (ec *executionContext) _Story_publishedAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_publishedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37436,38 +39699,35 @@ func (ec *executionContext) _StoryPage_layersIds(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LayersIds, nil
+		return obj.PublishedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]gqlmodel.ID)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_layersIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_publishedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_swipeableLayersIds(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_swipeableLayersIds(ctx, field)
+func (ec *executionContext) _Story_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_sceneId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37480,23 +39740,26 @@ func (ec *executionContext) _StoryPage_swipeableLayersIds(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SwipeableLayersIds, nil
+		return obj.SceneID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]gqlmodel.ID)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalOID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_swipeableLayersIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -37507,8 +39770,8 @@ func (ec *executionContext) fieldContext_StoryPage_swipeableLayersIds(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_propertyId(ctx, field)
+func (ec *executionContext) _Story_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_scene(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37521,38 +39784,69 @@ func (ec *executionContext) _StoryPage_propertyId(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PropertyID, nil
+		return ec.resolvers.Story().Scene(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(*gqlmodel.Scene)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_scene(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Scene_id(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Scene_projectId(ctx, field)
+			case "teamId":
+				return ec.fieldContext_Scene_teamId(ctx, field)
+			case "propertyId":
+				return ec.fieldContext_Scene_propertyId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Scene_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
+			case "widgets":
+				return ec.fieldContext_Scene_widgets(ctx, field)
+			case "plugins":
+				return ec.fieldContext_Scene_plugins(ctx, field)
+			case "widgetAlignSystem":
+				return ec.fieldContext_Scene_widgetAlignSystem(ctx, field)
+			case "project":
+				return ec.fieldContext_Scene_project(ctx, field)
+			case "team":
+				return ec.fieldContext_Scene_team(ctx, field)
+			case "property":
+				return ec.fieldContext_Scene_property(ctx, field)
+			case "newLayers":
+				return ec.fieldContext_Scene_newLayers(ctx, field)
+			case "stories":
+				return ec.fieldContext_Scene_stories(ctx, field)
+			case "styles":
+				return ec.fieldContext_Scene_styles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Scene", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_property(ctx, field)
+func (ec *executionContext) _Story_panelPosition(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_panelPosition(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37565,47 +39859,38 @@ func (ec *executionContext) _StoryPage_property(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StoryPage().Property(rctx, obj)
+		return obj.PanelPosition, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Property)
+	res := resTmp.(gqlmodel.Position)
 	fc.Result = res
-	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
+	return ec.marshalNPosition2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPosition(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_panelPosition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Property_id(ctx, field)
-			case "schemaId":
-				return ec.fieldContext_Property_schemaId(ctx, field)
-			case "items":
-				return ec.fieldContext_Property_items(ctx, field)
-			case "schema":
-				return ec.fieldContext_Property_schema(ctx, field)
-			case "merged":
-				return ec.fieldContext_Property_merged(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, errors.New("field of type Position does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_createdAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_createdAt(ctx, field)
+func (ec *executionContext) _Story_bgColor(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_bgColor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37618,38 +39903,35 @@ func (ec *executionContext) _StoryPage_createdAt(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.BgColor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_bgColor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_sceneId(ctx, field)
+func (ec *executionContext) _Story_isBasicAuthActive(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_isBasicAuthActive(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37662,7 +39944,7 @@ func (ec *executionContext) _StoryPage_sceneId(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SceneID, nil
+		return obj.IsBasicAuthActive, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37674,26 +39956,26 @@ func (ec *executionContext) _StoryPage_sceneId(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_isBasicAuthActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPage_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPage_scene(ctx, field)
+func (ec *executionContext) _Story_basicAuthUsername(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_basicAuthUsername(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37706,67 +39988,38 @@ func (ec *executionContext) _StoryPage_scene(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.StoryPage().Scene(rctx, obj)
+		return obj.BasicAuthUsername, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Scene)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPage_scene(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_basicAuthUsername(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPage",
+		Object:     "Story",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Scene_id(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Scene_projectId(ctx, field)
-			case "teamId":
-				return ec.fieldContext_Scene_teamId(ctx, field)
-			case "propertyId":
-				return ec.fieldContext_Scene_propertyId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Scene_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Scene_updatedAt(ctx, field)
-			case "widgets":
-				return ec.fieldContext_Scene_widgets(ctx, field)
-			case "plugins":
-				return ec.fieldContext_Scene_plugins(ctx, field)
-			case "widgetAlignSystem":
-				return ec.fieldContext_Scene_widgetAlignSystem(ctx, field)
-			case "project":
-				return ec.fieldContext_Scene_project(ctx, field)
-			case "team":
-				return ec.fieldContext_Scene_team(ctx, field)
-			case "property":
-				return ec.fieldContext_Scene_property(ctx, field)
-			case "newLayers":
-				return ec.fieldContext_Scene_newLayers(ctx, field)
-			case "stories":
-				return ec.fieldContext_Scene_stories(ctx, field)
-			case "styles":
-				return ec.fieldContext_Scene_styles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Scene", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPagePayload_page(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPagePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPagePayload_page(ctx, field)
+func (ec *executionContext) _Story_basicAuthPassword(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_basicAuthPassword(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37779,7 +40032,7 @@ func (ec *executionContext) _StoryPagePayload_page(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.BasicAuthPassword, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37791,50 +40044,26 @@ func (ec *executionContext) _StoryPagePayload_page(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.StoryPage)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNStoryPage2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPage(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPagePayload_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_basicAuthPassword(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPagePayload",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_StoryPage_id(ctx, field)
-			case "title":
-				return ec.fieldContext_StoryPage_title(ctx, field)
-			case "blocks":
-				return ec.fieldContext_StoryPage_blocks(ctx, field)
-			case "swipeable":
-				return ec.fieldContext_StoryPage_swipeable(ctx, field)
-			case "layersIds":
-				return ec.fieldContext_StoryPage_layersIds(ctx, field)
-			case "swipeableLayersIds":
-				return ec.fieldContext_StoryPage_swipeableLayersIds(ctx, field)
-			case "propertyId":
-				return ec.fieldContext_StoryPage_propertyId(ctx, field)
-			case "property":
-				return ec.fieldContext_StoryPage_property(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_StoryPage_createdAt(ctx, field)
-			case "sceneId":
-				return ec.fieldContext_StoryPage_sceneId(ctx, field)
-			case "scene":
-				return ec.fieldContext_StoryPage_scene(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type StoryPage", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPagePayload_story(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPagePayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPagePayload_story(ctx, field)
+func (ec *executionContext) _Story_publicTitle(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_publicTitle(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37847,7 +40076,7 @@ func (ec *executionContext) _StoryPagePayload_story(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Story, nil
+		return obj.PublicTitle, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37859,26 +40088,1368 @@ func (ec *executionContext) _StoryPagePayload_story(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Story)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPagePayload_story(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Story_publicTitle(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPagePayload",
+		Object:     "Story",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Story_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Story_title(ctx, field)
-			case "alias":
-				return ec.fieldContext_Story_alias(ctx, field)
-			case "propertyId":
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Story_publicDescription(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_publicDescription(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PublicDescription, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Story_publicDescription(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Story",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Story_publicImage(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_publicImage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PublicImage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Story_publicImage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Story",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Story_publicNoIndex(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_publicNoIndex(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PublicNoIndex, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Story_publicNoIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Story",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Story_enableGa(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_enableGa(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnableGa, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Story_enableGa(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Story",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Story_trackingId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Story) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Story_trackingId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TrackingID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Story_trackingId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Story",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_pluginId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_pluginId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PluginID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_pluginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_plugin(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_plugin(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.StoryBlock().Plugin(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Plugin)
+	fc.Result = res
+	return ec.marshalOPlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPlugin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_plugin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Plugin_id(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_Plugin_sceneId(ctx, field)
+			case "name":
+				return ec.fieldContext_Plugin_name(ctx, field)
+			case "version":
+				return ec.fieldContext_Plugin_version(ctx, field)
+			case "description":
+				return ec.fieldContext_Plugin_description(ctx, field)
+			case "author":
+				return ec.fieldContext_Plugin_author(ctx, field)
+			case "repositoryUrl":
+				return ec.fieldContext_Plugin_repositoryUrl(ctx, field)
+			case "propertySchemaId":
+				return ec.fieldContext_Plugin_propertySchemaId(ctx, field)
+			case "extensions":
+				return ec.fieldContext_Plugin_extensions(ctx, field)
+			case "scenePlugin":
+				return ec.fieldContext_Plugin_scenePlugin(ctx, field)
+			case "allTranslatedDescription":
+				return ec.fieldContext_Plugin_allTranslatedDescription(ctx, field)
+			case "allTranslatedName":
+				return ec.fieldContext_Plugin_allTranslatedName(ctx, field)
+			case "scene":
+				return ec.fieldContext_Plugin_scene(ctx, field)
+			case "translatedName":
+				return ec.fieldContext_Plugin_translatedName(ctx, field)
+			case "translatedDescription":
+				return ec.fieldContext_Plugin_translatedDescription(ctx, field)
+			case "propertySchema":
+				return ec.fieldContext_Plugin_propertySchema(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Plugin", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_extensionId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_extensionId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExtensionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_extensionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_extension(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_extension(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Extension, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.PluginExtension)
+	fc.Result = res
+	return ec.marshalOPluginExtension2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐPluginExtension(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_extension(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "extensionId":
+				return ec.fieldContext_PluginExtension_extensionId(ctx, field)
+			case "pluginId":
+				return ec.fieldContext_PluginExtension_pluginId(ctx, field)
+			case "type":
+				return ec.fieldContext_PluginExtension_type(ctx, field)
+			case "name":
+				return ec.fieldContext_PluginExtension_name(ctx, field)
+			case "description":
+				return ec.fieldContext_PluginExtension_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_PluginExtension_icon(ctx, field)
+			case "singleOnly":
+				return ec.fieldContext_PluginExtension_singleOnly(ctx, field)
+			case "widgetLayout":
+				return ec.fieldContext_PluginExtension_widgetLayout(ctx, field)
+			case "visualizer":
+				return ec.fieldContext_PluginExtension_visualizer(ctx, field)
+			case "propertySchemaId":
+				return ec.fieldContext_PluginExtension_propertySchemaId(ctx, field)
+			case "allTranslatedName":
+				return ec.fieldContext_PluginExtension_allTranslatedName(ctx, field)
+			case "allTranslatedDescription":
+				return ec.fieldContext_PluginExtension_allTranslatedDescription(ctx, field)
+			case "plugin":
+				return ec.fieldContext_PluginExtension_plugin(ctx, field)
+			case "sceneWidget":
+				return ec.fieldContext_PluginExtension_sceneWidget(ctx, field)
+			case "propertySchema":
+				return ec.fieldContext_PluginExtension_propertySchema(ctx, field)
+			case "translatedName":
+				return ec.fieldContext_PluginExtension_translatedName(ctx, field)
+			case "translatedDescription":
+				return ec.fieldContext_PluginExtension_translatedDescription(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PluginExtension", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_propertyId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PropertyID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryBlock_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryBlock) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryBlock_property(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.StoryBlock().Property(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Property)
+	fc.Result = res
+	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryBlock_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryBlock",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Property_id(ctx, field)
+			case "schemaId":
+				return ec.fieldContext_Property_schemaId(ctx, field)
+			case "items":
+				return ec.fieldContext_Property_items(ctx, field)
+			case "schema":
+				return ec.fieldContext_Property_schema(ctx, field)
+			case "merged":
+				return ec.fieldContext_Property_merged(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_title(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_title(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Title, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_blocks(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_blocks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Blocks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqlmodel.StoryBlock)
+	fc.Result = res
+	return ec.marshalNStoryBlock2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlockᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_blocks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StoryBlock_id(ctx, field)
+			case "pluginId":
+				return ec.fieldContext_StoryBlock_pluginId(ctx, field)
+			case "plugin":
+				return ec.fieldContext_StoryBlock_plugin(ctx, field)
+			case "extensionId":
+				return ec.fieldContext_StoryBlock_extensionId(ctx, field)
+			case "extension":
+				return ec.fieldContext_StoryBlock_extension(ctx, field)
+			case "propertyId":
+				return ec.fieldContext_StoryBlock_propertyId(ctx, field)
+			case "property":
+				return ec.fieldContext_StoryBlock_property(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StoryBlock", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_swipeable(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_swipeable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Swipeable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_swipeable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_layersIds(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_layersIds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LayersIds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_layersIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_swipeableLayersIds(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_swipeableLayersIds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SwipeableLayersIds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalOID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_swipeableLayersIds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_propertyId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_propertyId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PropertyID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_propertyId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_property(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_property(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.StoryPage().Property(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Property)
+	fc.Result = res
+	return ec.marshalOProperty2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐProperty(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_property(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Property_id(ctx, field)
+			case "schemaId":
+				return ec.fieldContext_Property_schemaId(ctx, field)
+			case "items":
+				return ec.fieldContext_Property_items(ctx, field)
+			case "schema":
+				return ec.fieldContext_Property_schema(ctx, field)
+			case "merged":
+				return ec.fieldContext_Property_merged(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_createdAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_createdAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_sceneId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SceneID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPage_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPage_scene(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.StoryPage().Scene(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Scene)
+	fc.Result = res
+	return ec.marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPage_scene(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPage",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Scene_id(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Scene_projectId(ctx, field)
+			case "teamId":
+				return ec.fieldContext_Scene_teamId(ctx, field)
+			case "propertyId":
+				return ec.fieldContext_Scene_propertyId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Scene_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
+			case "widgets":
+				return ec.fieldContext_Scene_widgets(ctx, field)
+			case "plugins":
+				return ec.fieldContext_Scene_plugins(ctx, field)
+			case "widgetAlignSystem":
+				return ec.fieldContext_Scene_widgetAlignSystem(ctx, field)
+			case "project":
+				return ec.fieldContext_Scene_project(ctx, field)
+			case "team":
+				return ec.fieldContext_Scene_team(ctx, field)
+			case "property":
+				return ec.fieldContext_Scene_property(ctx, field)
+			case "newLayers":
+				return ec.fieldContext_Scene_newLayers(ctx, field)
+			case "stories":
+				return ec.fieldContext_Scene_stories(ctx, field)
+			case "styles":
+				return ec.fieldContext_Scene_styles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Scene", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPagePayload_page(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPagePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPagePayload_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.StoryPage)
+	fc.Result = res
+	return ec.marshalNStoryPage2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPagePayload_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPagePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StoryPage_id(ctx, field)
+			case "title":
+				return ec.fieldContext_StoryPage_title(ctx, field)
+			case "blocks":
+				return ec.fieldContext_StoryPage_blocks(ctx, field)
+			case "swipeable":
+				return ec.fieldContext_StoryPage_swipeable(ctx, field)
+			case "layersIds":
+				return ec.fieldContext_StoryPage_layersIds(ctx, field)
+			case "swipeableLayersIds":
+				return ec.fieldContext_StoryPage_swipeableLayersIds(ctx, field)
+			case "propertyId":
+				return ec.fieldContext_StoryPage_propertyId(ctx, field)
+			case "property":
+				return ec.fieldContext_StoryPage_property(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StoryPage_createdAt(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_StoryPage_sceneId(ctx, field)
+			case "scene":
+				return ec.fieldContext_StoryPage_scene(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StoryPage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPagePayload_story(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPagePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPagePayload_story(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Story, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Story)
+	fc.Result = res
+	return ec.marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPagePayload_story(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPagePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Story_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Story_title(ctx, field)
+			case "alias":
+				return ec.fieldContext_Story_alias(ctx, field)
+			case "propertyId":
+				return ec.fieldContext_Story_propertyId(ctx, field)
+			case "property":
+				return ec.fieldContext_Story_property(ctx, field)
+			case "pages":
+				return ec.fieldContext_Story_pages(ctx, field)
+			case "publishmentStatus":
+				return ec.fieldContext_Story_publishmentStatus(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Story_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Story_updatedAt(ctx, field)
+			case "publishedAt":
+				return ec.fieldContext_Story_publishedAt(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_Story_sceneId(ctx, field)
+			case "scene":
+				return ec.fieldContext_Story_scene(ctx, field)
+			case "panelPosition":
+				return ec.fieldContext_Story_panelPosition(ctx, field)
+			case "bgColor":
+				return ec.fieldContext_Story_bgColor(ctx, field)
+			case "isBasicAuthActive":
+				return ec.fieldContext_Story_isBasicAuthActive(ctx, field)
+			case "basicAuthUsername":
+				return ec.fieldContext_Story_basicAuthUsername(ctx, field)
+			case "basicAuthPassword":
+				return ec.fieldContext_Story_basicAuthPassword(ctx, field)
+			case "publicTitle":
+				return ec.fieldContext_Story_publicTitle(ctx, field)
+			case "publicDescription":
+				return ec.fieldContext_Story_publicDescription(ctx, field)
+			case "publicImage":
+				return ec.fieldContext_Story_publicImage(ctx, field)
+			case "publicNoIndex":
+				return ec.fieldContext_Story_publicNoIndex(ctx, field)
+			case "enableGa":
+				return ec.fieldContext_Story_enableGa(ctx, field)
+			case "trackingId":
+				return ec.fieldContext_Story_trackingId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Story", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoryPayload_story(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StoryPayload_story(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Story, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Story)
+	fc.Result = res
+	return ec.marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StoryPayload_story(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoryPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Story_id(ctx, field)
+			case "title":
+				return ec.fieldContext_Story_title(ctx, field)
+			case "alias":
+				return ec.fieldContext_Story_alias(ctx, field)
+			case "propertyId":
 				return ec.fieldContext_Story_propertyId(ctx, field)
 			case "property":
 				return ec.fieldContext_Story_property(ctx, field)
@@ -37925,8 +41496,140 @@ func (ec *executionContext) fieldContext_StoryPagePayload_story(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _StoryPayload_story(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StoryPayload) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_StoryPayload_story(ctx, field)
+func (ec *executionContext) _Style_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Style_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Style",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Style_name(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Style_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Style",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Style_value(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.JSON)
+	fc.Result = res
+	return ec.marshalNJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Style_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Style",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JSON does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Style_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_sceneId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -37939,7 +41642,7 @@ func (ec *executionContext) _StoryPayload_story(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Story, nil
+		return obj.SceneID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -37951,74 +41654,413 @@ func (ec *executionContext) _StoryPayload_story(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Story)
+	res := resTmp.(gqlmodel.ID)
 	fc.Result = res
-	return ec.marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx, field.Selections, res)
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_StoryPayload_story(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Style_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "StoryPayload",
+		Object:     "Style",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Style_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_scene(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Style().Scene(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.Scene)
+	fc.Result = res
+	return ec.marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Style_scene(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Style",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Story_id(ctx, field)
-			case "title":
-				return ec.fieldContext_Story_title(ctx, field)
-			case "alias":
-				return ec.fieldContext_Story_alias(ctx, field)
+				return ec.fieldContext_Scene_id(ctx, field)
+			case "projectId":
+				return ec.fieldContext_Scene_projectId(ctx, field)
+			case "teamId":
+				return ec.fieldContext_Scene_teamId(ctx, field)
 			case "propertyId":
-				return ec.fieldContext_Story_propertyId(ctx, field)
+				return ec.fieldContext_Scene_propertyId(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Scene_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
+			case "widgets":
+				return ec.fieldContext_Scene_widgets(ctx, field)
+			case "plugins":
+				return ec.fieldContext_Scene_plugins(ctx, field)
+			case "widgetAlignSystem":
+				return ec.fieldContext_Scene_widgetAlignSystem(ctx, field)
+			case "project":
+				return ec.fieldContext_Scene_project(ctx, field)
+			case "team":
+				return ec.fieldContext_Scene_team(ctx, field)
 			case "property":
-				return ec.fieldContext_Story_property(ctx, field)
-			case "pages":
-				return ec.fieldContext_Story_pages(ctx, field)
-			case "publishmentStatus":
-				return ec.fieldContext_Story_publishmentStatus(ctx, field)
+				return ec.fieldContext_Scene_property(ctx, field)
+			case "newLayers":
+				return ec.fieldContext_Scene_newLayers(ctx, field)
+			case "stories":
+				return ec.fieldContext_Scene_stories(ctx, field)
+			case "styles":
+				return ec.fieldContext_Scene_styles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Scene", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Style_presetId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_presetId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PresetID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Style_presetId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Style",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Style_preset(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Style_preset(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Style().Preset(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.StylePreset)
+	fc.Result = res
+	return ec.marshalOStylePreset2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePreset(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Style_preset(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Style",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StylePreset_id(ctx, field)
+			case "teamId":
+				return ec.fieldContext_StylePreset_teamId(ctx, field)
+			case "name":
+				return ec.fieldContext_StylePreset_name(ctx, field)
+			case "description":
+				return ec.fieldContext_StylePreset_description(ctx, field)
+			case "value":
+				return ec.fieldContext_StylePreset_value(ctx, field)
 			case "createdAt":
-				return ec.fieldContext_Story_createdAt(ctx, field)
+				return ec.fieldContext_StylePreset_createdAt(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Story_updatedAt(ctx, field)
-			case "publishedAt":
-				return ec.fieldContext_Story_publishedAt(ctx, field)
-			case "sceneId":
-				return ec.fieldContext_Story_sceneId(ctx, field)
-			case "scene":
-				return ec.fieldContext_Story_scene(ctx, field)
-			case "panelPosition":
-				return ec.fieldContext_Story_panelPosition(ctx, field)
-			case "bgColor":
-				return ec.fieldContext_Story_bgColor(ctx, field)
-			case "isBasicAuthActive":
-				return ec.fieldContext_Story_isBasicAuthActive(ctx, field)
-			case "basicAuthUsername":
-				return ec.fieldContext_Story_basicAuthUsername(ctx, field)
-			case "basicAuthPassword":
-				return ec.fieldContext_Story_basicAuthPassword(ctx, field)
-			case "publicTitle":
-				return ec.fieldContext_Story_publicTitle(ctx, field)
-			case "publicDescription":
-				return ec.fieldContext_Story_publicDescription(ctx, field)
-			case "publicImage":
-				return ec.fieldContext_Story_publicImage(ctx, field)
-			case "publicNoIndex":
-				return ec.fieldContext_Story_publicNoIndex(ctx, field)
-			case "enableGa":
-				return ec.fieldContext_Story_enableGa(ctx, field)
-			case "trackingId":
-				return ec.fieldContext_Story_trackingId(ctx, field)
+				return ec.fieldContext_StylePreset_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Story", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type StylePreset", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Style_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Style_id(ctx, field)
+func (ec *executionContext) _StyleIssue_path(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StyleIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StyleIssue_path(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Path, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StyleIssue_path(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StyleIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StyleIssue_type(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StyleIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StyleIssue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.StyleIssueType)
+	fc.Result = res
+	return ec.marshalNStyleIssueType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssueType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StyleIssue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StyleIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type StyleIssueType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StyleIssue_message(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StyleIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StyleIssue_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StyleIssue_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StyleIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StyleIssue_attribute(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StyleIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StyleIssue_attribute(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attribute, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StyleIssue_attribute(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StyleIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StyleIssue_suggestion(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StyleIssue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StyleIssue_suggestion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Suggestion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StyleIssue_suggestion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StyleIssue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StylePreset_id(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -38048,9 +42090,9 @@ func (ec *executionContext) _Style_id(ctx context.Context, field graphql.Collect
 	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Style_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StylePreset_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Style",
+		Object:     "StylePreset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -38061,8 +42103,52 @@ func (ec *executionContext) fieldContext_Style_id(_ context.Context, field graph
 	return fc, nil
 }
 
-func (ec *executionContext) _Style_name(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Style_name(ctx, field)
+func (ec *executionContext) _StylePreset_teamId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_teamId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(gqlmodel.ID)
+	fc.Result = res
+	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StylePreset_teamId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StylePreset",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StylePreset_name(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -38092,9 +42178,9 @@ func (ec *executionContext) _Style_name(ctx context.Context, field graphql.Colle
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Style_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StylePreset_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Style",
+		Object:     "StylePreset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -38105,8 +42191,52 @@ func (ec *executionContext) fieldContext_Style_name(_ context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _Style_value(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Style_value(ctx, field)
+func (ec *executionContext) _StylePreset_description(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_StylePreset_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StylePreset",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StylePreset_value(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -38136,9 +42266,9 @@ func (ec *executionContext) _Style_value(ctx context.Context, field graphql.Coll
 	return ec.marshalNJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Style_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StylePreset_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Style",
+		Object:     "StylePreset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -38149,8 +42279,8 @@ func (ec *executionContext) fieldContext_Style_value(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Style_sceneId(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Style_sceneId(ctx, field)
+func (ec *executionContext) _StylePreset_createdAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -38163,7 +42293,7 @@ func (ec *executionContext) _Style_sceneId(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SceneID, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -38175,26 +42305,26 @@ func (ec *executionContext) _Style_sceneId(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(gqlmodel.ID)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Style_sceneId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StylePreset_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Style",
+		Object:     "StylePreset",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type DateTime does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Style_scene(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.Style) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Style_scene(ctx, field)
+func (ec *executionContext) _StylePreset_updatedAt(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.StylePreset) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_StylePreset_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -38207,62 +42337,199 @@ func (ec *executionContext) _Style_scene(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Style().Scene(rctx, obj)
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*gqlmodel.Scene)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx, field.Selections, res)
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Style_scene(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_StylePreset_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Style",
+		Object:     "StylePreset",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_sceneEvents(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_sceneEvents(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SceneEvents(rctx, fc.Args["sceneId"].(gqlmodel.ID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *gqlmodel.SceneEvent):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNSceneEvent2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneEvent(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_sceneEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Scene_id(ctx, field)
-			case "projectId":
-				return ec.fieldContext_Scene_projectId(ctx, field)
-			case "teamId":
-				return ec.fieldContext_Scene_teamId(ctx, field)
-			case "propertyId":
-				return ec.fieldContext_Scene_propertyId(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Scene_createdAt(ctx, field)
+			case "sceneId":
+				return ec.fieldContext_SceneEvent_sceneId(ctx, field)
+			case "version":
+				return ec.fieldContext_SceneEvent_version(ctx, field)
+			case "revisionId":
+				return ec.fieldContext_SceneEvent_revisionId(ctx, field)
+			case "action":
+				return ec.fieldContext_SceneEvent_action(ctx, field)
+			case "userId":
+				return ec.fieldContext_SceneEvent_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_SceneEvent_user(ctx, field)
+			case "changes":
+				return ec.fieldContext_SceneEvent_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SceneEvent", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_sceneEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_scenePresences(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_scenePresences(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ScenePresences(rctx, fc.Args["sceneId"].(gqlmodel.ID))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*gqlmodel.ScenePresence):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNScenePresence2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePresenceᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_scenePresences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sceneId":
+				return ec.fieldContext_ScenePresence_sceneId(ctx, field)
+			case "userId":
+				return ec.fieldContext_ScenePresence_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_ScenePresence_user(ctx, field)
+			case "layerId":
+				return ec.fieldContext_ScenePresence_layerId(ctx, field)
 			case "updatedAt":
-				return ec.fieldContext_Scene_updatedAt(ctx, field)
-			case "widgets":
-				return ec.fieldContext_Scene_widgets(ctx, field)
-			case "plugins":
-				return ec.fieldContext_Scene_plugins(ctx, field)
-			case "widgetAlignSystem":
-				return ec.fieldContext_Scene_widgetAlignSystem(ctx, field)
-			case "project":
-				return ec.fieldContext_Scene_project(ctx, field)
-			case "team":
-				return ec.fieldContext_Scene_team(ctx, field)
-			case "property":
-				return ec.fieldContext_Scene_property(ctx, field)
-			case "newLayers":
-				return ec.fieldContext_Scene_newLayers(ctx, field)
-			case "stories":
-				return ec.fieldContext_Scene_stories(ctx, field)
-			case "styles":
-				return ec.fieldContext_Scene_styles(ctx, field)
+				return ec.fieldContext_ScenePresence_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Scene", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ScenePresence", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_scenePresences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
@@ -39369,6 +43636,8 @@ func (ec *executionContext) fieldContext_UninstallPluginPayload_scene(_ context.
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -39693,6 +43962,62 @@ func (ec *executionContext) fieldContext_UpdateNLSLayersPayload_layers(_ context
 	return fc, nil
 }
 
+func (ec *executionContext) _UpdateScenePresencePayload_presences(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.UpdateScenePresencePayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UpdateScenePresencePayload_presences(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Presences, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*gqlmodel.ScenePresence)
+	fc.Result = res
+	return ec.marshalNScenePresence2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePresenceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UpdateScenePresencePayload_presences(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateScenePresencePayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sceneId":
+				return ec.fieldContext_ScenePresence_sceneId(ctx, field)
+			case "userId":
+				return ec.fieldContext_ScenePresence_userId(ctx, field)
+			case "user":
+				return ec.fieldContext_ScenePresence_user(ctx, field)
+			case "layerId":
+				return ec.fieldContext_ScenePresence_layerId(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_ScenePresence_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScenePresence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _UpdateStylePayload_style(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.UpdateStylePayload) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_UpdateStylePayload_style(ctx, field)
 	if err != nil {
@@ -39742,6 +44067,10 @@ func (ec *executionContext) fieldContext_UpdateStylePayload_style(_ context.Cont
 				return ec.fieldContext_Style_sceneId(ctx, field)
 			case "scene":
 				return ec.fieldContext_Style_scene(ctx, field)
+			case "presetId":
+				return ec.fieldContext_Style_presetId(ctx, field)
+			case "preset":
+				return ec.fieldContext_Style_preset(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Style", field.Name)
 		},
@@ -39749,6 +44078,66 @@ func (ec *executionContext) fieldContext_UpdateStylePayload_style(_ context.Cont
 	return fc, nil
 }
 
+func (ec *executionContext) _UpdateStylePresetPayload_stylePreset(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.UpdateStylePresetPayload) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_UpdateStylePresetPayload_stylePreset(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StylePreset, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*gqlmodel.StylePreset)
+	fc.Result = res
+	return ec.marshalNStylePreset2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePreset(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_UpdateStylePresetPayload_stylePreset(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateStylePresetPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_StylePreset_id(ctx, field)
+			case "teamId":
+				return ec.fieldContext_StylePreset_teamId(ctx, field)
+			case "name":
+				return ec.fieldContext_StylePreset_name(ctx, field)
+			case "description":
+				return ec.fieldContext_StylePreset_description(ctx, field)
+			case "value":
+				return ec.fieldContext_StylePreset_value(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_StylePreset_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_StylePreset_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StylePreset", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _UpdateTeamPayload_team(ctx context.Context, field graphql.CollectedField, obj *gqlmodel.UpdateTeamPayload) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_UpdateTeamPayload_team(ctx, field)
 	if err != nil {
@@ -39862,6 +44251,8 @@ func (ec *executionContext) fieldContext_UpdateWidgetAlignSystemPayload_scene(_
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -39938,6 +44329,8 @@ func (ec *executionContext) fieldContext_UpdateWidgetPayload_scene(_ context.Con
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -40078,6 +44471,8 @@ func (ec *executionContext) fieldContext_UpgradePluginPayload_scene(_ context.Co
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -40286,6 +44681,8 @@ func (ec *executionContext) fieldContext_UploadPluginPayload_scene(_ context.Con
 				return ec.fieldContext_Scene_createdAt(ctx, field)
 			case "updatedAt":
 				return ec.fieldContext_Scene_updatedAt(ctx, field)
+			case "version":
+				return ec.fieldContext_Scene_version(ctx, field)
 			case "widgets":
 				return ec.fieldContext_Scene_widgets(ctx, field)
 			case "plugins":
@@ -43754,7 +48151,7 @@ func (ec *executionContext) unmarshalInputAddGeoJSONFeatureInput(ctx context.Con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"type", "geometry", "properties", "layerId"}
+	fieldsInOrder := [...]string{"type", "geometry", "properties", "layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -43789,6 +48186,13 @@ func (ec *executionContext) unmarshalInputAddGeoJSONFeatureInput(ctx context.Con
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -43843,7 +48247,7 @@ func (ec *executionContext) unmarshalInputAddNLSInfoboxBlockInput(ctx context.Co
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId", "pluginId", "extensionId", "index"}
+	fieldsInOrder := [...]string{"layerId", "pluginId", "extensionId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -43878,6 +48282,13 @@ func (ec *executionContext) unmarshalInputAddNLSInfoboxBlockInput(ctx context.Co
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -43891,7 +48302,7 @@ func (ec *executionContext) unmarshalInputAddNLSLayerSimpleInput(ctx context.Con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerType", "title", "sceneId", "config", "index", "visible", "schema"}
+	fieldsInOrder := [...]string{"layerType", "title", "sceneId", "config", "index", "visible", "schema", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -43947,6 +48358,13 @@ func (ec *executionContext) unmarshalInputAddNLSLayerSimpleInput(ctx context.Con
 				return it, err
 			}
 			it.Schema = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -43960,7 +48378,7 @@ func (ec *executionContext) unmarshalInputAddPropertyItemInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "index", "nameFieldValue", "nameFieldType"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "index", "nameFieldValue", "nameFieldType", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44002,6 +48420,13 @@ func (ec *executionContext) unmarshalInputAddPropertyItemInput(ctx context.Conte
 				return it, err
 			}
 			it.NameFieldType = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44015,7 +48440,7 @@ func (ec *executionContext) unmarshalInputAddStyleInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "name", "value"}
+	fieldsInOrder := [...]string{"sceneId", "name", "value", "presetId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44038,11 +48463,25 @@ func (ec *executionContext) unmarshalInputAddStyleInput(ctx context.Context, obj
 			it.Name = data
 		case "value":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalNJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, v)
+			data, err := ec.unmarshalOJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Value = data
+		case "presetId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("presetId"))
+			data, err := ec.unmarshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PresetID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44056,7 +48495,7 @@ func (ec *executionContext) unmarshalInputAddWidgetInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "pluginId", "extensionId"}
+	fieldsInOrder := [...]string{"sceneId", "pluginId", "extensionId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44084,6 +48523,13 @@ func (ec *executionContext) unmarshalInputAddWidgetInput(ctx context.Context, ob
 				return it, err
 			}
 			it.ExtensionID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44131,7 +48577,7 @@ func (ec *executionContext) unmarshalInputChangeCustomPropertyTitleInput(ctx con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId", "schema", "oldTitle", "newTitle"}
+	fieldsInOrder := [...]string{"layerId", "schema", "oldTitle", "newTitle", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44166,6 +48612,13 @@ func (ec *executionContext) unmarshalInputChangeCustomPropertyTitleInput(ctx con
 				return it, err
 			}
 			it.NewTitle = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44227,7 +48680,7 @@ func (ec *executionContext) unmarshalInputCreateNLSInfoboxInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId"}
+	fieldsInOrder := [...]string{"layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44241,6 +48694,13 @@ func (ec *executionContext) unmarshalInputCreateNLSInfoboxInput(ctx context.Cont
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44254,7 +48714,7 @@ func (ec *executionContext) unmarshalInputCreateNLSPhotoOverlayInput(ctx context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId"}
+	fieldsInOrder := [...]string{"layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44268,6 +48728,13 @@ func (ec *executionContext) unmarshalInputCreateNLSPhotoOverlayInput(ctx context
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44384,7 +48851,7 @@ func (ec *executionContext) unmarshalInputCreateStoryBlockInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"storyId", "pageId", "pluginId", "extensionId", "index"}
+	fieldsInOrder := [...]string{"storyId", "pageId", "pluginId", "extensionId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44426,6 +48893,13 @@ func (ec *executionContext) unmarshalInputCreateStoryBlockInput(ctx context.Cont
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44439,7 +48913,7 @@ func (ec *executionContext) unmarshalInputCreateStoryInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "title", "index"}
+	fieldsInOrder := [...]string{"sceneId", "title", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44467,6 +48941,13 @@ func (ec *executionContext) unmarshalInputCreateStoryInput(ctx context.Context,
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44480,7 +48961,253 @@ func (ec *executionContext) unmarshalInputCreateStoryPageInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "title", "swipeable", "layers", "swipeableLayers", "index"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "title", "swipeable", "layers", "swipeableLayers", "index", "version"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sceneId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SceneID = data
+		case "storyId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("storyId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StoryID = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "swipeable":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("swipeable"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Swipeable = data
+		case "layers":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layers"))
+			data, err := ec.unmarshalOID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Layers = data
+		case "swipeableLayers":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("swipeableLayers"))
+			data, err := ec.unmarshalOID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SwipeableLayers = data
+		case "index":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateStylePresetInput(ctx context.Context, obj any) (gqlmodel.CreateStylePresetInput, error) {
+	var it gqlmodel.CreateStylePresetInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"teamId", "name", "description", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "teamId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TeamID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateTeamInput(ctx context.Context, obj any) (gqlmodel.CreateTeamInput, error) {
+	var it gqlmodel.CreateTeamInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteGeoJSONFeatureInput(ctx context.Context, obj any) (gqlmodel.DeleteGeoJSONFeatureInput, error) {
+	var it gqlmodel.DeleteGeoJSONFeatureInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"featureId", "layerId", "version"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "featureId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("featureId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FeatureID = data
+		case "layerId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layerId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteMeInput(ctx context.Context, obj any) (gqlmodel.DeleteMeInput, error) {
+	var it gqlmodel.DeleteMeInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"userId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "userId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteProjectInput(ctx context.Context, obj any) (gqlmodel.DeleteProjectInput, error) {
+	var it gqlmodel.DeleteProjectInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"projectId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "projectId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ProjectID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDeleteStoryInput(ctx context.Context, obj any) (gqlmodel.DeleteStoryInput, error) {
+	var it gqlmodel.DeleteStoryInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sceneId", "storyId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44501,204 +49228,136 @@ func (ec *executionContext) unmarshalInputCreateStoryPageInput(ctx context.Conte
 				return it, err
 			}
 			it.StoryID = data
-		case "title":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Title = data
-		case "swipeable":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("swipeable"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Swipeable = data
-		case "layers":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layers"))
-			data, err := ec.unmarshalOID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Layers = data
-		case "swipeableLayers":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("swipeableLayers"))
-			data, err := ec.unmarshalOID2ᚕgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐIDᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.SwipeableLayers = data
-		case "index":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
 			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Index = data
+			it.Version = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateTeamInput(ctx context.Context, obj any) (gqlmodel.CreateTeamInput, error) {
-	var it gqlmodel.CreateTeamInput
+func (ec *executionContext) unmarshalInputDeleteStoryPageInput(ctx context.Context, obj any) (gqlmodel.DeleteStoryPageInput, error) {
+	var it gqlmodel.DeleteStoryPageInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+		case "sceneId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Name = data
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputDeleteGeoJSONFeatureInput(ctx context.Context, obj any) (gqlmodel.DeleteGeoJSONFeatureInput, error) {
-	var it gqlmodel.DeleteGeoJSONFeatureInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"featureId", "layerId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "featureId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("featureId"))
+			it.SceneID = data
+		case "storyId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("storyId"))
 			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.FeatureID = data
-		case "layerId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layerId"))
+			it.StoryID = data
+		case "pageId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageId"))
 			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.LayerID = data
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputDeleteMeInput(ctx context.Context, obj any) (gqlmodel.DeleteMeInput, error) {
-	var it gqlmodel.DeleteMeInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"userId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "userId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
-			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			it.PageID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.UserID = data
+			it.Version = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteProjectInput(ctx context.Context, obj any) (gqlmodel.DeleteProjectInput, error) {
-	var it gqlmodel.DeleteProjectInput
+func (ec *executionContext) unmarshalInputDeleteTeamInput(ctx context.Context, obj any) (gqlmodel.DeleteTeamInput, error) {
+	var it gqlmodel.DeleteTeamInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"projectId"}
+	fieldsInOrder := [...]string{"teamId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "projectId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectId"))
+		case "teamId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
 			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ProjectID = data
+			it.TeamID = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteStoryInput(ctx context.Context, obj any) (gqlmodel.DeleteStoryInput, error) {
-	var it gqlmodel.DeleteStoryInput
+func (ec *executionContext) unmarshalInputDuplicateNLSLayerInput(ctx context.Context, obj any) (gqlmodel.DuplicateNLSLayerInput, error) {
+	var it gqlmodel.DuplicateNLSLayerInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId"}
+	fieldsInOrder := [...]string{"layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "sceneId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+		case "layerId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layerId"))
 			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.SceneID = data
-		case "storyId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("storyId"))
-			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.StoryID = data
+			it.Version = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteStoryPageInput(ctx context.Context, obj any) (gqlmodel.DeleteStoryPageInput, error) {
-	var it gqlmodel.DeleteStoryPageInput
+func (ec *executionContext) unmarshalInputDuplicateStoryPageInput(ctx context.Context, obj any) (gqlmodel.DuplicateStoryPageInput, error) {
+	var it gqlmodel.DuplicateStoryPageInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44726,74 +49385,88 @@ func (ec *executionContext) unmarshalInputDeleteStoryPageInput(ctx context.Conte
 				return it, err
 			}
 			it.PageID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDeleteTeamInput(ctx context.Context, obj any) (gqlmodel.DeleteTeamInput, error) {
-	var it gqlmodel.DeleteTeamInput
+func (ec *executionContext) unmarshalInputDuplicateStyleInput(ctx context.Context, obj any) (gqlmodel.DuplicateStyleInput, error) {
+	var it gqlmodel.DuplicateStyleInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"teamId"}
+	fieldsInOrder := [...]string{"styleId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "teamId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+		case "styleId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("styleId"))
 			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.TeamID = data
+			it.StyleID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDuplicateNLSLayerInput(ctx context.Context, obj any) (gqlmodel.DuplicateNLSLayerInput, error) {
-	var it gqlmodel.DuplicateNLSLayerInput
+func (ec *executionContext) unmarshalInputExportProjectInput(ctx context.Context, obj any) (gqlmodel.ExportProjectInput, error) {
+	var it gqlmodel.ExportProjectInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId"}
+	fieldsInOrder := [...]string{"projectId"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "layerId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layerId"))
+		case "projectId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectId"))
 			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.LayerID = data
+			it.ProjectID = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputDuplicateStoryPageInput(ctx context.Context, obj any) (gqlmodel.DuplicateStoryPageInput, error) {
-	var it gqlmodel.DuplicateStoryPageInput
+func (ec *executionContext) unmarshalInputImportNLSLayerFileInput(ctx context.Context, obj any) (gqlmodel.ImportNLSLayerFileInput, error) {
+	var it gqlmodel.ImportNLSLayerFileInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId"}
+	fieldsInOrder := [...]string{"sceneId", "file", "title", "index", "visible", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44807,74 +49480,41 @@ func (ec *executionContext) unmarshalInputDuplicateStoryPageInput(ctx context.Co
 				return it, err
 			}
 			it.SceneID = data
-		case "storyId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("storyId"))
-			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+		case "file":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("file"))
+			data, err := ec.unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.StoryID = data
-		case "pageId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pageId"))
-			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			it.File = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.PageID = data
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputDuplicateStyleInput(ctx context.Context, obj any) (gqlmodel.DuplicateStyleInput, error) {
-	var it gqlmodel.DuplicateStyleInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"styleId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "styleId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("styleId"))
-			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			it.Title = data
+		case "index":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("index"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.StyleID = data
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputExportProjectInput(ctx context.Context, obj any) (gqlmodel.ExportProjectInput, error) {
-	var it gqlmodel.ExportProjectInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"projectId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "projectId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("projectId"))
-			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			it.Index = data
+		case "visible":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("visible"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ProjectID = data
+			it.Visible = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44956,7 +49596,7 @@ func (ec *executionContext) unmarshalInputMoveNLSInfoboxBlockInput(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId", "infoboxBlockId", "index"}
+	fieldsInOrder := [...]string{"layerId", "infoboxBlockId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -44984,6 +49624,13 @@ func (ec *executionContext) unmarshalInputMoveNLSInfoboxBlockInput(ctx context.C
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -44997,7 +49644,7 @@ func (ec *executionContext) unmarshalInputMovePropertyItemInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "index"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45032,6 +49679,13 @@ func (ec *executionContext) unmarshalInputMovePropertyItemInput(ctx context.Cont
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45045,7 +49699,7 @@ func (ec *executionContext) unmarshalInputMoveStoryBlockInput(ctx context.Contex
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"storyId", "pageId", "blockId", "index"}
+	fieldsInOrder := [...]string{"storyId", "pageId", "blockId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45080,6 +49734,13 @@ func (ec *executionContext) unmarshalInputMoveStoryBlockInput(ctx context.Contex
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45093,7 +49754,7 @@ func (ec *executionContext) unmarshalInputMoveStoryInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "index"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45121,6 +49782,13 @@ func (ec *executionContext) unmarshalInputMoveStoryInput(ctx context.Context, ob
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45134,7 +49802,7 @@ func (ec *executionContext) unmarshalInputMoveStoryPageInput(ctx context.Context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"storyId", "pageId", "index"}
+	fieldsInOrder := [...]string{"storyId", "pageId", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45162,6 +49830,13 @@ func (ec *executionContext) unmarshalInputMoveStoryPageInput(ctx context.Context
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45175,7 +49850,7 @@ func (ec *executionContext) unmarshalInputPageLayerInput(ctx context.Context, ob
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId", "swipeable", "layerId"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId", "swipeable", "layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45217,6 +49892,13 @@ func (ec *executionContext) unmarshalInputPageLayerInput(ctx context.Context, ob
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45353,7 +50035,7 @@ func (ec *executionContext) unmarshalInputPublishStoryInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"storyId", "alias", "status"}
+	fieldsInOrder := [...]string{"storyId", "alias", "status", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45381,6 +50063,13 @@ func (ec *executionContext) unmarshalInputPublishStoryInput(ctx context.Context,
 				return it, err
 			}
 			it.Status = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45421,7 +50110,7 @@ func (ec *executionContext) unmarshalInputRemoveCustomPropertyInput(ctx context.
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId", "schema", "removedTitle"}
+	fieldsInOrder := [...]string{"layerId", "schema", "removedTitle", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45449,6 +50138,13 @@ func (ec *executionContext) unmarshalInputRemoveCustomPropertyInput(ctx context.
 				return it, err
 			}
 			it.RemovedTitle = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45523,7 +50219,7 @@ func (ec *executionContext) unmarshalInputRemoveNLSInfoboxBlockInput(ctx context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId", "infoboxBlockId"}
+	fieldsInOrder := [...]string{"layerId", "infoboxBlockId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45544,6 +50240,13 @@ func (ec *executionContext) unmarshalInputRemoveNLSInfoboxBlockInput(ctx context
 				return it, err
 			}
 			it.InfoboxBlockID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45557,7 +50260,7 @@ func (ec *executionContext) unmarshalInputRemoveNLSInfoboxInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId"}
+	fieldsInOrder := [...]string{"layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45571,6 +50274,13 @@ func (ec *executionContext) unmarshalInputRemoveNLSInfoboxInput(ctx context.Cont
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45584,7 +50294,7 @@ func (ec *executionContext) unmarshalInputRemoveNLSLayerInput(ctx context.Contex
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId"}
+	fieldsInOrder := [...]string{"layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45598,6 +50308,13 @@ func (ec *executionContext) unmarshalInputRemoveNLSLayerInput(ctx context.Contex
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45611,7 +50328,7 @@ func (ec *executionContext) unmarshalInputRemoveNLSPhotoOverlayInput(ctx context
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId"}
+	fieldsInOrder := [...]string{"layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45625,6 +50342,13 @@ func (ec *executionContext) unmarshalInputRemoveNLSPhotoOverlayInput(ctx context
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45638,7 +50362,7 @@ func (ec *executionContext) unmarshalInputRemovePropertyFieldInput(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45673,6 +50397,13 @@ func (ec *executionContext) unmarshalInputRemovePropertyFieldInput(ctx context.C
 				return it, err
 			}
 			it.FieldID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45686,7 +50417,7 @@ func (ec *executionContext) unmarshalInputRemovePropertyItemInput(ctx context.Co
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45714,6 +50445,13 @@ func (ec *executionContext) unmarshalInputRemovePropertyItemInput(ctx context.Co
 				return it, err
 			}
 			it.ItemID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45727,7 +50465,7 @@ func (ec *executionContext) unmarshalInputRemoveStoryBlockInput(ctx context.Cont
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"storyId", "pageId", "blockId"}
+	fieldsInOrder := [...]string{"storyId", "pageId", "blockId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45755,6 +50493,13 @@ func (ec *executionContext) unmarshalInputRemoveStoryBlockInput(ctx context.Cont
 				return it, err
 			}
 			it.BlockID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45768,7 +50513,7 @@ func (ec *executionContext) unmarshalInputRemoveStyleInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"styleId"}
+	fieldsInOrder := [...]string{"styleId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45782,6 +50527,40 @@ func (ec *executionContext) unmarshalInputRemoveStyleInput(ctx context.Context,
 				return it, err
 			}
 			it.StyleID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRemoveStylePresetInput(ctx context.Context, obj any) (gqlmodel.RemoveStylePresetInput, error) {
+	var it gqlmodel.RemoveStylePresetInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"presetId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "presetId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("presetId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PresetID = data
 		}
 	}
 
@@ -45795,7 +50574,7 @@ func (ec *executionContext) unmarshalInputRemoveWidgetInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "widgetId"}
+	fieldsInOrder := [...]string{"sceneId", "widgetId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45816,6 +50595,47 @@ func (ec *executionContext) unmarshalInputRemoveWidgetInput(ctx context.Context,
 				return it, err
 			}
 			it.WidgetID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRestoreSceneRevisionInput(ctx context.Context, obj any) (gqlmodel.RestoreSceneRevisionInput, error) {
+	var it gqlmodel.RestoreSceneRevisionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"revisionId", "version"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "revisionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("revisionId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RevisionID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -45918,7 +50738,7 @@ func (ec *executionContext) unmarshalInputUnlinkPropertyValueInput(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -45953,6 +50773,13 @@ func (ec *executionContext) unmarshalInputUnlinkPropertyValueInput(ctx context.C
 				return it, err
 			}
 			it.FieldID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46000,7 +50827,7 @@ func (ec *executionContext) unmarshalInputUpdateCustomPropertySchemaInput(ctx co
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layerId", "schema"}
+	fieldsInOrder := [...]string{"layerId", "schema", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46021,6 +50848,13 @@ func (ec *executionContext) unmarshalInputUpdateCustomPropertySchemaInput(ctx co
 				return it, err
 			}
 			it.Schema = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46034,7 +50868,7 @@ func (ec *executionContext) unmarshalInputUpdateGeoJSONFeatureInput(ctx context.
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"featureId", "geometry", "properties", "layerId"}
+	fieldsInOrder := [...]string{"featureId", "geometry", "properties", "layerId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46069,6 +50903,13 @@ func (ec *executionContext) unmarshalInputUpdateGeoJSONFeatureInput(ctx context.
 				return it, err
 			}
 			it.LayerID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46185,7 +51026,7 @@ func (ec *executionContext) unmarshalInputUpdateNLSLayerInput(ctx context.Contex
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"index", "layerId", "name", "visible", "config"}
+	fieldsInOrder := [...]string{"index", "layerId", "name", "visible", "config", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46227,6 +51068,13 @@ func (ec *executionContext) unmarshalInputUpdateNLSLayerInput(ctx context.Contex
 				return it, err
 			}
 			it.Config = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46240,7 +51088,7 @@ func (ec *executionContext) unmarshalInputUpdateNLSLayersInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"layers"}
+	fieldsInOrder := [...]string{"layers", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46254,6 +51102,13 @@ func (ec *executionContext) unmarshalInputUpdateNLSLayersInput(ctx context.Conte
 				return it, err
 			}
 			it.Layers = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46427,7 +51282,7 @@ func (ec *executionContext) unmarshalInputUpdatePropertyItemInput(ctx context.Co
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "operations"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "operations", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46455,6 +51310,13 @@ func (ec *executionContext) unmarshalInputUpdatePropertyItemInput(ctx context.Co
 				return it, err
 			}
 			it.Operations = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46523,7 +51385,7 @@ func (ec *executionContext) unmarshalInputUpdatePropertyValueInput(ctx context.C
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId", "value", "type"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId", "value", "type", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46572,6 +51434,47 @@ func (ec *executionContext) unmarshalInputUpdatePropertyValueInput(ctx context.C
 				return it, err
 			}
 			it.Type = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateScenePresenceInput(ctx context.Context, obj any) (gqlmodel.UpdateScenePresenceInput, error) {
+	var it gqlmodel.UpdateScenePresenceInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"sceneId", "layerId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "sceneId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sceneId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SceneID = data
+		case "layerId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("layerId"))
+			data, err := ec.unmarshalOID2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LayerID = data
 		}
 	}
 
@@ -46585,7 +51488,7 @@ func (ec *executionContext) unmarshalInputUpdateStoryInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "title", "index", "panelPosition", "bgColor", "isBasicAuthActive", "basicAuthUsername", "basicAuthPassword", "alias", "publicTitle", "publicDescription", "publicImage", "publicNoIndex", "deletePublicImage", "enableGa", "trackingId"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "title", "index", "panelPosition", "bgColor", "isBasicAuthActive", "basicAuthUsername", "basicAuthPassword", "alias", "publicTitle", "publicDescription", "publicImage", "publicNoIndex", "deletePublicImage", "enableGa", "trackingId", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46711,6 +51614,13 @@ func (ec *executionContext) unmarshalInputUpdateStoryInput(ctx context.Context,
 				return it, err
 			}
 			it.TrackingID = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46724,7 +51634,7 @@ func (ec *executionContext) unmarshalInputUpdateStoryPageInput(ctx context.Conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId", "title", "swipeable", "layers", "swipeableLayers", "index"}
+	fieldsInOrder := [...]string{"sceneId", "storyId", "pageId", "title", "swipeable", "layers", "swipeableLayers", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46787,6 +51697,13 @@ func (ec *executionContext) unmarshalInputUpdateStoryPageInput(ctx context.Conte
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46800,7 +51717,7 @@ func (ec *executionContext) unmarshalInputUpdateStyleInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"styleId", "name", "value"}
+	fieldsInOrder := [...]string{"styleId", "name", "value", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46828,6 +51745,61 @@ func (ec *executionContext) unmarshalInputUpdateStyleInput(ctx context.Context,
 				return it, err
 			}
 			it.Value = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateStylePresetInput(ctx context.Context, obj any) (gqlmodel.UpdateStylePresetInput, error) {
+	var it gqlmodel.UpdateStylePresetInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"presetId", "name", "description", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "presetId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("presetId"))
+			data, err := ec.unmarshalNID2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐID(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PresetID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "description":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Description = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOJSON2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐJSON(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
 		}
 	}
 
@@ -46875,7 +51847,7 @@ func (ec *executionContext) unmarshalInputUpdateWidgetAlignSystemInput(ctx conte
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "location", "align", "padding", "gap", "centered", "background"}
+	fieldsInOrder := [...]string{"sceneId", "location", "align", "padding", "gap", "centered", "background", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46931,6 +51903,13 @@ func (ec *executionContext) unmarshalInputUpdateWidgetAlignSystemInput(ctx conte
 				return it, err
 			}
 			it.Background = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -46944,7 +51923,7 @@ func (ec *executionContext) unmarshalInputUpdateWidgetInput(ctx context.Context,
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"sceneId", "widgetId", "enabled", "location", "extended", "index"}
+	fieldsInOrder := [...]string{"sceneId", "widgetId", "enabled", "location", "extended", "index", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -46993,6 +51972,13 @@ func (ec *executionContext) unmarshalInputUpdateWidgetInput(ctx context.Context,
 				return it, err
 			}
 			it.Index = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -47047,7 +52033,7 @@ func (ec *executionContext) unmarshalInputUploadFileToPropertyInput(ctx context.
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId", "file"}
+	fieldsInOrder := [...]string{"propertyId", "schemaGroupId", "itemId", "fieldId", "file", "version"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -47089,6 +52075,13 @@ func (ec *executionContext) unmarshalInputUploadFileToPropertyInput(ctx context.
 				return it, err
 			}
 			it.File = data
+		case "version":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("version"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Version = data
 		}
 	}
 
@@ -47951,19 +52944,58 @@ func (ec *executionContext) _CreateNLSInfoboxPayload(ctx context.Context, sel as
 	return out
 }
 
-var createNLSPhotoOverlayPayloadImplementors = []string{"CreateNLSPhotoOverlayPayload"}
+var createNLSPhotoOverlayPayloadImplementors = []string{"CreateNLSPhotoOverlayPayload"}
+
+func (ec *executionContext) _CreateNLSPhotoOverlayPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateNLSPhotoOverlayPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createNLSPhotoOverlayPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateNLSPhotoOverlayPayload")
+		case "layer":
+			out.Values[i] = ec._CreateNLSPhotoOverlayPayload_layer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createScenePayloadImplementors = []string{"CreateScenePayload"}
 
-func (ec *executionContext) _CreateNLSPhotoOverlayPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateNLSPhotoOverlayPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createNLSPhotoOverlayPayloadImplementors)
+func (ec *executionContext) _CreateScenePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateScenePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createScenePayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateNLSPhotoOverlayPayload")
-		case "layer":
-			out.Values[i] = ec._CreateNLSPhotoOverlayPayload_layer(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CreateScenePayload")
+		case "scene":
+			out.Values[i] = ec._CreateScenePayload_scene(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -47990,19 +53022,34 @@ func (ec *executionContext) _CreateNLSPhotoOverlayPayload(ctx context.Context, s
 	return out
 }
 
-var createScenePayloadImplementors = []string{"CreateScenePayload"}
+var createStoryBlockPayloadImplementors = []string{"CreateStoryBlockPayload"}
 
-func (ec *executionContext) _CreateScenePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateScenePayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createScenePayloadImplementors)
+func (ec *executionContext) _CreateStoryBlockPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateStoryBlockPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createStoryBlockPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateScenePayload")
-		case "scene":
-			out.Values[i] = ec._CreateScenePayload_scene(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CreateStoryBlockPayload")
+		case "block":
+			out.Values[i] = ec._CreateStoryBlockPayload_block(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "page":
+			out.Values[i] = ec._CreateStoryBlockPayload_page(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "story":
+			out.Values[i] = ec._CreateStoryBlockPayload_story(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "index":
+			out.Values[i] = ec._CreateStoryBlockPayload_index(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -48029,34 +53076,19 @@ func (ec *executionContext) _CreateScenePayload(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var createStoryBlockPayloadImplementors = []string{"CreateStoryBlockPayload"}
+var createStylePresetPayloadImplementors = []string{"CreateStylePresetPayload"}
 
-func (ec *executionContext) _CreateStoryBlockPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateStoryBlockPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createStoryBlockPayloadImplementors)
+func (ec *executionContext) _CreateStylePresetPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.CreateStylePresetPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createStylePresetPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateStoryBlockPayload")
-		case "block":
-			out.Values[i] = ec._CreateStoryBlockPayload_block(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "page":
-			out.Values[i] = ec._CreateStoryBlockPayload_page(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "story":
-			out.Values[i] = ec._CreateStoryBlockPayload_story(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "index":
-			out.Values[i] = ec._CreateStoryBlockPayload_index(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CreateStylePresetPayload")
+		case "stylePreset":
+			out.Values[i] = ec._CreateStylePresetPayload_stylePreset(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -48617,6 +53649,45 @@ func (ec *executionContext) _GeometryCollection(ctx context.Context, sel ast.Sel
 	return out
 }
 
+var importNLSLayerFilePayloadImplementors = []string{"ImportNLSLayerFilePayload"}
+
+func (ec *executionContext) _ImportNLSLayerFilePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.ImportNLSLayerFilePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, importNLSLayerFilePayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImportNLSLayerFilePayload")
+		case "layer":
+			out.Values[i] = ec._ImportNLSLayerFilePayload_layer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var importProjectPayloadImplementors = []string{"ImportProjectPayload"}
 
 func (ec *executionContext) _ImportProjectPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.ImportProjectPayload) graphql.Marshaler {
@@ -49937,6 +55008,10 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_removeAsset(ctx, field)
 			})
+		case "updateScenePresence":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateScenePresence(ctx, field)
+			})
 		case "addGeoJSONFeature":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_addGeoJSONFeature(ctx, field)
@@ -49965,6 +55040,13 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "importNLSLayerFile":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_importNLSLayerFile(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "removeNLSLayer":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_removeNLSLayer(ctx, field)
@@ -50114,6 +55196,10 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_updatePropertyItems(ctx, field)
 			})
+		case "restoreSceneRevision":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_restoreSceneRevision(ctx, field)
+			})
 		case "createScene":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_createScene(ctx, field)
@@ -50239,6 +55325,18 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_duplicateStyle(ctx, field)
 			})
+		case "createStylePreset":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createStylePreset(ctx, field)
+			})
+		case "updateStylePreset":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateStylePreset(ctx, field)
+			})
+		case "removeStylePreset":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeStylePreset(ctx, field)
+			})
 		case "signup":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_signup(ctx, field)
@@ -53335,6 +58433,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sceneRevisions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sceneRevisions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sceneRevisionDiff":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sceneRevisionDiff(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "scene":
 			field := field
@@ -53354,6 +58496,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "validateStyle":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_validateStyle(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "stylePresets":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_stylePresets(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "me":
 			field := field
@@ -53639,19 +58825,107 @@ func (ec *executionContext) _RemoveNLSInfoboxPayload(ctx context.Context, sel as
 	return out
 }
 
-var removeNLSLayerPayloadImplementors = []string{"RemoveNLSLayerPayload"}
+var removeNLSLayerPayloadImplementors = []string{"RemoveNLSLayerPayload"}
+
+func (ec *executionContext) _RemoveNLSLayerPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveNLSLayerPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeNLSLayerPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RemoveNLSLayerPayload")
+		case "layerId":
+			out.Values[i] = ec._RemoveNLSLayerPayload_layerId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var removeNLSPhotoOverlayPayloadImplementors = []string{"RemoveNLSPhotoOverlayPayload"}
+
+func (ec *executionContext) _RemoveNLSPhotoOverlayPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveNLSPhotoOverlayPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeNLSPhotoOverlayPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RemoveNLSPhotoOverlayPayload")
+		case "layer":
+			out.Values[i] = ec._RemoveNLSPhotoOverlayPayload_layer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var removeStoryBlockPayloadImplementors = []string{"RemoveStoryBlockPayload"}
 
-func (ec *executionContext) _RemoveNLSLayerPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveNLSLayerPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, removeNLSLayerPayloadImplementors)
+func (ec *executionContext) _RemoveStoryBlockPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveStoryBlockPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeStoryBlockPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RemoveNLSLayerPayload")
-		case "layerId":
-			out.Values[i] = ec._RemoveNLSLayerPayload_layerId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RemoveStoryBlockPayload")
+		case "blockId":
+			out.Values[i] = ec._RemoveStoryBlockPayload_blockId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "page":
+			out.Values[i] = ec._RemoveStoryBlockPayload_page(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "story":
+			out.Values[i] = ec._RemoveStoryBlockPayload_story(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -53678,19 +58952,19 @@ func (ec *executionContext) _RemoveNLSLayerPayload(ctx context.Context, sel ast.
 	return out
 }
 
-var removeNLSPhotoOverlayPayloadImplementors = []string{"RemoveNLSPhotoOverlayPayload"}
+var removeStylePayloadImplementors = []string{"RemoveStylePayload"}
 
-func (ec *executionContext) _RemoveNLSPhotoOverlayPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveNLSPhotoOverlayPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, removeNLSPhotoOverlayPayloadImplementors)
+func (ec *executionContext) _RemoveStylePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveStylePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeStylePayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RemoveNLSPhotoOverlayPayload")
-		case "layer":
-			out.Values[i] = ec._RemoveNLSPhotoOverlayPayload_layer(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RemoveStylePayload")
+		case "styleId":
+			out.Values[i] = ec._RemoveStylePayload_styleId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -53717,29 +58991,19 @@ func (ec *executionContext) _RemoveNLSPhotoOverlayPayload(ctx context.Context, s
 	return out
 }
 
-var removeStoryBlockPayloadImplementors = []string{"RemoveStoryBlockPayload"}
+var removeStylePresetPayloadImplementors = []string{"RemoveStylePresetPayload"}
 
-func (ec *executionContext) _RemoveStoryBlockPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveStoryBlockPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, removeStoryBlockPayloadImplementors)
+func (ec *executionContext) _RemoveStylePresetPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveStylePresetPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeStylePresetPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RemoveStoryBlockPayload")
-		case "blockId":
-			out.Values[i] = ec._RemoveStoryBlockPayload_blockId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "page":
-			out.Values[i] = ec._RemoveStoryBlockPayload_page(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "story":
-			out.Values[i] = ec._RemoveStoryBlockPayload_story(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RemoveStylePresetPayload")
+		case "presetId":
+			out.Values[i] = ec._RemoveStylePresetPayload_presetId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -53766,19 +59030,24 @@ func (ec *executionContext) _RemoveStoryBlockPayload(ctx context.Context, sel as
 	return out
 }
 
-var removeStylePayloadImplementors = []string{"RemoveStylePayload"}
+var removeWidgetPayloadImplementors = []string{"RemoveWidgetPayload"}
 
-func (ec *executionContext) _RemoveStylePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveStylePayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, removeStylePayloadImplementors)
+func (ec *executionContext) _RemoveWidgetPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveWidgetPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, removeWidgetPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RemoveStylePayload")
-		case "styleId":
-			out.Values[i] = ec._RemoveStylePayload_styleId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RemoveWidgetPayload")
+		case "scene":
+			out.Values[i] = ec._RemoveWidgetPayload_scene(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "widgetId":
+			out.Values[i] = ec._RemoveWidgetPayload_widgetId(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -53805,24 +59074,19 @@ func (ec *executionContext) _RemoveStylePayload(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var removeWidgetPayloadImplementors = []string{"RemoveWidgetPayload"}
+var restoreSceneRevisionPayloadImplementors = []string{"RestoreSceneRevisionPayload"}
 
-func (ec *executionContext) _RemoveWidgetPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RemoveWidgetPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, removeWidgetPayloadImplementors)
+func (ec *executionContext) _RestoreSceneRevisionPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.RestoreSceneRevisionPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, restoreSceneRevisionPayloadImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RemoveWidgetPayload")
-		case "scene":
-			out.Values[i] = ec._RemoveWidgetPayload_scene(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "widgetId":
-			out.Values[i] = ec._RemoveWidgetPayload_widgetId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RestoreSceneRevisionPayload")
+		case "revision":
+			out.Values[i] = ec._RestoreSceneRevisionPayload_revision(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -53890,6 +59154,11 @@ func (ec *executionContext) _Scene(ctx context.Context, sel ast.SelectionSet, ob
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
+		case "version":
+			out.Values[i] = ec._Scene_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		case "widgets":
 			out.Values[i] = ec._Scene_widgets(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -54132,6 +59401,100 @@ func (ec *executionContext) _Scene(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var sceneEventImplementors = []string{"SceneEvent"}
+
+func (ec *executionContext) _SceneEvent(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.SceneEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SceneEvent")
+		case "sceneId":
+			out.Values[i] = ec._SceneEvent_sceneId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "version":
+			out.Values[i] = ec._SceneEvent_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "revisionId":
+			out.Values[i] = ec._SceneEvent_revisionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "action":
+			out.Values[i] = ec._SceneEvent_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "userId":
+			out.Values[i] = ec._SceneEvent_userId(ctx, field, obj)
+		case "user":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SceneEvent_user(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "changes":
+			out.Values[i] = ec._SceneEvent_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var scenePluginImplementors = []string{"ScenePlugin"}
 
 func (ec *executionContext) _ScenePlugin(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.ScenePlugin) graphql.Marshaler {
@@ -54183,7 +59546,175 @@ func (ec *executionContext) _ScenePlugin(ctx context.Context, sel ast.SelectionS
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "property":
+		case "property":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ScenePlugin_property(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var scenePresenceImplementors = []string{"ScenePresence"}
+
+func (ec *executionContext) _ScenePresence(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.ScenePresence) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scenePresenceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ScenePresence")
+		case "sceneId":
+			out.Values[i] = ec._ScenePresence_sceneId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "userId":
+			out.Values[i] = ec._ScenePresence_userId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "user":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ScenePresence_user(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "layerId":
+			out.Values[i] = ec._ScenePresence_layerId(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._ScenePresence_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sceneRevisionImplementors = []string{"SceneRevision"}
+
+func (ec *executionContext) _SceneRevision(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.SceneRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneRevisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SceneRevision")
+		case "id":
+			out.Values[i] = ec._SceneRevision_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sceneId":
+			out.Values[i] = ec._SceneRevision_sceneId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "action":
+			out.Values[i] = ec._SceneRevision_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "userId":
+			out.Values[i] = ec._SceneRevision_userId(ctx, field, obj)
+		case "user":
 			field := field
 
 			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
@@ -54192,7 +59723,7 @@ func (ec *executionContext) _ScenePlugin(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._ScenePlugin_property(ctx, field, obj)
+				res = ec._SceneRevision_user(ctx, field, obj)
 				return res
 			}
 
@@ -54216,6 +59747,155 @@ func (ec *executionContext) _ScenePlugin(ctx context.Context, sel ast.SelectionS
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			out.Values[i] = ec._SceneRevision_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sceneRevisionChangeImplementors = []string{"SceneRevisionChange"}
+
+func (ec *executionContext) _SceneRevisionChange(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.SceneRevisionChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneRevisionChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SceneRevisionChange")
+		case "objectType":
+			out.Values[i] = ec._SceneRevisionChange_objectType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "id":
+			out.Values[i] = ec._SceneRevisionChange_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._SceneRevisionChange_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sceneRevisionConnectionImplementors = []string{"SceneRevisionConnection"}
+
+func (ec *executionContext) _SceneRevisionConnection(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.SceneRevisionConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneRevisionConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SceneRevisionConnection")
+		case "edges":
+			out.Values[i] = ec._SceneRevisionConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nodes":
+			out.Values[i] = ec._SceneRevisionConnection_nodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._SceneRevisionConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCount":
+			out.Values[i] = ec._SceneRevisionConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sceneRevisionEdgeImplementors = []string{"SceneRevisionEdge"}
+
+func (ec *executionContext) _SceneRevisionEdge(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.SceneRevisionEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneRevisionEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SceneRevisionEdge")
+		case "cursor":
+			out.Values[i] = ec._SceneRevisionEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "node":
+			out.Values[i] = ec._SceneRevisionEdge_node(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -55001,27 +60681,188 @@ func (ec *executionContext) _StoryPage(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var storyPagePayloadImplementors = []string{"StoryPagePayload"}
+var storyPagePayloadImplementors = []string{"StoryPagePayload"}
+
+func (ec *executionContext) _StoryPagePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.StoryPagePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, storyPagePayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StoryPagePayload")
+		case "page":
+			out.Values[i] = ec._StoryPagePayload_page(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "story":
+			out.Values[i] = ec._StoryPagePayload_story(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var storyPayloadImplementors = []string{"StoryPayload"}
+
+func (ec *executionContext) _StoryPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.StoryPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, storyPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StoryPayload")
+		case "story":
+			out.Values[i] = ec._StoryPayload_story(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var styleImplementors = []string{"Style"}
 
-func (ec *executionContext) _StoryPagePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.StoryPagePayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, storyPagePayloadImplementors)
+func (ec *executionContext) _Style(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.Style) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, styleImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("StoryPagePayload")
-		case "page":
-			out.Values[i] = ec._StoryPagePayload_page(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Style")
+		case "id":
+			out.Values[i] = ec._Style_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "story":
-			out.Values[i] = ec._StoryPagePayload_story(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Style_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "value":
+			out.Values[i] = ec._Style_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sceneId":
+			out.Values[i] = ec._Style_sceneId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "scene":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Style_scene(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "presetId":
+			out.Values[i] = ec._Style_presetId(ctx, field, obj)
+		case "preset":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Style_preset(ctx, field, obj)
+				return res
 			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -55045,22 +60886,36 @@ func (ec *executionContext) _StoryPagePayload(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var storyPayloadImplementors = []string{"StoryPayload"}
+var styleIssueImplementors = []string{"StyleIssue"}
 
-func (ec *executionContext) _StoryPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.StoryPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, storyPayloadImplementors)
+func (ec *executionContext) _StyleIssue(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.StyleIssue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, styleIssueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("StoryPayload")
-		case "story":
-			out.Values[i] = ec._StoryPayload_story(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("StyleIssue")
+		case "path":
+			out.Values[i] = ec._StyleIssue_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._StyleIssue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._StyleIssue_message(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "attribute":
+			out.Values[i] = ec._StyleIssue_attribute(ctx, field, obj)
+		case "suggestion":
+			out.Values[i] = ec._StyleIssue_suggestion(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -55084,70 +60939,52 @@ func (ec *executionContext) _StoryPayload(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var styleImplementors = []string{"Style"}
+var stylePresetImplementors = []string{"StylePreset"}
 
-func (ec *executionContext) _Style(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.Style) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, styleImplementors)
+func (ec *executionContext) _StylePreset(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.StylePreset) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stylePresetImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Style")
+			out.Values[i] = graphql.MarshalString("StylePreset")
 		case "id":
-			out.Values[i] = ec._Style_id(ctx, field, obj)
+			out.Values[i] = ec._StylePreset_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
+			}
+		case "teamId":
+			out.Values[i] = ec._StylePreset_teamId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
 		case "name":
-			out.Values[i] = ec._Style_name(ctx, field, obj)
+			out.Values[i] = ec._StylePreset_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "value":
-			out.Values[i] = ec._Style_value(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._StylePreset_description(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "sceneId":
-			out.Values[i] = ec._Style_sceneId(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._StylePreset_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "scene":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Style_scene(ctx, field, obj)
-				return res
+		case "createdAt":
+			out.Values[i] = ec._StylePreset_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+		case "updatedAt":
+			out.Values[i] = ec._StylePreset_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -55171,6 +61008,28 @@ func (ec *executionContext) _Style(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "sceneEvents":
+		return ec._Subscription_sceneEvents(ctx, fields[0])
+	case "scenePresences":
+		return ec._Subscription_scenePresences(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var teamImplementors = []string{"Team", "Node"}
 
 func (ec *executionContext) _Team(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.Team) graphql.Marshaler {
@@ -55740,6 +61599,45 @@ func (ec *executionContext) _UpdateNLSLayersPayload(ctx context.Context, sel ast
 	return out
 }
 
+var updateScenePresencePayloadImplementors = []string{"UpdateScenePresencePayload"}
+
+func (ec *executionContext) _UpdateScenePresencePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.UpdateScenePresencePayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateScenePresencePayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdateScenePresencePayload")
+		case "presences":
+			out.Values[i] = ec._UpdateScenePresencePayload_presences(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var updateStylePayloadImplementors = []string{"UpdateStylePayload"}
 
 func (ec *executionContext) _UpdateStylePayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.UpdateStylePayload) graphql.Marshaler {
@@ -55779,6 +61677,45 @@ func (ec *executionContext) _UpdateStylePayload(ctx context.Context, sel ast.Sel
 	return out
 }
 
+var updateStylePresetPayloadImplementors = []string{"UpdateStylePresetPayload"}
+
+func (ec *executionContext) _UpdateStylePresetPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.UpdateStylePresetPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateStylePresetPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UpdateStylePresetPayload")
+		case "stylePreset":
+			out.Values[i] = ec._UpdateStylePresetPayload_stylePreset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var updateTeamPayloadImplementors = []string{"UpdateTeamPayload"}
 
 func (ec *executionContext) _UpdateTeamPayload(ctx context.Context, sel ast.SelectionSet, obj *gqlmodel.UpdateTeamPayload) graphql.Marshaler {
@@ -57000,6 +62937,11 @@ func (ec *executionContext) unmarshalNCreateStoryPageInput2githubᚗcomᚋreeart
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNCreateStylePresetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐCreateStylePresetInput(ctx context.Context, v any) (gqlmodel.CreateStylePresetInput, error) {
+	res, err := ec.unmarshalInputCreateStylePresetInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNCreateTeamInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐCreateTeamInput(ctx context.Context, v any) (gqlmodel.CreateTeamInput, error) {
 	res, err := ec.unmarshalInputCreateTeamInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -57459,6 +63401,25 @@ func (ec *executionContext) marshalNID2ᚕgithubᚗcomᚋreearthᚋreearthᚋser
 	return ret
 }
 
+func (ec *executionContext) unmarshalNImportNLSLayerFileInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐImportNLSLayerFileInput(ctx context.Context, v any) (gqlmodel.ImportNLSLayerFileInput, error) {
+	res, err := ec.unmarshalInputImportNLSLayerFileInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNImportNLSLayerFilePayload2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐImportNLSLayerFilePayload(ctx context.Context, sel ast.SelectionSet, v gqlmodel.ImportNLSLayerFilePayload) graphql.Marshaler {
+	return ec._ImportNLSLayerFilePayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNImportNLSLayerFilePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐImportNLSLayerFilePayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.ImportNLSLayerFilePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ImportNLSLayerFilePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNImportProjectInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐImportProjectInput(ctx context.Context, v any) (gqlmodel.ImportProjectInput, error) {
 	res, err := ec.unmarshalInputImportProjectInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -58664,11 +64625,21 @@ func (ec *executionContext) unmarshalNRemoveStyleInput2githubᚗcomᚋreearthᚋ
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRemoveStylePresetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRemoveStylePresetInput(ctx context.Context, v any) (gqlmodel.RemoveStylePresetInput, error) {
+	res, err := ec.unmarshalInputRemoveStylePresetInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNRemoveWidgetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRemoveWidgetInput(ctx context.Context, v any) (gqlmodel.RemoveWidgetInput, error) {
 	res, err := ec.unmarshalInputRemoveWidgetInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNRestoreSceneRevisionInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRestoreSceneRevisionInput(ctx context.Context, v any) (gqlmodel.RestoreSceneRevisionInput, error) {
+	res, err := ec.unmarshalInputRestoreSceneRevisionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNRole2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRole(ctx context.Context, v any) (gqlmodel.Role, error) {
 	var res gqlmodel.Role
 	err := res.UnmarshalGQL(v)
@@ -58689,6 +64660,20 @@ func (ec *executionContext) marshalNScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋ
 	return ec._Scene(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSceneEvent2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneEvent(ctx context.Context, sel ast.SelectionSet, v gqlmodel.SceneEvent) graphql.Marshaler {
+	return ec._SceneEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSceneEvent2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneEvent(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SceneEvent(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNScenePlugin2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePluginᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.ScenePlugin) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -58713,7 +64698,482 @@ func (ec *executionContext) marshalNScenePlugin2ᚕᚖgithubᚗcomᚋreearthᚋr
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNScenePlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePlugin(ctx, sel, v[i])
+			ret[i] = ec.marshalNScenePlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePlugin(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNScenePlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePlugin(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.ScenePlugin) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ScenePlugin(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNScenePresence2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePresenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.ScenePresence) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNScenePresence2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePresence(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNScenePresence2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePresence(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.ScenePresence) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ScenePresence(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSceneRevision2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.SceneRevision) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOSceneRevision2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSceneRevision2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneRevision) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SceneRevision(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSceneRevisionChange2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.SceneRevisionChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSceneRevisionChange2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSceneRevisionChange2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChange(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneRevisionChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SceneRevisionChange(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSceneRevisionChangeType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChangeType(ctx context.Context, v any) (gqlmodel.SceneRevisionChangeType, error) {
+	var res gqlmodel.SceneRevisionChangeType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSceneRevisionChangeType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionChangeType(ctx context.Context, sel ast.SelectionSet, v gqlmodel.SceneRevisionChangeType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNSceneRevisionConnection2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionConnection(ctx context.Context, sel ast.SelectionSet, v gqlmodel.SceneRevisionConnection) graphql.Marshaler {
+	return ec._SceneRevisionConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSceneRevisionConnection2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionConnection(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneRevisionConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SceneRevisionConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSceneRevisionEdge2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.SceneRevisionEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSceneRevisionEdge2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSceneRevisionEdge2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionEdge(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneRevisionEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SceneRevisionEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSceneRevisionObjectType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionObjectType(ctx context.Context, v any) (gqlmodel.SceneRevisionObjectType, error) {
+	var res gqlmodel.SceneRevisionObjectType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSceneRevisionObjectType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevisionObjectType(ctx context.Context, sel ast.SelectionSet, v gqlmodel.SceneRevisionObjectType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNSceneWidget2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidgetᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.SceneWidget) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSceneWidget2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidget(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSceneWidget2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidget(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneWidget) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SceneWidget(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSignupInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSignupInput(ctx context.Context, v any) (gqlmodel.SignupInput, error) {
+	res, err := ec.unmarshalInputSignupInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSortDirection2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSortDirection(ctx context.Context, v any) (gqlmodel.SortDirection, error) {
+	var res gqlmodel.SortDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSortDirection2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSortDirection(ctx context.Context, sel ast.SelectionSet, v gqlmodel.SortDirection) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNStory2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.Story) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.Story) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Story(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNStoryBlock2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlockᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.StoryBlock) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStoryBlock2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlock(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNStoryBlock2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlock(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryBlock) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._StoryBlock(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNStoryPage2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPageᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.StoryPage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStoryPage2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPage(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -58733,119 +65193,81 @@ func (ec *executionContext) marshalNScenePlugin2ᚕᚖgithubᚗcomᚋreearthᚋr
 	return ret
 }
 
-func (ec *executionContext) marshalNScenePlugin2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScenePlugin(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.ScenePlugin) graphql.Marshaler {
+func (ec *executionContext) marshalNStoryPage2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPage(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryPage) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ScenePlugin(ctx, sel, v)
+	return ec._StoryPage(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSceneWidget2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidgetᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.SceneWidget) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
-	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalNSceneWidget2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidget(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
-
-	}
-	wg.Wait()
+func (ec *executionContext) marshalNStoryPagePayload2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPagePayload(ctx context.Context, sel ast.SelectionSet, v gqlmodel.StoryPagePayload) graphql.Marshaler {
+	return ec._StoryPagePayload(ctx, sel, &v)
+}
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
+func (ec *executionContext) marshalNStoryPagePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPagePayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryPagePayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
+	return ec._StoryPagePayload(ctx, sel, v)
+}
 
-	return ret
+func (ec *executionContext) marshalNStoryPayload2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPayload(ctx context.Context, sel ast.SelectionSet, v gqlmodel.StoryPayload) graphql.Marshaler {
+	return ec._StoryPayload(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSceneWidget2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidget(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneWidget) graphql.Marshaler {
+func (ec *executionContext) marshalNStoryPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryPayload) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SceneWidget(ctx, sel, v)
+	return ec._StoryPayload(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNSignupInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSignupInput(ctx context.Context, v any) (gqlmodel.SignupInput, error) {
-	res, err := ec.unmarshalInputSignupInput(ctx, v)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNSortDirection2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSortDirection(ctx context.Context, v any) (gqlmodel.SortDirection, error) {
-	var res gqlmodel.SortDirection
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
 }
 
-func (ec *executionContext) marshalNSortDirection2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSortDirection(ctx context.Context, sel ast.SelectionSet, v gqlmodel.SortDirection) graphql.Marshaler {
-	return v
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNStory2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.Story) graphql.Marshaler {
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
-	}
 	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
-
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
 	}
-	wg.Wait()
 
 	for _, e := range ret {
 		if e == graphql.Null {
@@ -58856,17 +65278,7 @@ func (ec *executionContext) marshalNStory2ᚕᚖgithubᚗcomᚋreearthᚋreearth
 	return ret
 }
 
-func (ec *executionContext) marshalNStory2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStory(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.Story) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Story(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNStoryBlock2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlockᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.StoryBlock) graphql.Marshaler {
+func (ec *executionContext) marshalNStyle2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.Style) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -58890,7 +65302,7 @@ func (ec *executionContext) marshalNStoryBlock2ᚕᚖgithubᚗcomᚋreearthᚋre
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNStoryBlock2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlock(ctx, sel, v[i])
+			ret[i] = ec.marshalNStyle2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyle(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -58910,17 +65322,17 @@ func (ec *executionContext) marshalNStoryBlock2ᚕᚖgithubᚗcomᚋreearthᚋre
 	return ret
 }
 
-func (ec *executionContext) marshalNStoryBlock2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryBlock(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryBlock) graphql.Marshaler {
+func (ec *executionContext) marshalNStyle2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyle(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.Style) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._StoryBlock(ctx, sel, v)
+	return ec._Style(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNStoryPage2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPageᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.StoryPage) graphql.Marshaler {
+func (ec *executionContext) marshalNStyleIssue2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssueᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.StyleIssue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -58944,7 +65356,7 @@ func (ec *executionContext) marshalNStoryPage2ᚕᚖgithubᚗcomᚋreearthᚋree
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNStoryPage2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPage(ctx, sel, v[i])
+			ret[i] = ec.marshalNStyleIssue2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -58964,92 +65376,27 @@ func (ec *executionContext) marshalNStoryPage2ᚕᚖgithubᚗcomᚋreearthᚋree
 	return ret
 }
 
-func (ec *executionContext) marshalNStoryPage2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPage(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryPage) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._StoryPage(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNStoryPagePayload2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPagePayload(ctx context.Context, sel ast.SelectionSet, v gqlmodel.StoryPagePayload) graphql.Marshaler {
-	return ec._StoryPagePayload(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNStoryPagePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPagePayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryPagePayload) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._StoryPagePayload(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNStoryPayload2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPayload(ctx context.Context, sel ast.SelectionSet, v gqlmodel.StoryPayload) graphql.Marshaler {
-	return ec._StoryPayload(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNStoryPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStoryPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StoryPayload) graphql.Marshaler {
+func (ec *executionContext) marshalNStyleIssue2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssue(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StyleIssue) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._StoryPayload(ctx, sel, v)
+	return ec._StyleIssue(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalNStyleIssueType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssueType(ctx context.Context, v any) (gqlmodel.StyleIssueType, error) {
+	var res gqlmodel.StyleIssueType
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
-	var vSlice []any
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
-	}
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
-	return ret
+func (ec *executionContext) marshalNStyleIssueType2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleIssueType(ctx context.Context, sel ast.SelectionSet, v gqlmodel.StyleIssueType) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNStyle2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyleᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.Style) graphql.Marshaler {
+func (ec *executionContext) marshalNStylePreset2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePresetᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.StylePreset) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -59073,7 +65420,7 @@ func (ec *executionContext) marshalNStyle2ᚕᚖgithubᚗcomᚋreearthᚋreearth
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNStyle2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyle(ctx, sel, v[i])
+			ret[i] = ec.marshalNStylePreset2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePreset(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -59093,14 +65440,14 @@ func (ec *executionContext) marshalNStyle2ᚕᚖgithubᚗcomᚋreearthᚋreearth
 	return ret
 }
 
-func (ec *executionContext) marshalNStyle2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStyle(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.Style) graphql.Marshaler {
+func (ec *executionContext) marshalNStylePreset2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePreset(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StylePreset) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Style(ctx, sel, v)
+	return ec._StylePreset(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNTeam2ᚕᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐTeamᚄ(ctx context.Context, sel ast.SelectionSet, v []*gqlmodel.Team) graphql.Marshaler {
@@ -59353,6 +65700,11 @@ func (ec *executionContext) unmarshalNUpdatePropertyValueInput2githubᚗcomᚋre
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNUpdateScenePresenceInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateScenePresenceInput(ctx context.Context, v any) (gqlmodel.UpdateScenePresenceInput, error) {
+	res, err := ec.unmarshalInputUpdateScenePresenceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateStoryInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateStoryInput(ctx context.Context, v any) (gqlmodel.UpdateStoryInput, error) {
 	res, err := ec.unmarshalInputUpdateStoryInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -59368,6 +65720,11 @@ func (ec *executionContext) unmarshalNUpdateStyleInput2githubᚗcomᚋreearthᚋ
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalNUpdateStylePresetInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateStylePresetInput(ctx context.Context, v any) (gqlmodel.UpdateStylePresetInput, error) {
+	res, err := ec.unmarshalInputUpdateStylePresetInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateTeamInput2githubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateTeamInput(ctx context.Context, v any) (gqlmodel.UpdateTeamInput, error) {
 	res, err := ec.unmarshalInputUpdateTeamInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -59864,6 +66221,13 @@ func (ec *executionContext) marshalOCreateScenePayload2ᚖgithubᚗcomᚋreearth
 	return ec._CreateScenePayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOCreateStylePresetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐCreateStylePresetPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.CreateStylePresetPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CreateStylePresetPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOCreateTeamPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐCreateTeamPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.CreateTeamPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -60385,6 +66749,13 @@ func (ec *executionContext) marshalORemoveStylePayload2ᚖgithubᚗcomᚋreearth
 	return ec._RemoveStylePayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalORemoveStylePresetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRemoveStylePresetPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.RemoveStylePresetPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RemoveStylePresetPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalORemoveWidgetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRemoveWidgetPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.RemoveWidgetPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -60392,6 +66763,13 @@ func (ec *executionContext) marshalORemoveWidgetPayload2ᚖgithubᚗcomᚋreeart
 	return ec._RemoveWidgetPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalORestoreSceneRevisionPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐRestoreSceneRevisionPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.RestoreSceneRevisionPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RestoreSceneRevisionPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOScene2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐScene(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.Scene) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -60406,6 +66784,13 @@ func (ec *executionContext) marshalOScenePlugin2ᚖgithubᚗcomᚋreearthᚋreea
 	return ec._ScenePlugin(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSceneRevision2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneRevision(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneRevision) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SceneRevision(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOSceneWidget2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐSceneWidget(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.SceneWidget) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -60443,6 +66828,13 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) marshalOStylePreset2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐStylePreset(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.StylePreset) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._StylePreset(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTeam2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐTeam(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.Team) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -60542,6 +66934,13 @@ func (ec *executionContext) marshalOUpdateMemberOfTeamPayload2ᚖgithubᚗcomᚋ
 	return ec._UpdateMemberOfTeamPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOUpdateScenePresencePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateScenePresencePayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.UpdateScenePresencePayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._UpdateScenePresencePayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOUpdateStylePayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateStylePayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.UpdateStylePayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -60549,6 +66948,13 @@ func (ec *executionContext) marshalOUpdateStylePayload2ᚖgithubᚗcomᚋreearth
 	return ec._UpdateStylePayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOUpdateStylePresetPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateStylePresetPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.UpdateStylePresetPayload) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._UpdateStylePresetPayload(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOUpdateTeamPayload2ᚖgithubᚗcomᚋreearthᚋreearthᚋserverᚋinternalᚋadapterᚋgqlᚋgqlmodelᚐUpdateTeamPayload(ctx context.Context, sel ast.SelectionSet, v *gqlmodel.UpdateTeamPayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -0,0 +1,35 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/samber/lo"
+)
+
+func ToSceneEvent(e collaboration.Event) *SceneEvent {
+	return &SceneEvent{
+		SceneID:    IDFrom(e.Scene),
+		Version:    e.Version,
+		RevisionID: IDFrom(e.Revision),
+		Action:     e.Action,
+		UserID:     IDFromRef(e.User),
+		Changes:    ToSceneRevisionChanges(e.Changes),
+	}
+}
+
+func ToScenePresence(p *collaboration.Presence) *ScenePresence {
+	if p == nil {
+		return nil
+	}
+	return &ScenePresence{
+		SceneID:   IDFrom(p.Scene),
+		UserID:    IDFrom(p.User),
+		LayerID:   IDFromRef(p.Layer),
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func ToScenePresences(l collaboration.PresenceList) []*ScenePresence {
+	return lo.Map(l, func(p *collaboration.Presence, _ int) *ScenePresence {
+		return ToScenePresence(p)
+	})
+}
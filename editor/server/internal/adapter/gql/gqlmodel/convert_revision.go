@@ -0,0 +1,67 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/samber/lo"
+)
+
+func ToSceneRevision(r *revision.Revision) *SceneRevision {
+	if r == nil {
+		return nil
+	}
+	return &SceneRevision{
+		ID:        IDFrom(r.ID()),
+		SceneID:   IDFrom(r.Scene()),
+		Action:    r.Action(),
+		UserID:    IDFromRef(r.User()),
+		CreatedAt: r.CreatedAt(),
+	}
+}
+
+func ToSceneRevisions(l revision.List) []*SceneRevision {
+	return lo.Map(l, func(r *revision.Revision, _ int) *SceneRevision {
+		return ToSceneRevision(r)
+	})
+}
+
+func ToSceneRevisionChange(c revision.Change) *SceneRevisionChange {
+	return &SceneRevisionChange{
+		ObjectType: ToSceneRevisionObjectType(c.ObjectType),
+		ID:         ID(c.ID),
+		Type:       ToSceneRevisionChangeType(c.Type),
+	}
+}
+
+func ToSceneRevisionChanges(changes []revision.Change) []*SceneRevisionChange {
+	return lo.Map(changes, func(c revision.Change, _ int) *SceneRevisionChange {
+		return ToSceneRevisionChange(c)
+	})
+}
+
+func ToSceneRevisionObjectType(t revision.ObjectType) SceneRevisionObjectType {
+	switch t {
+	case revision.ObjectTypeScene:
+		return SceneRevisionObjectTypeScene
+	case revision.ObjectTypeNLSLayer:
+		return SceneRevisionObjectTypeNlsLayer
+	case revision.ObjectTypeStyle:
+		return SceneRevisionObjectTypeStyle
+	case revision.ObjectTypeStory:
+		return SceneRevisionObjectTypeStory
+	case revision.ObjectTypeProperty:
+		return SceneRevisionObjectTypeProperty
+	}
+	return ""
+}
+
+func ToSceneRevisionChangeType(t revision.ChangeType) SceneRevisionChangeType {
+	switch t {
+	case revision.ChangeTypeAdded:
+		return SceneRevisionChangeTypeAdded
+	case revision.ChangeTypeRemoved:
+		return SceneRevisionChangeTypeRemoved
+	case revision.ChangeTypeModified:
+		return SceneRevisionChangeTypeModified
+	}
+	return ""
+}
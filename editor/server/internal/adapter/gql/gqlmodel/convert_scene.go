@@ -43,6 +43,7 @@ func ToScene(scene *scene.Scene) *Scene {
 		TeamID:            IDFrom(scene.Workspace()),
 		CreatedAt:         scene.CreatedAt(),
 		UpdatedAt:         scene.UpdatedAt(),
+		Version:           scene.Version(),
 		Plugins:           util.Map(scene.Plugins().Plugins(), ToScenePlugin),
 		Widgets:           util.Map(scene.Widgets().Widgets(), ToSceneWidget),
 		WidgetAlignSystem: ToWidgetAlignSystem(scene.Widgets().Alignment()),
@@ -55,7 +56,8 @@ func ToStyle(v *scene.Style) *Style {
 		Name:  v.Name(),
 		Value: JSON(*v.Value()),
 
-		SceneID: IDFrom(v.Scene()),
+		SceneID:  IDFrom(v.Scene()),
+		PresetID: IDFromRef(v.Preset()),
 	}
 }
 
@@ -0,0 +1,54 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/scene/styleexpr"
+	"github.com/samber/lo"
+)
+
+func ToStylePreset(p *scene.StylePreset) *StylePreset {
+	if p == nil {
+		return nil
+	}
+	return &StylePreset{
+		ID:          IDFrom(p.ID()),
+		TeamID:      IDFrom(p.Workspace()),
+		Name:        p.Name(),
+		Description: p.Description(),
+		Value:       JSON(*p.Value()),
+		CreatedAt:   p.CreatedAt(),
+		UpdatedAt:   p.UpdatedAt(),
+	}
+}
+
+func ToStylePresets(l scene.StylePresetList) []*StylePreset {
+	return lo.Map(l, func(p *scene.StylePreset, _ int) *StylePreset {
+		return ToStylePreset(p)
+	})
+}
+
+func ToStyleIssue(i styleexpr.Issue) *StyleIssue {
+	return &StyleIssue{
+		Path:       i.Path,
+		Type:       ToStyleIssueType(i.Type),
+		Message:    i.Message,
+		Attribute:  lo.EmptyableToPtr(i.Attribute),
+		Suggestion: lo.EmptyableToPtr(i.Suggestion),
+	}
+}
+
+func ToStyleIssues(l styleexpr.Issues) []*StyleIssue {
+	return lo.Map(l, func(i styleexpr.Issue, _ int) *StyleIssue {
+		return ToStyleIssue(i)
+	})
+}
+
+func ToStyleIssueType(t styleexpr.IssueType) StyleIssueType {
+	switch t {
+	case styleexpr.IssueTypeSyntax:
+		return StyleIssueTypeSyntax
+	case styleexpr.IssueTypeUnknownAttribute:
+		return StyleIssueTypeUnknownAttribute
+	}
+	return ""
+}
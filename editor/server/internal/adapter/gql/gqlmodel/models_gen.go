@@ -47,6 +47,7 @@ type AddGeoJSONFeatureInput struct {
 	Geometry   JSON   `json:"geometry"`
 	Properties JSON   `json:"properties,omitempty"`
 	LayerID    ID     `json:"layerId"`
+	Version    *int   `json:"version,omitempty"`
 }
 
 type AddMemberToTeamInput struct {
@@ -64,6 +65,7 @@ type AddNLSInfoboxBlockInput struct {
 	PluginID    ID   `json:"pluginId"`
 	ExtensionID ID   `json:"extensionId"`
 	Index       *int `json:"index,omitempty"`
+	Version     *int `json:"version,omitempty"`
 }
 
 type AddNLSInfoboxBlockPayload struct {
@@ -79,6 +81,7 @@ type AddNLSLayerSimpleInput struct {
 	Index     *int   `json:"index,omitempty"`
 	Visible   *bool  `json:"visible,omitempty"`
 	Schema    JSON   `json:"schema,omitempty"`
+	Version   *int   `json:"version,omitempty"`
 }
 
 type AddNLSLayerSimplePayload struct {
@@ -91,12 +94,15 @@ type AddPropertyItemInput struct {
 	Index          *int       `json:"index,omitempty"`
 	NameFieldValue any        `json:"nameFieldValue,omitempty"`
 	NameFieldType  *ValueType `json:"nameFieldType,omitempty"`
+	Version        *int       `json:"version,omitempty"`
 }
 
 type AddStyleInput struct {
-	SceneID ID     `json:"sceneId"`
-	Name    string `json:"name"`
-	Value   JSON   `json:"value"`
+	SceneID  ID     `json:"sceneId"`
+	Name     string `json:"name"`
+	Value    JSON   `json:"value,omitempty"`
+	PresetID *ID    `json:"presetId,omitempty"`
+	Version  *int   `json:"version,omitempty"`
 }
 
 type AddStylePayload struct {
@@ -104,9 +110,10 @@ type AddStylePayload struct {
 }
 
 type AddWidgetInput struct {
-	SceneID     ID `json:"sceneId"`
-	PluginID    ID `json:"pluginId"`
-	ExtensionID ID `json:"extensionId"`
+	SceneID     ID   `json:"sceneId"`
+	PluginID    ID   `json:"pluginId"`
+	ExtensionID ID   `json:"extensionId"`
+	Version     *int `json:"version,omitempty"`
 }
 
 type AddWidgetPayload struct {
@@ -162,6 +169,7 @@ type ChangeCustomPropertyTitleInput struct {
 	Schema   JSON   `json:"schema,omitempty"`
 	OldTitle string `json:"oldTitle"`
 	NewTitle string `json:"newTitle"`
+	Version  *int   `json:"version,omitempty"`
 }
 
 type CreateAssetInput struct {
@@ -176,7 +184,8 @@ type CreateAssetPayload struct {
 }
 
 type CreateNLSInfoboxInput struct {
-	LayerID ID `json:"layerId"`
+	LayerID ID   `json:"layerId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type CreateNLSInfoboxPayload struct {
@@ -184,7 +193,8 @@ type CreateNLSInfoboxPayload struct {
 }
 
 type CreateNLSPhotoOverlayInput struct {
-	LayerID ID `json:"layerId"`
+	LayerID ID   `json:"layerId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type CreateNLSPhotoOverlayPayload struct {
@@ -216,6 +226,7 @@ type CreateStoryBlockInput struct {
 	PluginID    ID   `json:"pluginId"`
 	ExtensionID ID   `json:"extensionId"`
 	Index       *int `json:"index,omitempty"`
+	Version     *int `json:"version,omitempty"`
 }
 
 type CreateStoryBlockPayload struct {
@@ -229,6 +240,7 @@ type CreateStoryInput struct {
 	SceneID ID     `json:"sceneId"`
 	Title   string `json:"title"`
 	Index   *int   `json:"index,omitempty"`
+	Version *int   `json:"version,omitempty"`
 }
 
 type CreateStoryPageInput struct {
@@ -239,6 +251,18 @@ type CreateStoryPageInput struct {
 	Layers          []ID    `json:"layers,omitempty"`
 	SwipeableLayers []ID    `json:"swipeableLayers,omitempty"`
 	Index           *int    `json:"index,omitempty"`
+	Version         *int    `json:"version,omitempty"`
+}
+
+type CreateStylePresetInput struct {
+	TeamID      ID      `json:"teamId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Value       JSON    `json:"value"`
+}
+
+type CreateStylePresetPayload struct {
+	StylePreset *StylePreset `json:"stylePreset"`
 }
 
 type CreateTeamInput struct {
@@ -250,8 +274,9 @@ type CreateTeamPayload struct {
 }
 
 type DeleteGeoJSONFeatureInput struct {
-	FeatureID ID `json:"featureId"`
-	LayerID   ID `json:"layerId"`
+	FeatureID ID   `json:"featureId"`
+	LayerID   ID   `json:"layerId"`
+	Version   *int `json:"version,omitempty"`
 }
 
 type DeleteGeoJSONFeaturePayload struct {
@@ -275,14 +300,16 @@ type DeleteProjectPayload struct {
 }
 
 type DeleteStoryInput struct {
-	SceneID ID `json:"sceneId"`
-	StoryID ID `json:"storyId"`
+	SceneID ID   `json:"sceneId"`
+	StoryID ID   `json:"storyId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type DeleteStoryPageInput struct {
-	SceneID ID `json:"sceneId"`
-	StoryID ID `json:"storyId"`
-	PageID  ID `json:"pageId"`
+	SceneID ID   `json:"sceneId"`
+	StoryID ID   `json:"storyId"`
+	PageID  ID   `json:"pageId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type DeleteStoryPagePayload struct {
@@ -303,7 +330,8 @@ type DeleteTeamPayload struct {
 }
 
 type DuplicateNLSLayerInput struct {
-	LayerID ID `json:"layerId"`
+	LayerID ID   `json:"layerId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type DuplicateNLSLayerPayload struct {
@@ -311,13 +339,15 @@ type DuplicateNLSLayerPayload struct {
 }
 
 type DuplicateStoryPageInput struct {
-	SceneID ID `json:"sceneId"`
-	StoryID ID `json:"storyId"`
-	PageID  ID `json:"pageId"`
+	SceneID ID   `json:"sceneId"`
+	StoryID ID   `json:"storyId"`
+	PageID  ID   `json:"pageId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type DuplicateStyleInput struct {
-	StyleID ID `json:"styleId"`
+	StyleID ID   `json:"styleId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type DuplicateStylePayload struct {
@@ -351,6 +381,19 @@ type GeometryCollection struct {
 
 func (GeometryCollection) IsGeometry() {}
 
+type ImportNLSLayerFileInput struct {
+	SceneID ID             `json:"sceneId"`
+	File    graphql.Upload `json:"file"`
+	Title   *string        `json:"title,omitempty"`
+	Index   *int           `json:"index,omitempty"`
+	Visible *bool          `json:"visible,omitempty"`
+	Version *int           `json:"version,omitempty"`
+}
+
+type ImportNLSLayerFilePayload struct {
+	Layer *NLSLayerSimple `json:"layer"`
+}
+
 type ImportProjectInput struct {
 	TeamID ID             `json:"teamId"`
 	File   graphql.Upload `json:"file"`
@@ -450,9 +493,10 @@ type MergedPropertyGroup struct {
 }
 
 type MoveNLSInfoboxBlockInput struct {
-	LayerID        ID  `json:"layerId"`
-	InfoboxBlockID ID  `json:"infoboxBlockId"`
-	Index          int `json:"index"`
+	LayerID        ID   `json:"layerId"`
+	InfoboxBlockID ID   `json:"infoboxBlockId"`
+	Index          int  `json:"index"`
+	Version        *int `json:"version,omitempty"`
 }
 
 type MoveNLSInfoboxBlockPayload struct {
@@ -462,17 +506,19 @@ type MoveNLSInfoboxBlockPayload struct {
 }
 
 type MovePropertyItemInput struct {
-	PropertyID    ID  `json:"propertyId"`
-	SchemaGroupID ID  `json:"schemaGroupId"`
-	ItemID        ID  `json:"itemId"`
-	Index         int `json:"index"`
+	PropertyID    ID   `json:"propertyId"`
+	SchemaGroupID ID   `json:"schemaGroupId"`
+	ItemID        ID   `json:"itemId"`
+	Index         int  `json:"index"`
+	Version       *int `json:"version,omitempty"`
 }
 
 type MoveStoryBlockInput struct {
-	StoryID ID  `json:"storyId"`
-	PageID  ID  `json:"pageId"`
-	BlockID ID  `json:"blockId"`
-	Index   int `json:"index"`
+	StoryID ID   `json:"storyId"`
+	PageID  ID   `json:"pageId"`
+	BlockID ID   `json:"blockId"`
+	Index   int  `json:"index"`
+	Version *int `json:"version,omitempty"`
 }
 
 type MoveStoryBlockPayload struct {
@@ -483,15 +529,17 @@ type MoveStoryBlockPayload struct {
 }
 
 type MoveStoryInput struct {
-	SceneID ID  `json:"sceneId"`
-	StoryID ID  `json:"storyId"`
-	Index   int `json:"index"`
+	SceneID ID   `json:"sceneId"`
+	StoryID ID   `json:"storyId"`
+	Index   int  `json:"index"`
+	Version *int `json:"version,omitempty"`
 }
 
 type MoveStoryPageInput struct {
-	StoryID ID  `json:"storyId"`
-	PageID  ID  `json:"pageId"`
-	Index   int `json:"index"`
+	StoryID ID   `json:"storyId"`
+	PageID  ID   `json:"pageId"`
+	Index   int  `json:"index"`
+	Version *int `json:"version,omitempty"`
 }
 
 type MoveStoryPagePayload struct {
@@ -606,6 +654,7 @@ type PageLayerInput struct {
 	PageID    ID    `json:"pageId"`
 	Swipeable *bool `json:"swipeable,omitempty"`
 	LayerID   ID    `json:"layerId"`
+	Version   *int  `json:"version,omitempty"`
 }
 
 type Pagination struct {
@@ -870,6 +919,7 @@ type PublishStoryInput struct {
 	StoryID ID                `json:"storyId"`
 	Alias   *string           `json:"alias,omitempty"`
 	Status  PublishmentStatus `json:"status"`
+	Version *int              `json:"version,omitempty"`
 }
 
 type Query struct {
@@ -894,6 +944,7 @@ type RemoveCustomPropertyInput struct {
 	LayerID      ID     `json:"layerId"`
 	Schema       JSON   `json:"schema,omitempty"`
 	RemovedTitle string `json:"removedTitle"`
+	Version      *int   `json:"version,omitempty"`
 }
 
 type RemoveMemberFromTeamInput struct {
@@ -910,8 +961,9 @@ type RemoveMyAuthInput struct {
 }
 
 type RemoveNLSInfoboxBlockInput struct {
-	LayerID        ID `json:"layerId"`
-	InfoboxBlockID ID `json:"infoboxBlockId"`
+	LayerID        ID   `json:"layerId"`
+	InfoboxBlockID ID   `json:"infoboxBlockId"`
+	Version        *int `json:"version,omitempty"`
 }
 
 type RemoveNLSInfoboxBlockPayload struct {
@@ -920,7 +972,8 @@ type RemoveNLSInfoboxBlockPayload struct {
 }
 
 type RemoveNLSInfoboxInput struct {
-	LayerID ID `json:"layerId"`
+	LayerID ID   `json:"layerId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type RemoveNLSInfoboxPayload struct {
@@ -928,7 +981,8 @@ type RemoveNLSInfoboxPayload struct {
 }
 
 type RemoveNLSLayerInput struct {
-	LayerID ID `json:"layerId"`
+	LayerID ID   `json:"layerId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type RemoveNLSLayerPayload struct {
@@ -936,7 +990,8 @@ type RemoveNLSLayerPayload struct {
 }
 
 type RemoveNLSPhotoOverlayInput struct {
-	LayerID ID `json:"layerId"`
+	LayerID ID   `json:"layerId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type RemoveNLSPhotoOverlayPayload struct {
@@ -944,22 +999,25 @@ type RemoveNLSPhotoOverlayPayload struct {
 }
 
 type RemovePropertyFieldInput struct {
-	PropertyID    ID  `json:"propertyId"`
-	SchemaGroupID *ID `json:"schemaGroupId,omitempty"`
-	ItemID        *ID `json:"itemId,omitempty"`
-	FieldID       ID  `json:"fieldId"`
+	PropertyID    ID   `json:"propertyId"`
+	SchemaGroupID *ID  `json:"schemaGroupId,omitempty"`
+	ItemID        *ID  `json:"itemId,omitempty"`
+	FieldID       ID   `json:"fieldId"`
+	Version       *int `json:"version,omitempty"`
 }
 
 type RemovePropertyItemInput struct {
-	PropertyID    ID `json:"propertyId"`
-	SchemaGroupID ID `json:"schemaGroupId"`
-	ItemID        ID `json:"itemId"`
+	PropertyID    ID   `json:"propertyId"`
+	SchemaGroupID ID   `json:"schemaGroupId"`
+	ItemID        ID   `json:"itemId"`
+	Version       *int `json:"version,omitempty"`
 }
 
 type RemoveStoryBlockInput struct {
-	StoryID ID `json:"storyId"`
-	PageID  ID `json:"pageId"`
-	BlockID ID `json:"blockId"`
+	StoryID ID   `json:"storyId"`
+	PageID  ID   `json:"pageId"`
+	BlockID ID   `json:"blockId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type RemoveStoryBlockPayload struct {
@@ -969,16 +1027,26 @@ type RemoveStoryBlockPayload struct {
 }
 
 type RemoveStyleInput struct {
-	StyleID ID `json:"styleId"`
+	StyleID ID   `json:"styleId"`
+	Version *int `json:"version,omitempty"`
 }
 
 type RemoveStylePayload struct {
 	StyleID ID `json:"styleId"`
 }
 
+type RemoveStylePresetInput struct {
+	PresetID ID `json:"presetId"`
+}
+
+type RemoveStylePresetPayload struct {
+	PresetID ID `json:"presetId"`
+}
+
 type RemoveWidgetInput struct {
-	SceneID  ID `json:"sceneId"`
-	WidgetID ID `json:"widgetId"`
+	SceneID  ID   `json:"sceneId"`
+	WidgetID ID   `json:"widgetId"`
+	Version  *int `json:"version,omitempty"`
 }
 
 type RemoveWidgetPayload struct {
@@ -986,6 +1054,15 @@ type RemoveWidgetPayload struct {
 	WidgetID ID     `json:"widgetId"`
 }
 
+type RestoreSceneRevisionInput struct {
+	RevisionID ID   `json:"revisionId"`
+	Version    *int `json:"version,omitempty"`
+}
+
+type RestoreSceneRevisionPayload struct {
+	Revision *SceneRevision `json:"revision"`
+}
+
 type Scene struct {
 	ID                ID                 `json:"id"`
 	ProjectID         ID                 `json:"projectId"`
@@ -993,6 +1070,7 @@ type Scene struct {
 	PropertyID        ID                 `json:"propertyId"`
 	CreatedAt         time.Time          `json:"createdAt"`
 	UpdatedAt         time.Time          `json:"updatedAt"`
+	Version           int                `json:"version"`
 	Widgets           []*SceneWidget     `json:"widgets"`
 	Plugins           []*ScenePlugin     `json:"plugins"`
 	WidgetAlignSystem *WidgetAlignSystem `json:"widgetAlignSystem,omitempty"`
@@ -1007,6 +1085,16 @@ type Scene struct {
 func (Scene) IsNode()        {}
 func (this Scene) GetID() ID { return this.ID }
 
+type SceneEvent struct {
+	SceneID    ID                     `json:"sceneId"`
+	Version    int                    `json:"version"`
+	RevisionID ID                     `json:"revisionId"`
+	Action     string                 `json:"action"`
+	UserID     *ID                    `json:"userId,omitempty"`
+	User       *User                  `json:"user,omitempty"`
+	Changes    []*SceneRevisionChange `json:"changes"`
+}
+
 type ScenePlugin struct {
 	PluginID   ID        `json:"pluginId"`
 	PropertyID *ID       `json:"propertyId,omitempty"`
@@ -1014,6 +1102,41 @@ type ScenePlugin struct {
 	Property   *Property `json:"property,omitempty"`
 }
 
+type ScenePresence struct {
+	SceneID   ID        `json:"sceneId"`
+	UserID    ID        `json:"userId"`
+	User      *User     `json:"user,omitempty"`
+	LayerID   *ID       `json:"layerId,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type SceneRevision struct {
+	ID        ID        `json:"id"`
+	SceneID   ID        `json:"sceneId"`
+	Action    string    `json:"action"`
+	UserID    *ID       `json:"userId,omitempty"`
+	User      *User     `json:"user,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type SceneRevisionChange struct {
+	ObjectType SceneRevisionObjectType `json:"objectType"`
+	ID         ID                      `json:"id"`
+	Type       SceneRevisionChangeType `json:"type"`
+}
+
+type SceneRevisionConnection struct {
+	Edges      []*SceneRevisionEdge `json:"edges"`
+	Nodes      []*SceneRevision     `json:"nodes"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+type SceneRevisionEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *SceneRevision  `json:"node,omitempty"`
+}
+
 type SceneWidget struct {
 	ID          ID               `json:"id"`
 	PluginID    ID               `json:"pluginId"`
@@ -1120,11 +1243,34 @@ type StoryPayload struct {
 }
 
 type Style struct {
-	ID      ID     `json:"id"`
-	Name    string `json:"name"`
-	Value   JSON   `json:"value"`
-	SceneID ID     `json:"sceneId"`
-	Scene   *Scene `json:"scene,omitempty"`
+	ID       ID           `json:"id"`
+	Name     string       `json:"name"`
+	Value    JSON         `json:"value"`
+	SceneID  ID           `json:"sceneId"`
+	Scene    *Scene       `json:"scene,omitempty"`
+	PresetID *ID          `json:"presetId,omitempty"`
+	Preset   *StylePreset `json:"preset,omitempty"`
+}
+
+type StyleIssue struct {
+	Path       string         `json:"path"`
+	Type       StyleIssueType `json:"type"`
+	Message    string         `json:"message"`
+	Attribute  *string        `json:"attribute,omitempty"`
+	Suggestion *string        `json:"suggestion,omitempty"`
+}
+
+type StylePreset struct {
+	ID          ID        `json:"id"`
+	TeamID      ID        `json:"teamId"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Value       JSON      `json:"value"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type Subscription struct {
 }
 
 type Team struct {
@@ -1175,10 +1321,11 @@ type UninstallPluginPayload struct {
 }
 
 type UnlinkPropertyValueInput struct {
-	PropertyID    ID  `json:"propertyId"`
-	SchemaGroupID *ID `json:"schemaGroupId,omitempty"`
-	ItemID        *ID `json:"itemId,omitempty"`
-	FieldID       ID  `json:"fieldId"`
+	PropertyID    ID   `json:"propertyId"`
+	SchemaGroupID *ID  `json:"schemaGroupId,omitempty"`
+	ItemID        *ID  `json:"itemId,omitempty"`
+	FieldID       ID   `json:"fieldId"`
+	Version       *int `json:"version,omitempty"`
 }
 
 type UpdateAssetInput struct {
@@ -1194,6 +1341,7 @@ type UpdateAssetPayload struct {
 type UpdateCustomPropertySchemaInput struct {
 	LayerID ID   `json:"layerId"`
 	Schema  JSON `json:"schema,omitempty"`
+	Version *int `json:"version,omitempty"`
 }
 
 type UpdateGeoJSONFeatureInput struct {
@@ -1201,6 +1349,7 @@ type UpdateGeoJSONFeatureInput struct {
 	Geometry   JSON `json:"geometry,omitempty"`
 	Properties JSON `json:"properties,omitempty"`
 	LayerID    ID   `json:"layerId"`
+	Version    *int `json:"version,omitempty"`
 }
 
 type UpdateMeInput struct {
@@ -1232,6 +1381,7 @@ type UpdateNLSLayerInput struct {
 	Name    *string `json:"name,omitempty"`
 	Visible *bool   `json:"visible,omitempty"`
 	Config  JSON    `json:"config,omitempty"`
+	Version *int    `json:"version,omitempty"`
 }
 
 type UpdateNLSLayerPayload struct {
@@ -1239,7 +1389,8 @@ type UpdateNLSLayerPayload struct {
 }
 
 type UpdateNLSLayersInput struct {
-	Layers []*UpdateNLSLayerInput `json:"layers"`
+	Layers  []*UpdateNLSLayerInput `json:"layers"`
+	Version *int                   `json:"version,omitempty"`
 }
 
 type UpdateNLSLayersPayload struct {
@@ -1273,6 +1424,7 @@ type UpdatePropertyItemInput struct {
 	PropertyID    ID                                  `json:"propertyId"`
 	SchemaGroupID ID                                  `json:"schemaGroupId"`
 	Operations    []*UpdatePropertyItemOperationInput `json:"operations"`
+	Version       *int                                `json:"version,omitempty"`
 }
 
 type UpdatePropertyItemOperationInput struct {
@@ -1290,6 +1442,16 @@ type UpdatePropertyValueInput struct {
 	FieldID       ID        `json:"fieldId"`
 	Value         any       `json:"value,omitempty"`
 	Type          ValueType `json:"type"`
+	Version       *int      `json:"version,omitempty"`
+}
+
+type UpdateScenePresenceInput struct {
+	SceneID ID  `json:"sceneId"`
+	LayerID *ID `json:"layerId,omitempty"`
+}
+
+type UpdateScenePresencePayload struct {
+	Presences []*ScenePresence `json:"presences"`
 }
 
 type UpdateStoryInput struct {
@@ -1310,6 +1472,7 @@ type UpdateStoryInput struct {
 	DeletePublicImage *bool     `json:"deletePublicImage,omitempty"`
 	EnableGa          *bool     `json:"enableGa,omitempty"`
 	TrackingID        *string   `json:"trackingId,omitempty"`
+	Version           *int      `json:"version,omitempty"`
 }
 
 type UpdateStoryPageInput struct {
@@ -1321,18 +1484,31 @@ type UpdateStoryPageInput struct {
 	Layers          []ID    `json:"layers,omitempty"`
 	SwipeableLayers []ID    `json:"swipeableLayers,omitempty"`
 	Index           *int    `json:"index,omitempty"`
+	Version         *int    `json:"version,omitempty"`
 }
 
 type UpdateStyleInput struct {
 	StyleID ID      `json:"styleId"`
 	Name    *string `json:"name,omitempty"`
 	Value   JSON    `json:"value,omitempty"`
+	Version *int    `json:"version,omitempty"`
 }
 
 type UpdateStylePayload struct {
 	Style *Style `json:"style"`
 }
 
+type UpdateStylePresetInput struct {
+	PresetID    ID      `json:"presetId"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Value       JSON    `json:"value,omitempty"`
+}
+
+type UpdateStylePresetPayload struct {
+	StylePreset *StylePreset `json:"stylePreset"`
+}
+
 type UpdateTeamInput struct {
 	TeamID ID     `json:"teamId"`
 	Name   string `json:"name"`
@@ -1350,6 +1526,7 @@ type UpdateWidgetAlignSystemInput struct {
 	Gap        *int                    `json:"gap,omitempty"`
 	Centered   *bool                   `json:"centered,omitempty"`
 	Background *string                 `json:"background,omitempty"`
+	Version    *int                    `json:"version,omitempty"`
 }
 
 type UpdateWidgetAlignSystemPayload struct {
@@ -1363,6 +1540,7 @@ type UpdateWidgetInput struct {
 	Location *WidgetLocationInput `json:"location,omitempty"`
 	Extended *bool                `json:"extended,omitempty"`
 	Index    *int                 `json:"index,omitempty"`
+	Version  *int                 `json:"version,omitempty"`
 }
 
 type UpdateWidgetPayload struct {
@@ -1387,6 +1565,7 @@ type UploadFileToPropertyInput struct {
 	ItemID        *ID            `json:"itemId,omitempty"`
 	FieldID       ID             `json:"fieldId"`
 	File          graphql.Upload `json:"file"`
+	Version       *int           `json:"version,omitempty"`
 }
 
 type UploadPluginInput struct {
@@ -1914,6 +2093,96 @@ func (e Role) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type SceneRevisionChangeType string
+
+const (
+	SceneRevisionChangeTypeAdded    SceneRevisionChangeType = "ADDED"
+	SceneRevisionChangeTypeRemoved  SceneRevisionChangeType = "REMOVED"
+	SceneRevisionChangeTypeModified SceneRevisionChangeType = "MODIFIED"
+)
+
+var AllSceneRevisionChangeType = []SceneRevisionChangeType{
+	SceneRevisionChangeTypeAdded,
+	SceneRevisionChangeTypeRemoved,
+	SceneRevisionChangeTypeModified,
+}
+
+func (e SceneRevisionChangeType) IsValid() bool {
+	switch e {
+	case SceneRevisionChangeTypeAdded, SceneRevisionChangeTypeRemoved, SceneRevisionChangeTypeModified:
+		return true
+	}
+	return false
+}
+
+func (e SceneRevisionChangeType) String() string {
+	return string(e)
+}
+
+func (e *SceneRevisionChangeType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SceneRevisionChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SceneRevisionChangeType", str)
+	}
+	return nil
+}
+
+func (e SceneRevisionChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SceneRevisionObjectType string
+
+const (
+	SceneRevisionObjectTypeScene    SceneRevisionObjectType = "SCENE"
+	SceneRevisionObjectTypeNlsLayer SceneRevisionObjectType = "NLS_LAYER"
+	SceneRevisionObjectTypeStyle    SceneRevisionObjectType = "STYLE"
+	SceneRevisionObjectTypeStory    SceneRevisionObjectType = "STORY"
+	SceneRevisionObjectTypeProperty SceneRevisionObjectType = "PROPERTY"
+)
+
+var AllSceneRevisionObjectType = []SceneRevisionObjectType{
+	SceneRevisionObjectTypeScene,
+	SceneRevisionObjectTypeNlsLayer,
+	SceneRevisionObjectTypeStyle,
+	SceneRevisionObjectTypeStory,
+	SceneRevisionObjectTypeProperty,
+}
+
+func (e SceneRevisionObjectType) IsValid() bool {
+	switch e {
+	case SceneRevisionObjectTypeScene, SceneRevisionObjectTypeNlsLayer, SceneRevisionObjectTypeStyle, SceneRevisionObjectTypeStory, SceneRevisionObjectTypeProperty:
+		return true
+	}
+	return false
+}
+
+func (e SceneRevisionObjectType) String() string {
+	return string(e)
+}
+
+func (e *SceneRevisionObjectType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SceneRevisionObjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SceneRevisionObjectType", str)
+	}
+	return nil
+}
+
+func (e SceneRevisionObjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SortDirection string
 
 const (
@@ -1955,6 +2224,47 @@ func (e SortDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type StyleIssueType string
+
+const (
+	StyleIssueTypeSyntax           StyleIssueType = "SYNTAX"
+	StyleIssueTypeUnknownAttribute StyleIssueType = "UNKNOWN_ATTRIBUTE"
+)
+
+var AllStyleIssueType = []StyleIssueType{
+	StyleIssueTypeSyntax,
+	StyleIssueTypeUnknownAttribute,
+}
+
+func (e StyleIssueType) IsValid() bool {
+	switch e {
+	case StyleIssueTypeSyntax, StyleIssueTypeUnknownAttribute:
+		return true
+	}
+	return false
+}
+
+func (e StyleIssueType) String() string {
+	return string(e)
+}
+
+func (e *StyleIssueType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StyleIssueType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StyleIssueType", str)
+	}
+	return nil
+}
+
+func (e StyleIssueType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type TextAlign string
 
 const (
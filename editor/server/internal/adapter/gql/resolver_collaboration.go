@@ -0,0 +1,100 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/log"
+)
+
+func (r *Resolver) SceneEvent() SceneEventResolver {
+	return &sceneEventResolver{r}
+}
+
+type sceneEventResolver struct{ *Resolver }
+
+func (r *sceneEventResolver) User(ctx context.Context, obj *gqlmodel.SceneEvent) (*gqlmodel.User, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+	return dataloaders(ctx).User.Load(*obj.UserID)
+}
+
+func (r *Resolver) ScenePresence() ScenePresenceResolver {
+	return &scenePresenceResolver{r}
+}
+
+type scenePresenceResolver struct{ *Resolver }
+
+func (r *scenePresenceResolver) User(ctx context.Context, obj *gqlmodel.ScenePresence) (*gqlmodel.User, error) {
+	return dataloaders(ctx).User.Load(obj.UserID)
+}
+
+func (r *subscriptionResolver) SceneEvents(ctx context.Context, sceneID gqlmodel.ID) (<-chan *gqlmodel.SceneEvent, error) {
+	sid, err := gqlmodel.ToID[id.Scene](sceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc, op := usecases(ctx).Collaboration, getOperator(ctx)
+	// the subscription is also canceled when it is closed because the user is no longer a member
+	ctx, cancel := context.WithCancel(ctx)
+	events, err := uc.SubscribeEvents(ctx, sid, op)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res := make(chan *gqlmodel.SceneEvent)
+	go func() {
+		defer close(res)
+		defer cancel()
+		for e := range events {
+			if err := uc.CheckMembership(ctx, sid, op); err != nil {
+				log.Debugfc(ctx, "collaboration: sceneEvents of scene %s is closed: %s", sid, err)
+				return
+			}
+			select {
+			case res <- gqlmodel.ToSceneEvent(e):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res, nil
+}
+
+func (r *subscriptionResolver) ScenePresences(ctx context.Context, sceneID gqlmodel.ID) (<-chan []*gqlmodel.ScenePresence, error) {
+	sid, err := gqlmodel.ToID[id.Scene](sceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc, op := usecases(ctx).Collaboration, getOperator(ctx)
+	// the subscription is also canceled when it is closed because the user is no longer a member
+	ctx, cancel := context.WithCancel(ctx)
+	presences, err := uc.Join(ctx, sid, op)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res := make(chan []*gqlmodel.ScenePresence)
+	go func() {
+		defer close(res)
+		defer cancel()
+		for l := range presences {
+			if err := uc.CheckMembership(ctx, sid, op); err != nil {
+				log.Debugfc(ctx, "collaboration: scenePresences of scene %s is closed: %s", sid, err)
+				return
+			}
+			select {
+			case res <- gqlmodel.ToScenePresences(l):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res, nil
+}
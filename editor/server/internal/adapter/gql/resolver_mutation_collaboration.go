@@ -0,0 +1,28 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+)
+
+func (r *mutationResolver) UpdateScenePresence(ctx context.Context, input gqlmodel.UpdateScenePresenceInput) (*gqlmodel.UpdateScenePresencePayload, error) {
+	sid, err := gqlmodel.ToID[id.Scene](input.SceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Collaboration.UpdatePresence(ctx, interfaces.UpdatePresenceInput{
+		SceneID: sid,
+		LayerID: gqlmodel.ToIDRef[id.NLSLayer](input.LayerID),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.UpdateScenePresencePayload{
+		Presences: gqlmodel.ToScenePresences(res),
+	}, nil
+}
@@ -11,6 +11,8 @@ import (
 )
 
 func (r *mutationResolver) AddGeoJSONFeature(ctx context.Context, input gqlmodel.AddGeoJSONFeatureInput) (*gqlmodel.Feature, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -40,6 +42,8 @@ func (r *mutationResolver) AddGeoJSONFeature(ctx context.Context, input gqlmodel
 }
 
 func (r *mutationResolver) UpdateGeoJSONFeature(ctx context.Context, input gqlmodel.UpdateGeoJSONFeatureInput) (*gqlmodel.Feature, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -74,6 +78,8 @@ func (r *mutationResolver) UpdateGeoJSONFeature(ctx context.Context, input gqlmo
 }
 
 func (r *mutationResolver) DeleteGeoJSONFeature(ctx context.Context, input gqlmodel.DeleteGeoJSONFeatureInput) (*gqlmodel.DeleteGeoJSONFeaturePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,7 @@ import (
 )
 
 func (r *mutationResolver) AddNLSLayerSimple(ctx context.Context, input gqlmodel.AddNLSLayerSimpleInput) (*gqlmodel.AddNLSLayerSimplePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
 
 	sId, err := gqlmodel.ToID[id.Scene](input.SceneID)
 	if err != nil {
@@ -35,7 +36,33 @@ func (r *mutationResolver) AddNLSLayerSimple(ctx context.Context, input gqlmodel
 	}, nil
 }
 
+func (r *mutationResolver) ImportNLSLayerFile(ctx context.Context, input gqlmodel.ImportNLSLayerFileInput) (*gqlmodel.ImportNLSLayerFilePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
+	sId, err := gqlmodel.ToID[id.Scene](input.SceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	layer, err := usecases(ctx).NLSLayer.ImportLayerFile(ctx, interfaces.ImportNLSLayerFileInput{
+		SceneID: sId,
+		Title:   input.Title,
+		Index:   input.Index,
+		Visible: input.Visible,
+		File:    gqlmodel.FromFile(&input.File),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ImportNLSLayerFilePayload{
+		Layer: gqlmodel.ToNLSLayerSimple(layer),
+	}, nil
+}
+
 func (r *mutationResolver) RemoveNLSLayer(ctx context.Context, input gqlmodel.RemoveNLSLayerInput) (*gqlmodel.RemoveNLSLayerPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -52,6 +79,8 @@ func (r *mutationResolver) RemoveNLSLayer(ctx context.Context, input gqlmodel.Re
 }
 
 func (r *mutationResolver) UpdateNLSLayer(ctx context.Context, input gqlmodel.UpdateNLSLayerInput) (*gqlmodel.UpdateNLSLayerPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -76,13 +105,15 @@ func (r *mutationResolver) UpdateNLSLayer(ctx context.Context, input gqlmodel.Up
 func (r *mutationResolver) UpdateNLSLayers(ctx context.Context, input gqlmodel.UpdateNLSLayersInput) (*gqlmodel.UpdateNLSLayersPayload, error) {
 	var updatedLayers []gqlmodel.NLSLayer
 
+	// only the first update is checked with the version, as each update increments the version
+	vctx := withSceneVersion(ctx, input.Version)
 	for _, layerInput := range input.Layers {
 		lid, err := gqlmodel.ToID[id.NLSLayer](layerInput.LayerID)
 		if err != nil {
 			return nil, err
 		}
 
-		layer, err := usecases(ctx).NLSLayer.Update(ctx, interfaces.UpdateNLSLayerInput{
+		layer, err := usecases(ctx).NLSLayer.Update(vctx, interfaces.UpdateNLSLayerInput{
 			LayerID: lid,
 			Index:   layerInput.Index,
 			Name:    layerInput.Name,
@@ -92,6 +123,7 @@ func (r *mutationResolver) UpdateNLSLayers(ctx context.Context, input gqlmodel.U
 		if err != nil {
 			return nil, err
 		}
+		vctx = ctx
 
 		updatedLayers = append(updatedLayers, gqlmodel.ToNLSLayer(layer, nil))
 	}
@@ -102,6 +134,8 @@ func (r *mutationResolver) UpdateNLSLayers(ctx context.Context, input gqlmodel.U
 }
 
 func (r *mutationResolver) DuplicateNLSLayer(ctx context.Context, input gqlmodel.DuplicateNLSLayerInput) (*gqlmodel.DuplicateNLSLayerPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -118,6 +152,8 @@ func (r *mutationResolver) DuplicateNLSLayer(ctx context.Context, input gqlmodel
 }
 
 func (r *mutationResolver) CreateNLSInfobox(ctx context.Context, input gqlmodel.CreateNLSInfoboxInput) (*gqlmodel.CreateNLSInfoboxPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -134,6 +170,8 @@ func (r *mutationResolver) CreateNLSInfobox(ctx context.Context, input gqlmodel.
 }
 
 func (r *mutationResolver) RemoveNLSInfobox(ctx context.Context, input gqlmodel.RemoveNLSInfoboxInput) (*gqlmodel.RemoveNLSInfoboxPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -150,6 +188,8 @@ func (r *mutationResolver) RemoveNLSInfobox(ctx context.Context, input gqlmodel.
 }
 
 func (r *mutationResolver) CreateNLSPhotoOverlay(ctx context.Context, input gqlmodel.CreateNLSPhotoOverlayInput) (*gqlmodel.CreateNLSPhotoOverlayPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -166,6 +206,8 @@ func (r *mutationResolver) CreateNLSPhotoOverlay(ctx context.Context, input gqlm
 }
 
 func (r *mutationResolver) RemoveNLSPhotoOverlay(ctx context.Context, input gqlmodel.RemoveNLSPhotoOverlayInput) (*gqlmodel.RemoveNLSPhotoOverlayPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -182,6 +224,8 @@ func (r *mutationResolver) RemoveNLSPhotoOverlay(ctx context.Context, input gqlm
 }
 
 func (r *mutationResolver) AddNLSInfoboxBlock(ctx context.Context, input gqlmodel.AddNLSInfoboxBlockInput) (*gqlmodel.AddNLSInfoboxBlockPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -209,6 +253,8 @@ func (r *mutationResolver) AddNLSInfoboxBlock(ctx context.Context, input gqlmode
 }
 
 func (r *mutationResolver) MoveNLSInfoboxBlock(ctx context.Context, input gqlmodel.MoveNLSInfoboxBlockInput) (*gqlmodel.MoveNLSInfoboxBlockPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, ifid, err := gqlmodel.ToID2[id.NLSLayer, id.InfoboxBlock](input.LayerID, input.InfoboxBlockID)
 	if err != nil {
 		return nil, err
@@ -231,6 +277,8 @@ func (r *mutationResolver) MoveNLSInfoboxBlock(ctx context.Context, input gqlmod
 }
 
 func (r *mutationResolver) RemoveNLSInfoboxBlock(ctx context.Context, input gqlmodel.RemoveNLSInfoboxBlockInput) (*gqlmodel.RemoveNLSInfoboxBlockPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, ibid, err := gqlmodel.ToID2[id.NLSLayer, id.InfoboxBlock](input.LayerID, input.InfoboxBlockID)
 	if err != nil {
 		return nil, err
@@ -251,6 +299,8 @@ func (r *mutationResolver) RemoveNLSInfoboxBlock(ctx context.Context, input gqlm
 }
 
 func (r *mutationResolver) UpdateCustomProperties(ctx context.Context, input gqlmodel.UpdateCustomPropertySchemaInput) (*gqlmodel.UpdateNLSLayerPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -270,6 +320,8 @@ func (r *mutationResolver) UpdateCustomProperties(ctx context.Context, input gql
 }
 
 func (r *mutationResolver) ChangeCustomPropertyTitle(ctx context.Context, input gqlmodel.ChangeCustomPropertyTitleInput) (*gqlmodel.UpdateNLSLayerPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -289,6 +341,8 @@ func (r *mutationResolver) ChangeCustomPropertyTitle(ctx context.Context, input
 }
 
 func (r *mutationResolver) RemoveCustomProperty(ctx context.Context, input gqlmodel.RemoveCustomPropertyInput) (*gqlmodel.UpdateNLSLayerPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	lid, err := gqlmodel.ToID[id.NLSLayer](input.LayerID)
 	if err != nil {
 		return nil, err
@@ -228,25 +228,25 @@ func (r *mutationResolver) ImportProject(ctx context.Context, input gqlmodel.Imp
 	//　The following is the saving of sceneJSON. -----------------------
 
 	// Scene data save
-	newScene, err = usecases(ctx).Scene.ImportScene(ctx, newScene, importData)
+	newScene, err = usecases(ctx).Scene.ImportScene(ctx, newScene, importData, getOperator(ctx))
 	if err != nil {
 		return nil, errors.New("Fail sceneJSON ImportScene :" + err.Error())
 	}
 
 	// Styles data save
-	styleList, err := usecases(ctx).Style.ImportStyles(ctx, newScene.ID(), importData)
+	styleList, err := usecases(ctx).Style.ImportStyles(ctx, newScene.ID(), importData, getOperator(ctx))
 	if err != nil {
 		return nil, errors.New("Fail sceneJSON ImportStyles :" + err.Error())
 	}
 
 	// NLSLayers data save
-	nlayers, err := usecases(ctx).NLSLayer.ImportNLSLayers(ctx, newScene.ID(), importData)
+	nlayers, err := usecases(ctx).NLSLayer.ImportNLSLayers(ctx, newScene.ID(), importData, getOperator(ctx))
 	if err != nil {
 		return nil, errors.New("Fail sceneJSON ImportNLSLayers :" + err.Error())
 	}
 
 	// Story data save
-	st, err := usecases(ctx).StoryTelling.ImportStory(ctx, newScene.ID(), importData)
+	st, err := usecases(ctx).StoryTelling.ImportStory(ctx, newScene.ID(), importData, getOperator(ctx))
 	if err != nil {
 		return nil, errors.New("Fail sceneJSON ImportStory :" + err.Error())
 	}
@@ -14,6 +14,8 @@ import (
 )
 
 func (r *mutationResolver) UpdatePropertyValue(ctx context.Context, input gqlmodel.UpdatePropertyValueInput) (*gqlmodel.PropertyFieldPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	var v *property.Value
 	if input.Value != nil {
 		v = gqlmodel.FromPropertyValueAndType(input.Value, input.Type)
@@ -47,6 +49,8 @@ func (r *mutationResolver) UpdatePropertyValue(ctx context.Context, input gqlmod
 }
 
 func (r *mutationResolver) RemovePropertyField(ctx context.Context, input gqlmodel.RemovePropertyFieldInput) (*gqlmodel.PropertyFieldPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
@@ -70,6 +74,8 @@ func (r *mutationResolver) RemovePropertyField(ctx context.Context, input gqlmod
 }
 
 func (r *mutationResolver) UploadFileToProperty(ctx context.Context, input gqlmodel.UploadFileToPropertyInput) (*gqlmodel.PropertyFieldPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
@@ -127,6 +133,8 @@ func (r *mutationResolver) UploadFileToProperty(ctx context.Context, input gqlmo
 }
 
 func (r *mutationResolver) UnlinkPropertyValue(ctx context.Context, input gqlmodel.UnlinkPropertyValueInput) (*gqlmodel.PropertyFieldPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
@@ -151,6 +159,8 @@ func (r *mutationResolver) UnlinkPropertyValue(ctx context.Context, input gqlmod
 }
 
 func (r *mutationResolver) AddPropertyItem(ctx context.Context, input gqlmodel.AddPropertyItemInput) (*gqlmodel.PropertyItemPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
@@ -182,6 +192,8 @@ func (r *mutationResolver) AddPropertyItem(ctx context.Context, input gqlmodel.A
 }
 
 func (r *mutationResolver) MovePropertyItem(ctx context.Context, input gqlmodel.MovePropertyItemInput) (*gqlmodel.PropertyItemPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
@@ -207,6 +219,8 @@ func (r *mutationResolver) MovePropertyItem(ctx context.Context, input gqlmodel.
 }
 
 func (r *mutationResolver) RemovePropertyItem(ctx context.Context, input gqlmodel.RemovePropertyItemInput) (*gqlmodel.PropertyItemPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
@@ -230,6 +244,8 @@ func (r *mutationResolver) RemovePropertyItem(ctx context.Context, input gqlmode
 }
 
 func (r *mutationResolver) UpdatePropertyItems(ctx context.Context, input gqlmodel.UpdatePropertyItemInput) (*gqlmodel.PropertyItemPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	pid, err := gqlmodel.ToID[id.Property](input.PropertyID)
 	if err != nil {
 		return nil, err
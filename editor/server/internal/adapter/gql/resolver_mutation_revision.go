@@ -0,0 +1,26 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth/server/pkg/id"
+)
+
+func (r *mutationResolver) RestoreSceneRevision(ctx context.Context, input gqlmodel.RestoreSceneRevisionInput) (*gqlmodel.RestoreSceneRevisionPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
+	rid, err := gqlmodel.ToID[id.Revision](input.RevisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Revision.Restore(ctx, rid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RestoreSceneRevisionPayload{
+		Revision: gqlmodel.ToSceneRevision(res),
+	}, nil
+}
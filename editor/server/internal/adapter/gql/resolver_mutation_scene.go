@@ -35,6 +35,8 @@ func (r *mutationResolver) CreateScene(ctx context.Context, input gqlmodel.Creat
 }
 
 func (r *mutationResolver) AddWidget(ctx context.Context, input gqlmodel.AddWidgetInput) (*gqlmodel.AddWidgetPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, err := gqlmodel.ToID[id.Scene](input.SceneID)
 	if err != nil {
 		return nil, err
@@ -63,6 +65,8 @@ func (r *mutationResolver) AddWidget(ctx context.Context, input gqlmodel.AddWidg
 }
 
 func (r *mutationResolver) UpdateWidget(ctx context.Context, input gqlmodel.UpdateWidgetInput) (*gqlmodel.UpdateWidgetPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, wid, err := gqlmodel.ToID2[id.Scene, id.Widget](input.SceneID, input.WidgetID)
 	if err != nil {
 		return nil, err
@@ -87,6 +91,8 @@ func (r *mutationResolver) UpdateWidget(ctx context.Context, input gqlmodel.Upda
 }
 
 func (r *mutationResolver) RemoveWidget(ctx context.Context, input gqlmodel.RemoveWidgetInput) (*gqlmodel.RemoveWidgetPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, wid, err := gqlmodel.ToID2[id.Scene, id.Widget](input.SceneID, input.WidgetID)
 	if err != nil {
 		return nil, err
@@ -108,6 +114,8 @@ func (r *mutationResolver) RemoveWidget(ctx context.Context, input gqlmodel.Remo
 }
 
 func (r *mutationResolver) UpdateWidgetAlignSystem(ctx context.Context, input gqlmodel.UpdateWidgetAlignSystemInput) (*gqlmodel.UpdateWidgetAlignSystemPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, err := gqlmodel.ToID[id.Scene](input.SceneID)
 	if err != nil {
 		return nil, err
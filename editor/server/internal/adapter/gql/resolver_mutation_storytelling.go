@@ -11,6 +11,8 @@ import (
 )
 
 func (r *mutationResolver) CreateStory(ctx context.Context, input gqlmodel.CreateStoryInput) (*gqlmodel.StoryPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sId, err := gqlmodel.ToID[id.Scene](input.SceneID)
 	if err != nil {
 		return nil, err
@@ -33,6 +35,8 @@ func (r *mutationResolver) CreateStory(ctx context.Context, input gqlmodel.Creat
 }
 
 func (r *mutationResolver) UpdateStory(ctx context.Context, input gqlmodel.UpdateStoryInput) (*gqlmodel.StoryPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, err := gqlmodel.ToID2[id.Scene, id.Story](input.SceneID, input.StoryID)
 	if err != nil {
 		return nil, err
@@ -70,6 +74,8 @@ func (r *mutationResolver) UpdateStory(ctx context.Context, input gqlmodel.Updat
 }
 
 func (r *mutationResolver) DeleteStory(ctx context.Context, input gqlmodel.DeleteStoryInput) (*gqlmodel.DeleteStoryPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, err := gqlmodel.ToID2[id.Scene, id.Story](input.SceneID, input.StoryID)
 	if err != nil {
 		return nil, err
@@ -90,6 +96,8 @@ func (r *mutationResolver) DeleteStory(ctx context.Context, input gqlmodel.Delet
 }
 
 func (r *mutationResolver) PublishStory(ctx context.Context, input gqlmodel.PublishStoryInput) (*gqlmodel.StoryPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sID, err := gqlmodel.ToID[id.Story](input.StoryID)
 	if err != nil {
 		return nil, err
@@ -108,12 +116,15 @@ func (r *mutationResolver) PublishStory(ctx context.Context, input gqlmodel.Publ
 }
 
 func (r *mutationResolver) MoveStory(ctx context.Context, input gqlmodel.MoveStoryInput) (*gqlmodel.MoveStoryPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	scId, sId, err := gqlmodel.ToID2[id.Scene, id.Story](input.SceneID, input.StoryID)
 	if err != nil {
 		return nil, err
 	}
 
 	inp := interfaces.MoveStoryInput{
+		SceneID: scId,
 		StoryID: sId,
 		Index:   input.Index,
 	}
@@ -136,6 +147,8 @@ func (r *mutationResolver) MoveStory(ctx context.Context, input gqlmodel.MoveSto
 }
 
 func (r *mutationResolver) CreateStoryPage(ctx context.Context, input gqlmodel.CreateStoryPageInput) (*gqlmodel.StoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, err := gqlmodel.ToID2[id.Scene, id.Story](input.SceneID, input.StoryID)
 	if err != nil {
 		return nil, err
@@ -173,6 +186,8 @@ func (r *mutationResolver) CreateStoryPage(ctx context.Context, input gqlmodel.C
 }
 
 func (r *mutationResolver) UpdateStoryPage(ctx context.Context, input gqlmodel.UpdateStoryPageInput) (*gqlmodel.StoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, pageId, err := gqlmodel.ToID3[id.Scene, id.Story, id.Page](input.SceneID, input.StoryID, input.PageID)
 	if err != nil {
 		return nil, err
@@ -211,6 +226,8 @@ func (r *mutationResolver) UpdateStoryPage(ctx context.Context, input gqlmodel.U
 }
 
 func (r *mutationResolver) RemoveStoryPage(ctx context.Context, input gqlmodel.DeleteStoryPageInput) (*gqlmodel.DeleteStoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, pageId, err := gqlmodel.ToID3[id.Scene, id.Story, id.Page](input.SceneID, input.StoryID, input.PageID)
 	if err != nil {
 		return nil, err
@@ -234,6 +251,8 @@ func (r *mutationResolver) RemoveStoryPage(ctx context.Context, input gqlmodel.D
 }
 
 func (r *mutationResolver) MoveStoryPage(ctx context.Context, input gqlmodel.MoveStoryPageInput) (*gqlmodel.MoveStoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	storyId, pageId, err := gqlmodel.ToID2[id.Story, id.Page](input.StoryID, input.PageID)
 	if err != nil {
 		return nil, err
@@ -258,6 +277,8 @@ func (r *mutationResolver) MoveStoryPage(ctx context.Context, input gqlmodel.Mov
 }
 
 func (r *mutationResolver) DuplicateStoryPage(ctx context.Context, input gqlmodel.DuplicateStoryPageInput) (*gqlmodel.StoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, pageId, err := gqlmodel.ToID3[id.Scene, id.Story, id.Page](input.SceneID, input.StoryID, input.PageID)
 	if err != nil {
 		return nil, err
@@ -281,6 +302,8 @@ func (r *mutationResolver) DuplicateStoryPage(ctx context.Context, input gqlmode
 }
 
 func (r *mutationResolver) AddPageLayer(ctx context.Context, input gqlmodel.PageLayerInput) (*gqlmodel.StoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, pageId, layerId, err := gqlmodel.ToID4[id.Scene, id.Story, id.Page, id.NLSLayer](input.SceneID, input.StoryID, input.PageID, input.LayerID)
 	if err != nil {
 		return nil, err
@@ -306,6 +329,8 @@ func (r *mutationResolver) AddPageLayer(ctx context.Context, input gqlmodel.Page
 }
 
 func (r *mutationResolver) RemovePageLayer(ctx context.Context, input gqlmodel.PageLayerInput) (*gqlmodel.StoryPagePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sceneId, storyId, pageId, layerId, err := gqlmodel.ToID4[id.Scene, id.Story, id.Page, id.NLSLayer](input.SceneID, input.StoryID, input.PageID, input.LayerID)
 	if err != nil {
 		return nil, err
@@ -331,6 +356,8 @@ func (r *mutationResolver) RemovePageLayer(ctx context.Context, input gqlmodel.P
 }
 
 func (r *mutationResolver) CreateStoryBlock(ctx context.Context, input gqlmodel.CreateStoryBlockInput) (*gqlmodel.CreateStoryBlockPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sId, pId, err := gqlmodel.ToID2[id.Story, id.Page](input.StoryID, input.PageID)
 	if err != nil {
 		return nil, err
@@ -361,6 +388,8 @@ func (r *mutationResolver) CreateStoryBlock(ctx context.Context, input gqlmodel.
 }
 
 func (r *mutationResolver) MoveStoryBlock(ctx context.Context, input gqlmodel.MoveStoryBlockInput) (*gqlmodel.MoveStoryBlockPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sId, pId, bId, err := gqlmodel.ToID3[id.Story, id.Page, id.Block](input.StoryID, input.PageID, input.BlockID)
 	if err != nil {
 		return nil, err
@@ -385,6 +414,8 @@ func (r *mutationResolver) MoveStoryBlock(ctx context.Context, input gqlmodel.Mo
 }
 
 func (r *mutationResolver) RemoveStoryBlock(ctx context.Context, input gqlmodel.RemoveStoryBlockInput) (*gqlmodel.RemoveStoryBlockPayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sId, pId, bId, err := gqlmodel.ToID3[id.Story, id.Page, id.Block](input.StoryID, input.PageID, input.BlockID)
 	if err != nil {
 		return nil, err
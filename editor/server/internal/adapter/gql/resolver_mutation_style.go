@@ -9,6 +9,8 @@ import (
 )
 
 func (r *mutationResolver) AddStyle(ctx context.Context, input gqlmodel.AddStyleInput) (*gqlmodel.AddStylePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, err := gqlmodel.ToID[id.Scene](input.SceneID)
 	if err != nil {
 		return nil, err
@@ -18,6 +20,7 @@ func (r *mutationResolver) AddStyle(ctx context.Context, input gqlmodel.AddStyle
 		SceneID: sid,
 		Name:    input.Name,
 		Value:   gqlmodel.ToStyleValue(input.Value),
+		Preset:  gqlmodel.ToIDRef[id.StylePreset](input.PresetID),
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -29,6 +32,8 @@ func (r *mutationResolver) AddStyle(ctx context.Context, input gqlmodel.AddStyle
 }
 
 func (r *mutationResolver) UpdateStyle(ctx context.Context, input gqlmodel.UpdateStyleInput) (*gqlmodel.UpdateStylePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, err := gqlmodel.ToID[id.Style](input.StyleID)
 	if err != nil {
 		return nil, err
@@ -49,6 +54,8 @@ func (r *mutationResolver) UpdateStyle(ctx context.Context, input gqlmodel.Updat
 }
 
 func (r *mutationResolver) RemoveStyle(ctx context.Context, input gqlmodel.RemoveStyleInput) (*gqlmodel.RemoveStylePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, err := gqlmodel.ToID[id.Style](input.StyleID)
 	if err != nil {
 		return nil, err
@@ -65,6 +72,8 @@ func (r *mutationResolver) RemoveStyle(ctx context.Context, input gqlmodel.Remov
 }
 
 func (r *mutationResolver) DuplicateStyle(ctx context.Context, input gqlmodel.DuplicateStyleInput) (*gqlmodel.DuplicateStylePayload, error) {
+	ctx = withSceneVersion(ctx, input.Version)
+
 	sid, err := gqlmodel.ToID[id.Style](input.StyleID)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,68 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+func (r *mutationResolver) CreateStylePreset(ctx context.Context, input gqlmodel.CreateStylePresetInput) (*gqlmodel.CreateStylePresetPayload, error) {
+	wid, err := gqlmodel.ToID[accountdomain.Workspace](input.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := usecases(ctx).StylePreset.Create(ctx, interfaces.CreateStylePresetInput{
+		WorkspaceID: wid,
+		Name:        input.Name,
+		Description: input.Description,
+		Value:       gqlmodel.ToStyleValue(input.Value),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CreateStylePresetPayload{
+		StylePreset: gqlmodel.ToStylePreset(p),
+	}, nil
+}
+
+func (r *mutationResolver) UpdateStylePreset(ctx context.Context, input gqlmodel.UpdateStylePresetInput) (*gqlmodel.UpdateStylePresetPayload, error) {
+	pid, err := gqlmodel.ToID[id.StylePreset](input.PresetID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := usecases(ctx).StylePreset.Update(ctx, interfaces.UpdateStylePresetInput{
+		PresetID:    pid,
+		Name:        input.Name,
+		Description: input.Description,
+		Value:       gqlmodel.ToStyleValue(input.Value),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.UpdateStylePresetPayload{
+		StylePreset: gqlmodel.ToStylePreset(p),
+	}, nil
+}
+
+func (r *mutationResolver) RemoveStylePreset(ctx context.Context, input gqlmodel.RemoveStylePresetInput) (*gqlmodel.RemoveStylePresetPayload, error) {
+	pid, err := gqlmodel.ToID[id.StylePreset](input.PresetID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).StylePreset.Remove(ctx, pid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RemoveStylePresetPayload{
+		PresetID: gqlmodel.IDFrom(res),
+	}, nil
+}
@@ -0,0 +1,77 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+func (r *Resolver) SceneRevision() SceneRevisionResolver {
+	return &sceneRevisionResolver{r}
+}
+
+type sceneRevisionResolver struct{ *Resolver }
+
+func (r *sceneRevisionResolver) User(ctx context.Context, obj *gqlmodel.SceneRevision) (*gqlmodel.User, error) {
+	if obj.UserID == nil {
+		return nil, nil
+	}
+	return dataloaders(ctx).User.Load(*obj.UserID)
+}
+
+func (r *queryResolver) SceneRevisions(ctx context.Context, sceneID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.SceneRevisionConnection, error) {
+	sid, err := gqlmodel.ToID[id.Scene](sceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, pi, err := usecases(ctx).Revision.FetchByScene(ctx, sid, gqlmodel.ToPagination(pagination), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := gqlmodel.ToSceneRevisions(res)
+	edges := make([]*gqlmodel.SceneRevisionEdge, len(nodes))
+	for i, rev := range nodes {
+		edges[i] = &gqlmodel.SceneRevisionEdge{
+			Node:   rev,
+			Cursor: usecasex.Cursor(rev.ID),
+		}
+	}
+
+	return &gqlmodel.SceneRevisionConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
+
+func (r *queryResolver) SceneRevisionDiff(ctx context.Context, from gqlmodel.ID, to *gqlmodel.ID) ([]*gqlmodel.SceneRevisionChange, error) {
+	fid, err := gqlmodel.ToID[id.Revision](from)
+	if err != nil {
+		return nil, err
+	}
+
+	var tid *id.RevisionID
+	if to != nil {
+		rid, err := gqlmodel.ToID[id.Revision](*to)
+		if err != nil {
+			return nil, err
+		}
+		tid = &rid
+	}
+
+	res, err := usecases(ctx).Revision.Diff(ctx, interfaces.DiffRevisionsInput{
+		From: fid,
+		To:   tid,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToSceneRevisionChanges(res), nil
+}
@@ -2,8 +2,12 @@ package gql
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/rerror"
 )
 
 func (r *Resolver) Style() StyleResolver {
@@ -15,3 +19,40 @@ type styleResolver struct{ *Resolver }
 func (r *styleResolver) Scene(ctx context.Context, obj *gqlmodel.Style) (*gqlmodel.Scene, error) {
 	return dataloaders(ctx).Scene.Load(obj.SceneID)
 }
+
+// Preset returns nil when the preset has been removed or cannot be read.
+func (r *styleResolver) Preset(ctx context.Context, obj *gqlmodel.Style) (*gqlmodel.StylePreset, error) {
+	if obj.PresetID == nil {
+		return nil, nil
+	}
+	pid, err := gqlmodel.ToID[id.StylePreset](*obj.PresetID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := usecases(ctx).StylePreset.Fetch(ctx, pid, getOperator(ctx))
+	if errors.Is(err, rerror.ErrNotFound) || errors.Is(err, interfaces.ErrOperationDenied) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gqlmodel.ToStylePreset(p), nil
+}
+
+func (r *queryResolver) ValidateStyle(ctx context.Context, layerID gqlmodel.ID, value gqlmodel.JSON) ([]*gqlmodel.StyleIssue, error) {
+	lid, err := gqlmodel.ToID[id.NLSLayer](layerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Style.ValidateStyle(ctx, interfaces.ValidateStyleInput{
+		Layer: lid,
+		Value: *gqlmodel.ToStyleValue(value),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToStyleIssues(res), nil
+}
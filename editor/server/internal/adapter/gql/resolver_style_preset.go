@@ -0,0 +1,22 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+func (r *queryResolver) StylePresets(ctx context.Context, teamID gqlmodel.ID) ([]*gqlmodel.StylePreset, error) {
+	wid, err := gqlmodel.ToID[accountdomain.Workspace](teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).StylePreset.FetchByWorkspace(ctx, wid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToStylePresets(res), nil
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/reearth/reearth/server/internal/adapter"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer/encoding"
+)
+
+type NLSLayerController struct {
+	usecase interfaces.NLSLayer
+}
+
+func NewNLSLayerController(usecase interfaces.NLSLayer) *NLSLayerController {
+	return &NLSLayerController{
+		usecase: usecase,
+	}
+}
+
+type ExportNLSLayersInput struct {
+	LayerIDs []string
+	Format   string
+}
+
+type ExportNLSLayersOutput struct {
+	// Ext is the extension of the exported file such as ".kml".
+	Ext         string
+	ContentType string
+	Data        []byte
+}
+
+func (c *NLSLayerController) Export(ctx context.Context, input ExportNLSLayersInput) (*ExportNLSLayersOutput, error) {
+	lids, err := id.NLSLayerIDListFrom(input.LayerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := encoding.FormatFrom(input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := c.usecase.ExportLayers(ctx, interfaces.ExportNLSLayersInput{
+		LayerIDs: lids,
+		Format:   f,
+	}, &buf, adapter.Operator(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &ExportNLSLayersOutput{
+		Ext:         f.Ext(),
+		ContentType: f.ContentType(),
+		Data:        buf.Bytes(),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/url"
 
+	"github.com/reearth/reearth/server/internal/adapter"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 )
 
@@ -27,3 +28,8 @@ func (c *PublishedController) Data(ctx context.Context, name string) (io.Reader,
 func (c *PublishedController) Index(ctx context.Context, name string, url *url.URL) (string, error) {
 	return c.usecase.Index(ctx, name, url)
 }
+
+// Export writes the zip of the published project to w and returns URLs of assets that could not be bundled.
+func (c *PublishedController) Export(ctx context.Context, name string, w io.Writer) ([]string, error) {
+	return c.usecase.Export(ctx, name, w, adapter.Operator(ctx))
+}
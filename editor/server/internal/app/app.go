@@ -18,6 +18,7 @@ import (
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
+	"github.com/spf13/afero"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
@@ -109,13 +110,19 @@ func initEcho(ctx context.Context, cfg *ServerConfig) *echo.Echo {
 			publishedIndexHTML = rewriteHTML(string(html), cfg.Config.Web_Title, favicon)
 		}
 	}
+	var publishedWeb afero.Fs
+	if _, err := os.Stat("web"); err == nil && !cfg.Config.Web_Disabled {
+		publishedWeb = afero.NewReadOnlyFs(afero.NewBasePathFs(afero.NewOsFs(), "web"))
+	}
 
-	e.Use(UsecaseMiddleware(cfg.Repos, cfg.Gateways, cfg.AccountRepos, cfg.AccountGateways, interactor.ContainerConfig{
+	usecaseMiddleware := UsecaseMiddleware(cfg.Repos, cfg.Gateways, cfg.AccountRepos, cfg.AccountGateways, interactor.ContainerConfig{
 		SignupSecret:       cfg.Config.SignupSecret,
 		PublishedIndexHTML: publishedIndexHTML,
 		PublishedIndexURL:  cfg.Config.Published.IndexURL,
+		PublishedWeb:       publishedWeb,
 		AuthSrvUIDomain:    cfg.Config.Host_Web,
-	}))
+	})
+	e.Use(usecaseMiddleware)
 
 	e.Use(AttachLanguageMiddleware)
 
@@ -129,8 +136,14 @@ func initEcho(ctx context.Context, cfg *ServerConfig) *echo.Echo {
 	api.GET("/published_data/:name", PublishedData("", true))
 
 	apiPrivate := api.Group("", privateCache)
-	apiPrivate.POST("/graphql", GraphqlAPI(cfg.Config.GraphQL, gqldev))
+	gql := GraphqlAPI(cfg.Config.GraphQL, gqldev, authenticateWebsocket(e, echo.WrapMiddleware(wrapHandler), attachOpMiddleware(cfg), usecaseMiddleware))
+	apiPrivate.POST("/graphql", gql)
+	// subscriptions are served over websocket connections opened with GET requests
+	apiPrivate.GET("/graphql", gql)
 	apiPrivate.POST("/signup", Signup())
+	apiPrivate.GET("/layers/export", ExportNLSLayers())
+	apiPrivate.GET("/layers/:layerId/export", ExportNLSLayers())
+	apiPrivate.GET("/published/:name/export", ExportPublished())
 	log.Infofc(ctx, "auth: config: %#v", cfg.Config.AuthSrv)
 	if !cfg.Config.AuthSrv.Disabled {
 		apiPrivate.POST("/signup/verify", StartSignupVerify())
@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearth/server/internal/adapter"
@@ -175,6 +178,39 @@ func addAuth0SubToUser(ctx context.Context, u *user.User, a user.Auth, cfg *Serv
 	return nil
 }
 
+// authenticateWebsocket returns a function that authenticates a websocket connection with a token by running the middlewares as if the token were the Authorization header,
+// as browsers cannot set headers of websocket connections. It returns the context with the operator of the token.
+func authenticateWebsocket(e *echo.Echo, middlewares ...echo.MiddlewareFunc) func(context.Context, string) (context.Context, error) {
+	return func(ctx context.Context, token string) (context.Context, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(token, "Bearer ") {
+			token = "Bearer " + token
+		}
+		req.Header.Set("Authorization", token)
+
+		var res context.Context
+		h := func(c echo.Context) error {
+			res = c.Request().Context()
+			return nil
+		}
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			h = middlewares[i](h)
+		}
+		if err := h(e.NewContext(req, httptest.NewRecorder())); err != nil {
+			return nil, err
+		}
+
+		// the middlewares do not call the next handler when the token is invalid
+		if res == nil || adapter.Operator(res) == nil {
+			return nil, echo.ErrUnauthorized
+		}
+		return res, nil
+	}
+}
+
 func AuthRequiredMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth/server/internal/adapter"
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticateWebsocket(t *testing.T) {
+	op := &usecase.Operator{}
+	auth := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get("Authorization") != "Bearer token" {
+				return c.NoContent(401)
+			}
+			c.SetRequest(c.Request().WithContext(adapter.AttachOperator(c.Request().Context(), op)))
+			return next(c)
+		}
+	}
+	f := authenticateWebsocket(echo.New(), auth)
+
+	ctx, err := f(context.Background(), "token")
+	require.NoError(t, err)
+	assert.Same(t, op, adapter.Operator(ctx))
+
+	ctx, err = f(context.Background(), "Bearer token")
+	require.NoError(t, err)
+	assert.Same(t, op, adapter.Operator(ctx))
+
+	_, err = f(context.Background(), "invalid")
+	assert.Equal(t, echo.ErrUnauthorized, err)
+}
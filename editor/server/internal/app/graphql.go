@@ -29,7 +29,8 @@ const (
 	maxMemorySize     = 100 * 1024 * 1024       // 100MB
 )
 
-func GraphqlAPI(conf config.GraphQLConfig, dev bool) echo.HandlerFunc {
+// GraphqlAPI serves the GraphQL API. wsAuth authenticates websocket connections of subscriptions with the token in their connection_init payload.
+func GraphqlAPI(conf config.GraphQLConfig, dev bool, wsAuth func(context.Context, string) (context.Context, error)) echo.HandlerFunc {
 
 	schema := gql.NewExecutableSchema(gql.Config{
 		Resolvers: gql.NewResolver(),
@@ -39,6 +40,18 @@ func GraphqlAPI(conf config.GraphQLConfig, dev bool) echo.HandlerFunc {
 
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc: func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			token := payload.Authorization()
+			if token == "" || wsAuth == nil {
+				return ctx, nil, nil
+			}
+			ctx, err := wsAuth(ctx, token)
+			if err != nil {
+				return nil, nil, err
+			}
+			// dataloaders cache loaded objects for the context, which lives as long as the connection, so they are not used for subscriptions
+			return gql.AttachUsecases(ctx, adapter.Usecases(ctx), false), nil, nil
+		},
 	})
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
@@ -14,6 +14,7 @@ import (
 	"github.com/reearth/reearth/server/internal/adapter"
 	http1 "github.com/reearth/reearth/server/internal/adapter/http"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 )
 
@@ -111,6 +112,46 @@ func SignupVerify() echo.HandlerFunc {
 	}
 }
 
+// ExportNLSLayers exports a sketch layer specified by the path, or layers specified by the "layers" query such as "layers=id1,id2".
+// The format is specified by the "format" query: geojson, kml, shapefile or czml.
+func ExportNLSLayers() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var lids []string
+		if lid := c.Param("layerId"); lid != "" {
+			lids = []string{lid}
+		} else {
+			for _, v := range c.QueryParams()["layers"] {
+				for _, lid := range strings.Split(v, ",") {
+					if lid = strings.TrimSpace(lid); lid != "" {
+						lids = append(lids, lid)
+					}
+				}
+			}
+		}
+		if len(lids) == 0 {
+			return echo.ErrBadRequest
+		}
+
+		uc := adapter.Usecases(c.Request().Context())
+		controller := http1.NewNLSLayerController(uc.NLSLayer)
+
+		output, err := controller.Export(c.Request().Context(), http1.ExportNLSLayersInput{
+			LayerIDs: lids,
+			Format:   c.QueryParam("format"),
+		})
+		if err != nil {
+			return err
+		}
+
+		name := "layers"
+		if len(lids) == 1 {
+			name = lids[0]
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+output.Ext))
+		return c.Blob(http.StatusOK, output.ContentType, output.Data)
+	}
+}
+
 func PublishedMetadata() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		name := c.Param("name")
@@ -132,6 +173,55 @@ func PublishedMetadata() echo.HandlerFunc {
 	}
 }
 
+// ExportPublished responds with a zip of the published project that can be opened without the server.
+func ExportPublished() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		name := c.Param("name")
+		if name == "" {
+			return rerror.ErrNotFound
+		}
+
+		contr, err := publishedController(c)
+		if err != nil {
+			return err
+		}
+
+		ctx := c.Request().Context()
+		res := c.Response()
+		w := &lazyHeaderWriter{Response: res, header: func() {
+			res.Header().Set(echo.HeaderContentType, "application/zip")
+			res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".zip"))
+		}}
+
+		skipped, err := contr.Export(ctx, name, w)
+		if err != nil {
+			if res.Committed {
+				// the response cannot be changed to an error any more
+				log.Errorfc(ctx, "published: failed to export %s: %v", name, err)
+			}
+			return err
+		}
+		if len(skipped) > 0 {
+			log.Warnfc(ctx, "published: assets of %s are not exported: %v", name, skipped)
+		}
+		return nil
+	}
+}
+
+// lazyHeaderWriter writes the header of the response on the first write so that errors that occur before it can still be responded.
+type lazyHeaderWriter struct {
+	*echo.Response
+	header func()
+}
+
+func (w *lazyHeaderWriter) Write(b []byte) (int, error) {
+	if !w.Committed {
+		w.header()
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.Response.Write(b)
+}
+
 func PublishedData(pattern string, useParam bool) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		alias := resolveAlias(c, pattern, useParam)
@@ -12,6 +12,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearth/server/internal/adapter"
+	"github.com/reearth/reearth/server/internal/usecase"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/stretchr/testify/assert"
@@ -190,6 +191,57 @@ func TestPublishedIndex(t *testing.T) {
 	}
 }
 
+func TestExportPublished(t *testing.T) {
+	tests := []struct {
+		Name          string
+		PublishedName string
+		Error         error
+	}{
+		{
+			Name:  "empty",
+			Error: rerror.ErrNotFound,
+		},
+		{
+			Name:          "not found",
+			PublishedName: "pr",
+			Error:         rerror.ErrNotFound,
+		},
+		{
+			Name:          "ok",
+			PublishedName: "prj",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			assert := assert.New(t)
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			res := httptest.NewRecorder()
+			e := echo.New()
+			c := e.NewContext(req, res)
+			c.SetParamNames("name")
+			c.SetParamValues(tc.PublishedName)
+			m := mockPublishedUsecaseMiddleware(false)
+			err := m(ExportPublished())(c)
+
+			if tc.Error == nil {
+				assert.NoError(err)
+				assert.Equal(http.StatusOK, res.Code)
+				assert.Equal("application/zip", res.Header().Get(echo.HeaderContentType))
+				assert.Equal(`attachment; filename="prj.zip"`, res.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal("zip", res.Body.String())
+			} else {
+				assert.ErrorIs(err, tc.Error)
+				assert.False(c.Response().Committed)
+				assert.Empty(res.Header().Get(echo.HeaderContentDisposition))
+			}
+		})
+	}
+}
+
 func mockPublishedUsecaseMiddleware(emptyIndex bool) echo.MiddlewareFunc {
 	return ContextMiddleware(func(ctx context.Context) context.Context {
 		return adapter.AttachUsecases(ctx, &interfaces.Container{
@@ -237,6 +289,14 @@ func (p *mockPublished) Index(ctx context.Context, name string, url *url.URL) (s
 	return "", rerror.ErrNotFound
 }
 
+func (p *mockPublished) Export(ctx context.Context, name string, w io.Writer, _ *usecase.Operator) ([]string, error) {
+	if name != "prj" {
+		return nil, rerror.ErrNotFound
+	}
+	_, err := io.WriteString(w, "zip")
+	return nil, err
+}
+
 func TestGetAliasFromHost(t *testing.T) {
 	assert.Equal(t, "", getAliasFromHost("", ".example.com")) // invalid regexp
 	assert.Equal(t, "", getAliasFromHost("", "{}.example.com"))
@@ -5,6 +5,7 @@ import (
 
 	"github.com/reearth/reearth/server/internal/app/config"
 	"github.com/reearth/reearth/server/internal/infrastructure/auth0"
+	"github.com/reearth/reearth/server/internal/infrastructure/broker"
 	"github.com/reearth/reearth/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth/server/internal/infrastructure/gcs"
 	"github.com/reearth/reearth/server/internal/infrastructure/google"
@@ -88,6 +89,10 @@ func initReposAndGateways(ctx context.Context, conf *config.Config, debug bool)
 	// google
 	gateways.Google = google.NewGoogle()
 
+	// collaboration
+	// events are delivered only within this process, so subscriptions require a single instance of the server
+	gateways.Collaboration = broker.NewCollaboration()
+
 	// mailer
 	mailer := mailer.New(ctx, &conf.Config)
 	gateways.Mailer = mailer
@@ -16,6 +16,7 @@ import (
 	"github.com/reearth/reearth/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth/server/internal/usecase/interactor"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/internal/usecase/repo"
 	"github.com/reearth/reearth/server/pkg/project"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/rerror"
@@ -179,7 +180,7 @@ func TestWeb(t *testing.T) {
 
 			e.Use(ContextMiddleware(func(ctx context.Context) context.Context {
 				return adapter.AttachUsecases(ctx, &interfaces.Container{
-					Published: interactor.NewPublished(prjRepo, storyRepo, fileg, publishedHTML),
+					Published: interactor.NewPublished(&repo.Container{Project: prjRepo, Storytelling: storyRepo}, fileg, nil, publishedHTML),
 				})
 			}))
 
@@ -0,0 +1,165 @@
+package broker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+const eventBufferSize = 64
+
+// Collaboration delivers events and presences within the process, so editors connected to other instances of the server do not receive them.
+// Deployments that use subscriptions must therefore run a single instance of the server.
+type Collaboration struct {
+	lock      sync.Mutex
+	events    map[id.SceneID]map[chan collaboration.Event]struct{}
+	watchers  map[id.SceneID]map[chan collaboration.PresenceList]struct{}
+	presences map[id.SceneID]map[accountdomain.UserID]*presence
+}
+
+type presence struct {
+	collaboration.Presence
+	// connections is the number of subscriptions of the user, as a user may open the scene in several tabs.
+	connections int
+}
+
+func NewCollaboration() gateway.Collaboration {
+	return &Collaboration{
+		events:    map[id.SceneID]map[chan collaboration.Event]struct{}{},
+		watchers:  map[id.SceneID]map[chan collaboration.PresenceList]struct{}{},
+		presences: map[id.SceneID]map[accountdomain.UserID]*presence{},
+	}
+}
+
+func (c *Collaboration) Publish(ctx context.Context, e collaboration.Event) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for ch := range c.events[e.Scene] {
+		select {
+		case ch <- e:
+		default:
+			log.Warnfc(ctx, "collaboration: event of scene %s was dropped for a slow subscriber", e.Scene)
+		}
+	}
+	return nil
+}
+
+func (c *Collaboration) Subscribe(ctx context.Context, sid id.SceneID) (<-chan collaboration.Event, error) {
+	ch := make(chan collaboration.Event, eventBufferSize)
+
+	c.lock.Lock()
+	if c.events[sid] == nil {
+		c.events[sid] = map[chan collaboration.Event]struct{}{}
+	}
+	c.events[sid][ch] = struct{}{}
+	c.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		delete(c.events[sid], ch)
+		if len(c.events[sid]) == 0 {
+			delete(c.events, sid)
+		}
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+func (c *Collaboration) Join(ctx context.Context, sid id.SceneID, uid accountdomain.UserID) (<-chan collaboration.PresenceList, error) {
+	// only the latest presences matter, so a watcher keeps only one list that has not been received
+	ch := make(chan collaboration.PresenceList, 1)
+
+	c.lock.Lock()
+	if c.presences[sid] == nil {
+		c.presences[sid] = map[accountdomain.UserID]*presence{}
+	}
+	p := c.presences[sid][uid]
+	if p == nil {
+		p = &presence{Presence: collaboration.Presence{Scene: sid, User: uid, UpdatedAt: util.Now()}}
+		c.presences[sid][uid] = p
+	}
+	p.connections++
+
+	if c.watchers[sid] == nil {
+		c.watchers[sid] = map[chan collaboration.PresenceList]struct{}{}
+	}
+	c.watchers[sid][ch] = struct{}{}
+	c.notify(sid)
+	c.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		delete(c.watchers[sid], ch)
+		if len(c.watchers[sid]) == 0 {
+			delete(c.watchers, sid)
+		}
+		close(ch)
+
+		p.connections--
+		if p.connections == 0 {
+			delete(c.presences[sid], uid)
+			if len(c.presences[sid]) == 0 {
+				delete(c.presences, sid)
+			}
+		}
+		c.notify(sid)
+	}()
+
+	return ch, nil
+}
+
+func (c *Collaboration) UpdatePresence(_ context.Context, sid id.SceneID, uid accountdomain.UserID, layer *id.NLSLayerID) (collaboration.PresenceList, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	p := c.presences[sid][uid]
+	if p == nil {
+		return nil, rerror.ErrNotFound
+	}
+	p.Layer = layer.CloneRef()
+	p.UpdatedAt = util.Now()
+	c.notify(sid)
+
+	return c.list(sid), nil
+}
+
+// notify sends the presences to watchers of the scene, replacing lists they have not received yet. It must be called with the lock held.
+func (c *Collaboration) notify(sid id.SceneID) {
+	l := c.list(sid)
+	for ch := range c.watchers[sid] {
+		select {
+		case ch <- l:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- l
+		}
+	}
+}
+
+func (c *Collaboration) list(sid id.SceneID) collaboration.PresenceList {
+	res := make(collaboration.PresenceList, 0, len(c.presences[sid]))
+	for _, p := range c.presences[sid] {
+		p2 := p.Presence
+		p2.Layer = p.Layer.CloneRef()
+		res = append(res, &p2)
+	}
+	return res.Sorted()
+}
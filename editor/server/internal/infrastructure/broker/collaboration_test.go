@@ -0,0 +1,106 @@
+package broker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollaboration_Events(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewCollaboration()
+	sid, sid2 := id.NewSceneID(), id.NewSceneID()
+
+	ch, err := c.Subscribe(ctx, sid)
+	require.NoError(t, err)
+
+	e := collaboration.Event{Scene: sid, Version: 1, Revision: id.NewRevisionID(), Action: "addStyle"}
+	assert.NoError(t, c.Publish(context.Background(), collaboration.Event{Scene: sid2, Version: 1}))
+	assert.NoError(t, c.Publish(context.Background(), e))
+	assert.Equal(t, e, receive(t, ch))
+
+	// slow subscribers do not block publishers
+	for i := 0; i < eventBufferSize+1; i++ {
+		assert.NoError(t, c.Publish(context.Background(), e))
+	}
+
+	cancel()
+	for range ch {
+	}
+}
+
+func TestCollaboration_Presences(t *testing.T) {
+	sid := id.NewSceneID()
+	uid, uid2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	lid := id.NewNLSLayerID()
+	c := NewCollaboration()
+
+	_, err := c.UpdatePresence(context.Background(), sid, uid, &lid)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := c.Join(ctx, sid, uid)
+	require.NoError(t, err)
+	assert.Equal(t, []accountdomain.UserID{uid}, users(receive(t, ch)))
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	ch2, err := c.Join(ctx2, sid, uid2)
+	require.NoError(t, err)
+	l := receive(t, ch2)
+	assert.Len(t, l, 2)
+
+	l, err = c.UpdatePresence(context.Background(), sid, uid2, &lid)
+	require.NoError(t, err)
+	assert.Equal(t, l, receive(t, ch))
+	for _, p := range l {
+		if p.User == uid2 {
+			assert.Equal(t, &lid, p.Layer)
+		} else {
+			assert.Nil(t, p.Layer)
+		}
+	}
+
+	// the user leaves the scene when the subscription ends
+	cancel2()
+	assert.Eventually(t, func() bool {
+		select {
+		case l := <-ch:
+			return len(l) == 1 && l[0].User == uid
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+	_, err = c.UpdatePresence(context.Background(), sid, uid2, nil)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	cancel()
+	for range ch {
+	}
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	var zero T
+	return zero
+}
+
+func users(l collaboration.PresenceList) []accountdomain.UserID {
+	res := make([]accountdomain.UserID, 0, len(l))
+	for _, p := range l {
+		res = append(res, p.User)
+	}
+	return res
+}
@@ -13,6 +13,7 @@ func New() *repo.Container {
 		Config:         NewConfig(),
 		NLSLayer:       NewNLSLayer(),
 		Style:          NewStyle(),
+		StylePreset:    NewStylePreset(),
 		Plugin:         NewPlugin(),
 		Project:        NewProject(),
 		PropertySchema: NewPropertySchema(),
@@ -24,6 +25,7 @@ func New() *repo.Container {
 		AuthRequest:    authserver.NewMemory(),
 		Policy:         NewPolicy(),
 		Storytelling:   NewStorytelling(),
+		Revision:       NewRevision(),
 		Lock:           NewLock(),
 		Transaction:    &usecasex.NopTransaction{},
 	}
@@ -0,0 +1,154 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+type Revision struct {
+	lock sync.Mutex
+	data map[id.RevisionID]*revision.Revision
+	f    repo.SceneFilter
+}
+
+func NewRevision() repo.Revision {
+	return &Revision{
+		data: map[id.RevisionID]*revision.Revision{},
+	}
+}
+
+func (r *Revision) Filtered(f repo.SceneFilter) repo.Revision {
+	return &Revision{
+		// note data is shared between the source repo and mutex cannot work well
+		data: r.data,
+		f:    r.f.Merge(f),
+	}
+}
+
+func (r *Revision) FindByID(_ context.Context, rid id.RevisionID) (*revision.Revision, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if d, ok := r.data[rid]; ok && r.f.CanRead(d.Scene()) {
+		return d, nil
+	}
+	return nil, rerror.ErrNotFound
+}
+
+func (r *Revision) FindByScene(_ context.Context, sid id.SceneID, pagination *usecasex.Pagination) (revision.List, *usecasex.PageInfo, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.f.CanRead(sid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	result := r.findByScene(sid)
+	total := int64(len(result))
+
+	// only first and after are supported
+	hasNext := false
+	if pagination != nil && pagination.Cursor != nil {
+		if after := pagination.Cursor.After; after != nil {
+			if i := slices.IndexFunc(result, func(d *revision.Revision) bool { return d.ID().String() == string(*after) }); i >= 0 {
+				result = result[i+1:]
+			}
+		}
+		if first := pagination.Cursor.First; first != nil && int64(len(result)) > *first {
+			result = result[:*first]
+			hasNext = true
+		}
+	}
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+	return result, usecasex.NewPageInfo(total, startCursor, endCursor, hasNext, false), nil
+}
+
+func (r *Revision) FindLatestByScene(_ context.Context, sid id.SceneID) (*revision.Revision, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var res *revision.Revision
+	if r.f.CanRead(sid) {
+		for _, d := range r.data {
+			if d.Scene() == sid && (res == nil || d.ID().Compare(res.ID()) > 0) {
+				res = d
+			}
+		}
+	}
+	if res == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return res, nil
+}
+
+func (r *Revision) Save(_ context.Context, rev *revision.Revision) error {
+	if !r.f.CanWrite(rev.Scene()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.data[rev.ID()] = rev
+	return nil
+}
+
+func (r *Revision) RemoveOldByScene(_ context.Context, sid id.SceneID, keep int) error {
+	if !r.f.CanWrite(sid) {
+		return repo.ErrOperationDenied
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if revs := r.findByScene(sid); len(revs) > keep {
+		for _, d := range revs[keep:] {
+			delete(r.data, d.ID())
+		}
+	}
+	return nil
+}
+
+func (r *Revision) RemoveByScene(_ context.Context, sid id.SceneID) error {
+	if !r.f.CanWrite(sid) {
+		return repo.ErrOperationDenied
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for k, d := range r.data {
+		if d.Scene() == sid {
+			delete(r.data, k)
+		}
+	}
+	return nil
+}
+
+// findByScene returns revisions of the scene from the newest one.
+func (r *Revision) findByScene(sid id.SceneID) revision.List {
+	result := revision.List{}
+	for _, d := range r.data {
+		if d.Scene() == sid {
+			result = append(result, d)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID().Compare(result[j].ID()) > 0
+	})
+	return result
+}
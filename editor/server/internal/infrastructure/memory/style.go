@@ -95,6 +95,20 @@ func (r *Style) FindByScene(ctx context.Context, sceneID id.SceneID) (*scene.Sty
 	return &res, nil
 }
 
+func (r *Style) FindByPreset(ctx context.Context, pid id.StylePresetID) (*scene.StyleList, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	res := scene.StyleList{}
+	for _, l := range r.data {
+		l := l
+		if p := l.Preset(); p != nil && *p == pid && r.f.CanRead(l.Scene()) {
+			res = append(res, &l)
+		}
+	}
+	return &res, nil
+}
+
 func (r *Style) SaveAll(ctx context.Context, ll scene.StyleList) error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
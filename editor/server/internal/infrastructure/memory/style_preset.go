@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type StylePreset struct {
+	data *util.SyncMap[id.StylePresetID, *scene.StylePreset]
+	f    repo.WorkspaceFilter
+}
+
+func NewStylePreset() *StylePreset {
+	return &StylePreset{
+		data: util.SyncMapFrom[id.StylePresetID, *scene.StylePreset](nil),
+	}
+}
+
+func (r *StylePreset) Filtered(f repo.WorkspaceFilter) repo.StylePreset {
+	return &StylePreset{
+		data: r.data,
+		f:    r.f.Merge(f),
+	}
+}
+
+func (r *StylePreset) FindByID(_ context.Context, id id.StylePresetID) (*scene.StylePreset, error) {
+	d, ok := r.data.Load(id)
+	if ok && r.f.CanRead(d.Workspace()) {
+		return d, nil
+	}
+	return nil, rerror.ErrNotFound
+}
+
+func (r *StylePreset) FindByWorkspace(_ context.Context, wid accountdomain.WorkspaceID) (scene.StylePresetList, error) {
+	if !r.f.CanRead(wid) {
+		return nil, nil
+	}
+
+	res := r.data.FindAll(func(_ id.StylePresetID, v *scene.StylePreset) bool {
+		return v.Workspace() == wid
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name() < res[j].Name()
+	})
+	return res, nil
+}
+
+func (r *StylePreset) Save(_ context.Context, p *scene.StylePreset) error {
+	if !r.f.CanWrite(p.Workspace()) {
+		return repo.ErrOperationDenied
+	}
+	r.data.Store(p.ID(), p)
+	return nil
+}
+
+func (r *StylePreset) Remove(_ context.Context, id id.StylePresetID) error {
+	p, _ := r.data.Load(id)
+	if p == nil {
+		return nil
+	}
+
+	if !r.f.CanWrite(p.Workspace()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Delete(id)
+	return nil
+}
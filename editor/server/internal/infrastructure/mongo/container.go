@@ -39,6 +39,7 @@ func New(ctx context.Context, db *mongo.Database, account *accountrepo.Container
 		Config:         NewConfig(db.Collection("config"), lock),
 		NLSLayer:       NewNLSLayer(client),
 		Style:          NewStyle(client),
+		StylePreset:    NewStylePreset(client),
 		Plugin:         NewPlugin(client),
 		Project:        NewProject(client),
 		PropertySchema: NewPropertySchema(client),
@@ -47,6 +48,7 @@ func New(ctx context.Context, db *mongo.Database, account *accountrepo.Container
 		SceneLock:      NewSceneLock(client),
 		Policy:         NewPolicy(client),
 		Storytelling:   NewStorytelling(client),
+		Revision:       NewRevision(client),
 		Lock:           lock,
 		Transaction:    client.Transaction(),
 		Workspace:      account.Workspace,
@@ -114,6 +116,8 @@ func Init(r *repo.Container) error {
 		func() error { return r.Property.(*Property).Init(ctx) },
 		func() error { return r.PropertySchema.(*PropertySchema).Init(ctx) },
 		func() error { return r.Scene.(*Scene).Init(ctx) },
+		func() error { return r.Revision.(*Revision).Init(ctx) },
+		func() error { return r.StylePreset.(*StylePreset).Init(ctx) },
 		func() error { return r.User.(*accountmongo.User).Init() },
 		func() error { return r.Workspace.(*accountmongo.Workspace).Init() },
 	)
@@ -0,0 +1,229 @@
+package mongodoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/property"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/storytelling"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/exp/slices"
+)
+
+// RevisionDocument refers to objects of the snapshot by their hashes so that it stays small
+// and objects that are not changed between revisions are stored only once.
+type RevisionDocument struct {
+	ID      string
+	Scene   string
+	Action  string
+	User    *string
+	Objects *RevisionObjectsDocument
+}
+
+type RevisionObjectsDocument struct {
+	Scene      string
+	NLSLayers  []string
+	Styles     []string
+	Stories    []string
+	Properties []string
+}
+
+// RevisionObjectDocument is an object of snapshots in the same form as its own collection. ID is the hash of the data.
+type RevisionObjectDocument struct {
+	ID    string
+	Scene string
+	Data  bson.Raw
+}
+
+type RevisionConsumer = Consumer[*RevisionDocument, *revision.Revision]
+
+func NewRevisionConsumer(scenes []id.SceneID) *RevisionConsumer {
+	return NewConsumer[*RevisionDocument, *revision.Revision](func(a *revision.Revision) bool {
+		return scenes == nil || slices.Contains(scenes, a.Scene())
+	})
+}
+
+// NewRevision returns the document of the revision and the documents of objects of its snapshot.
+func NewRevision(r *revision.Revision) (*RevisionDocument, []*RevisionObjectDocument, error) {
+	s := r.Snapshot()
+	b := revisionObjectBuilder{scene: r.Scene().String()}
+
+	sceneDoc, _ := NewScene(s.Scene())
+	objects := &RevisionObjectsDocument{
+		Scene: b.add(sceneDoc),
+	}
+	for _, l := range s.NLSLayers() {
+		d, _ := NewNLSLayer(*l)
+		objects.NLSLayers = append(objects.NLSLayers, b.add(d))
+	}
+	for _, st := range s.Styles() {
+		d, _ := NewStyle(*st)
+		objects.Styles = append(objects.Styles, b.add(d))
+	}
+	for _, st := range s.Stories() {
+		d, _ := NewStorytelling(st)
+		objects.Stories = append(objects.Stories, b.add(d))
+	}
+	for _, p := range s.Properties() {
+		d, _ := NewProperty(p)
+		objects.Properties = append(objects.Properties, b.add(d))
+	}
+	if b.err != nil {
+		return nil, nil, b.err
+	}
+
+	return &RevisionDocument{
+		ID:      r.ID().String(),
+		Scene:   r.Scene().String(),
+		Action:  r.Action(),
+		User:    r.User().StringRef(),
+		Objects: objects,
+	}, b.docs, nil
+}
+
+type revisionObjectBuilder struct {
+	scene string
+	docs  []*RevisionObjectDocument
+	err   error
+}
+
+func (b *revisionObjectBuilder) add(doc any) string {
+	if b.err != nil {
+		return ""
+	}
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		b.err = err
+		return ""
+	}
+	// the scene is included as objects are removed with revisions of the scene
+	h := sha256.New()
+	_, _ = h.Write([]byte(b.scene))
+	_, _ = h.Write(data)
+	hash := hex.EncodeToString(h.Sum(nil))
+	b.docs = append(b.docs, &RevisionObjectDocument{ID: hash, Scene: b.scene, Data: data})
+	return hash
+}
+
+// ObjectIDs returns hashes of all objects of the snapshot.
+func (d *RevisionDocument) ObjectIDs() []string {
+	if d.Objects == nil {
+		return nil
+	}
+	res := append([]string{d.Objects.Scene}, d.Objects.NLSLayers...)
+	res = append(res, d.Objects.Styles...)
+	res = append(res, d.Objects.Stories...)
+	return append(res, d.Objects.Properties...)
+}
+
+// Model returns the revision without its snapshot.
+func (d *RevisionDocument) Model() (*revision.Revision, error) {
+	return d.model(nil)
+}
+
+// ModelWithSnapshot returns the revision with the snapshot made of the objects. objects must contain all objects of ObjectIDs.
+func (d *RevisionDocument) ModelWithSnapshot(objects map[string]bson.Raw) (*revision.Revision, error) {
+	if d.Objects == nil {
+		return d.model(nil)
+	}
+
+	var sd SceneDocument
+	if err := unmarshalRevisionObject(objects, d.Objects.Scene, &sd); err != nil {
+		return nil, err
+	}
+	s, err := sd.Model()
+	if err != nil {
+		return nil, err
+	}
+
+	layers := make(nlslayer.NLSLayerList, 0, len(d.Objects.NLSLayers))
+	for _, h := range d.Objects.NLSLayers {
+		var ld NLSLayerDocument
+		if err := unmarshalRevisionObject(objects, h, &ld); err != nil {
+			return nil, err
+		}
+		l, err := ld.Model()
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, &l)
+	}
+
+	styles := make(scene.StyleList, 0, len(d.Objects.Styles))
+	for _, h := range d.Objects.Styles {
+		var sd StyleDocument
+		if err := unmarshalRevisionObject(objects, h, &sd); err != nil {
+			return nil, err
+		}
+		st, err := sd.Model()
+		if err != nil {
+			return nil, err
+		}
+		styles = append(styles, st)
+	}
+
+	stories := make(storytelling.StoryList, 0, len(d.Objects.Stories))
+	for _, h := range d.Objects.Stories {
+		var sd StorytellingDocument
+		if err := unmarshalRevisionObject(objects, h, &sd); err != nil {
+			return nil, err
+		}
+		st, err := sd.Model()
+		if err != nil {
+			return nil, err
+		}
+		stories = append(stories, st)
+	}
+
+	properties := make(property.List, 0, len(d.Objects.Properties))
+	for _, h := range d.Objects.Properties {
+		var pd PropertyDocument
+		if err := unmarshalRevisionObject(objects, h, &pd); err != nil {
+			return nil, err
+		}
+		p, err := pd.Model()
+		if err != nil {
+			return nil, err
+		}
+		properties = append(properties, p)
+	}
+
+	return d.model(revision.NewSnapshot(s, layers, styles, stories, properties))
+}
+
+func (d *RevisionDocument) model(s *revision.Snapshot) (*revision.Revision, error) {
+	rid, err := id.RevisionIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	sid, err := id.SceneIDFrom(d.Scene)
+	if err != nil {
+		return nil, err
+	}
+	var uid *accountdomain.UserID
+	if d.User != nil {
+		uid = accountdomain.UserIDFromRef(d.User)
+	}
+
+	return revision.New().
+		ID(rid).
+		Scene(sid).
+		Action(d.Action).
+		User(uid).
+		Snapshot(s).
+		Build()
+}
+
+func unmarshalRevisionObject(objects map[string]bson.Raw, hash string, v any) error {
+	data, ok := objects[hash]
+	if !ok {
+		return rerror.ErrNotFound
+	}
+	return bson.Unmarshal(data, v)
+}
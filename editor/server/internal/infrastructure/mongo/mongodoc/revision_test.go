@@ -0,0 +1,58 @@
+package mongodoc
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestNewRevision(t *testing.T) {
+	s := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Property(id.NewPropertyID()).MustBuild()
+	a := scene.NewStyle().NewID().Scene(s.ID()).Name("a").Value(&scene.StyleValue{"color": "red"}).MustBuild()
+	b := scene.NewStyle().NewID().Scene(s.ID()).Name("b").Value(&scene.StyleValue{"color": "blue"}).MustBuild()
+	uid := accountdomain.NewUserID()
+
+	r1 := revision.New().NewID().Scene(s.ID()).Action("addStyle").User(&uid).
+		Snapshot(revision.NewSnapshot(s, nil, scene.StyleList{a, b}, nil, nil)).MustBuild()
+	doc1, objects1, err := NewRevision(r1)
+	require.NoError(t, err)
+	assert.Len(t, objects1, 3)
+	assert.Equal(t, len(objects1), len(doc1.ObjectIDs()))
+
+	b.Rename("c")
+	r2 := revision.New().NewID().Scene(s.ID()).Action("updateStyle").
+		Snapshot(revision.NewSnapshot(s, nil, scene.StyleList{a, b}, nil, nil)).MustBuild()
+	doc2, _, err := NewRevision(r2)
+	require.NoError(t, err)
+
+	// objects not changed have the same IDs
+	assert.Equal(t, doc1.Objects.Scene, doc2.Objects.Scene)
+	assert.Len(t, lo.Intersect(doc1.Objects.Styles, doc2.Objects.Styles), 1)
+
+	objects := map[string]bson.Raw{}
+	for _, o := range objects1 {
+		objects[o.ID] = o.Data
+	}
+	got, err := doc1.ModelWithSnapshot(objects)
+	require.NoError(t, err)
+	assert.Equal(t, r1.ID(), got.ID())
+	assert.Equal(t, "addStyle", got.Action())
+	assert.Equal(t, &uid, got.User())
+	assert.Equal(t, s.ID(), got.Snapshot().Scene().ID())
+	assert.ElementsMatch(t, []string{"a", "b"}, []string{got.Snapshot().Styles()[0].Name(), got.Snapshot().Styles()[1].Name()})
+
+	// a snapshot cannot be made without its objects
+	_, err = doc2.ModelWithSnapshot(objects)
+	assert.Error(t, err)
+
+	got, err = (&RevisionDocument{ID: doc1.ID, Scene: doc1.Scene, Action: doc1.Action}).Model()
+	require.NoError(t, err)
+	assert.Nil(t, got.Snapshot())
+}
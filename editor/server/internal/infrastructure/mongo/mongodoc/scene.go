@@ -21,6 +21,7 @@ type SceneDocument struct {
 	Plugins     []ScenePluginDocument
 	UpdateAt    time.Time
 	Property    string
+	Version     int
 }
 
 type SceneWidgetDocument struct {
@@ -105,6 +106,7 @@ func NewScene(scene *scene.Scene) (*SceneDocument, string) {
 		AlignSystem: NewWidgetAlignSystem(scene.Widgets().Alignment()),
 		UpdateAt:    scene.UpdatedAt(),
 		Property:    scene.Property().String(),
+		Version:     scene.Version(),
 	}, id
 }
 
@@ -172,6 +174,7 @@ func (d *SceneDocument) Model() (*scene.Scene, error) {
 		Plugins(scene.NewPlugins(ps)).
 		UpdatedAt(d.UpdateAt).
 		Property(prid).
+		Version(d.Version).
 		Build()
 }
 
@@ -73,7 +73,7 @@ func NewStorytelling(s *storytelling.Story) (*StorytellingDocument, string) {
 		Status:        string(s.Status()),
 		PublishedAt:   s.PublishedAt(),
 		UpdatedAt:     s.UpdatedAt(),
-		Index:         1,
+		Index:         s.Index(),
 		PanelPosition: string(s.PanelPosition()),
 		BgColor:       s.BgColor(),
 
@@ -182,6 +182,7 @@ func (d *StorytellingDocument) Model() (*storytelling.Story, error) {
 		Property(property).
 		Scene(scene).
 		Title(d.Title).
+		Index(d.Index).
 		Alias(d.Alias).
 		Status(storytelling.PublishmentStatus(d.Status)).
 		PanelPosition(storytelling.Position(d.PanelPosition)).
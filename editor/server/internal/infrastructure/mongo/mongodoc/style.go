@@ -7,10 +7,11 @@ import (
 )
 
 type StyleDocument struct {
-	ID    string
-	Name  string
-	Value map[string]any
-	Scene string
+	ID     string
+	Name   string
+	Value  map[string]any
+	Scene  string
+	Preset *string
 }
 
 type StyleConsumer = Consumer[*StyleDocument, *scene.Style]
@@ -24,10 +25,11 @@ func NewStyleConsumer(scenes []id.SceneID) *StyleConsumer {
 func NewStyle(s scene.Style) (*StyleDocument, string) {
 	id := s.ID().String()
 	return &StyleDocument{
-		ID:    id,
-		Name:  s.Name(),
-		Value: *s.Value(),
-		Scene: s.Scene().String(),
+		ID:     id,
+		Name:   s.Name(),
+		Value:  *s.Value(),
+		Scene:  s.Scene().String(),
+		Preset: s.Preset().StringRef(),
 	}, id
 }
 
@@ -69,6 +71,7 @@ func (d *StyleDocument) Model() (*scene.Style, error) {
 		Value(NewStyleValue(d.Value)).
 		Name(d.Name).
 		Scene(scid).
+		Preset(id.StylePresetIDFromRef(d.Preset)).
 		Build()
 }
 
@@ -0,0 +1,59 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"golang.org/x/exp/slices"
+)
+
+type StylePresetDocument struct {
+	ID          string
+	Team        string // named as other documents so that the workspace filter can be applied
+	Name        string
+	Description string
+	Value       map[string]any
+	UpdatedAt   time.Time
+}
+
+type StylePresetConsumer = Consumer[*StylePresetDocument, *scene.StylePreset]
+
+func NewStylePresetConsumer(workspaces []accountdomain.WorkspaceID) *StylePresetConsumer {
+	return NewConsumer[*StylePresetDocument, *scene.StylePreset](func(a *scene.StylePreset) bool {
+		return workspaces == nil || slices.Contains(workspaces, a.Workspace())
+	})
+}
+
+func NewStylePreset(p *scene.StylePreset) (*StylePresetDocument, string) {
+	pid := p.ID().String()
+	return &StylePresetDocument{
+		ID:          pid,
+		Team:        p.Workspace().String(),
+		Name:        p.Name(),
+		Description: p.Description(),
+		Value:       *p.Value(),
+		UpdatedAt:   p.UpdatedAt(),
+	}, pid
+}
+
+func (d *StylePresetDocument) Model() (*scene.StylePreset, error) {
+	pid, err := id.StylePresetIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	return scene.NewStylePreset().
+		ID(pid).
+		Workspace(wid).
+		Name(d.Name).
+		Description(d.Description).
+		Value(NewStyleValue(d.Value)).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
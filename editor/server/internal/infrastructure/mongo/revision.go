@@ -0,0 +1,177 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/reearth/reearth/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+var (
+	revisionIndexes             = []string{"scene", "scene,id"}
+	revisionUniqueIndexes       = []string{"id"}
+	revisionObjectIndexes       = []string{"scene"}
+	revisionObjectUniqueIndexes = []string{"id"}
+
+	// fields of revisions without their snapshots
+	revisionListProjection = bson.M{"id": 1, "scene": 1, "action": 1, "user": 1}
+)
+
+// Revision stores objects of snapshots in the revisionObject collection separately from revisions.
+type Revision struct {
+	client  *mongox.ClientCollection
+	objects *mongox.ClientCollection
+	f       repo.SceneFilter
+}
+
+func NewRevision(client *mongox.Client) *Revision {
+	return &Revision{
+		client:  client.WithCollection("revision"),
+		objects: client.WithCollection("revisionObject"),
+	}
+}
+
+func (r *Revision) Init(ctx context.Context) error {
+	if err := createIndexes(ctx, r.client, revisionIndexes, revisionUniqueIndexes); err != nil {
+		return err
+	}
+	return createIndexes(ctx, r.objects, revisionObjectIndexes, revisionObjectUniqueIndexes)
+}
+
+func (r *Revision) Filtered(f repo.SceneFilter) repo.Revision {
+	return &Revision{
+		client:  r.client,
+		objects: r.objects,
+		f:       r.f.Merge(f),
+	}
+}
+
+func (r *Revision) FindByID(ctx context.Context, id id.RevisionID) (*revision.Revision, error) {
+	return r.findOne(ctx, bson.M{"id": id.String()})
+}
+
+func (r *Revision) FindByScene(ctx context.Context, id id.SceneID, pagination *usecasex.Pagination) (revision.List, *usecasex.PageInfo, error) {
+	if !r.f.CanRead(id) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	// IDs are sorted by their timestamps
+	c := mongodoc.NewRevisionConsumer(r.f.Readable)
+	pageInfo, err := r.client.Paginate(ctx, bson.M{"scene": id.String()}, &usecasex.Sort{Key: "id", Reverted: true}, pagination, c, options.Find().SetProjection(revisionListProjection))
+	if err != nil {
+		return nil, nil, rerror.ErrInternalByWithContext(ctx, err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Revision) FindLatestByScene(ctx context.Context, id id.SceneID) (*revision.Revision, error) {
+	if !r.f.CanRead(id) {
+		return nil, rerror.ErrNotFound
+	}
+	return r.findOne(ctx, bson.M{"scene": id.String()}, options.FindOne().SetSort(bson.D{{Key: "id", Value: -1}}))
+}
+
+func (r *Revision) Save(ctx context.Context, rev *revision.Revision) error {
+	if !r.f.CanWrite(rev.Scene()) {
+		return repo.ErrOperationDenied
+	}
+
+	doc, objects, err := mongodoc.NewRevision(rev)
+	if err != nil {
+		return rerror.ErrInternalByWithContext(ctx, err)
+	}
+
+	// objects are upserted even if they are stored by the previous revisions, as RemoveOldByScene running concurrently may remove them
+	// before this revision is committed. Objects are identified by their contents, so they are still stored once.
+	objects = lo.UniqBy(objects, func(o *mongodoc.RevisionObjectDocument) string { return o.ID })
+	if len(objects) > 0 {
+		ids := lo.Map(objects, func(o *mongodoc.RevisionObjectDocument, _ int) string { return o.ID })
+		docs := lo.Map(objects, func(o *mongodoc.RevisionObjectDocument, _ int) any { return o })
+		if err := r.objects.SaveAll(ctx, ids, docs); err != nil {
+			return err
+		}
+	}
+
+	return r.client.SaveOne(ctx, doc.ID, doc)
+}
+
+func (r *Revision) RemoveOldByScene(ctx context.Context, sid id.SceneID, keep int) error {
+	if !r.f.CanWrite(sid) {
+		return repo.ErrOperationDenied
+	}
+
+	old := mongox.SliceConsumer[mongodoc.RevisionDocument]{}
+	if err := r.client.Find(ctx, bson.M{"scene": sid.String()}, &old, options.Find().
+		SetSort(bson.D{{Key: "id", Value: -1}}).
+		SetSkip(int64(keep)).
+		SetProjection(bson.M{"id": 1}),
+	); err != nil {
+		return err
+	}
+	if len(old.Result) == 0 {
+		return nil
+	}
+
+	ids := lo.Map(old.Result, func(d mongodoc.RevisionDocument, _ int) string { return d.ID })
+	if err := r.client.RemoveAll(ctx, bson.M{"id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+
+	// remove objects that are no longer referred by the kept revisions
+	kept := mongox.SliceConsumer[mongodoc.RevisionDocument]{}
+	if err := r.client.Find(ctx, bson.M{"scene": sid.String()}, &kept, options.Find().SetProjection(bson.M{"objects": 1})); err != nil {
+		return err
+	}
+	used := lo.Uniq(lo.FlatMap(kept.Result, func(d mongodoc.RevisionDocument, _ int) []string { return d.ObjectIDs() }))
+	return r.objects.RemoveAll(ctx, bson.M{"scene": sid.String(), "id": bson.M{"$nin": used}})
+}
+
+func (r *Revision) RemoveByScene(ctx context.Context, id id.SceneID) error {
+	if !r.f.CanWrite(id) {
+		return repo.ErrOperationDenied
+	}
+	if err := r.client.RemoveAll(ctx, bson.M{"scene": id.String()}); err != nil {
+		return err
+	}
+	return r.objects.RemoveAll(ctx, bson.M{"scene": id.String()})
+}
+
+func (r *Revision) findOne(ctx context.Context, filter any, opts ...*options.FindOneOptions) (*revision.Revision, error) {
+	c := mongox.SliceConsumer[mongodoc.RevisionDocument]{}
+	if err := r.client.FindOne(ctx, filter, &c, opts...); err != nil {
+		return nil, err
+	}
+	doc := c.Result[0]
+
+	sid, err := id.SceneIDFrom(doc.Scene)
+	if err != nil {
+		return nil, rerror.ErrInternalByWithContext(ctx, err)
+	}
+	if !r.f.CanRead(sid) {
+		return nil, rerror.ErrNotFound
+	}
+
+	oc := mongox.SliceConsumer[mongodoc.RevisionObjectDocument]{}
+	if err := r.objects.Find(ctx, bson.M{"id": bson.M{"$in": doc.ObjectIDs()}}, &oc); err != nil {
+		return nil, err
+	}
+	objects := make(map[string]bson.Raw, len(oc.Result))
+	for _, o := range oc.Result {
+		objects[o.ID] = o.Data
+	}
+
+	rev, err := doc.ModelWithSnapshot(objects)
+	if err != nil {
+		return nil, rerror.ErrInternalByWithContext(ctx, err)
+	}
+	return rev, nil
+}
@@ -0,0 +1,71 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestRevision(t *testing.T) {
+	c := mongotest.Connect(t)(t)
+	ctx := context.Background()
+	r := NewRevision(mongox.NewClientWithDatabase(c))
+	require.NoError(t, r.Init(ctx))
+
+	s := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Property(id.NewPropertyID()).MustBuild()
+	a := scene.NewStyle().NewID().Scene(s.ID()).Name("a").Value(&scene.StyleValue{}).MustBuild()
+	b := scene.NewStyle().NewID().Scene(s.ID()).Name("b").Value(&scene.StyleValue{}).MustBuild()
+
+	r1 := revision.New().NewID().Scene(s.ID()).Action("addStyle").Snapshot(revision.NewSnapshot(s, nil, scene.StyleList{a, b}, nil, nil)).MustBuild()
+	require.NoError(t, r.Save(ctx, r1))
+	b.Rename("c")
+	r2 := revision.New().NewID().Scene(s.ID()).Action("updateStyle").Snapshot(revision.NewSnapshot(s, nil, scene.StyleList{a, b}, nil, nil)).MustBuild()
+	require.NoError(t, r.Save(ctx, r2))
+
+	// objects not changed are stored once
+	count, err := c.Collection("revisionObject").CountDocuments(ctx, bson.M{"scene": s.ID().String()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	got, err := r.FindByID(ctx, r1.ID())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b"}, lo.Map(got.Snapshot().Styles(), func(s *scene.Style, _ int) string { return s.Name() }))
+
+	list, pi, err := r.FindByScene(ctx, s.ID(), usecasex.CursorPagination{First: lo.ToPtr(int64(1))}.Wrap())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"updateStyle"}, lo.Map(list, func(r *revision.Revision, _ int) string { return r.Action() }))
+	assert.Nil(t, list[0].Snapshot())
+	assert.Equal(t, int64(2), pi.TotalCount)
+	assert.True(t, pi.HasNextPage)
+
+	// objects removed by RemoveOldByScene running concurrently are stored again
+	_, err = c.Collection("revisionObject").DeleteMany(ctx, bson.M{"scene": s.ID().String()})
+	require.NoError(t, err)
+	r3 := revision.New().NewID().Scene(s.ID()).Action("updateStyle").Snapshot(revision.NewSnapshot(s, nil, scene.StyleList{a, b}, nil, nil)).MustBuild()
+	require.NoError(t, r.Save(ctx, r3))
+	got, err = r.FindByID(ctx, r3.ID())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "c"}, lo.Map(got.Snapshot().Styles(), func(s *scene.Style, _ int) string { return s.Name() }))
+
+	require.NoError(t, r.RemoveOldByScene(ctx, s.ID(), 1))
+	_, err = r.FindByID(ctx, r2.ID())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	got, err = r.FindLatestByScene(ctx, s.ID())
+	require.NoError(t, err)
+	assert.Equal(t, r3.ID(), got.ID())
+	count, err = c.Collection("revisionObject").CountDocuments(ctx, bson.M{"scene": s.ID().String()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+}
@@ -67,9 +67,14 @@ func (r *Storytelling) FindByScene(ctx context.Context, id id.SceneID) (*storyte
 	if !r.f.CanRead(id) {
 		return nil, nil
 	}
-	return r.find(ctx, bson.M{
+	res, err := r.find(ctx, bson.M{
 		"scene": id.String(),
 	})
+	if err != nil {
+		return nil, err
+	}
+	sorted := res.Sorted()
+	return &sorted, nil
 }
 
 func (r *Storytelling) FindByPublicName(ctx context.Context, name string) (*storytelling.Story, error) {
@@ -13,7 +13,7 @@ import (
 )
 
 var (
-	styleIndexes       = []string{"scene", "id,scene", "scene,infobox.fields"}
+	styleIndexes       = []string{"scene", "id,scene", "scene,infobox.fields", "preset"}
 	styleUniqueIndexes = []string{"id"}
 )
 
@@ -71,6 +71,12 @@ func (r *Style) FindByScene(ctx context.Context, id id.SceneID) (*scene.StyleLis
 	})
 }
 
+func (r *Style) FindByPreset(ctx context.Context, id id.StylePresetID) (*scene.StyleList, error) {
+	return r.find(ctx, bson.M{
+		"preset": id.String(),
+	})
+}
+
 func (r *Style) Save(ctx context.Context, style scene.Style) error {
 	if !r.f.CanWrite(style.Scene()) {
 		return repo.ErrOperationDenied
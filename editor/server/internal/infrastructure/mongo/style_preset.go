@@ -0,0 +1,74 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/reearth/reearth/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+var (
+	stylePresetIndexes       = []string{"team"}
+	stylePresetUniqueIndexes = []string{"id"}
+)
+
+type StylePreset struct {
+	client *mongox.ClientCollection
+	f      repo.WorkspaceFilter
+}
+
+func NewStylePreset(client *mongox.Client) *StylePreset {
+	return &StylePreset{
+		client: client.WithCollection("stylePreset"),
+	}
+}
+
+func (r *StylePreset) Init(ctx context.Context) error {
+	return createIndexes(ctx, r.client, stylePresetIndexes, stylePresetUniqueIndexes)
+}
+
+func (r *StylePreset) Filtered(f repo.WorkspaceFilter) repo.StylePreset {
+	return &StylePreset{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *StylePreset) FindByID(ctx context.Context, id id.StylePresetID) (*scene.StylePreset, error) {
+	c := mongodoc.NewStylePresetConsumer(r.f.Readable)
+	if err := r.client.FindOne(ctx, bson.M{"id": id.String()}, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *StylePreset) FindByWorkspace(ctx context.Context, id accountdomain.WorkspaceID) (scene.StylePresetList, error) {
+	if !r.f.CanRead(id) {
+		return nil, nil
+	}
+
+	c := mongodoc.NewStylePresetConsumer(r.f.Readable)
+	if err := r.client.Find(ctx, bson.M{"team": id.String()}, c, options.Find().SetSort(bson.D{{Key: "name", Value: 1}})); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
+
+func (r *StylePreset) Save(ctx context.Context, p *scene.StylePreset) error {
+	if !r.f.CanWrite(p.Workspace()) {
+		return repo.ErrOperationDenied
+	}
+	doc, id := mongodoc.NewStylePreset(p)
+	return r.client.SaveOne(ctx, id, doc)
+}
+
+func (r *StylePreset) Remove(ctx context.Context, id id.StylePresetID) error {
+	return r.client.RemoveOne(ctx, applyWorkspaceFilter(bson.M{"id": id.String()}, r.f.Writable))
+}
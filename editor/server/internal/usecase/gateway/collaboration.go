@@ -0,0 +1,21 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+// Collaboration delivers change events and presences of scenes to editors connected to the server.
+type Collaboration interface {
+	// Publish sends the event to subscribers of its scene. It does not wait for slow subscribers.
+	Publish(context.Context, collaboration.Event) error
+	// Subscribe returns a channel that receives events of the scene. The channel is closed when the context is done.
+	Subscribe(context.Context, id.SceneID) (<-chan collaboration.Event, error)
+	// Join makes the user present in the scene until the context is done, and returns a channel that receives presences of the scene whenever they change.
+	Join(context.Context, id.SceneID, accountdomain.UserID) (<-chan collaboration.PresenceList, error)
+	// UpdatePresence changes the layer the user is editing. It returns rerror.ErrNotFound when the user has not joined the scene.
+	UpdatePresence(context.Context, id.SceneID, accountdomain.UserID, *id.NLSLayerID) (collaboration.PresenceList, error)
+}
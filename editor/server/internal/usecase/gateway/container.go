@@ -8,4 +8,5 @@ type Container struct {
 	PluginRegistry PluginRegistry
 	File           File
 	Google         Google
+	Collaboration  Collaboration
 }
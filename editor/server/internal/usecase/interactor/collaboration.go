@@ -0,0 +1,97 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountusecase/accountrepo"
+	"github.com/reearth/reearthx/rerror"
+)
+
+type Collaboration struct {
+	common
+	nlsLayerRepo  repo.NLSLayer
+	sceneRepo     repo.Scene
+	workspaceRepo accountrepo.Workspace
+	collaboration gateway.Collaboration
+}
+
+func NewCollaboration(r *repo.Container, g *gateway.Container) interfaces.Collaboration {
+	return &Collaboration{
+		nlsLayerRepo:  r.NLSLayer,
+		sceneRepo:     r.Scene,
+		workspaceRepo: r.Workspace,
+		collaboration: g.Collaboration,
+	}
+}
+
+func (i *Collaboration) SubscribeEvents(ctx context.Context, sid id.SceneID, op *usecase.Operator) (<-chan collaboration.Event, error) {
+	if i.collaboration == nil {
+		return nil, interfaces.ErrCollaborationUnavailable
+	}
+	if err := i.CanReadScene(sid, op); err != nil {
+		return nil, err
+	}
+	return i.collaboration.Subscribe(ctx, sid)
+}
+
+func (i *Collaboration) Join(ctx context.Context, sid id.SceneID, op *usecase.Operator) (<-chan collaboration.PresenceList, error) {
+	if i.collaboration == nil {
+		return nil, interfaces.ErrCollaborationUnavailable
+	}
+	if err := i.CanReadScene(sid, op); err != nil {
+		return nil, err
+	}
+	if op.AcOperator == nil || op.AcOperator.User == nil {
+		return nil, interfaces.ErrOperationDenied
+	}
+	return i.collaboration.Join(ctx, sid, *op.AcOperator.User)
+}
+
+func (i *Collaboration) UpdatePresence(ctx context.Context, inp interfaces.UpdatePresenceInput, op *usecase.Operator) (collaboration.PresenceList, error) {
+	if i.collaboration == nil {
+		return nil, interfaces.ErrCollaborationUnavailable
+	}
+	if err := i.CanReadScene(inp.SceneID, op); err != nil {
+		return nil, err
+	}
+	if op.AcOperator == nil || op.AcOperator.User == nil {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	if inp.LayerID != nil {
+		l, err := i.nlsLayerRepo.FindByID(ctx, *inp.LayerID)
+		if err != nil {
+			return nil, err
+		}
+		if l.Scene() != inp.SceneID {
+			return nil, rerror.ErrNotFound
+		}
+	}
+
+	return i.collaboration.UpdatePresence(ctx, inp.SceneID, *op.AcOperator.User, inp.LayerID)
+}
+
+func (i *Collaboration) CheckMembership(ctx context.Context, sid id.SceneID, op *usecase.Operator) error {
+	if op == nil || op.AcOperator == nil || op.AcOperator.User == nil {
+		return interfaces.ErrOperationDenied
+	}
+
+	s, err := i.sceneRepo.FindByID(ctx, sid)
+	if err != nil {
+		return err
+	}
+	ws, err := i.workspaceRepo.FindByID(ctx, s.Workspace())
+	if err != nil {
+		return err
+	}
+	if !ws.Members().HasUser(*op.AcOperator.User) {
+		return interfaces.ErrOperationDenied
+	}
+	return nil
+}
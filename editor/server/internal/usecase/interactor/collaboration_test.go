@@ -0,0 +1,43 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth/server/internal/infrastructure/broker"
+	"github.com/reearth/reearth/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollaboration_CheckMembership(t *testing.T) {
+	ctx := context.Background()
+
+	uid := accountdomain.NewUserID()
+	ws := workspace.New().NewID().Members(map[accountdomain.UserID]workspace.Member{uid: {Role: workspace.RoleReader}}).MustBuild()
+	db := memory.New()
+	require.NoError(t, db.Workspace.Save(ctx, ws))
+	sce := scene.New().NewID().Workspace(ws.ID()).Project(id.NewProjectID()).MustBuild()
+	require.NoError(t, db.Scene.Save(ctx, sce))
+
+	op := &usecase.Operator{
+		AcOperator:     &accountusecase.Operator{User: &uid},
+		ReadableScenes: []id.SceneID{sce.ID()},
+	}
+	c := NewCollaboration(db, &gateway.Container{Collaboration: broker.NewCollaboration()})
+	assert.NoError(t, c.CheckMembership(ctx, sce.ID(), op))
+	assert.ErrorIs(t, c.CheckMembership(ctx, sce.ID(), &usecase.Operator{ReadableScenes: []id.SceneID{sce.ID()}}), interfaces.ErrOperationDenied)
+
+	// the operator still has the scene, but the user has left the workspace
+	require.NoError(t, ws.Members().Leave(uid))
+	require.NoError(t, db.Workspace.Save(ctx, ws))
+	assert.ErrorIs(t, c.CheckMembership(ctx, sce.ID(), op), interfaces.ErrOperationDenied)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/reearth/reearthx/account/accountusecase/accountinteractor"
 	"github.com/reearth/reearthx/account/accountusecase/accountrepo"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/spf13/afero"
 )
 
 type ContainerConfig struct {
@@ -26,6 +27,8 @@ type ContainerConfig struct {
 	AuthSrvUIDomain    string
 	PublishedIndexHTML string
 	PublishedIndexURL  *url.URL
+	// PublishedWeb is the file system of the built web app, which is bundled into exports of published projects.
+	PublishedWeb afero.Fs
 }
 
 func NewContainer(r *repo.Container, g *gateway.Container,
@@ -33,24 +36,27 @@ func NewContainer(r *repo.Container, g *gateway.Container,
 	config ContainerConfig) interfaces.Container {
 	var published interfaces.Published
 	if config.PublishedIndexURL != nil && config.PublishedIndexURL.String() != "" {
-		published = NewPublishedWithURL(r.Project, r.Storytelling, g.File, config.PublishedIndexURL)
+		published = NewPublishedWithURL(r, g.File, config.PublishedWeb, config.PublishedIndexURL)
 	} else {
-		published = NewPublished(r.Project, r.Storytelling, g.File, config.PublishedIndexHTML)
+		published = NewPublished(r, g.File, config.PublishedWeb, config.PublishedIndexHTML)
 	}
 
 	return interfaces.Container{
-		Asset:        NewAsset(r, g),
-		NLSLayer:     NewNLSLayer(r, g),
-		Style:        NewStyle(r),
-		Plugin:       NewPlugin(r, g),
-		Policy:       NewPolicy(r),
-		Project:      NewProject(r, g),
-		Property:     NewProperty(r, g),
-		Published:    published,
-		Scene:        NewScene(r, g),
-		StoryTelling: NewStorytelling(r, g),
-		Workspace:    accountinteractor.NewWorkspace(ar, workspaceMemberCountEnforcer(r)),
-		User:         accountinteractor.NewMultiUser(ar, ag, config.SignupSecret, config.AuthSrvUIDomain, ar.Users),
+		Asset:         NewAsset(r, g),
+		Collaboration: NewCollaboration(r, g),
+		NLSLayer:      NewNLSLayer(r, g),
+		Style:         NewStyle(r, g),
+		StylePreset:   NewStylePreset(r, g),
+		Plugin:        NewPlugin(r, g),
+		Policy:        NewPolicy(r),
+		Project:       NewProject(r, g),
+		Property:      NewProperty(r, g),
+		Published:     published,
+		Revision:      NewRevision(r, g),
+		Scene:         NewScene(r, g),
+		StoryTelling:  NewStorytelling(r, g),
+		Workspace:     accountinteractor.NewWorkspace(ar, workspaceMemberCountEnforcer(r)),
+		User:          accountinteractor.NewMultiUser(ar, ag, config.SignupSecret, config.AuthSrvUIDomain, ar.Users),
 	}
 }
 
@@ -151,6 +157,7 @@ type SceneDeleter struct {
 	Plugin         repo.Plugin
 	Storytelling   repo.Storytelling
 	Style          repo.Style
+	Revision       repo.Revision
 	File           gateway.File
 }
 
@@ -200,6 +207,13 @@ func (d SceneDeleter) Delete(ctx context.Context, s *scene.Scene, force bool) er
 		return err
 	}
 
+	// Delete revisions
+	if d.Revision != nil {
+		if err := d.Revision.RemoveByScene(ctx, s.ID()); err != nil {
+			return err
+		}
+	}
+
 	// Release scene lock
 	if err := d.SceneLock.SaveLock(ctx, s.ID(), scene.LockModeFree); err != nil {
 		return err
@@ -11,6 +11,7 @@ import (
 
 	"net/http"
 	"path"
+	"strings"
 
 	"github.com/reearth/orb"
 	"github.com/reearth/orb/geojson"
@@ -19,14 +20,19 @@ import (
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth/server/internal/usecase/repo"
 	"github.com/reearth/reearth/server/pkg/builtin"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/nlslayer/decoding"
+	"github.com/reearth/reearth/server/pkg/nlslayer/encoding"
 	"github.com/reearth/reearth/server/pkg/plugin"
 	"github.com/reearth/reearth/server/pkg/property"
+	"github.com/reearth/reearth/server/pkg/scene"
 	"github.com/reearth/reearth/server/pkg/scene/builder"
 	"github.com/reearth/reearthx/account/accountusecase/accountrepo"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 )
 
 var (
@@ -47,10 +53,14 @@ var (
 	ErrInvalidExtensionType                 error = errors.New("invalid extension type")
 	ErrSketchNotFound                       error = errors.New("sketch not found")
 	ErrFeatureCollectionNotFound            error = errors.New("featureCollection not found")
+	ErrImportFileTooLarge                   error = errors.New("import file is too large")
 )
 
+const maxImportFileSize = 50 * 1024 * 1024 // 50MB
+
 type NLSLayer struct {
 	common
+	commonRevision
 	commonSceneLock
 	nlslayerRepo  repo.NLSLayer
 	sceneLockRepo repo.SceneLock
@@ -59,6 +69,7 @@ type NLSLayer struct {
 	propertyRepo  repo.Property
 	pluginRepo    repo.Plugin
 	policyRepo    repo.Policy
+	styleRepo     repo.Style
 	file          gateway.File
 	workspaceRepo accountrepo.Workspace
 	transaction   usecasex.Transaction
@@ -68,6 +79,7 @@ type NLSLayer struct {
 
 func NewNLSLayer(r *repo.Container, gr *gateway.Container) interfaces.NLSLayer {
 	return &NLSLayer{
+		commonRevision:  newCommonRevision(r, gr),
 		commonSceneLock: commonSceneLock{sceneLockRepo: r.SceneLock},
 		nlslayerRepo:    r.NLSLayer,
 		sceneLockRepo:   r.SceneLock,
@@ -76,6 +88,7 @@ func NewNLSLayer(r *repo.Container, gr *gateway.Container) interfaces.NLSLayer {
 		propertyRepo:    r.Property,
 		pluginRepo:      r.Plugin,
 		policyRepo:      r.Policy,
+		styleRepo:       r.Style,
 		file:            gr.File,
 		workspaceRepo:   r.Workspace,
 		transaction:     r.Transaction,
@@ -107,10 +120,14 @@ func (i *NLSLayer) AddLayerSimple(ctx context.Context, inp interfaces.AddNLSLaye
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	if err := i.CanWriteScene(inp.SceneID, operator); err != nil {
@@ -219,10 +236,179 @@ func (i *NLSLayer) AddLayerSimple(ctx context.Context, inp interfaces.AddNLSLaye
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layerSimple.Scene(), "addLayerSimple", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layerSimple, nil
 }
 
+// ImportLayerFile creates a sketch layer from a KML/KMZ, zipped Shapefile or CZML file.
+// Styles in the file are saved as a new layer style of the scene and applied to the layer.
+func (i *NLSLayer) ImportLayerFile(ctx context.Context, inp interfaces.ImportNLSLayerFileInput, operator *usecase.Operator) (_ *nlslayer.NLSLayerSimple, err error) {
+	if inp.File == nil {
+		return nil, interfaces.ErrFileNotIncluded
+	}
+
+	format, err := decoding.FormatFromFilename(inp.File.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
+
+	if err := i.CanWriteScene(inp.SceneID, operator); err != nil {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	s, err := i.sceneRepo.FindByID(ctx, inp.SceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	ws, err := i.workspaceRepo.FindByID(ctx, s.Workspace())
+	if err != nil {
+		return nil, err
+	}
+
+	if policyID := operator.Policy(ws.Policy()); policyID != nil {
+		p, err := i.policyRepo.FindByID(ctx, *policyID)
+		if err != nil {
+			return nil, err
+		}
+		s, err := i.nlslayerRepo.CountByScene(ctx, s.ID())
+		if err != nil {
+			return nil, err
+		}
+		if err := p.EnforceNLSLayersCount(s + 1); err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := io.ReadAll(io.LimitReader(inp.File.Content, maxImportFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxImportFileSize {
+		return nil, ErrImportFileTooLarge
+	}
+
+	res, err := decoding.Decode(bytes.NewReader(content), format)
+	if err != nil {
+		return nil, err
+	}
+
+	title := lo.FromPtr(inp.Title)
+	if title == "" {
+		title = res.Name
+	}
+	if title == "" {
+		title = strings.TrimSuffix(path.Base(inp.File.Path), path.Ext(inp.File.Path))
+	}
+
+	config := nlslayer.Config{
+		"properties": map[string]any{
+			"name": title,
+		},
+		"data": map[string]any{
+			"type": "geojson",
+		},
+	}
+
+	if res.Style != nil {
+		value := scene.StyleValue(res.Style)
+		style, err := scene.NewStyle().
+			NewID().
+			Scene(inp.SceneID).
+			Name(title).
+			Value(&value).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		if err := i.styleRepo.Save(ctx, *style); err != nil {
+			return nil, err
+		}
+		config["layerStyleId"] = style.ID().String()
+	}
+
+	layerSimple, err := nlslayer.NewNLSLayerSimple().
+		NewID().
+		Scene(inp.SceneID).
+		Config(&config).
+		LayerType(nlslayer.LayerType(nlslayer.Simple)).
+		Title(title).
+		Index(inp.Index).
+		IsVisible(lo.FromPtrOr(inp.Visible, true)).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	layerSimple.SetIsSketch(true)
+	layerSimple.SetSketch(nlslayer.NewSketchInfo(&res.Schema, res.FeatureCollection))
+
+	if err := i.nlslayerRepo.Save(ctx, layerSimple); err != nil {
+		return nil, err
+	}
+
+	err = updateProjectUpdatedAtByScene(ctx, layerSimple.Scene(), i.projectRepo, i.sceneRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if ev, err = i.RecordRevision(ctx, layerSimple.Scene(), "importLayerFile", operator); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return layerSimple, nil
+}
+
+// ExportLayers writes features of the sketch layers in the order of the IDs. All layers must be readable by the operator.
+func (i *NLSLayer) ExportLayers(ctx context.Context, inp interfaces.ExportNLSLayersInput, w io.Writer, operator *usecase.Operator) error {
+	ids := id.NLSLayerIDList(lo.Uniq(inp.LayerIDs))
+	if len(ids) == 0 {
+		return encoding.ErrNoLayers
+	}
+
+	layers, err := i.nlslayerRepo.FindNLSLayerSimpleByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	res := make([]*nlslayer.NLSLayerSimple, 0, len(layers))
+	for _, l := range layers {
+		if l == nil {
+			continue
+		}
+		if err := i.CanReadScene(l.Scene(), operator); err != nil {
+			return err
+		}
+		res = append(res, l)
+	}
+	if len(res) != len(ids) {
+		return rerror.ErrNotFound
+	}
+
+	return encoding.Encode(w, res, inp.Format)
+}
+
 func (i *NLSLayer) fetchAllChildren(ctx context.Context, l nlslayer.NLSLayer) ([]id.NLSLayerID, error) {
 	lidl := nlslayer.ToNLSLayerGroup(l).Children().Layers()
 	layers, err := i.nlslayerRepo.FindByIDs(ctx, lidl)
@@ -250,10 +436,14 @@ func (i *NLSLayer) Remove(ctx context.Context, lid id.NLSLayerID, operator *usec
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	l, err := i.nlslayerRepo.FindByID(ctx, lid)
@@ -297,6 +487,10 @@ func (i *NLSLayer) Remove(ctx context.Context, lid id.NLSLayerID, operator *usec
 		return lid, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, l.Scene(), "remove", operator); err != nil {
+		return lid, nil, err
+	}
+
 	tx.Commit()
 	return lid, parentLayer, nil
 }
@@ -308,10 +502,14 @@ func (i *NLSLayer) Update(ctx context.Context, inp interfaces.UpdateNLSLayerInpu
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -353,6 +551,10 @@ func (i *NLSLayer) Update(ctx context.Context, inp interfaces.UpdateNLSLayerInpu
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "update", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layer, nil
 }
@@ -364,10 +566,14 @@ func (i *NLSLayer) CreateNLSInfobox(ctx context.Context, lid id.NLSLayerID, oper
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	l, err := i.nlslayerRepo.FindByID(ctx, lid)
@@ -410,6 +616,10 @@ func (i *NLSLayer) CreateNLSInfobox(ctx context.Context, lid id.NLSLayerID, oper
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, l.Scene(), "createNLSInfobox", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return l, nil
 }
@@ -421,10 +631,14 @@ func (i *NLSLayer) CreateNLSPhotoOverlay(ctx context.Context, lid id.NLSLayerID,
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	l, err := i.nlslayerRepo.FindByID(ctx, lid)
@@ -467,6 +681,10 @@ func (i *NLSLayer) CreateNLSPhotoOverlay(ctx context.Context, lid id.NLSLayerID,
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, l.Scene(), "createNLSPhotoOverlay", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return l, nil
 }
@@ -479,10 +697,14 @@ func (i *NLSLayer) RemoveNLSInfobox(ctx context.Context, layerID id.NLSLayerID,
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, layerID)
@@ -520,6 +742,10 @@ func (i *NLSLayer) RemoveNLSInfobox(ctx context.Context, layerID id.NLSLayerID,
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "removeNLSInfobox", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layer, nil
 }
@@ -532,10 +758,14 @@ func (i *NLSLayer) RemoveNLSPhotoOverlay(ctx context.Context, layerID id.NLSLaye
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, layerID)
@@ -573,6 +803,10 @@ func (i *NLSLayer) RemoveNLSPhotoOverlay(ctx context.Context, layerID id.NLSLaye
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "removeNLSPhotoOverlay", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layer, nil
 }
@@ -652,10 +886,14 @@ func (i *NLSLayer) AddNLSInfoboxBlock(ctx context.Context, inp interfaces.AddNLS
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	l, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -712,6 +950,10 @@ func (i *NLSLayer) AddNLSInfoboxBlock(ctx context.Context, inp interfaces.AddNLS
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, l.Scene(), "addNLSInfoboxBlock", operator); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return block, l, err
 }
@@ -723,10 +965,14 @@ func (i *NLSLayer) MoveNLSInfoboxBlock(ctx context.Context, inp interfaces.MoveN
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -759,6 +1005,10 @@ func (i *NLSLayer) MoveNLSInfoboxBlock(ctx context.Context, inp interfaces.MoveN
 		return inp.InfoboxBlockID, nil, -1, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "moveNLSInfoboxBlock", operator); err != nil {
+		return inp.InfoboxBlockID, nil, -1, err
+	}
+
 	tx.Commit()
 	return inp.InfoboxBlockID, layer, inp.Index, err
 }
@@ -770,10 +1020,14 @@ func (i *NLSLayer) RemoveNLSInfoboxBlock(ctx context.Context, inp interfaces.Rem
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -806,6 +1060,10 @@ func (i *NLSLayer) RemoveNLSInfoboxBlock(ctx context.Context, inp interfaces.Rem
 		return inp.InfoboxBlockID, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "removeNLSInfoboxBlock", operator); err != nil {
+		return inp.InfoboxBlockID, nil, err
+	}
+
 	tx.Commit()
 	return inp.InfoboxBlockID, layer, err
 }
@@ -817,10 +1075,14 @@ func (i *NLSLayer) Duplicate(ctx context.Context, lid id.NLSLayerID, operator *u
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, lid)
@@ -843,6 +1105,10 @@ func (i *NLSLayer) Duplicate(ctx context.Context, lid id.NLSLayerID, operator *u
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "duplicate", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return duplicatedLayer, nil
 }
@@ -854,10 +1120,14 @@ func (i *NLSLayer) AddOrUpdateCustomProperties(ctx context.Context, inp interfac
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -894,6 +1164,10 @@ func (i *NLSLayer) AddOrUpdateCustomProperties(ctx context.Context, inp interfac
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "addOrUpdateCustomProperties", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layer, nil
 }
@@ -905,10 +1179,14 @@ func (i *NLSLayer) ChangeCustomPropertyTitle(ctx context.Context, inp interfaces
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -961,6 +1239,10 @@ func (i *NLSLayer) ChangeCustomPropertyTitle(ctx context.Context, inp interfaces
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "changeCustomPropertyTitle", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layer, nil
 }
@@ -972,10 +1254,14 @@ func (i *NLSLayer) RemoveCustomProperty(ctx context.Context, inp interfaces.AddO
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -1021,6 +1307,10 @@ func (i *NLSLayer) RemoveCustomProperty(ctx context.Context, inp interfaces.AddO
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "removeCustomProperty", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return layer, nil
 }
@@ -1032,10 +1322,14 @@ func (i *NLSLayer) AddGeoJSONFeature(ctx context.Context, inp interfaces.AddNLSL
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -1088,6 +1382,10 @@ func (i *NLSLayer) AddGeoJSONFeature(ctx context.Context, inp interfaces.AddNLSL
 		return nlslayer.Feature{}, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "addGeoJSONFeature", operator); err != nil {
+		return nlslayer.Feature{}, err
+	}
+
 	tx.Commit()
 	return *feature, nil
 }
@@ -1099,10 +1397,14 @@ func (i *NLSLayer) UpdateGeoJSONFeature(ctx context.Context, inp interfaces.Upda
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -1145,6 +1447,10 @@ func (i *NLSLayer) UpdateGeoJSONFeature(ctx context.Context, inp interfaces.Upda
 		return nlslayer.Feature{}, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "updateGeoJSONFeature", operator); err != nil {
+		return nlslayer.Feature{}, err
+	}
+
 	tx.Commit()
 	return updatedFeature, nil
 }
@@ -1156,10 +1462,14 @@ func (i *NLSLayer) DeleteGeoJSONFeature(ctx context.Context, inp interfaces.Dele
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	layer, err := i.nlslayerRepo.FindByID(ctx, inp.LayerID)
@@ -1186,11 +1496,30 @@ func (i *NLSLayer) DeleteGeoJSONFeature(ctx context.Context, inp interfaces.Dele
 		return id.FeatureID{}, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, layer.Scene(), "deleteGeoJSONFeature", operator); err != nil {
+		return id.FeatureID{}, err
+	}
+
 	tx.Commit()
 	return inp.FeatureID, nil
 }
 
-func (i *NLSLayer) ImportNLSLayers(ctx context.Context, sceneID id.SceneID, data *[]byte) (nlslayer.NLSLayerList, error) {
+func (i *NLSLayer) ImportNLSLayers(ctx context.Context, sceneID id.SceneID, data *[]byte, operator *usecase.Operator) (_ nlslayer.NLSLayerList, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
 
 	sceneJSON, err := builder.ParseSceneJSONByByte(data)
 	if err != nil {
@@ -1350,6 +1679,11 @@ func (i *NLSLayer) ImportNLSLayers(ctx context.Context, sceneID id.SceneID, data
 	if err != nil {
 		return nil, err
 	}
+	if ev, err = i.Filtered(filter).RecordRevision(ctx, sceneID, "importNLSLayers", operator); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return results, nil
 }
 
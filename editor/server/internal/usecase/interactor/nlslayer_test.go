@@ -1,7 +1,10 @@
 package interactor
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/reearth/reearth/server/internal/infrastructure/fs"
@@ -9,11 +12,16 @@ import (
 	"github.com/reearth/reearth/server/internal/usecase"
 	"github.com/reearth/reearth/server/internal/usecase/gateway"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/file"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/nlslayer/decoding"
+	"github.com/reearth/reearth/server/pkg/nlslayer/encoding"
 	"github.com/reearth/reearth/server/pkg/project"
 	"github.com/reearth/reearth/server/pkg/scene"
 	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
@@ -267,3 +275,165 @@ func TestDeleteGeoJSONFeature(t *testing.T) {
 	assert.NotNil(t, featureCollection)
 	assert.Equal(t, 0, len(featureCollection.Features()))
 }
+
+func TestImportLayerFile(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	ws := workspace.New().NewID().MustBuild()
+	_ = db.Workspace.Save(ctx, ws)
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	scene, _ := scene.New().NewID().Workspace(ws.ID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, scene)
+	il := NewNLSLayer(db, &gateway.Container{
+		File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com")),
+	})
+	op := &usecase.Operator{
+		WritableScenes: []id.SceneID{scene.ID()},
+	}
+
+	czml := `[
+		{"id": "document", "name": "stations", "version": "1.0"},
+		{"id": "a", "name": "A", "position": {"cartographicDegrees": [139.7, 35.6, 0]}, "point": {"color": {"rgba": [255, 0, 0, 255]}}}
+	]`
+	l, err := il.ImportLayerFile(ctx, interfaces.ImportNLSLayerFileInput{
+		SceneID: scene.ID(),
+		File: &file.File{
+			Content: io.NopCloser(strings.NewReader(czml)),
+			Path:    "stations.czml",
+		},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "stations", l.Title())
+	assert.True(t, l.IsVisible())
+	assert.True(t, l.IsSketch())
+	assert.Len(t, l.Sketch().FeatureCollection().Features(), 1)
+	assert.Equal(t, map[string]any{"marker-color": "Text_1", "name": "Text_2"}, *l.Sketch().CustomPropertySchema())
+
+	styleID, ok := (*l.Config())["layerStyleId"].(string)
+	assert.True(t, ok)
+	style, err := db.Style.FindByID(ctx, id.MustStyleID(styleID))
+	assert.NoError(t, err)
+	assert.Equal(t, "stations", style.Name())
+	assert.Contains(t, *style.Value(), "marker")
+
+	saved, err := db.NLSLayer.FindByID(ctx, l.ID())
+	assert.NoError(t, err)
+	assert.True(t, saved.IsSketch())
+
+	_, err = il.ImportLayerFile(ctx, interfaces.ImportNLSLayerFileInput{
+		SceneID: scene.ID(),
+		File: &file.File{
+			Content: io.NopCloser(strings.NewReader("{}")),
+			Path:    "data.geojson",
+		},
+	}, op)
+	assert.ErrorIs(t, err, decoding.ErrUnsupportedFormat)
+
+	_, err = il.ImportLayerFile(ctx, interfaces.ImportNLSLayerFileInput{
+		SceneID: scene.ID(),
+		File: &file.File{
+			Content: io.NopCloser(strings.NewReader(czml)),
+			Path:    "stations.czml",
+		},
+	}, &usecase.Operator{})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+}
+
+func TestImportLayerFile_StyledKML(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	ws := workspace.New().NewID().MustBuild()
+	_ = db.Workspace.Save(ctx, ws)
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	scene, _ := scene.New().NewID().Workspace(ws.ID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, scene)
+	gw := &gateway.Container{
+		File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "https://example.com")),
+	}
+	il := NewNLSLayer(db, gw)
+	op := &usecase.Operator{
+		ReadableScenes: []id.SceneID{scene.ID()},
+		WritableScenes: []id.SceneID{scene.ID()},
+	}
+
+	kml := `<kml><Document>
+		<Style id="s">
+			<IconStyle><color>ff0000ff</color></IconStyle>
+			<LineStyle><color>ff00ff00</color><width>2</width></LineStyle>
+			<PolyStyle><color>80ff0000</color></PolyStyle>
+		</Style>
+		<Placemark><styleUrl>#s</styleUrl><Point><coordinates>1,2</coordinates></Point></Placemark>
+		<Placemark><styleUrl>#s</styleUrl><LineString><coordinates>0,0 1,1</coordinates></LineString></Placemark>
+		<Placemark><styleUrl>#s</styleUrl><Polygon><outerBoundaryIs><LinearRing><coordinates>0,0 0,1 1,1 0,0</coordinates></LinearRing></outerBoundaryIs></Polygon></Placemark>
+	</Document></kml>`
+	l, err := il.ImportLayerFile(ctx, interfaces.ImportNLSLayerFileInput{
+		SceneID: scene.ID(),
+		File: &file.File{
+			Content: io.NopCloser(strings.NewReader(kml)),
+			Path:    "styled.kml",
+		},
+	}, op)
+	assert.NoError(t, err)
+
+	style, err := db.Style.FindByID(ctx, id.MustStyleID((*l.Config())["layerStyleId"].(string)))
+	assert.NoError(t, err)
+
+	// the style refers to the properties set by the import, which are known as attributes of the sketch
+	issues, err := NewStyle(db, gw).ValidateStyle(ctx, interfaces.ValidateStyleInput{
+		Layer: l.ID(),
+		Value: *style.Value(),
+	}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestExportLayers(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	sid := id.NewSceneID()
+	schema := map[string]any{"name": "Text_1", "height": "Float_2"}
+	f, _ := nlslayer.NewFeature(id.NewFeatureID(), "Feature", nlslayer.NewPoint("Point", []float64{139.7, 35.6}))
+	f.UpdateProperties(&map[string]any{"name": "Tokyo", "height": 10.5})
+	fc := nlslayer.NewFeatureCollection("FeatureCollection", []nlslayer.Feature{*f})
+	sketch := nlslayer.NewNLSLayerSimple().NewID().Scene(sid).Title("sketch").
+		IsSketch(true).Sketch(nlslayer.NewSketchInfo(&schema, fc)).MustBuild()
+	data := nlslayer.NewNLSLayerSimple().NewID().Scene(sid).Title("data").MustBuild()
+	_ = db.NLSLayer.Save(ctx, sketch)
+	_ = db.NLSLayer.Save(ctx, data)
+
+	il := NewNLSLayer(db, &gateway.Container{})
+	op := &usecase.Operator{
+		ReadableScenes: []id.SceneID{sid},
+	}
+
+	var buf bytes.Buffer
+	err := il.ExportLayers(ctx, interfaces.ExportNLSLayersInput{
+		LayerIDs: id.NLSLayerIDList{sketch.ID(), sketch.ID()},
+		Format:   encoding.FormatGeoJSON,
+	}, &buf, op)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `"properties":{"height":10.5,"name":"Tokyo"}`)
+
+	err = il.ExportLayers(ctx, interfaces.ExportNLSLayersInput{
+		LayerIDs: id.NLSLayerIDList{sketch.ID(), data.ID()},
+		Format:   encoding.FormatKML,
+	}, io.Discard, op)
+	assert.ErrorIs(t, err, encoding.ErrNotSketchLayer)
+
+	err = il.ExportLayers(ctx, interfaces.ExportNLSLayersInput{
+		LayerIDs: id.NLSLayerIDList{sketch.ID(), id.NewNLSLayerID()},
+		Format:   encoding.FormatKML,
+	}, io.Discard, op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	err = il.ExportLayers(ctx, interfaces.ExportNLSLayersInput{
+		LayerIDs: id.NLSLayerIDList{sketch.ID()},
+		Format:   encoding.FormatKML,
+	}, io.Discard, &usecase.Operator{})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+}
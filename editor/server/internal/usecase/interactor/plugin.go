@@ -27,6 +27,7 @@ import (
 
 type Plugin struct {
 	common
+	commonRevision
 	sceneRepo          repo.Scene
 	pluginRepo         repo.Plugin
 	propertySchemaRepo repo.PropertySchema
@@ -38,6 +39,7 @@ type Plugin struct {
 
 func NewPlugin(r *repo.Container, gr *gateway.Container) interfaces.Plugin {
 	return &Plugin{
+		commonRevision:     newCommonRevision(r, gr),
 		sceneRepo:          r.Scene,
 		pluginRepo:         r.Plugin,
 		propertySchemaRepo: r.PropertySchema,
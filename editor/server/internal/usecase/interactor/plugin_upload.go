@@ -8,6 +8,7 @@ import (
 
 	"github.com/reearth/reearth/server/internal/usecase"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/plugin"
 	"github.com/reearth/reearth/server/pkg/plugin/manifest"
@@ -92,10 +93,14 @@ func (i *Plugin) upload(ctx context.Context, p *pluginpack.Package, sid id.Scene
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	var oldPManifest *manifest.Manifest
@@ -170,6 +175,10 @@ func (i *Plugin) upload(ctx context.Context, p *pluginpack.Package, sid id.Scene
 		}
 	}
 
+	if ev, err = i.RecordRevision(ctx, sid, "uploadPlugin", operator); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return p.Manifest.Plugin, s, nil
 }
@@ -98,6 +98,7 @@ func TestPlugin_Upload_New(t *testing.T) {
 	_ = repos.Scene.Save(ctx, scene)
 
 	uc := &Plugin{
+		commonRevision:     commonRevision{sceneRepo: repos.Scene},
 		sceneRepo:          repos.Scene,
 		pluginRepo:         repos.Plugin,
 		propertySchemaRepo: repos.PropertySchema,
@@ -178,6 +179,7 @@ func TestPlugin_Upload_SameVersion(t *testing.T) {
 	_ = repos.Scene.Save(ctx, scene)
 
 	uc := &Plugin{
+		commonRevision:     commonRevision{sceneRepo: repos.Scene},
 		sceneRepo:          repos.Scene,
 		pluginRepo:         repos.Plugin,
 		propertySchemaRepo: repos.PropertySchema,
@@ -284,6 +286,7 @@ func TestPlugin_Upload_DiffVersion(t *testing.T) {
 	_ = repos.Scene.Save(ctx, scene)
 
 	uc := &Plugin{
+		commonRevision:     commonRevision{sceneRepo: repos.Scene},
 		sceneRepo:          repos.Scene,
 		pluginRepo:         repos.Plugin,
 		propertySchemaRepo: repos.PropertySchema,
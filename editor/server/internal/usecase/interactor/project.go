@@ -28,6 +28,7 @@ import (
 	"github.com/reearth/reearthx/account/accountusecase/accountrepo"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
 	"github.com/spf13/afero"
 )
 
@@ -48,6 +49,7 @@ type Project struct {
 	nlsLayerRepo       repo.NLSLayer
 	layerStyles        repo.Style
 	pluginRepo         repo.Plugin
+	revisionRepo       repo.Revision
 }
 
 func NewProject(r *repo.Container, gr *gateway.Container) interfaces.Project {
@@ -67,6 +69,7 @@ func NewProject(r *repo.Container, gr *gateway.Container) interfaces.Project {
 		layerStyles:        r.Style,
 		pluginRepo:         r.Plugin,
 		propertySchemaRepo: r.PropertySchema,
+		revisionRepo:       r.Revision,
 	}
 }
 
@@ -404,6 +407,11 @@ func (i *Project) Publish(ctx context.Context, params interfaces.PublishProjectP
 		return nil, err
 	}
 
+	stories, err := i.storytellingRepo.FindByScene(ctx, sceneID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Lock
 	if err := i.UpdateSceneLock(ctx, sceneID, scene.LockModeFree, scene.LockModePublishing); err != nil {
 		return nil, err
@@ -432,7 +440,7 @@ func (i *Project) Publish(ctx context.Context, params interfaces.PublishProjectP
 				repo.PropertyLoaderFrom(i.propertyRepo),
 				repo.NLSLayerLoaderFrom(i.nlsLayerRepo),
 				false,
-			).ForScene(s).WithNLSLayers(&nlsLayers).WithLayerStyle(layerStyles).Build(ctx, w, time.Now(), coreSupport, enableGa, trackingId)
+			).ForScene(s).WithNLSLayers(&nlsLayers).WithLayerStyle(layerStyles).WithStories(lo.FromPtr(stories).Public()).Build(ctx, w, time.Now(), coreSupport, enableGa, trackingId)
 		}()
 
 		// Save
@@ -490,6 +498,7 @@ func (i *Project) Delete(ctx context.Context, projectID id.ProjectID, operator *
 			Plugin:         i.pluginRepo,
 			Storytelling:   i.storytellingRepo,
 			Style:          i.layerStyles,
+			Revision:       i.revisionRepo,
 			PropertySchema: i.propertySchemaRepo,
 			File:           i.file,
 		},
@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth/server/internal/usecase/gateway"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/property"
 	"github.com/reearth/reearthx/usecasex"
@@ -16,6 +17,7 @@ import (
 type Property struct {
 	common
 	commonSceneLock
+	commonRevision
 	propertyRepo       repo.Property
 	propertySchemaRepo repo.PropertySchema
 	sceneRepo          repo.Scene
@@ -27,6 +29,7 @@ type Property struct {
 func NewProperty(r *repo.Container, gr *gateway.Container) interfaces.Property {
 	return &Property{
 		commonSceneLock:    commonSceneLock{sceneLockRepo: r.SceneLock},
+		commonRevision:     newCommonRevision(r, gr),
 		propertyRepo:       r.Property,
 		propertySchemaRepo: r.PropertySchema,
 		sceneRepo:          r.Scene,
@@ -51,10 +54,14 @@ func (i *Property) UpdateValue(ctx context.Context, inp interfaces.UpdatePropert
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -84,6 +91,10 @@ func (i *Property) UpdateValue(ctx context.Context, inp interfaces.UpdatePropert
 		return nil, nil, nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "updatePropertyValue", operator); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	tx.Commit()
 	return p, pgl, pg, field, nil
 }
@@ -95,10 +106,14 @@ func (i *Property) RemoveField(ctx context.Context, inp interfaces.RemovePropert
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -121,6 +136,10 @@ func (i *Property) RemoveField(ctx context.Context, inp interfaces.RemovePropert
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "removePropertyField", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return p, nil
 }
@@ -132,10 +151,14 @@ func (i *Property) LinkValue(ctx context.Context, inp interfaces.LinkPropertyVal
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -162,6 +185,10 @@ func (i *Property) LinkValue(ctx context.Context, inp interfaces.LinkPropertyVal
 		return nil, nil, nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "linkPropertyValue", operator); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	tx.Commit()
 	return p, pgl, pg, field, nil
 }
@@ -173,10 +200,14 @@ func (i *Property) UnlinkValue(ctx context.Context, inp interfaces.UnlinkPropert
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -208,6 +239,10 @@ func (i *Property) UnlinkValue(ctx context.Context, inp interfaces.UnlinkPropert
 		return nil, nil, nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "unlinkPropertyValue", operator); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	tx.Commit()
 	return p, pgl, pg, field, nil
 }
@@ -219,10 +254,14 @@ func (i *Property) AddItem(ctx context.Context, inp interfaces.AddPropertyItemPa
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -257,6 +296,10 @@ func (i *Property) AddItem(ctx context.Context, inp interfaces.AddPropertyItemPa
 		return nil, nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "addPropertyItem", operator); err != nil {
+		return nil, nil, nil, err
+	}
+
 	tx.Commit()
 	return p, gl, item, nil
 }
@@ -268,10 +311,14 @@ func (i *Property) MoveItem(ctx context.Context, inp interfaces.MovePropertyItem
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -296,6 +343,10 @@ func (i *Property) MoveItem(ctx context.Context, inp interfaces.MovePropertyItem
 		return nil, nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "movePropertyItem", operator); err != nil {
+		return nil, nil, nil, err
+	}
+
 	tx.Commit()
 	return p, gl, item, nil
 }
@@ -307,10 +358,14 @@ func (i *Property) RemoveItem(ctx context.Context, inp interfaces.RemoveProperty
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	p, err = i.propertyRepo.FindByID(ctx, inp.PropertyID)
@@ -334,11 +389,31 @@ func (i *Property) RemoveItem(ctx context.Context, inp interfaces.RemoveProperty
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "removePropertyItem", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return p, nil
 }
 
-func (i *Property) UpdateItems(ctx context.Context, inp interfaces.UpdatePropertyItemsParam, operator *usecase.Operator) (*property.Property, error) {
+func (i *Property) UpdateItems(ctx context.Context, inp interfaces.UpdatePropertyItemsParam, operator *usecase.Operator) (_ *property.Property, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
+
 	p, err := i.propertyRepo.FindByID(ctx, inp.PropertyID)
 	if err != nil {
 		return nil, err
@@ -379,5 +454,10 @@ func (i *Property) UpdateItems(ctx context.Context, inp interfaces.UpdatePropert
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, p.Scene(), "updatePropertyItems", operator); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return p, nil
 }
@@ -35,6 +35,7 @@ func TestProperty_AddItem(t *testing.T) {
 	_ = memory.Property.Save(ctx, p)
 
 	uc := &Property{
+		commonRevision:     commonRevision{sceneRepo: memory.Scene},
 		commonSceneLock:    commonSceneLock{sceneLockRepo: memory.SceneLock},
 		propertyRepo:       memory.Property,
 		propertySchemaRepo: memory.PropertySchema,
@@ -87,6 +88,7 @@ func TestProperty_RemoveItem(t *testing.T) {
 	_ = memory.Property.Save(ctx, p)
 
 	uc := &Property{
+		commonRevision:     commonRevision{sceneRepo: memory.Scene},
 		commonSceneLock:    commonSceneLock{sceneLockRepo: memory.SceneLock},
 		propertyRepo:       memory.Property,
 		propertySchemaRepo: memory.PropertySchema,
@@ -131,6 +133,7 @@ func TestProperty_UpdateValue_FieldOfGroupInList(t *testing.T) {
 	_ = memory.Property.Save(ctx, p)
 
 	uc := &Property{
+		commonRevision:     commonRevision{sceneRepo: memory.Scene},
 		commonSceneLock:    commonSceneLock{sceneLockRepo: memory.SceneLock},
 		sceneRepo:          memory.Scene,
 		propertyRepo:       memory.Property,
@@ -19,30 +19,45 @@ import (
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
+	"github.com/spf13/afero"
 )
 
 type Published struct {
+	common
 	project      repo.Project
+	scene        repo.Scene
+	plugin       repo.Plugin
+	asset        repo.Asset
 	Storytelling repo.Storytelling
 	file         gateway.File
+	web          afero.Fs
 	indexHTML    *util.Cache[string]
 	indexHTMLStr string
 }
 
-func NewPublished(project repo.Project, storytelling repo.Storytelling, file gateway.File, indexHTML string) interfaces.Published {
+// NewPublished returns the usecase of published projects. web is the file system of the web app bundled into exports, and may be nil.
+func NewPublished(r *repo.Container, file gateway.File, web afero.Fs, indexHTML string) interfaces.Published {
 	return &Published{
-		project:      project,
-		Storytelling: storytelling,
+		project:      r.Project,
+		scene:        r.Scene,
+		plugin:       r.Plugin,
+		asset:        r.Asset,
+		Storytelling: r.Storytelling,
 		file:         file,
+		web:          web,
 		indexHTMLStr: indexHTML,
 	}
 }
 
-func NewPublishedWithURL(project repo.Project, storytelling repo.Storytelling, file gateway.File, indexHTMLURL *url.URL) interfaces.Published {
+func NewPublishedWithURL(r *repo.Container, file gateway.File, web afero.Fs, indexHTMLURL *url.URL) interfaces.Published {
 	return &Published{
-		project:      project,
+		project:      r.Project,
+		scene:        r.Scene,
+		plugin:       r.Plugin,
+		asset:        r.Asset,
+		Storytelling: r.Storytelling,
 		file:         file,
-		Storytelling: storytelling,
+		web:          web,
 		indexHTML: util.NewCache(func(c context.Context, i string) (string, error) {
 			req, err := http.NewRequestWithContext(c, http.MethodGet, indexHTMLURL.String(), nil)
 			if err != nil {
@@ -110,13 +125,9 @@ func (i *Published) Data(ctx context.Context, name string) (io.Reader, error) {
 }
 
 func (i *Published) Index(ctx context.Context, name string, u *url.URL) (string, error) {
-	htmlStr := i.indexHTMLStr
-	if i.indexHTML != nil {
-		htmlCachedStr, err := i.indexHTML.Get(ctx)
-		if err != nil {
-			return "", err
-		}
-		htmlStr = htmlCachedStr
+	htmlStr, err := i.index(ctx)
+	if err != nil {
+		return "", err
 	}
 
 	if name == "" {
@@ -144,6 +155,13 @@ func (i *Published) Index(ctx context.Context, name string, u *url.URL) (string,
 	return htmlStr, nil
 }
 
+func (i *Published) index(ctx context.Context) (string, error) {
+	if i.indexHTML != nil {
+		return i.indexHTML.Get(ctx)
+	}
+	return i.indexHTMLStr, nil
+}
+
 const headers = `{{if .title}}  <meta name="twitter:title" content="{{.title}}" />
   <meta property="og:title" content="{{.title}}" />{{end}}{{if .description}}
   <meta name="twitter:description" content="{{.description}}" />
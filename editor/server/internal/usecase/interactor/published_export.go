@@ -0,0 +1,337 @@
+package interactor
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"golang.org/x/exp/slices"
+)
+
+// files of the web app that are not used by the published page or are replaced by the export
+var publishedExportWebSkip = []string{"index.html", "published.html", "reearth_config.json"}
+
+// publishedExportConfig is the config of the web app embedded in the exported index HTML.
+// The web app does not fetch its config from the root path when it is already set, so the bundle can be served from any path.
+// The data is always loaded from data.json next to the index HTML.
+var publishedExportConfig = map[string]any{
+	"api":       "",
+	"plugins":   "plugins",
+	"published": "",
+}
+
+const publishedExportSkippedFile = "skipped_assets.txt"
+
+var (
+	rootRelativeURLRegexp = regexp.MustCompile(`(\s(?:src|href)=["'])/([^/])`)
+	headRegexp            = regexp.MustCompile(`<head(?:\s[^>]*)?>`)
+	// URLs may be embedded in HTML, markdown, CSS and quoted style expressions
+	embeddedURLRegexp = regexp.MustCompile(`https?://[^\s"'()<>\\\[\]{}]+`)
+)
+
+// Export writes a zip that contains the published scene as data.json, the index HTML, files of plugins and assets uploaded to Re:Earth.
+// URLs of the bundled assets, including ones embedded in texts such as HTML and markdown, are rewritten to paths relative to the index HTML.
+// Files of the web app are also bundled when the server has them, and the bundle can be served from any path of a static file server.
+//
+// Data that is hosted on other servers is not bundled and is still loaded over the network.
+// URLs of assets that could not be read are returned and listed in skipped_assets.txt of the zip.
+func (i *Published) Export(ctx context.Context, name string, w io.Writer, operator *usecase.Operator) ([]string, error) {
+	title, sid, err := i.findPublished(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CanReadScene(sid, operator); err != nil {
+		return nil, err
+	}
+	sce, err := i.scene.FindByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	index, err := i.exportIndex(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := i.Data(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var data any
+	err = json.NewDecoder(r).Decode(&data)
+	if c, ok := r.(io.Closer); ok {
+		_ = c.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// nothing is written to w until here so that errors above can be responded as they are
+	zw := zip.NewWriter(w)
+
+	e := &assetExporter{Published: i, zw: zw, paths: map[string]string{}, used: map[string]bool{}}
+	data, err = e.exportAssets(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeZipJSON(zw, "data.json", data); err != nil {
+		return nil, err
+	}
+	if err := writeZipFile(zw, "index.html", strings.NewReader(index)); err != nil {
+		return nil, err
+	}
+	if len(e.skipped) > 0 {
+		if err := writeZipFile(zw, publishedExportSkippedFile, strings.NewReader(strings.Join(e.skipped, "\n")+"\n")); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.exportPlugins(ctx, zw, sce.PluginIds()); err != nil {
+		return nil, err
+	}
+	if err := i.exportWeb(zw); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return e.skipped, nil
+}
+
+// exportIndex returns the index HTML whose root-relative URLs are rewritten and which has the config of the web app.
+func (i *Published) exportIndex(ctx context.Context, title string) (string, error) {
+	index, err := i.index(ctx)
+	if err != nil {
+		return "", err
+	}
+	if title != "" {
+		index = titleRegexp.ReplaceAllLiteralString(index, "<title>"+html.EscapeString(title)+"</title>")
+	}
+	index = rootRelativeURLRegexp.ReplaceAllString(index, "$1./$2")
+
+	config, err := json.Marshal(publishedExportConfig)
+	if err != nil {
+		return "", err
+	}
+	script := "<script>window.REEARTH_CONFIG = " + string(config) + ";</script>"
+	if loc := headRegexp.FindStringIndex(index); loc != nil {
+		return index[:loc[1]] + script + index[loc[1]:], nil
+	}
+	return script + index, nil
+}
+
+// findPublished returns the title and the scene of the project or story published with the name.
+func (i *Published) findPublished(ctx context.Context, name string) (string, id.SceneID, error) {
+	prj, err := i.project.FindByPublicName(ctx, name)
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return "", id.SceneID{}, err
+	}
+	if prj != nil {
+		sce, err := i.scene.FindByProject(ctx, prj.ID())
+		if err != nil {
+			return "", id.SceneID{}, err
+		}
+		return prj.PublicTitle(), sce.ID(), nil
+	}
+
+	story, err := i.Storytelling.FindByPublicName(ctx, name)
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return "", id.SceneID{}, err
+	}
+	if story != nil {
+		return story.PublicTitle(), story.Scene(), nil
+	}
+	return "", id.SceneID{}, rerror.ErrNotFound
+}
+
+// assetExporter adds assets referred by the published data to the zip.
+type assetExporter struct {
+	*Published
+	zw *zip.Writer
+	// paths caches the path in the zip of each URL. An empty path means that the URL is not bundled.
+	paths map[string]string
+	// used holds paths in the zip already taken by other URLs
+	used    map[string]bool
+	skipped []string
+}
+
+// exportAssets returns the data whose URLs of the bundled assets are replaced with the paths in the zip.
+func (e *assetExporter) exportAssets(ctx context.Context, data any) (any, error) {
+	switch v := data.(type) {
+	case map[string]any:
+		for k, value := range v {
+			res, err := e.exportAssets(ctx, value)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = res
+		}
+	case []any:
+		for k, item := range v {
+			res, err := e.exportAssets(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = res
+		}
+	case string:
+		return e.exportAssetsInString(ctx, v)
+	}
+	return data, nil
+}
+
+func (e *assetExporter) exportAssetsInString(ctx context.Context, s string) (string, error) {
+	var b strings.Builder
+	last := 0
+	for _, loc := range embeddedURLRegexp.FindAllStringIndex(s, -1) {
+		// punctuation right after URLs in texts is not a part of them
+		u := strings.TrimRight(s[loc[0]:loc[1]], ".,;:!?")
+		p, err := e.exportAsset(ctx, u)
+		if err != nil {
+			return "", err
+		}
+		if p == "" {
+			continue
+		}
+		b.WriteString(s[last:loc[0]])
+		b.WriteString(p)
+		last = loc[0] + len(u)
+	}
+	if last == 0 {
+		return s, nil
+	}
+	b.WriteString(s[last:])
+	return b.String(), nil
+}
+
+func (e *assetExporter) exportAsset(ctx context.Context, u string) (string, error) {
+	if p, ok := e.paths[u]; ok {
+		return p, nil
+	}
+	e.paths[u] = ""
+
+	pu, err := url.Parse(u)
+	if err != nil {
+		return "", nil
+	}
+
+	name := path.Base(pu.Path)
+	if a, err := e.asset.FindByURL(ctx, u); err != nil || a == nil {
+		if !IsCurrentHostAssets(ctx, u) || path.Base(path.Dir(pu.Path)) != "assets" {
+			return "", nil
+		}
+	}
+
+	r, err := e.file.ReadAsset(ctx, name)
+	if err != nil {
+		e.skipped = append(e.skipped, u)
+		return "", nil
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	p := e.assetPath(name)
+	if err := writeZipFile(e.zw, p, r); err != nil {
+		return "", err
+	}
+	e.paths[u] = p
+	return p, nil
+}
+
+// assetPath returns a path in the zip for the file name that is not used by other URLs.
+func (e *assetExporter) assetPath(name string) string {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	p := path.Join("assets", name)
+	for n := 1; e.used[p]; n++ {
+		p = path.Join("assets", fmt.Sprintf("%s-%d%s", base, n, ext))
+	}
+	e.used[p] = true
+	return p
+}
+
+func (i *Published) exportPlugins(ctx context.Context, zw *zip.Writer, ids []id.PluginID) error {
+	// the official plugin is built in the web app
+	ids = lo.Filter(ids, func(p id.PluginID, _ int) bool { return !p.Equal(id.OfficialPluginID) })
+	if len(ids) == 0 {
+		return nil
+	}
+
+	plugins, err := i.plugin.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range plugins {
+		for _, e := range p.Extensions() {
+			filename := fmt.Sprintf("%s.js", e.ID().String())
+			r, err := i.file.ReadPluginFile(ctx, p.ID(), filename)
+			if err != nil {
+				return err
+			}
+			err = writeZipFile(zw, path.Join("plugins", p.ID().String(), filename), r)
+			_ = r.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (i *Published) exportWeb(zw *zip.Writer) error {
+	if i.web == nil {
+		return nil
+	}
+
+	return afero.Walk(i.web, ".", func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || slices.Contains(publishedExportWebSkip, p) {
+			return nil
+		}
+
+		f, err := i.web.Open(p)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		return writeZipFile(zw, filepath.ToSlash(p), f)
+	})
+}
+
+func writeZipFile(zw *zip.Writer, name string, r io.Reader) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func writeZipJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(v)
+}
@@ -1,10 +1,26 @@
 package interactor
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
 	"testing"
 
+	"github.com/reearth/reearth/server/internal/adapter"
+	"github.com/reearth/reearth/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth/server/internal/usecase"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/plugin"
+	"github.com/reearth/reearth/server/pkg/project"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRenderIndex(t *testing.T) {
@@ -33,3 +49,85 @@ func TestRenderIndex(t *testing.T) {
 		},
 	))
 }
+
+func TestPublished_Export(t *testing.T) {
+	ctx := adapter.AttachCurrentHost(context.Background(), "https://example.com")
+
+	db := memory.New()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).Alias("flood").PublishmentStatus(project.PublishmentStatusPublic).PublicTitle("Flood map").MustBuild()
+	_ = db.Project.Save(ctx, prj)
+	pid := mockPluginID.WithScene(nil)
+	sce := scene.New().NewID().Workspace(wid).Project(prj.ID()).Plugins(scene.NewPlugins([]*scene.Plugin{
+		scene.NewPlugin(id.OfficialPluginID, nil),
+		scene.NewPlugin(pid, nil),
+	})).MustBuild()
+	_ = db.Scene.Save(ctx, sce)
+	_ = db.Plugin.Save(ctx, plugin.New().ID(pid).Extensions([]*plugin.Extension{
+		plugin.NewExtension().ID("marker").Type(plugin.ExtensionTypeWidget).MustBuild(),
+	}).MustBuild())
+
+	files := lo.Must(fs.NewFile(mockFS(map[string]string{
+		"assets/a.geojson":                       `{"type":"FeatureCollection"}`,
+		"assets/b.png":                           "png",
+		"plugins/" + pid.String() + "/marker.js": "reearth.ui.show()",
+		"published/flood.json": `{"nlsLayers":[
+			{"config":{"data":{"url":"https://example.com/assets/a.geojson"}}},
+			{"config":{"data":{"url":"https://example.com/assets/missing.czml"}}},
+			{"config":{"data":{"url":"https://other.example.com/c.czml"}}},
+			{"config":{"data":{"url":"https://example.com/assets/a.geojson?v=2"}}}
+		],"story":{"text":"<img src=\"https://example.com/assets/b.png\"> see ![b](https://example.com/assets/b.png)."},"layerStyles":[{"value":{"marker":{"image":{"expression":"'https://example.com/assets/b.png'"}}}}]}`,
+	}), "https://example.com"))
+	web := mockFS(map[string]string{
+		"index.html":          "editor",
+		"published.html":      "published",
+		"reearth_config.json": "{}",
+		"static/published.js": "app",
+	})
+	html := `<html><head><title>Re:Earth</title><script type="module" src="/static/published.js"></script><link href="//fonts.example.com/a.css"></head></html>`
+	uc := NewPublished(db, files, web, html)
+	op := &usecase.Operator{ReadableScenes: id.SceneIDList{sce.ID()}}
+
+	var buf bytes.Buffer
+	skipped, err := uc.Export(ctx, "flood", &buf, op)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/assets/missing.czml"}, skipped)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	got := map[string]string{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		b, _ := io.ReadAll(r)
+		got[f.Name] = string(b)
+	}
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal([]byte(got["data.json"]), &data))
+	assert.Equal(t, map[string]any{
+		"nlsLayers": []any{
+			map[string]any{"config": map[string]any{"data": map[string]any{"url": "assets/a.geojson"}}},
+			map[string]any{"config": map[string]any{"data": map[string]any{"url": "https://example.com/assets/missing.czml"}}},
+			map[string]any{"config": map[string]any{"data": map[string]any{"url": "https://other.example.com/c.czml"}}},
+			map[string]any{"config": map[string]any{"data": map[string]any{"url": "assets/a-1.geojson"}}},
+		},
+		"story": map[string]any{"text": `<img src="assets/b.png"> see ![b](assets/b.png).`},
+		"layerStyles": []any{
+			map[string]any{"value": map[string]any{"marker": map[string]any{"image": map[string]any{"expression": "'assets/b.png'"}}}},
+		},
+	}, data)
+	assert.Equal(t, `<html><head><script>window.REEARTH_CONFIG = {"api":"","plugins":"plugins","published":""};</script><title>Flood map</title><script type="module" src="./static/published.js"></script><link href="//fonts.example.com/a.css"></head></html>`, got["index.html"])
+	assert.Equal(t, `{"type":"FeatureCollection"}`, got["assets/a.geojson"])
+	assert.Equal(t, `{"type":"FeatureCollection"}`, got["assets/a-1.geojson"])
+	assert.Equal(t, "png", got["assets/b.png"])
+	assert.Equal(t, "https://example.com/assets/missing.czml\n", got["skipped_assets.txt"])
+	assert.Equal(t, "reearth.ui.show()", got["plugins/"+pid.String()+"/marker.js"])
+	assert.Equal(t, "app", got["static/published.js"])
+	assert.NotContains(t, got, "published.html")
+	assert.NotContains(t, got, "reearth_config.json")
+	assert.Len(t, got, 8)
+
+	_, err = uc.Export(ctx, "flood", io.Discard, &usecase.Operator{})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
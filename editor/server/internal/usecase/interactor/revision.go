@@ -0,0 +1,379 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth/server/internal/adapter"
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+// maxRevisions is the number of revisions kept for each scene. Older revisions are removed when a new one is recorded.
+const maxRevisions = 100
+
+// commonRevision records a revision of the scene graph in the transaction of each change.
+// It also checks and increments the version of the scene, and notifies editors of the scene of the change.
+type commonRevision struct {
+	revisionRepo     repo.Revision
+	sceneRepo        repo.Scene
+	nlsLayerRepo     repo.NLSLayer
+	styleRepo        repo.Style
+	storytellingRepo repo.Storytelling
+	propertyRepo     repo.Property
+	collaboration    gateway.Collaboration
+}
+
+func newCommonRevision(r *repo.Container, g *gateway.Container) commonRevision {
+	res := commonRevision{
+		revisionRepo:     r.Revision,
+		sceneRepo:        r.Scene,
+		nlsLayerRepo:     r.NLSLayer,
+		styleRepo:        r.Style,
+		storytellingRepo: r.Storytelling,
+		propertyRepo:     r.Property,
+	}
+	if g != nil {
+		res.collaboration = g.Collaboration
+	}
+	return res
+}
+
+// RecordRevision checks and increments the version of the scene and saves the current state of the scene as a new revision.
+// Revisions are not saved when they are not stored.
+// It returns interfaces.ErrSceneVersionConflict when the scene has been changed since the version attached to the context,
+// so the caller must not commit the transaction then.
+// The returned event must be published with PublishEvents after the transaction is committed.
+func (i commonRevision) RecordRevision(ctx context.Context, sid id.SceneID, action string, op *usecase.Operator) (*collaboration.Event, error) {
+	ev, _, err := i.recordRevision(ctx, sid, action, op)
+	return ev, err
+}
+
+func (i commonRevision) recordRevision(ctx context.Context, sid id.SceneID, action string, op *usecase.Operator) (*collaboration.Event, *revision.Revision, error) {
+	version, err := i.incrementSceneVersion(ctx, sid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user *accountdomain.UserID
+	if op != nil && op.AcOperator != nil {
+		user = op.AcOperator.User
+	}
+
+	if i.revisionRepo == nil {
+		if i.collaboration == nil {
+			return nil, nil, nil
+		}
+		return &collaboration.Event{Scene: sid, Version: version, Action: action, User: user}, nil, nil
+	}
+
+	s, err := i.snapshot(ctx, sid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rev, err := revision.New().
+		NewID().
+		Scene(sid).
+		Action(action).
+		User(user).
+		Snapshot(s).
+		Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var prev *revision.Revision
+	if i.collaboration != nil {
+		if prev, err = i.revisionRepo.FindLatestByScene(ctx, sid); err != nil && !errors.Is(err, rerror.ErrNotFound) {
+			return nil, nil, err
+		}
+	}
+
+	if err := i.revisionRepo.Save(ctx, rev); err != nil {
+		return nil, nil, err
+	}
+	if err := i.revisionRepo.RemoveOldByScene(ctx, sid, maxRevisions); err != nil {
+		return nil, nil, err
+	}
+
+	if i.collaboration == nil {
+		return nil, rev, nil
+	}
+	ev := collaboration.NewEvent(rev, prev)
+	return &ev, rev, nil
+}
+
+// incrementSceneVersion checks the version attached to the context and increments the version of the scene.
+func (i commonRevision) incrementSceneVersion(ctx context.Context, sid id.SceneID) (int, error) {
+	sc, err := i.sceneRepo.FindByID(ctx, sid)
+	if err != nil {
+		return 0, err
+	}
+	if v := adapter.SceneVersion(ctx); v != nil && *v != sc.Version() {
+		return 0, interfaces.ErrSceneVersionConflict
+	}
+	sc.SetVersion(sc.Version() + 1)
+	if err := i.sceneRepo.Save(ctx, sc); err != nil {
+		return 0, err
+	}
+	return sc.Version(), nil
+}
+
+// PublishEvents notifies editors of the scenes of the events. Errors are only logged as the changes have already been committed.
+func (i commonRevision) PublishEvents(ctx context.Context, events ...*collaboration.Event) {
+	if i.collaboration == nil {
+		return
+	}
+	for _, ev := range events {
+		if ev == nil {
+			continue
+		}
+		if err := i.collaboration.Publish(ctx, *ev); err != nil {
+			log.Errorfc(ctx, "collaboration: failed to publish an event of scene %s: %s", ev.Scene, err)
+		}
+	}
+}
+
+// Filtered returns commonRevision that can also access scenes of the filter, such as a scene created in the same operation.
+func (i commonRevision) Filtered(f repo.SceneFilter) commonRevision {
+	if i.revisionRepo != nil {
+		i.revisionRepo = i.revisionRepo.Filtered(f)
+	}
+	i.nlsLayerRepo = i.nlsLayerRepo.Filtered(f)
+	i.styleRepo = i.styleRepo.Filtered(f)
+	i.storytellingRepo = i.storytellingRepo.Filtered(f)
+	i.propertyRepo = i.propertyRepo.Filtered(f)
+	return i
+}
+
+func (i commonRevision) snapshot(ctx context.Context, sid id.SceneID) (*revision.Snapshot, error) {
+	s, err := i.sceneRepo.FindByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := i.nlsLayerRepo.FindByScene(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	styles, err := i.styleRepo.FindByScene(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	stories, err := i.storytellingRepo.FindByScene(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+
+	pids := id.PropertyIDList(s.Properties())
+	for _, l := range layers {
+		if l != nil {
+			pids = append(pids, nlsLayerProperties(*l)...)
+		}
+	}
+	for _, st := range lo.FromPtr(stories) {
+		if st != nil {
+			pids = append(pids, st.Properties()...)
+		}
+	}
+
+	properties, err := i.propertyRepo.FindByIDs(ctx, lo.Uniq(pids))
+	if err != nil {
+		return nil, err
+	}
+
+	return revision.NewSnapshot(s, layers, lo.FromPtr(styles), lo.FromPtr(stories), properties), nil
+}
+
+func nlsLayerProperties(l nlslayer.NLSLayer) id.PropertyIDList {
+	var res id.PropertyIDList
+	if ib := l.Infobox(); ib != nil {
+		res = append(res, ib.Property())
+		for _, b := range ib.Blocks() {
+			if b != nil {
+				res = append(res, b.Property())
+			}
+		}
+	}
+	if po := l.PhotoOverlay(); po != nil {
+		res = append(res, po.Property())
+	}
+	return res
+}
+
+type Revision struct {
+	common
+	commonRevision
+	projectRepo repo.Project
+	transaction usecasex.Transaction
+}
+
+func NewRevision(r *repo.Container, g *gateway.Container) interfaces.Revision {
+	return &Revision{
+		commonRevision: newCommonRevision(r, g),
+		projectRepo:    r.Project,
+		transaction:    r.Transaction,
+	}
+}
+
+func (i *Revision) Fetch(ctx context.Context, rid id.RevisionID, op *usecase.Operator) (*revision.Revision, error) {
+	rev, err := i.revisionRepo.FindByID(ctx, rid)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CanReadScene(rev.Scene(), op); err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+func (i *Revision) FetchByScene(ctx context.Context, sid id.SceneID, p *usecasex.Pagination, op *usecase.Operator) (revision.List, *usecasex.PageInfo, error) {
+	if err := i.CanReadScene(sid, op); err != nil {
+		return nil, nil, err
+	}
+	if p == nil {
+		p = usecasex.CursorPagination{First: lo.ToPtr(int64(maxRevisions))}.Wrap()
+	}
+	return i.revisionRepo.FindByScene(ctx, sid, p)
+}
+
+func (i *Revision) Diff(ctx context.Context, inp interfaces.DiffRevisionsInput, op *usecase.Operator) ([]revision.Change, error) {
+	from, err := i.Fetch(ctx, inp.From, op)
+	if err != nil {
+		return nil, err
+	}
+
+	var to *revision.Snapshot
+	if inp.To != nil {
+		rev, err := i.Fetch(ctx, *inp.To, op)
+		if err != nil {
+			return nil, err
+		}
+		if rev.Scene() != from.Scene() {
+			return nil, rerror.ErrNotFound
+		}
+		to = rev.Snapshot()
+	} else if to, err = i.snapshot(ctx, from.Scene()); err != nil {
+		return nil, err
+	}
+
+	return revision.Diff(from.Snapshot(), to), nil
+}
+
+func (i *Revision) Restore(ctx context.Context, rid id.RevisionID, op *usecase.Operator) (_ *revision.Revision, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
+
+	rev, err := i.revisionRepo.FindByID(ctx, rid)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CanWriteScene(rev.Scene(), op); err != nil {
+		return nil, err
+	}
+
+	current, err := i.snapshot(ctx, rev.Scene())
+	if err != nil {
+		return nil, err
+	}
+	// the revision is copied so that it is not changed by saving objects
+	target := rev.Snapshot().Clone()
+	// the version is not restored as it keeps increasing
+	target.Scene().SetVersion(current.Scene().Version())
+
+	if err := i.restoreSnapshot(ctx, current, target); err != nil {
+		return nil, err
+	}
+
+	if err := updateProjectUpdatedAtByScene(ctx, rev.Scene(), i.projectRepo, i.sceneRepo); err != nil {
+		return nil, err
+	}
+
+	var res *revision.Revision
+	if ev, res, err = i.recordRevision(ctx, rev.Scene(), "restoreRevision", op); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return res, nil
+}
+
+// restoreSnapshot removes objects that do not exist in the target and saves objects of the target.
+func (i *Revision) restoreSnapshot(ctx context.Context, current, target *revision.Snapshot) error {
+	if err := i.sceneRepo.Save(ctx, target.Scene()); err != nil {
+		return err
+	}
+
+	if ids := lo.Without(current.NLSLayerIDs(), target.NLSLayerIDs()...); len(ids) > 0 {
+		if err := i.nlsLayerRepo.RemoveAll(ctx, ids); err != nil {
+			return err
+		}
+	}
+	if len(target.NLSLayers()) > 0 {
+		if err := i.nlsLayerRepo.SaveAll(ctx, target.NLSLayers()); err != nil {
+			return err
+		}
+	}
+
+	if ids := lo.Without(current.StyleIDs(), target.StyleIDs()...); len(ids) > 0 {
+		if err := i.styleRepo.RemoveAll(ctx, ids); err != nil {
+			return err
+		}
+	}
+	if len(target.Styles()) > 0 {
+		if err := i.styleRepo.SaveAll(ctx, target.Styles()); err != nil {
+			return err
+		}
+	}
+
+	if ids := lo.Without(current.StoryIDs(), target.StoryIDs()...); len(ids) > 0 {
+		if err := i.storytellingRepo.RemoveAll(ctx, ids); err != nil {
+			return err
+		}
+	}
+	if len(target.Stories()) > 0 {
+		if err := i.storytellingRepo.SaveAll(ctx, target.Stories()); err != nil {
+			return err
+		}
+	}
+
+	if ids := lo.Without(current.PropertyIDs(), target.PropertyIDs()...); len(ids) > 0 {
+		if err := i.propertyRepo.RemoveAll(ctx, ids); err != nil {
+			return err
+		}
+	}
+	if len(target.Properties()) > 0 {
+		if err := i.propertyRepo.SaveAll(ctx, target.Properties()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/reearth/reearth/server/internal/adapter"
+	"github.com/reearth/reearth/server/internal/infrastructure/broker"
+	"github.com/reearth/reearth/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/project"
+	"github.com/reearth/reearth/server/pkg/property"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevision_RecordDiffRestore(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+
+	uid := accountdomain.NewUserID()
+	op := &usecase.Operator{
+		AcOperator:     &accountusecase.Operator{User: &uid},
+		ReadableScenes: []id.SceneID{sce.ID()},
+		WritableScenes: []id.SceneID{sce.ID()},
+	}
+	styles := NewStyle(db, &gateway.Container{})
+	il := NewRevision(db, &gateway.Container{})
+
+	red, err := styles.AddStyle(ctx, interfaces.AddStyleInput{SceneID: sce.ID(), Name: "red", Value: &scene.StyleValue{"color": "red"}}, op)
+	require.NoError(t, err)
+	_, err = styles.UpdateStyle(ctx, interfaces.UpdateStyleInput{StyleID: red.ID(), Value: &scene.StyleValue{"color": "blue"}}, op)
+	require.NoError(t, err)
+	green, err := styles.AddStyle(ctx, interfaces.AddStyleInput{SceneID: sce.ID(), Name: "green", Value: &scene.StyleValue{}}, op)
+	require.NoError(t, err)
+
+	revs, _, err := il.FetchByScene(ctx, sce.ID(), nil, op)
+	require.NoError(t, err)
+	require.Len(t, revs, 3)
+	assert.Equal(t, []string{"addStyle", "updateStyle", "addStyle"}, []string{revs[2].Action(), revs[1].Action(), revs[0].Action()})
+	assert.Equal(t, &uid, revs[0].User())
+	first := revs[2]
+
+	changes, err := il.Diff(ctx, interfaces.DiffRevisionsInput{From: first.ID(), To: revs[1].ID().Ref()}, op)
+	require.NoError(t, err)
+	assert.Equal(t, []revision.Change{
+		{ObjectType: revision.ObjectTypeStyle, ID: red.ID().String(), Type: revision.ChangeTypeModified},
+	}, changes)
+
+	changes, err = il.Diff(ctx, interfaces.DiffRevisionsInput{From: first.ID()}, op)
+	require.NoError(t, err)
+	assert.Equal(t, []revision.Change{
+		{ObjectType: revision.ObjectTypeStyle, ID: red.ID().String(), Type: revision.ChangeTypeModified},
+		{ObjectType: revision.ObjectTypeStyle, ID: green.ID().String(), Type: revision.ChangeTypeAdded},
+	}, changes)
+
+	restored, err := il.Restore(ctx, first.ID(), op)
+	require.NoError(t, err)
+	assert.Equal(t, "restoreRevision", restored.Action())
+
+	current, err := styles.FetchByScene(ctx, sce.ID(), op)
+	require.NoError(t, err)
+	require.Len(t, *current, 1)
+	assert.Equal(t, red.ID(), (*current)[0].ID())
+	assert.Equal(t, &scene.StyleValue{"color": "red"}, (*current)[0].Value())
+
+	changes, err = il.Diff(ctx, interfaces.DiffRevisionsInput{From: first.ID()}, op)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// the restored revision is not changed by later changes
+	_, err = styles.UpdateStyle(ctx, interfaces.UpdateStyleInput{StyleID: red.ID(), Value: &scene.StyleValue{"color": "yellow"}}, op)
+	require.NoError(t, err)
+	rev, err := il.Fetch(ctx, first.ID(), op)
+	require.NoError(t, err)
+	assert.Equal(t, &scene.StyleValue{"color": "red"}, rev.Snapshot().Styles()[0].Value())
+
+	_, err = il.Restore(ctx, first.ID(), &usecase.Operator{ReadableScenes: []id.SceneID{sce.ID()}})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+}
+
+func TestRevision_VersionAndEvents(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+
+	uid := accountdomain.NewUserID()
+	op := &usecase.Operator{
+		AcOperator:     &accountusecase.Operator{User: &uid},
+		ReadableScenes: []id.SceneID{sce.ID()},
+		WritableScenes: []id.SceneID{sce.ID()},
+	}
+	gw := &gateway.Container{Collaboration: broker.NewCollaboration()}
+	styles := NewStyle(db, gw)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := NewCollaboration(db, gw).SubscribeEvents(subCtx, sce.ID(), op)
+	require.NoError(t, err)
+
+	s, err := styles.AddStyle(adapter.AttachSceneVersion(ctx, 0), interfaces.AddStyleInput{SceneID: sce.ID(), Name: "a", Value: &scene.StyleValue{}}, op)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sce.Version())
+
+	e := <-events
+	assert.Equal(t, 1, e.Version)
+	assert.Equal(t, "addStyle", e.Action)
+	assert.Equal(t, &uid, e.User)
+	assert.Contains(t, e.Changes, revision.Change{ObjectType: revision.ObjectTypeStyle, ID: s.ID().String(), Type: revision.ChangeTypeAdded})
+
+	// the client has not received the change of version 1
+	_, err = styles.UpdateStyle(adapter.AttachSceneVersion(ctx, 0), interfaces.UpdateStyleInput{StyleID: s.ID(), Name: lo.ToPtr("b")}, op)
+	assert.ErrorIs(t, err, interfaces.ErrSceneVersionConflict)
+	assert.Equal(t, 1, sce.Version())
+
+	_, err = styles.UpdateStyle(adapter.AttachSceneVersion(ctx, 1), interfaces.UpdateStyleInput{StyleID: s.ID(), Name: lo.ToPtr("b")}, op)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sce.Version())
+
+	e = <-events
+	assert.Equal(t, 2, e.Version)
+	assert.Equal(t, []revision.Change{
+		{ObjectType: revision.ObjectTypeStyle, ID: s.ID().String(), Type: revision.ChangeTypeModified},
+	}, e.Changes)
+
+	// the version is not checked without the version in the context
+	_, err = styles.RemoveStyle(ctx, s.ID(), op)
+	require.NoError(t, err)
+	assert.Equal(t, 3, sce.Version())
+}
+
+func TestRevision_PropertyEdit(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+
+	psf := property.NewSchemaField().ID("field").Type(property.ValueTypeString).MustBuild()
+	psg := property.NewSchemaGroup().ID("default").Fields([]*property.SchemaField{psf}).MustBuild()
+	ps := property.NewSchema().ID(id.MustPropertySchemaID("xxx~1.1.1/aa")).
+		Groups(property.NewSchemaGroupList([]*property.SchemaGroup{psg})).
+		MustBuild()
+	p := property.New().NewID().Scene(sce.ID()).Schema(ps.ID()).MustBuild()
+	_ = db.PropertySchema.Save(ctx, ps)
+	_ = db.Property.Save(ctx, p)
+
+	uid := accountdomain.NewUserID()
+	op := &usecase.Operator{
+		AcOperator:     &accountusecase.Operator{User: &uid},
+		ReadableScenes: []id.SceneID{sce.ID()},
+		WritableScenes: []id.SceneID{sce.ID()},
+	}
+
+	_, _, _, _, err := NewProperty(db, &gateway.Container{}).UpdateValue(ctx, interfaces.UpdatePropertyValueParam{
+		PropertyID: p.ID(),
+		Pointer:    property.PointFieldBySchemaGroup(psg.ID(), psf.ID()),
+		Value:      property.ValueTypeString.ValueFrom("aaaa"),
+	}, op)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sce.Version())
+
+	revs, _, err := NewRevision(db, &gateway.Container{}).FetchByScene(ctx, sce.ID(), nil, op)
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+	assert.Equal(t, "updatePropertyValue", revs[0].Action())
+	assert.Equal(t, &uid, revs[0].User())
+}
+
+func TestRevision_Retention(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+	op := &usecase.Operator{
+		ReadableScenes: []id.SceneID{sce.ID()},
+		WritableScenes: []id.SceneID{sce.ID()},
+	}
+
+	il := NewRevision(db, &gateway.Container{}).(*Revision)
+	var first *revision.Revision
+	for i := 0; i < maxRevisions+5; i++ {
+		_, rev, err := il.recordRevision(ctx, sce.ID(), "updateScene", op)
+		require.NoError(t, err)
+		if first == nil {
+			first = rev
+		}
+	}
+
+	revs, pi, err := il.FetchByScene(ctx, sce.ID(), usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap(), op)
+	require.NoError(t, err)
+	assert.Len(t, revs, 10)
+	assert.Equal(t, int64(maxRevisions), pi.TotalCount)
+	assert.True(t, pi.HasNextPage)
+
+	_, err = il.Fetch(ctx, first.ID(), op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
+
+func TestRevision_EventsAfterCommit(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	// the version is checked and events are published even when revisions are not stored
+	db.Revision = nil
+	tx := &usecasex.NopTransaction{CommitError: errors.New("commit failed")}
+	db.Transaction = tx
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+	op := &usecase.Operator{
+		ReadableScenes: []id.SceneID{sce.ID()},
+		WritableScenes: []id.SceneID{sce.ID()},
+	}
+	gw := &gateway.Container{Collaboration: broker.NewCollaboration()}
+	styles := NewStyle(db, gw)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := NewCollaboration(db, gw).SubscribeEvents(subCtx, sce.ID(), op)
+	require.NoError(t, err)
+
+	_, err = styles.AddStyle(ctx, interfaces.AddStyleInput{SceneID: sce.ID(), Name: "a", Value: &scene.StyleValue{}}, op)
+	assert.EqualError(t, err, "commit failed")
+	assert.Empty(t, events)
+	// changes are not rolled back by the transaction of the test
+	assert.Equal(t, 1, sce.Version())
+
+	tx.CommitError = nil
+	_, err = styles.AddStyle(adapter.AttachSceneVersion(ctx, 0), interfaces.AddStyleInput{SceneID: sce.ID(), Name: "a", Value: &scene.StyleValue{}}, op)
+	assert.ErrorIs(t, err, interfaces.ErrSceneVersionConflict)
+	assert.Empty(t, events)
+
+	_, err = styles.AddStyle(adapter.AttachSceneVersion(ctx, 1), interfaces.AddStyleInput{SceneID: sce.ID(), Name: "a", Value: &scene.StyleValue{}}, op)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	e := <-events
+	assert.Equal(t, 2, e.Version)
+	assert.Equal(t, "addStyle", e.Action)
+	assert.Empty(t, e.Changes)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth/server/internal/usecase/repo"
 	"github.com/reearth/reearth/server/pkg/builtin"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/plugin"
 	"github.com/reearth/reearth/server/pkg/project"
@@ -27,6 +28,7 @@ import (
 
 type Scene struct {
 	common
+	commonRevision
 	assetRepo          repo.Asset
 	sceneRepo          repo.Scene
 	propertyRepo       repo.Property
@@ -44,6 +46,7 @@ type Scene struct {
 
 func NewScene(r *repo.Container, g *gateway.Container) interfaces.Scene {
 	return &Scene{
+		commonRevision:     newCommonRevision(r, g),
 		assetRepo:          r.Asset,
 		sceneRepo:          r.Scene,
 		propertyRepo:       r.Property,
@@ -97,10 +100,14 @@ func (i *Scene) Create(ctx context.Context, pid id.ProjectID, defaultExtensionWi
 		return
 	}
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	prj, err := i.projectRepo.FindByID(ctx, pid)
@@ -149,6 +156,10 @@ func (i *Scene) Create(ctx context.Context, pid id.ProjectID, defaultExtensionWi
 		return nil, err
 	}
 
+	if ev, err = i.Filtered(Filter(sceneID)).RecordRevision(ctx, sceneID, "createScene", operator); err != nil {
+		return nil, err
+	}
+
 	operator.AddNewScene(ws, sceneID)
 	tx.Commit()
 	return res, nil
@@ -243,10 +254,14 @@ func (i *Scene) AddWidget(ctx context.Context, sid id.SceneID, pid id.PluginID,
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	s, err := i.sceneRepo.FindByID(ctx, sid)
@@ -318,6 +333,10 @@ func (i *Scene) AddWidget(ctx context.Context, sid id.SceneID, pid id.PluginID,
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, s.ID(), "addWidget", operator); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return s, widget, nil
 }
@@ -329,10 +348,14 @@ func (i *Scene) UpdateWidget(ctx context.Context, param interfaces.UpdateWidgetP
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	scene, err2 := i.sceneRepo.FindByID(ctx, param.SceneID)
@@ -395,6 +418,10 @@ func (i *Scene) UpdateWidget(ctx context.Context, param interfaces.UpdateWidgetP
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, scene.ID(), "updateWidget", operator); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return scene, widget, nil
 }
@@ -406,10 +433,14 @@ func (i *Scene) UpdateWidgetAlignSystem(ctx context.Context, param interfaces.Up
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	s, err2 := i.sceneRepo.FindByID(ctx, param.SceneID)
@@ -452,6 +483,10 @@ func (i *Scene) UpdateWidgetAlignSystem(ctx context.Context, param interfaces.Up
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, s.ID(), "updateWidgetAlignSystem", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return s, nil
 }
@@ -463,10 +498,14 @@ func (i *Scene) RemoveWidget(ctx context.Context, id id.SceneID, wid id.WidgetID
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	scene, err2 := i.sceneRepo.FindByID(ctx, id)
@@ -502,6 +541,10 @@ func (i *Scene) RemoveWidget(ctx context.Context, id id.SceneID, wid id.WidgetID
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, scene.ID(), "removeWidget", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return scene, nil
 }
@@ -555,7 +598,22 @@ func Filter(s id.SceneID) repo.SceneFilter {
 	return repo.SceneFilter{Readable: id.SceneIDList{s}, Writable: id.SceneIDList{s}}
 }
 
-func (i *Scene) ImportScene(ctx context.Context, sce *scene.Scene, data *[]byte) (*scene.Scene, error) {
+func (i *Scene) ImportScene(ctx context.Context, sce *scene.Scene, data *[]byte, operator *usecase.Operator) (_ *scene.Scene, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
 
 	sceneJSON, err := builder.ParseSceneJSONByByte(data)
 	if err != nil {
@@ -625,6 +683,11 @@ func (i *Scene) ImportScene(ctx context.Context, sce *scene.Scene, data *[]byte)
 	if err != nil {
 		return nil, err
 	}
+	if ev, err = i.Filtered(filter).RecordRevision(ctx, sce.ID(), "importScene", operator); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return result, nil
 }
 
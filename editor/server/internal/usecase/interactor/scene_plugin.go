@@ -7,6 +7,7 @@ import (
 	"github.com/reearth/reearth/server/internal/usecase"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/property"
 	"github.com/reearth/reearth/server/pkg/scene"
@@ -21,10 +22,14 @@ func (i *Scene) InstallPlugin(ctx context.Context, sid id.SceneID, pid id.Plugin
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	s, err := i.sceneRepo.FindByID(ctx, sid)
@@ -75,6 +80,10 @@ func (i *Scene) InstallPlugin(ctx context.Context, sid id.SceneID, pid id.Plugin
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, sid, "installPlugin", operator); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return s, p.IDRef(), nil
 }
@@ -90,10 +99,14 @@ func (i *Scene) UninstallPlugin(ctx context.Context, sid id.SceneID, pid id.Plug
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	scene, err := i.sceneRepo.FindByID(ctx, sid)
@@ -153,6 +166,10 @@ func (i *Scene) UninstallPlugin(ctx context.Context, sid id.SceneID, pid id.Plug
 		}
 	}
 
+	if ev, err = i.RecordRevision(ctx, sid, "uninstallPlugin", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return scene, nil
 }
@@ -164,10 +181,14 @@ func (i *Scene) UpgradePlugin(ctx context.Context, sid id.SceneID, oldPluginID,
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	s, err := i.sceneRepo.FindByID(ctx, sid)
@@ -213,6 +234,10 @@ func (i *Scene) UpgradePlugin(ctx context.Context, sid id.SceneID, oldPluginID,
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, sid, "upgradePlugin", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return result.Scene, err
 }
@@ -110,6 +110,7 @@ func TestScene_InstallPlugin(t *testing.T) {
 			prr := memory.NewProperty()
 
 			uc := &Scene{
+				commonRevision: commonRevision{sceneRepo: sr},
 				sceneRepo:      sr,
 				pluginRepo:     pr,
 				pluginRegistry: &mockPluginRegistry{},
@@ -233,6 +234,7 @@ func TestScene_UninstallPlugin(t *testing.T) {
 			fsg, _ := fs.NewFile(afero.NewMemMapFs(), "")
 
 			uc := &Scene{
+				commonRevision:     commonRevision{sceneRepo: sr},
 				sceneRepo:          sr,
 				pluginRepo:         pr,
 				propertyRepo:       prr,
@@ -366,6 +368,7 @@ func TestScene_UpgradePlugin(t *testing.T) {
 			sr := memory.NewSceneWith(sc)
 
 			uc := &Scene{
+				commonRevision:     commonRevision{sceneRepo: sr},
 				sceneRepo:          sr,
 				pluginRepo:         pr,
 				propertyRepo:       prr,
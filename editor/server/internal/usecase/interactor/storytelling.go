@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth/server/internal/usecase/repo"
 	"github.com/reearth/reearth/server/pkg/builtin"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/plugin"
 	"github.com/reearth/reearth/server/pkg/property"
@@ -26,6 +27,7 @@ import (
 
 type Storytelling struct {
 	common
+	commonRevision
 	commonSceneLock
 	storytellingRepo repo.Storytelling
 	pluginRepo       repo.Plugin
@@ -44,6 +46,7 @@ type Storytelling struct {
 
 func NewStorytelling(r *repo.Container, gr *gateway.Container) interfaces.Storytelling {
 	return &Storytelling{
+		commonRevision:   newCommonRevision(r, gr),
 		commonSceneLock:  commonSceneLock{sceneLockRepo: r.SceneLock},
 		storytellingRepo: r.Storytelling,
 		pluginRepo:       r.Plugin,
@@ -69,17 +72,21 @@ func (i *Storytelling) FetchByScene(ctx context.Context, sid id.SceneID, _ *usec
 	return i.storytellingRepo.FindByScene(ctx, sid)
 }
 
-func (i *Storytelling) Create(ctx context.Context, inp interfaces.CreateStoryInput, op *usecase.Operator) (*storytelling.Story, error) {
+func (i *Storytelling) Create(ctx context.Context, inp interfaces.CreateStoryInput, op *usecase.Operator) (_ *storytelling.Story, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	if err := i.CanWriteScene(inp.SceneID, op); err != nil {
@@ -104,8 +111,12 @@ func (i *Storytelling) Create(ctx context.Context, inp interfaces.CreateStoryInp
 		return nil, err
 	}
 
-	// TODO: Handel ordering
-	if err := i.storytellingRepo.Save(ctx, *story); err != nil {
+	stories, err := i.storytellingRepo.FindByScene(ctx, inp.SceneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.storytellingRepo.SaveAll(ctx, lo.FromPtr(stories).AddAt(story, inp.Index)); err != nil {
 		return nil, err
 	}
 
@@ -114,21 +125,29 @@ func (i *Storytelling) Create(ctx context.Context, inp interfaces.CreateStoryInp
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "create", op); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return story, nil
 }
 
-func (i *Storytelling) Update(ctx context.Context, inp interfaces.UpdateStoryInput, op *usecase.Operator) (*storytelling.Story, error) {
+func (i *Storytelling) Update(ctx context.Context, inp interfaces.UpdateStoryInput, op *usecase.Operator) (_ *storytelling.Story, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -188,10 +207,16 @@ func (i *Storytelling) Update(ctx context.Context, inp interfaces.UpdateStoryInp
 		}
 	}
 
-	// TODO: Handel ordering
-
-	err = i.storytellingRepo.Save(ctx, *story)
-	if err != nil {
+	if inp.Index != nil {
+		stories, err := i.storytellingRepo.FindByScene(ctx, story.Scene())
+		if err != nil {
+			return nil, err
+		}
+		// the story in the list is replaced with the updated one
+		if err := i.storytellingRepo.SaveAll(ctx, lo.FromPtr(stories).Remove(story.Id()).AddAt(story, inp.Index)); err != nil {
+			return nil, err
+		}
+	} else if err := i.storytellingRepo.Save(ctx, *story); err != nil {
 		return nil, err
 	}
 
@@ -209,21 +234,29 @@ func (i *Storytelling) Update(ctx context.Context, inp interfaces.UpdateStoryInp
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "update", op); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return story, nil
 }
 
-func (i *Storytelling) Remove(ctx context.Context, inp interfaces.RemoveStoryInput, op *usecase.Operator) (*id.StoryID, error) {
+func (i *Storytelling) Remove(ctx context.Context, inp interfaces.RemoveStoryInput, op *usecase.Operator) (_ *id.StoryID, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -234,31 +267,47 @@ func (i *Storytelling) Remove(ctx context.Context, inp interfaces.RemoveStoryInp
 		return nil, err
 	}
 
-	// TODO: Handel ordering
+	stories, err := i.storytellingRepo.FindByScene(ctx, story.Scene())
+	if err != nil {
+		return nil, err
+	}
 
 	if err := i.storytellingRepo.Remove(ctx, inp.StoryID); err != nil {
 		return nil, err
 	}
 
+	if err := i.storytellingRepo.SaveAll(ctx, lo.FromPtr(stories).Remove(inp.StoryID)); err != nil {
+		return nil, err
+	}
+
 	err = updateProjectUpdatedAtByScene(ctx, story.Scene(), i.projectRepo, i.sceneRepo)
 	if err != nil {
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "remove", op); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return &inp.StoryID, nil
 }
 
-func (i *Storytelling) Publish(ctx context.Context, inp interfaces.PublishStoryInput, op *usecase.Operator) (*storytelling.Story, error) {
+func (i *Storytelling) Publish(ctx context.Context, inp interfaces.PublishStoryInput, op *usecase.Operator) (_ *storytelling.Story, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.ID)
@@ -390,25 +439,80 @@ func (i *Storytelling) Publish(ctx context.Context, inp interfaces.PublishStoryI
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "publish", op); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return story, nil
 }
 
-func (i *Storytelling) Move(_ context.Context, _ interfaces.MoveStoryInput, _ *usecase.Operator) (*id.StoryID, int, error) {
-	return nil, 0, rerror.ErrNotImplemented
+func (i *Storytelling) Move(ctx context.Context, inp interfaces.MoveStoryInput, op *usecase.Operator) (_ *id.StoryID, _ int, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
+
+	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := i.CanWriteScene(story.Scene(), op); err != nil {
+		return nil, 0, interfaces.ErrOperationDenied
+	}
+	if !inp.SceneID.IsEmpty() && inp.SceneID != story.Scene() {
+		return nil, 0, rerror.ErrNotFound
+	}
+
+	stories, err := i.storytellingRepo.FindByScene(ctx, story.Scene())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	moved := lo.FromPtr(stories).Move(story.Id(), inp.Index)
+	if err := i.storytellingRepo.SaveAll(ctx, moved); err != nil {
+		return nil, 0, err
+	}
+
+	err = updateProjectUpdatedAtByScene(ctx, story.Scene(), i.projectRepo, i.sceneRepo)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "move", op); err != nil {
+		return nil, 0, err
+	}
+
+	tx.Commit()
+	return story.Id().Ref(), moved.IndexOf(story.Id()), nil
 }
 
-func (i *Storytelling) CreatePage(ctx context.Context, inp interfaces.CreatePageParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, error) {
+func (i *Storytelling) CreatePage(ctx context.Context, inp interfaces.CreatePageParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	if err := i.CanWriteScene(inp.SceneID, op); err != nil {
@@ -484,21 +588,29 @@ func (i *Storytelling) CreatePage(ctx context.Context, inp interfaces.CreatePage
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "createPage", op); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return story, page, nil
 }
 
-func (i *Storytelling) UpdatePage(ctx context.Context, inp interfaces.UpdatePageParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, error) {
+func (i *Storytelling) UpdatePage(ctx context.Context, inp interfaces.UpdatePageParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	if err := i.CanWriteScene(inp.SceneID, op); err != nil {
@@ -542,21 +654,29 @@ func (i *Storytelling) UpdatePage(ctx context.Context, inp interfaces.UpdatePage
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "updatePage", op); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return story, page, nil
 }
 
-func (i *Storytelling) RemovePage(ctx context.Context, inp interfaces.RemovePageParam, op *usecase.Operator) (*storytelling.Story, *id.PageID, error) {
+func (i *Storytelling) RemovePage(ctx context.Context, inp interfaces.RemovePageParam, op *usecase.Operator) (_ *storytelling.Story, _ *id.PageID, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	if err := i.CanWriteScene(inp.SceneID, op); err != nil {
@@ -584,21 +704,29 @@ func (i *Storytelling) RemovePage(ctx context.Context, inp interfaces.RemovePage
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "removePage", op); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return story, page.Id().Ref(), nil
 }
 
-func (i *Storytelling) MovePage(ctx context.Context, inp interfaces.MovePageParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, int, error) {
+func (i *Storytelling) MovePage(ctx context.Context, inp interfaces.MovePageParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, _ int, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, 0, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -626,21 +754,29 @@ func (i *Storytelling) MovePage(ctx context.Context, inp interfaces.MovePagePara
 		return nil, nil, 0, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "movePage", op); err != nil {
+		return nil, nil, 0, err
+	}
+
 	tx.Commit()
 	return story, page, inp.Index, nil
 }
 
-func (i *Storytelling) DuplicatePage(ctx context.Context, inp interfaces.DuplicatePageParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, error) {
+func (i *Storytelling) DuplicatePage(ctx context.Context, inp interfaces.DuplicatePageParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -669,21 +805,29 @@ func (i *Storytelling) DuplicatePage(ctx context.Context, inp interfaces.Duplica
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "duplicatePage", op); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return story, dupPage, nil
 }
 
-func (i *Storytelling) AddPageLayer(ctx context.Context, inp interfaces.PageLayerParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, error) {
+func (i *Storytelling) AddPageLayer(ctx context.Context, inp interfaces.PageLayerParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -719,21 +863,29 @@ func (i *Storytelling) AddPageLayer(ctx context.Context, inp interfaces.PageLaye
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "addPageLayer", op); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return story, page, nil
 }
 
-func (i *Storytelling) RemovePageLayer(ctx context.Context, inp interfaces.PageLayerParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, error) {
+func (i *Storytelling) RemovePageLayer(ctx context.Context, inp interfaces.PageLayerParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -769,21 +921,29 @@ func (i *Storytelling) RemovePageLayer(ctx context.Context, inp interfaces.PageL
 		return nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "removePageLayer", op); err != nil {
+		return nil, nil, err
+	}
+
 	tx.Commit()
 	return story, page, nil
 }
 
-func (i *Storytelling) CreateBlock(ctx context.Context, inp interfaces.CreateBlockParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, *storytelling.Block, int, error) {
+func (i *Storytelling) CreateBlock(ctx context.Context, inp interfaces.CreateBlockParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, _ *storytelling.Block, _ int, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, nil, -1, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -868,21 +1028,29 @@ func (i *Storytelling) CreateBlock(ctx context.Context, inp interfaces.CreateBlo
 		return nil, nil, nil, -1, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "createBlock", op); err != nil {
+		return nil, nil, nil, -1, err
+	}
+
 	tx.Commit()
 	return story, page, block, 1, err
 }
 
-func (i *Storytelling) RemoveBlock(ctx context.Context, inp interfaces.RemoveBlockParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, *id.BlockID, error) {
+func (i *Storytelling) RemoveBlock(ctx context.Context, inp interfaces.RemoveBlockParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, _ *id.BlockID, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -918,21 +1086,29 @@ func (i *Storytelling) RemoveBlock(ctx context.Context, inp interfaces.RemoveBlo
 		return nil, nil, nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "removeBlock", op); err != nil {
+		return nil, nil, nil, err
+	}
+
 	tx.Commit()
 	return story, page, &inp.BlockID, nil
 }
 
-func (i *Storytelling) MoveBlock(ctx context.Context, inp interfaces.MoveBlockParam, op *usecase.Operator) (*storytelling.Story, *storytelling.Page, *id.BlockID, int, error) {
+func (i *Storytelling) MoveBlock(ctx context.Context, inp interfaces.MoveBlockParam, op *usecase.Operator) (_ *storytelling.Story, _ *storytelling.Page, _ *id.BlockID, _ int, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, nil, nil, inp.Index, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	story, err := i.storytellingRepo.FindByID(ctx, inp.StoryID)
@@ -963,11 +1139,30 @@ func (i *Storytelling) MoveBlock(ctx context.Context, inp interfaces.MoveBlockPa
 		return nil, nil, nil, inp.Index, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, story.Scene(), "moveBlock", op); err != nil {
+		return nil, nil, nil, inp.Index, err
+	}
+
 	tx.Commit()
 	return story, page, &inp.BlockID, inp.Index, nil
 }
 
-func (i *Storytelling) ImportStory(ctx context.Context, sceneID id.SceneID, data *[]byte) (*storytelling.Story, error) {
+func (i *Storytelling) ImportStory(ctx context.Context, sceneID id.SceneID, data *[]byte, operator *usecase.Operator) (_ *storytelling.Story, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
 
 	sceneJSON, err := builder.ParseSceneJSONByByte(data)
 	if err != nil {
@@ -1081,6 +1276,11 @@ func (i *Storytelling) ImportStory(ctx context.Context, sceneID id.SceneID, data
 	if err != nil {
 		return nil, err
 	}
+	if ev, err = i.Filtered(filter).RecordRevision(ctx, sceneID, "importStory", operator); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return result, nil
 }
 
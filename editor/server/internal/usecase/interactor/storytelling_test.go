@@ -0,0 +1,73 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/project"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/storytelling"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorytelling_Ordering(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	prj, _ := project.New().NewID().Build()
+	_ = db.Project.Save(ctx, prj)
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+
+	il := NewStorytelling(db, &gateway.Container{})
+	op := &usecase.Operator{
+		ReadableScenes: []id.SceneID{sce.ID()},
+		WritableScenes: []id.SceneID{sce.ID()},
+	}
+	titles := func() []string {
+		l, err := il.FetchByScene(ctx, sce.ID(), op)
+		require.NoError(t, err)
+		return lo.Map(*l, func(s *storytelling.Story, i int) string {
+			assert.Equal(t, i, s.Index())
+			return s.Title()
+		})
+	}
+
+	residents, err := il.Create(ctx, interfaces.CreateStoryInput{SceneID: sce.ID(), Title: "residents"}, op)
+	require.NoError(t, err)
+	planners, err := il.Create(ctx, interfaces.CreateStoryInput{SceneID: sce.ID(), Title: "planners"}, op)
+	require.NoError(t, err)
+	_, err = il.Create(ctx, interfaces.CreateStoryInput{SceneID: sce.ID(), Title: "disaster", Index: lo.ToPtr(0)}, op)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"disaster", "residents", "planners"}, titles())
+
+	sid, i, err := il.Move(ctx, interfaces.MoveStoryInput{SceneID: sce.ID(), StoryID: planners.Id(), Index: 0}, op)
+	require.NoError(t, err)
+	assert.Equal(t, planners.Id(), *sid)
+	assert.Equal(t, 0, i)
+	assert.Equal(t, []string{"planners", "disaster", "residents"}, titles())
+
+	_, i, err = il.Move(ctx, interfaces.MoveStoryInput{SceneID: sce.ID(), StoryID: planners.Id(), Index: 10}, op)
+	require.NoError(t, err)
+	assert.Equal(t, 2, i)
+	assert.Equal(t, []string{"disaster", "residents", "planners"}, titles())
+
+	_, err = il.Update(ctx, interfaces.UpdateStoryInput{StoryID: residents.Id(), Title: lo.ToPtr("residents2"), Index: lo.ToPtr(0)}, op)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"residents2", "disaster", "planners"}, titles())
+
+	_, err = il.Remove(ctx, interfaces.RemoveStoryInput{StoryID: residents.Id()}, op)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"disaster", "planners"}, titles())
+
+	_, _, err = il.Move(ctx, interfaces.MoveStoryInput{SceneID: sce.ID(), StoryID: planners.Id(), Index: 0}, &usecase.Operator{})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+}
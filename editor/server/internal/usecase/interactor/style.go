@@ -5,29 +5,39 @@ import (
 	"context"
 
 	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
 	"github.com/reearth/reearth/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/collaboration"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/scene"
 	"github.com/reearth/reearth/server/pkg/scene/builder"
+	"github.com/reearth/reearth/server/pkg/scene/styleexpr"
 	"github.com/reearth/reearthx/idx"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 )
 
 type Style struct {
 	common
+	commonRevision
 	commonSceneLock
-	styleRepo     repo.Style
-	projectRepo   repo.Project
-	sceneRepo     repo.Scene
-	sceneLockRepo repo.SceneLock
-	transaction   usecasex.Transaction
+	styleRepo       repo.Style
+	stylePresetRepo repo.StylePreset
+	nlsLayerRepo    repo.NLSLayer
+	projectRepo     repo.Project
+	sceneRepo       repo.Scene
+	sceneLockRepo   repo.SceneLock
+	transaction     usecasex.Transaction
 }
 
-func NewStyle(r *repo.Container) interfaces.Style {
+func NewStyle(r *repo.Container, g *gateway.Container) interfaces.Style {
 	return &Style{
+		commonRevision:  newCommonRevision(r, g),
 		commonSceneLock: commonSceneLock{sceneLockRepo: r.SceneLock},
 		styleRepo:       r.Style,
+		stylePresetRepo: r.StylePreset,
+		nlsLayerRepo:    r.NLSLayer,
 		projectRepo:     r.Project,
 		sceneRepo:       r.Scene,
 		sceneLockRepo:   r.SceneLock,
@@ -43,17 +53,21 @@ func (i *Style) FetchByScene(ctx context.Context, sid id.SceneID, _ *usecase.Ope
 	return i.styleRepo.FindByScene(ctx, sid)
 }
 
-func (i *Style) AddStyle(ctx context.Context, param interfaces.AddStyleInput, operator *usecase.Operator) (*scene.Style, error) {
+func (i *Style) AddStyle(ctx context.Context, param interfaces.AddStyleInput, operator *usecase.Operator) (_ *scene.Style, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	// if err := i.CanWriteScene(param.SceneID, operator); err != nil {
@@ -69,6 +83,20 @@ func (i *Style) AddStyle(ctx context.Context, param interfaces.AddStyleInput, op
 		return nil, err
 	}
 
+	if param.Preset != nil {
+		preset, err := i.findPresetForScene(ctx, *param.Preset, param.SceneID)
+		if err != nil {
+			return nil, err
+		}
+		style.LinkPreset(preset)
+	} else if param.Value == nil {
+		return nil, interfaces.ErrStyleValueRequired
+	}
+
+	if err := validateStyleValue(style.Value()); err != nil {
+		return nil, err
+	}
+
 	if err := i.styleRepo.Save(ctx, *style); err != nil {
 		return nil, err
 	}
@@ -78,11 +106,15 @@ func (i *Style) AddStyle(ctx context.Context, param interfaces.AddStyleInput, op
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, style.Scene(), "addStyle", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return style, nil
 }
 
-func (i *Style) UpdateStyle(ctx context.Context, param interfaces.UpdateStyleInput, operator *usecase.Operator) (*scene.Style, error) {
+func (i *Style) UpdateStyle(ctx context.Context, param interfaces.UpdateStyleInput, operator *usecase.Operator) (_ *scene.Style, err error) {
 
 	tx, err := i.transaction.Begin(ctx)
 
@@ -92,10 +124,14 @@ func (i *Style) UpdateStyle(ctx context.Context, param interfaces.UpdateStyleInp
 
 	ctx = tx.Context()
 
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	style, err := i.styleRepo.FindByID(ctx, param.StyleID)
@@ -108,7 +144,11 @@ func (i *Style) UpdateStyle(ctx context.Context, param interfaces.UpdateStyleInp
 	}
 
 	if param.Value != nil {
+		if err := validateStyleValue(param.Value); err != nil {
+			return nil, err
+		}
 		style.UpdateValue(param.Value)
+		style.UnlinkPreset()
 	}
 
 	if err := i.styleRepo.Save(ctx, *style); err != nil {
@@ -120,6 +160,10 @@ func (i *Style) UpdateStyle(ctx context.Context, param interfaces.UpdateStyleInp
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, style.Scene(), "updateStyle", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return style, nil
 }
@@ -131,10 +175,14 @@ func (i *Style) RemoveStyle(ctx context.Context, styleID id.StyleID, operator *u
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	s, err := i.styleRepo.FindByID(ctx, styleID)
@@ -160,21 +208,29 @@ func (i *Style) RemoveStyle(ctx context.Context, styleID id.StyleID, operator *u
 		return styleID, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, s.Scene(), "removeStyle", operator); err != nil {
+		return styleID, err
+	}
+
 	tx.Commit()
 	return styleID, nil
 }
 
-func (i *Style) DuplicateStyle(ctx context.Context, styleID id.StyleID, operator *usecase.Operator) (*scene.Style, error) {
+func (i *Style) DuplicateStyle(ctx context.Context, styleID id.StyleID, operator *usecase.Operator) (_ *scene.Style, err error) {
 	tx, err := i.transaction.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx = tx.Context()
+	var ev *collaboration.Event
 	defer func() {
 		if err2 := tx.End(ctx); err == nil && err2 != nil {
 			err = err2
 		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
 	}()
 
 	style, err := i.styleRepo.FindByID(ctx, styleID)
@@ -197,11 +253,30 @@ func (i *Style) DuplicateStyle(ctx context.Context, styleID id.StyleID, operator
 		return nil, err
 	}
 
+	if ev, err = i.RecordRevision(ctx, style.Scene(), "duplicateStyle", operator); err != nil {
+		return nil, err
+	}
+
 	tx.Commit()
 	return duplicatedStyle, nil
 }
 
-func (i *Style) ImportStyles(ctx context.Context, sceneID idx.ID[id.Scene], data *[]byte) (scene.StyleList, error) {
+func (i *Style) ImportStyles(ctx context.Context, sceneID idx.ID[id.Scene], data *[]byte, operator *usecase.Operator) (_ scene.StyleList, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	var ev *collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, ev)
+		}
+	}()
 
 	sceneJSON, err := builder.ParseSceneJSONByByte(data)
 	if err != nil {
@@ -250,5 +325,51 @@ func (i *Style) ImportStyles(ctx context.Context, sceneID idx.ID[id.Scene], data
 	if err != nil {
 		return nil, err
 	}
+	if ev, err = i.Filtered(filter).RecordRevision(ctx, sceneID, "importStyles", operator); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
 	return *results, nil
 }
+
+func (i *Style) ValidateStyle(ctx context.Context, inp interfaces.ValidateStyleInput, operator *usecase.Operator) (styleexpr.Issues, error) {
+	layer, err := i.nlsLayerRepo.FindByID(ctx, inp.Layer)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CanReadScene(layer.Scene(), operator); err != nil {
+		return nil, err
+	}
+
+	var attributes []string
+	if layer.IsSketch() {
+		attributes = layer.Sketch().Attributes()
+	}
+	return styleexpr.Validate(inp.Value, attributes), nil
+}
+
+// findPresetForScene returns the preset only when it belongs to the workspace of the scene.
+func (i *Style) findPresetForScene(ctx context.Context, pid id.StylePresetID, sid id.SceneID) (*scene.StylePreset, error) {
+	preset, err := i.stylePresetRepo.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	s, err := i.sceneRepo.FindByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	if preset.Workspace() != s.Workspace() {
+		return nil, rerror.ErrNotFound
+	}
+	return preset, nil
+}
+
+// validateStyleValue rejects styles with syntax errors in their expressions, which break the map when they are published.
+// Attributes are not checked here as a style can be shared by layers with different attributes.
+func validateStyleValue(v *scene.StyleValue) error {
+	if v == nil {
+		return nil
+	}
+	return styleexpr.Validate(*v, nil).Err()
+}
@@ -0,0 +1,225 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/internal/usecase/repo"
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type StylePreset struct {
+	common
+	commonRevision
+	stylePresetRepo repo.StylePreset
+	styleRepo       repo.Style
+	projectRepo     repo.Project
+	sceneRepo       repo.Scene
+	transaction     usecasex.Transaction
+}
+
+func NewStylePreset(r *repo.Container, g *gateway.Container) interfaces.StylePreset {
+	return &StylePreset{
+		commonRevision:  newCommonRevision(r, g),
+		stylePresetRepo: r.StylePreset,
+		styleRepo:       r.Style,
+		projectRepo:     r.Project,
+		sceneRepo:       r.Scene,
+		transaction:     r.Transaction,
+	}
+}
+
+func (i *StylePreset) Fetch(ctx context.Context, pid id.StylePresetID, operator *usecase.Operator) (*scene.StylePreset, error) {
+	p, err := i.stylePresetRepo.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CanReadWorkspace(p.Workspace(), operator); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (i *StylePreset) FetchByWorkspace(ctx context.Context, wid accountdomain.WorkspaceID, operator *usecase.Operator) (scene.StylePresetList, error) {
+	if err := i.CanReadWorkspace(wid, operator); err != nil {
+		return nil, err
+	}
+	return i.stylePresetRepo.FindByWorkspace(ctx, wid)
+}
+
+func (i *StylePreset) Create(ctx context.Context, inp interfaces.CreateStylePresetInput, operator *usecase.Operator) (_ *scene.StylePreset, err error) {
+	if err := i.CanWriteWorkspace(inp.WorkspaceID, operator); err != nil {
+		return nil, err
+	}
+	if inp.Value == nil {
+		return nil, interfaces.ErrStyleValueRequired
+	}
+	if err := validateStyleValue(inp.Value); err != nil {
+		return nil, err
+	}
+
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+	}()
+
+	p, err := scene.NewStylePreset().
+		NewID().
+		Workspace(inp.WorkspaceID).
+		Name(inp.Name).
+		Description(lo.FromPtr(inp.Description)).
+		Value(inp.Value).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.stylePresetRepo.Save(ctx, p); err != nil {
+		return nil, err
+	}
+
+	tx.Commit()
+	return p, nil
+}
+
+func (i *StylePreset) Update(ctx context.Context, inp interfaces.UpdateStylePresetInput, operator *usecase.Operator) (_ *scene.StylePreset, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tx.Context()
+	var events []*collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, events...)
+		}
+	}()
+
+	p, err := i.stylePresetRepo.FindByID(ctx, inp.PresetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.CanWriteWorkspace(p.Workspace(), operator); err != nil {
+		return nil, err
+	}
+
+	if inp.Name != nil {
+		p.Rename(*inp.Name)
+	}
+	if inp.Description != nil {
+		p.SetDescription(*inp.Description)
+	}
+	if inp.Value != nil {
+		if err := validateStyleValue(inp.Value); err != nil {
+			return nil, err
+		}
+		p.UpdateValue(inp.Value)
+	}
+	p.SetUpdatedAt(util.Now())
+
+	if err := i.stylePresetRepo.Save(ctx, p); err != nil {
+		return nil, err
+	}
+
+	if inp.Value != nil {
+		if events, err = i.updateLinkedStyles(ctx, p.ID(), "updateStylePreset", operator, func(s *scene.Style) {
+			s.LinkPreset(p)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	tx.Commit()
+	return p, nil
+}
+
+func (i *StylePreset) Remove(ctx context.Context, pid id.StylePresetID, operator *usecase.Operator) (_ id.StylePresetID, err error) {
+	tx, err := i.transaction.Begin(ctx)
+	if err != nil {
+		return pid, err
+	}
+
+	ctx = tx.Context()
+	var events []*collaboration.Event
+	defer func() {
+		if err2 := tx.End(ctx); err == nil && err2 != nil {
+			err = err2
+		}
+		if err == nil {
+			i.PublishEvents(ctx, events...)
+		}
+	}()
+
+	p, err := i.stylePresetRepo.FindByID(ctx, pid)
+	if err != nil {
+		return pid, err
+	}
+	if err := i.CanWriteWorkspace(p.Workspace(), operator); err != nil {
+		return pid, err
+	}
+
+	if events, err = i.updateLinkedStyles(ctx, pid, "removeStylePreset", operator, func(s *scene.Style) {
+		s.UnlinkPreset()
+	}); err != nil {
+		return pid, err
+	}
+
+	if err := i.stylePresetRepo.Remove(ctx, pid); err != nil {
+		return pid, err
+	}
+
+	tx.Commit()
+	return pid, nil
+}
+
+// updateLinkedStyles saves styles linked to the preset after applying f, and records a revision of each scene of the styles.
+// It returns events of the revisions to publish after the transaction is committed.
+func (i *StylePreset) updateLinkedStyles(ctx context.Context, pid id.StylePresetID, action string, operator *usecase.Operator, f func(*scene.Style)) ([]*collaboration.Event, error) {
+	styles, err := i.styleRepo.FindByPreset(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	if styles == nil || len(*styles) == 0 {
+		return nil, nil
+	}
+
+	for _, s := range *styles {
+		f(s)
+	}
+	if err := i.styleRepo.SaveAll(ctx, *styles); err != nil {
+		return nil, err
+	}
+
+	var events []*collaboration.Event
+	scenes := lo.Uniq(lo.Map(*styles, func(s *scene.Style, _ int) id.SceneID { return s.Scene() }))
+	for _, sid := range scenes {
+		if err := updateProjectUpdatedAtByScene(ctx, sid, i.projectRepo, i.sceneRepo); err != nil {
+			return nil, err
+		}
+		ev, err := i.RecordRevision(ctx, sid, action, operator)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
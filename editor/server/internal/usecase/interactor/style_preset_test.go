@@ -0,0 +1,140 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/internal/usecase/gateway"
+	"github.com/reearth/reearth/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/project"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/scene/styleexpr"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStylePreset_LinkedStyles(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	wid := accountdomain.NewWorkspaceID()
+	prj, _ := project.New().NewID().Workspace(wid).Build()
+	_ = db.Project.Save(ctx, prj)
+	sce, _ := scene.New().NewID().Workspace(wid).Project(prj.ID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+
+	uid := accountdomain.NewUserID()
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               &uid,
+			ReadableWorkspaces: accountdomain.WorkspaceIDList{wid},
+			WritableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		ReadableScenes: id.SceneIDList{sce.ID()},
+		WritableScenes: id.SceneIDList{sce.ID()},
+	}
+	presets := NewStylePreset(db, &gateway.Container{})
+	styles := NewStyle(db, &gateway.Container{})
+
+	byUse := scene.StyleValue{"3dtiles": map[string]any{"color": map[string]any{"expression": map[string]any{
+		"conditions": []any{[]any{"${用途} === '住宅'", "color('#ffff00')"}, []any{"true", "color('#ffffff')"}},
+	}}}}
+	preset, err := presets.Create(ctx, interfaces.CreateStylePresetInput{WorkspaceID: wid, Name: "building use", Value: &byUse}, op)
+	require.NoError(t, err)
+
+	_, err = presets.Create(ctx, interfaces.CreateStylePresetInput{
+		WorkspaceID: wid,
+		Name:        "broken",
+		Value:       &scene.StyleValue{"marker": map[string]any{"pointColor": map[string]any{"expression": "${a} == 1"}}},
+	}, op)
+	var issues styleexpr.Issues
+	require.ErrorAs(t, err, &issues)
+	assert.Equal(t, "marker.pointColor.expression", issues[0].Path)
+
+	_, err = presets.Create(ctx, interfaces.CreateStylePresetInput{WorkspaceID: accountdomain.NewWorkspaceID(), Name: "x", Value: &byUse}, op)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	linked, err := styles.AddStyle(ctx, interfaces.AddStyleInput{SceneID: sce.ID(), Name: "linked", Preset: preset.ID().Ref()}, op)
+	require.NoError(t, err)
+	assert.Equal(t, preset.ID().Ref(), linked.Preset())
+	assert.Equal(t, &byUse, linked.Value())
+
+	other, err := styles.AddStyle(ctx, interfaces.AddStyleInput{SceneID: sce.ID(), Name: "other", Preset: preset.ID().Ref()}, op)
+	require.NoError(t, err)
+	other, err = styles.UpdateStyle(ctx, interfaces.UpdateStyleInput{StyleID: other.ID(), Value: &scene.StyleValue{"marker": map[string]any{}}}, op)
+	require.NoError(t, err)
+	assert.Nil(t, other.Preset())
+
+	_, err = styles.AddStyle(ctx, interfaces.AddStyleInput{SceneID: sce.ID(), Name: "none"}, op)
+	assert.Equal(t, interfaces.ErrStyleValueRequired, err)
+
+	// the value of the preset is applied only to styles that are still linked
+	commercial := scene.StyleValue{"3dtiles": map[string]any{"color": map[string]any{"expression": "${用途} === '商業施設' ? color('red') : color('white')"}}}
+	_, err = presets.Update(ctx, interfaces.UpdateStylePresetInput{PresetID: preset.ID(), Value: &commercial}, op)
+	require.NoError(t, err)
+
+	got, err := db.Style.FindByID(ctx, linked.ID())
+	require.NoError(t, err)
+	assert.Equal(t, &commercial, got.Value())
+	got, err = db.Style.FindByID(ctx, other.ID())
+	require.NoError(t, err)
+	assert.Equal(t, &scene.StyleValue{"marker": map[string]any{}}, got.Value())
+
+	revs, _, err := db.Revision.FindByScene(ctx, sce.ID(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "updateStylePreset", revs[0].Action())
+
+	_, err = presets.Remove(ctx, preset.ID(), op)
+	require.NoError(t, err)
+	got, err = db.Style.FindByID(ctx, linked.ID())
+	require.NoError(t, err)
+	assert.Nil(t, got.Preset())
+	assert.Equal(t, &commercial, got.Value())
+
+	_, err = presets.Fetch(ctx, preset.ID(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
+func TestStyle_ValidateStyle(t *testing.T) {
+	ctx := context.Background()
+
+	db := memory.New()
+	sce, _ := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Build()
+	_ = db.Scene.Save(ctx, sce)
+
+	schema := map[string]any{"height": "NUMBER_1"}
+	sketch, _ := nlslayer.NewNLSLayerSimple().NewID().Scene(sce.ID()).IsSketch(true).Build()
+	sketch.SetSketch(nlslayer.NewSketchInfo(&schema, nil))
+	_ = db.NLSLayer.Save(ctx, sketch)
+	tiles, _ := nlslayer.NewNLSLayerSimple().NewID().Scene(sce.ID()).Build()
+	_ = db.NLSLayer.Save(ctx, tiles)
+
+	op := &usecase.Operator{ReadableScenes: id.SceneIDList{sce.ID()}}
+	styles := NewStyle(db, &gateway.Container{})
+	value := scene.StyleValue{"polygon": map[string]any{"extrudedHeight": map[string]any{"expression": "${heigt} * 2"}}}
+
+	issues, err := styles.ValidateStyle(ctx, interfaces.ValidateStyleInput{Layer: sketch.ID(), Value: value}, op)
+	require.NoError(t, err)
+	assert.Equal(t, styleexpr.Issues{{
+		Path:       "polygon.extrudedHeight.expression",
+		Type:       styleexpr.IssueTypeUnknownAttribute,
+		Message:    `unknown attribute "heigt", did you mean "height"?`,
+		Attribute:  "heigt",
+		Suggestion: "height",
+	}}, issues)
+
+	// attributes of layers other than sketch layers are unknown
+	issues, err = styles.ValidateStyle(ctx, interfaces.ValidateStyleInput{Layer: tiles.ID(), Value: value}, op)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	_, err = styles.ValidateStyle(ctx, interfaces.ValidateStyleInput{Layer: sketch.ID(), Value: value}, &usecase.Operator{})
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+}
@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/pkg/collaboration"
+	"github.com/reearth/reearth/server/pkg/id"
+)
+
+var ErrCollaborationUnavailable error = errors.New("collaboration is not available")
+
+type UpdatePresenceInput struct {
+	SceneID id.SceneID
+	// LayerID is nil when the user is not editing any layer.
+	LayerID *id.NLSLayerID
+}
+
+type Collaboration interface {
+	// SubscribeEvents returns a channel that receives change events of the scene until the context is done.
+	SubscribeEvents(context.Context, id.SceneID, *usecase.Operator) (<-chan collaboration.Event, error)
+	// Join makes the user present in the scene until the context is done, and returns a channel that receives presences of the scene.
+	Join(context.Context, id.SceneID, *usecase.Operator) (<-chan collaboration.PresenceList, error)
+	UpdatePresence(context.Context, UpdatePresenceInput, *usecase.Operator) (collaboration.PresenceList, error)
+	// CheckMembership returns ErrOperationDenied if the user is no longer a member of the workspace of the scene.
+	// Operators are not updated during subscriptions, so subscriptions check the membership again before sending each value.
+	CheckMembership(context.Context, id.SceneID, *usecase.Operator) error
+}
@@ -15,23 +15,27 @@ const (
 )
 
 var (
-	ErrSceneIsLocked   error = errors.New("scene is locked")
-	ErrOperationDenied error = errors.New("operation denied")
-	ErrFileNotIncluded error = errors.New("file not included")
-	ErrFeatureNotFound error = errors.New("feature not found")
+	ErrSceneIsLocked        error = errors.New("scene is locked")
+	ErrOperationDenied      error = errors.New("operation denied")
+	ErrFileNotIncluded      error = errors.New("file not included")
+	ErrFeatureNotFound      error = errors.New("feature not found")
+	ErrSceneVersionConflict error = errors.New("scene has been changed by another user")
 )
 
 type Container struct {
-	Asset        Asset
-	NLSLayer     NLSLayer
-	Plugin       Plugin
-	Policy       Policy
-	Project      Project
-	Property     Property
-	Published    Published
-	Scene        Scene
-	StoryTelling Storytelling
-	Style        Style
-	User         accountinterfaces.User
-	Workspace    accountinterfaces.Workspace
+	Asset         Asset
+	Collaboration Collaboration
+	NLSLayer      NLSLayer
+	Plugin        Plugin
+	Policy        Policy
+	Project       Project
+	Property      Property
+	Published     Published
+	Revision      Revision
+	Scene         Scene
+	StoryTelling  Storytelling
+	Style         Style
+	StylePreset   StylePreset
+	User          accountinterfaces.User
+	Workspace     accountinterfaces.Workspace
 }
@@ -2,10 +2,13 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/pkg/file"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/nlslayer/encoding"
 	"github.com/reearth/reearthx/idx"
 )
 
@@ -20,6 +23,19 @@ type AddNLSLayerSimpleInput struct {
 	Schema        *map[string]any
 }
 
+type ImportNLSLayerFileInput struct {
+	SceneID id.SceneID
+	Title   *string
+	Index   *int
+	Visible *bool
+	File    *file.File
+}
+
+type ExportNLSLayersInput struct {
+	LayerIDs id.NLSLayerIDList
+	Format   encoding.Format
+}
+
 type UpdateNLSLayerInput struct {
 	LayerID id.NLSLayerID
 	Index   *int
@@ -76,6 +92,8 @@ type NLSLayer interface {
 	FetchLayerSimple(context.Context, id.NLSLayerIDList, *usecase.Operator) (nlslayer.NLSLayerSimpleList, error)
 	FetchParent(context.Context, id.NLSLayerID, *usecase.Operator) (*nlslayer.NLSLayerGroup, error)
 	AddLayerSimple(context.Context, AddNLSLayerSimpleInput, *usecase.Operator) (*nlslayer.NLSLayerSimple, error)
+	ImportLayerFile(context.Context, ImportNLSLayerFileInput, *usecase.Operator) (*nlslayer.NLSLayerSimple, error)
+	ExportLayers(context.Context, ExportNLSLayersInput, io.Writer, *usecase.Operator) error
 	Remove(context.Context, id.NLSLayerID, *usecase.Operator) (id.NLSLayerID, *nlslayer.NLSLayerGroup, error)
 	Update(context.Context, UpdateNLSLayerInput, *usecase.Operator) (nlslayer.NLSLayer, error)
 	CreateNLSInfobox(context.Context, id.NLSLayerID, *usecase.Operator) (nlslayer.NLSLayer, error)
@@ -92,5 +110,5 @@ type NLSLayer interface {
 	AddGeoJSONFeature(context.Context, AddNLSLayerGeoJSONFeatureParams, *usecase.Operator) (nlslayer.Feature, error)
 	UpdateGeoJSONFeature(context.Context, UpdateNLSLayerGeoJSONFeatureParams, *usecase.Operator) (nlslayer.Feature, error)
 	DeleteGeoJSONFeature(context.Context, DeleteNLSLayerGeoJSONFeatureParams, *usecase.Operator) (id.FeatureID, error)
-	ImportNLSLayers(context.Context, idx.ID[id.Scene], *[]byte) (nlslayer.NLSLayerList, error)
+	ImportNLSLayers(context.Context, idx.ID[id.Scene], *[]byte, *usecase.Operator) (nlslayer.NLSLayerList, error)
 }
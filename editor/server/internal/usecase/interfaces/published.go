@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 	"net/url"
+
+	"github.com/reearth/reearth/server/internal/usecase"
 )
 
 type HasPublicMeta interface {
@@ -45,4 +47,7 @@ type Published interface {
 	Metadata(context.Context, string) (ProjectPublishedMetadata, error)
 	Data(context.Context, string) (io.Reader, error)
 	Index(context.Context, string, *url.URL) (string, error)
+	// Export writes a zip of the published project or story that can be served by any static file server.
+	// It returns URLs of assets that could not be bundled.
+	Export(context.Context, string, io.Writer, *usecase.Operator) ([]string, error)
 }
@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type DiffRevisionsInput struct {
+	From id.RevisionID
+	// To is the current state of the scene when it is nil.
+	To *id.RevisionID
+}
+
+type Revision interface {
+	Fetch(context.Context, id.RevisionID, *usecase.Operator) (*revision.Revision, error)
+	// FetchByScene returns revisions of the scene from the newest one without their snapshots.
+	FetchByScene(context.Context, id.SceneID, *usecasex.Pagination, *usecase.Operator) (revision.List, *usecasex.PageInfo, error)
+	Diff(context.Context, DiffRevisionsInput, *usecase.Operator) ([]revision.Change, error)
+	// Restore restores the scene to the revision and returns a new revision recorded by restoring.
+	Restore(context.Context, id.RevisionID, *usecase.Operator) (*revision.Revision, error)
+}
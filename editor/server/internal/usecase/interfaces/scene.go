@@ -29,7 +29,7 @@ type Scene interface {
 	UninstallPlugin(context.Context, id.SceneID, id.PluginID, *usecase.Operator) (*scene.Scene, error)
 	UpgradePlugin(context.Context, id.SceneID, id.PluginID, id.PluginID, *usecase.Operator) (*scene.Scene, error)
 	ExportScene(context.Context, *project.Project) (*scene.Scene, map[string]any, error)
-	ImportScene(context.Context, *scene.Scene, *[]byte) (*scene.Scene, error)
+	ImportScene(context.Context, *scene.Scene, *[]byte, *usecase.Operator) (*scene.Scene, error)
 }
 
 type UpdateWidgetParam struct {
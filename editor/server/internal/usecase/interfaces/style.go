@@ -2,22 +2,35 @@ package interfaces
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth/server/internal/usecase"
 	"github.com/reearth/reearth/server/pkg/id"
 	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/scene/styleexpr"
 )
 
+var ErrStyleValueRequired error = errors.New("style value or preset is required")
+
 type AddStyleInput struct {
 	SceneID id.SceneID
 	Name    string
 	Value   *scene.StyleValue
+	// Preset links the style to the preset of the workspace of the scene. Value is ignored when it is set.
+	Preset *id.StylePresetID
 }
 
 type UpdateStyleInput struct {
 	StyleID id.StyleID
 	Name    *string
-	Value   *scene.StyleValue
+	// Value unlinks the style from its preset as the style no longer follows the preset.
+	Value *scene.StyleValue
+}
+
+type ValidateStyleInput struct {
+	// Layer is used to check attributes referred by expressions. Only attributes of sketch layers are known.
+	Layer id.NLSLayerID
+	Value scene.StyleValue
 }
 
 type Style interface {
@@ -27,5 +40,7 @@ type Style interface {
 	UpdateStyle(context.Context, UpdateStyleInput, *usecase.Operator) (*scene.Style, error)
 	RemoveStyle(context.Context, id.StyleID, *usecase.Operator) (id.StyleID, error)
 	DuplicateStyle(context.Context, id.StyleID, *usecase.Operator) (*scene.Style, error)
-	ImportStyles(context.Context, id.SceneID, *[]byte) (scene.StyleList, error)
+	ImportStyles(context.Context, id.SceneID, *[]byte, *usecase.Operator) (scene.StyleList, error)
+	// ValidateStyle checks syntax of expressions of the style. Attributes are checked only for sketch layers.
+	ValidateStyle(context.Context, ValidateStyleInput, *usecase.Operator) (styleexpr.Issues, error)
 }
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/internal/usecase"
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type CreateStylePresetInput struct {
+	WorkspaceID accountdomain.WorkspaceID
+	Name        string
+	Description *string
+	Value       *scene.StyleValue
+}
+
+type UpdateStylePresetInput struct {
+	PresetID    id.StylePresetID
+	Name        *string
+	Description *string
+	// Value is also applied to styles linked to the preset.
+	Value *scene.StyleValue
+}
+
+type StylePreset interface {
+	Fetch(context.Context, id.StylePresetID, *usecase.Operator) (*scene.StylePreset, error)
+	FetchByWorkspace(context.Context, accountdomain.WorkspaceID, *usecase.Operator) (scene.StylePresetList, error)
+	Create(context.Context, CreateStylePresetInput, *usecase.Operator) (*scene.StylePreset, error)
+	Update(context.Context, UpdateStylePresetInput, *usecase.Operator) (*scene.StylePreset, error)
+	// Remove unlinks styles from the preset. They keep the value of the preset.
+	Remove(context.Context, id.StylePresetID, *usecase.Operator) (id.StylePresetID, error)
+}
@@ -24,6 +24,7 @@ type Container struct {
 	Config         Config
 	NLSLayer       NLSLayer
 	Style          Style
+	StylePreset    StylePreset
 	Lock           Lock
 	Plugin         Plugin
 	Project        Project
@@ -35,6 +36,7 @@ type Container struct {
 	User           accountrepo.User
 	Policy         Policy
 	Storytelling   Storytelling
+	Revision       Revision
 	Transaction    usecasex.Transaction
 	Extensions     []id.PluginID
 }
@@ -58,10 +60,12 @@ func (c *Container) Filtered(workspace WorkspaceFilter, scene SceneFilter) *Cont
 		Config:         c.Config,
 		NLSLayer:       c.NLSLayer.Filtered(scene),
 		Style:          c.Style.Filtered(scene),
+		StylePreset:    c.StylePreset.Filtered(workspace),
 		Lock:           c.Lock,
 		Plugin:         c.Plugin.Filtered(scene),
 		Policy:         c.Policy,
 		Storytelling:   c.Storytelling.Filtered(scene),
+		Revision:       c.Revision.Filtered(scene),
 		Project:        c.Project.Filtered(workspace),
 		PropertySchema: c.PropertySchema.Filtered(scene),
 		Property:       c.Property.Filtered(scene),
@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Revision interface {
+	Filtered(SceneFilter) Revision
+	FindByID(context.Context, id.RevisionID) (*revision.Revision, error)
+	// FindByScene returns revisions of the scene from the newest one without their snapshots.
+	FindByScene(context.Context, id.SceneID, *usecasex.Pagination) (revision.List, *usecasex.PageInfo, error)
+	// FindLatestByScene returns rerror.ErrNotFound when the scene has no revisions.
+	FindLatestByScene(context.Context, id.SceneID) (*revision.Revision, error)
+	Save(context.Context, *revision.Revision) error
+	// RemoveOldByScene removes revisions of the scene except the newest ones up to the number.
+	RemoveOldByScene(context.Context, id.SceneID, int) error
+	RemoveByScene(context.Context, id.SceneID) error
+}
@@ -12,6 +12,8 @@ type Style interface {
 	FindByID(context.Context, id.StyleID) (*scene.Style, error)
 	FindByIDs(context.Context, id.StyleIDList) (*scene.StyleList, error)
 	FindByScene(context.Context, id.SceneID) (*scene.StyleList, error)
+	// FindByPreset returns styles linked to the preset across scenes.
+	FindByPreset(context.Context, id.StylePresetID) (*scene.StyleList, error)
 	Save(context.Context, scene.Style) error
 	SaveAll(context.Context, scene.StyleList) error
 	Remove(context.Context, id.StyleID) error
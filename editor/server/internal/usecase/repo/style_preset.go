@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type StylePreset interface {
+	Filtered(WorkspaceFilter) StylePreset
+	FindByID(context.Context, id.StylePresetID) (*scene.StylePreset, error)
+	// FindByWorkspace returns presets of the workspace sorted by their names.
+	FindByWorkspace(context.Context, accountdomain.WorkspaceID) (scene.StylePresetList, error)
+	Save(context.Context, *scene.StylePreset) error
+	Remove(context.Context, id.StylePresetID) error
+}
@@ -0,0 +1,34 @@
+package collaboration
+
+import (
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/revision"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+// Event notifies editors of a scene that the scene, its layers, styles or stories have been changed.
+type Event struct {
+	Scene    id.SceneID
+	Version  int
+	Revision id.RevisionID
+	Action   string
+	User     *accountdomain.UserID
+	Changes  []revision.Change
+}
+
+// NewEvent returns an event of the revision. Changes are ones from the previous revision, and all objects of the revision are reported as added when there is no previous revision.
+func NewEvent(rev, prev *revision.Revision) Event {
+	var prevSnapshot *revision.Snapshot
+	if prev != nil {
+		prevSnapshot = prev.Snapshot()
+	}
+
+	return Event{
+		Scene:    rev.Scene(),
+		Version:  rev.Snapshot().Scene().Version(),
+		Revision: rev.ID(),
+		Action:   rev.Action(),
+		User:     rev.User(),
+		Changes:  revision.Diff(prevSnapshot, rev.Snapshot()),
+	}
+}
@@ -0,0 +1,28 @@
+package collaboration
+
+import (
+	"slices"
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+// Presence is a user who has a scene open in the editor, with the layer the user is editing.
+type Presence struct {
+	Scene     id.SceneID
+	User      accountdomain.UserID
+	Layer     *id.NLSLayerID
+	UpdatedAt time.Time
+}
+
+type PresenceList []*Presence
+
+// Sorted returns presences in the order of user IDs so that clients receive them in a stable order.
+func (l PresenceList) Sorted() PresenceList {
+	res := slices.Clone(l)
+	slices.SortStableFunc(res, func(a, b *Presence) int {
+		return a.User.Compare(b.User)
+	})
+	return res
+}
@@ -1,12 +1,16 @@
 package czml
 
 type Feature struct {
-	Id       string    `json:"id"`
-	Name     string    `json:"name"`
-	Polygon  *Polygon  `json:"polygon,omitempty"`
-	Polyline *Polyline `json:"polyline,omitempty"`
-	Position *Position `json:"position,omitempty"`
-	Point    *Point    `json:"point,omitempty"`
+	Id          string         `json:"id"`
+	Name        string         `json:"name"`
+	Version     string         `json:"version,omitempty"`
+	Parent      string         `json:"parent,omitempty"`
+	Description any            `json:"description,omitempty"`
+	Properties  map[string]any `json:"properties,omitempty"`
+	Polygon     *Polygon       `json:"polygon,omitempty"`
+	Polyline    *Polyline      `json:"polyline,omitempty"`
+	Position    *Position      `json:"position,omitempty"`
+	Point       *Point         `json:"point,omitempty"`
 }
 type Polyline struct {
 	Positions Position  `json:"positions"`
@@ -14,20 +18,26 @@ type Polyline struct {
 	Width     float64   `json:"width,omitempty"`
 }
 type Polygon struct {
-	Positions   Position  `json:"positions"`
-	Fill        bool      `json:"fill,omitempty"`
-	Material    *Material `json:"material,omitempty"`
-	Stroke      bool      `json:"outline,omitempty"`
-	StrokeColor *Color    `json:"outlineColor,omitempty"`
-	StrokeWidth float64   `json:"outlineWidth,omitempty"`
+	Positions   Position      `json:"positions"`
+	Holes       *PositionList `json:"holes,omitempty"`
+	Fill        *bool         `json:"fill,omitempty"`
+	Material    *Material     `json:"material,omitempty"`
+	Stroke      *bool         `json:"outline,omitempty"`
+	StrokeColor *Color        `json:"outlineColor,omitempty"`
+	StrokeWidth float64       `json:"outlineWidth,omitempty"`
 }
 type Point struct {
-	Color     string  `json:"color,omitempty"`
+	Color     *Color  `json:"color,omitempty"`
 	PixelSize float64 `json:"pixelSize,omitempty"`
 }
 type Position struct {
 	CartographicDegrees []float64 `json:"cartographicDegrees"`
 }
+
+// PositionList is a list of positions, such as holes of a polygon.
+type PositionList struct {
+	CartographicDegrees [][]float64 `json:"cartographicDegrees"`
+}
 type Material struct {
 	SolidColor      *SolidColor      `json:"solidColor,omitempty"`
 	PolylineOutline *PolylineOutline `json:"polylineOutline,omitempty"`
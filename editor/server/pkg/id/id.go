@@ -230,3 +230,37 @@ type BlockIDList = idx.List[Block]
 var StoryIDListFrom = idx.ListFrom[Story]
 var PageIDListFrom = idx.ListFrom[Page]
 var BlockIDListFrom = idx.ListFrom[Block]
+
+// Revision ids
+
+type Revision struct{}
+
+func (Revision) Type() string { return "revision" }
+
+type RevisionID = idx.ID[Revision]
+
+var NewRevisionID = idx.New[Revision]
+var MustRevisionID = idx.Must[Revision]
+var RevisionIDFrom = idx.From[Revision]
+var RevisionIDFromRef = idx.FromRef[Revision]
+
+type RevisionIDList = idx.List[Revision]
+
+var RevisionIDListFrom = idx.ListFrom[Revision]
+
+// Style preset ids
+
+type StylePreset struct{}
+
+func (StylePreset) Type() string { return "stylePreset" }
+
+type StylePresetID = idx.ID[StylePreset]
+
+var NewStylePresetID = idx.New[StylePreset]
+var MustStylePresetID = idx.Must[StylePreset]
+var StylePresetIDFrom = idx.From[StylePreset]
+var StylePresetIDFromRef = idx.FromRef[StylePreset]
+
+type StylePresetIDList = idx.List[StylePreset]
+
+var StylePresetIDListFrom = idx.ListFrom[StylePreset]
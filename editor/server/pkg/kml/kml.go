@@ -1,21 +1,59 @@
 package kml
 
 import (
+	"encoding/xml"
+
 	"github.com/reearth/reearth/server/pkg/property"
 )
 
-type Collection struct {
+// KML is the root element of a KML document.
+type KML struct {
+	XMLName    xml.Name     `xml:"kml"`
+	Xmlns      string       `xml:"xmlns,attr,omitempty"`
+	Document   Collection   `xml:"Document"`
 	Folders    []Collection `xml:"Folder"`
 	Placemarks []Placemark  `xml:"Placemark"`
+}
+
+type Collection struct {
+	Name       string       `xml:"name,omitempty"`
 	Styles     []Style      `xml:"Style"`
-	Name       string       `xml:"name"`
+	StyleMaps  []StyleMap   `xml:"StyleMap"`
+	Folders    []Collection `xml:"Folder"`
+	Placemarks []Placemark  `xml:"Placemark"`
 }
+
+// Geometries and styles are pointers so that absent elements are not written.
 type Placemark struct {
-	Point    Point      `xml:"Point"`
-	Polygon  Polygon    `xml:"Polygon"`
-	Polyline LineString `xml:"LineString"`
-	Name     string     `xml:"name"`
-	StyleUrl string     `xml:"styleUrl"`
+	Name          string         `xml:"name,omitempty"`
+	Description   string         `xml:"description,omitempty"`
+	StyleUrl      string         `xml:"styleUrl,omitempty"`
+	Style         *Style         `xml:"Style"`
+	ExtendedData  *ExtendedData  `xml:"ExtendedData"`
+	Point         *Point         `xml:"Point"`
+	Polygon       *Polygon       `xml:"Polygon"`
+	Polyline      *LineString    `xml:"LineString"`
+	MultiGeometry *MultiGeometry `xml:"MultiGeometry"`
+}
+type MultiGeometry struct {
+	Points      []Point      `xml:"Point"`
+	Polygons    []Polygon    `xml:"Polygon"`
+	LineStrings []LineString `xml:"LineString"`
+}
+type ExtendedData struct {
+	Data       []Data       `xml:"Data"`
+	SchemaData []SchemaData `xml:"SchemaData"`
+}
+type Data struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+type SchemaData struct {
+	SimpleData []SimpleData `xml:"SimpleData"`
+}
+type SimpleData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:",chardata"`
 }
 type BoundaryIs struct {
 	LinearRing LinearRing `xml:"LinearRing"`
@@ -41,8 +79,8 @@ type PointFields struct {
 
 type IconStyle struct {
 	Icon  *Icon   `xml:"Icon"`
-	Color string  `xml:"color"`
-	Scale float64 `xml:"scale"`
+	Color string  `xml:"color,omitempty"`
+	Scale float64 `xml:"scale,omitempty"`
 }
 type Icon struct {
 	Href string `xml:"href"`
@@ -50,19 +88,31 @@ type Icon struct {
 
 // Marker Styling
 type Style struct {
-	Id        string    `xml:"id,attr"`
-	IconStyle IconStyle `xml:"IconStyle"`
-	LineStyle LineStyle `xml:"LineStyle"`
-	PolyStyle PolyStyle `xml:"PolyStyle"`
+	Id        string     `xml:"id,attr,omitempty"`
+	IconStyle *IconStyle `xml:"IconStyle"`
+	LineStyle *LineStyle `xml:"LineStyle"`
+	PolyStyle *PolyStyle `xml:"PolyStyle"`
+}
+
+// StyleMap switches styles of a placemark between "normal" and "highlight" mode
+type StyleMap struct {
+	Id    string `xml:"id,attr"`
+	Pairs []Pair `xml:"Pair"`
+}
+type Pair struct {
+	Key      string `xml:"key"`
+	StyleUrl string `xml:"styleUrl"`
 }
 
 // Polyline Styling
 type LineStyle struct {
-	Color string  `xml:"color"`
-	Width float64 `xml:"width"`
+	Color string  `xml:"color,omitempty"`
+	Width float64 `xml:"width,omitempty"`
 }
+
+// Fill and Stroke are nil when omitted, which means true in KML.
 type PolyStyle struct {
-	Color  string `xml:"color"`
-	Fill   bool   `xml:"fill"`
-	Stroke bool   `xml:"outline"`
+	Color  string `xml:"color,omitempty"`
+	Fill   *bool  `xml:"fill"`
+	Stroke *bool  `xml:"outline"`
 }
@@ -0,0 +1,162 @@
+package decoding
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/reearth/reearth/server/pkg/czml"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+// decodeCZML decodes static packets whose positions are in cartographicDegrees. Time-dynamic values are not supported.
+func decodeCZML(r io.Reader) (*document, error) {
+	var packets []czml.Feature
+	if err := json.NewDecoder(r).Decode(&packets); err != nil {
+		return nil, err
+	}
+
+	d := &document{}
+	for _, p := range packets {
+		if p.Id == "document" {
+			d.name = p.Name
+			continue
+		}
+
+		g, err := czmlGeometry(p)
+		if err != nil {
+			return nil, fmt.Errorf("packet %q: %w", p.Id, err)
+		}
+
+		props := map[string]any{}
+		for k, v := range p.Properties {
+			props[k] = v
+		}
+		if p.Name != "" {
+			props["name"] = p.Name
+		}
+		if desc, ok := p.Description.(string); ok && desc != "" {
+			props["description"] = desc
+		}
+
+		d.add(g, props, czmlStyle(p))
+	}
+
+	return d, nil
+}
+
+func czmlGeometry(p czml.Feature) (nlslayer.Geometry, error) {
+	switch {
+	case p.Polygon != nil:
+		coords, err := czmlPositions(p.Polygon.Positions)
+		if err != nil {
+			return nil, err
+		}
+		if len(coords) < 3 {
+			return nil, errors.New("polygon must have at least three positions")
+		}
+		rings := [][][]float64{closeRing(coords)}
+		if p.Polygon.Holes != nil {
+			for _, h := range p.Polygon.Holes.CartographicDegrees {
+				hole, err := czmlPositions(czml.Position{CartographicDegrees: h})
+				if err != nil {
+					return nil, err
+				}
+				if len(hole) >= 3 {
+					rings = append(rings, closeRing(hole))
+				}
+			}
+		}
+		return nlslayer.NewPolygon("Polygon", rings), nil
+	case p.Polyline != nil:
+		coords, err := czmlPositions(p.Polyline.Positions)
+		if err != nil {
+			return nil, err
+		}
+		if len(coords) < 2 {
+			return nil, errors.New("polyline must have at least two positions")
+		}
+		return nlslayer.NewLineString("LineString", coords), nil
+	case p.Position != nil:
+		coords, err := czmlPositions(*p.Position)
+		if err != nil {
+			return nil, err
+		}
+		if len(coords) != 1 {
+			return nil, errors.New("position must have one position")
+		}
+		return nlslayer.NewPoint("Point", coords[0]), nil
+	}
+	return nil, nil
+}
+
+func czmlPositions(p czml.Position) ([][]float64, error) {
+	values := p.CartographicDegrees
+	if len(values)%3 != 0 {
+		return nil, errors.New("cartographicDegrees must be a list of longitude, latitude and height")
+	}
+	res := make([][]float64, 0, len(values)/3)
+	for i := 0; i < len(values); i += 3 {
+		res = append(res, []float64{values[i], values[i+1], values[i+2]})
+	}
+	return res, nil
+}
+
+func czmlStyle(p czml.Feature) *featureStyle {
+	var s *featureStyle
+	switch {
+	case p.Polygon != nil:
+		s = &featureStyle{
+			fillColor:   czmlMaterialColor(p.Polygon.Material),
+			strokeColor: czmlColor(p.Polygon.StrokeColor),
+			fill:        p.Polygon.Fill,
+			stroke:      p.Polygon.Stroke,
+		}
+		if p.Polygon.StrokeWidth > 0 {
+			s.strokeWidth = &p.Polygon.StrokeWidth
+		}
+	case p.Polyline != nil:
+		s = &featureStyle{
+			strokeColor: czmlMaterialColor(p.Polyline.Material),
+		}
+		if p.Polyline.Width > 0 {
+			s.strokeWidth = &p.Polyline.Width
+		}
+	case p.Point != nil:
+		s = &featureStyle{
+			markerColor: czmlColor(p.Point.Color),
+		}
+	}
+
+	if s == nil || *s == (featureStyle{}) {
+		return nil
+	}
+	return s
+}
+
+func czmlMaterialColor(m *czml.Material) *color {
+	if m == nil {
+		return nil
+	}
+	if m.SolidColor != nil {
+		return czmlColor(m.SolidColor.Color)
+	}
+	if m.PolylineOutline != nil {
+		return czmlColor(m.PolylineOutline.Color)
+	}
+	return nil
+}
+
+// czmlColor converts rgba (0-255) or rgbaf (0-1) colors. References to other packets are not supported.
+func czmlColor(c *czml.Color) *color {
+	switch {
+	case c == nil:
+		return nil
+	case len(c.RGBA) == 4:
+		return rgbaColor(float64(c.RGBA[0]), float64(c.RGBA[1]), float64(c.RGBA[2]), float64(c.RGBA[3]))
+	case len(c.RGBAF) == 4:
+		return rgbaColor(c.RGBAF[0]*255, c.RGBAF[1]*255, c.RGBAF[2]*255, c.RGBAF[3]*255)
+	}
+	return nil
+}
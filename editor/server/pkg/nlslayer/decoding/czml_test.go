@@ -0,0 +1,89 @@
+package decoding
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode_CZML(t *testing.T) {
+	res, err := Decode(strings.NewReader(`[
+		{"id": "document", "name": "test", "version": "1.0"},
+		{
+			"id": "point", "name": "a", "description": "desc",
+			"properties": {"height": 10},
+			"position": {"cartographicDegrees": [139.7, 35.6, 0]},
+			"point": {"color": {"rgba": [255, 0, 0, 255]}, "pixelSize": 10}
+		},
+		{
+			"id": "line",
+			"polyline": {
+				"positions": {"cartographicDegrees": [139.7, 35.6, 0, 139.8, 35.7, 0]},
+				"material": {"polylineOutline": {"color": {"rgbaf": [0, 0, 1, 0.5]}}},
+				"width": 2
+			}
+		},
+		{
+			"id": "polygon",
+			"polygon": {
+				"positions": {"cartographicDegrees": [0, 0, 0, 0, 1, 0, 1, 1, 0]},
+				"material": {"solidColor": {"color": {"rgba": [0, 255, 0, 128]}}},
+				"outline": false
+			}
+		}
+	]`), FormatCZML)
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", res.Name)
+	features := res.FeatureCollection.Features()
+	require.Len(t, features, 3)
+
+	assert.Equal(t, nlslayer.NewPoint("Point", []float64{139.7, 35.6, 0}), features[0].Geometry())
+	assert.Equal(t, map[string]any{
+		"name":         "a",
+		"description":  "desc",
+		"height":       10.0,
+		"marker-color": "#ff0000",
+	}, *features[0].Properties())
+
+	assert.Equal(t, nlslayer.NewLineString("LineString", [][]float64{{139.7, 35.6, 0}, {139.8, 35.7, 0}}), features[1].Geometry())
+	assert.Equal(t, map[string]any{
+		"stroke":         "#0000ff",
+		"stroke-opacity": 0.5,
+		"stroke-width":   2.0,
+	}, *features[1].Properties())
+
+	assert.Equal(t, nlslayer.NewPolygon("Polygon", [][][]float64{{{0, 0, 0}, {0, 1, 0}, {1, 1, 0}, {0, 0, 0}}}), features[2].Geometry())
+	assert.Equal(t, map[string]any{
+		"fill":           "#00ff00",
+		"fill-opacity":   0.5,
+		"stroke":         "#ffffff",
+		"stroke-opacity": 0.0,
+		"stroke-width":   1.0,
+	}, *features[2].Properties())
+
+	assert.Equal(t, map[string]any{
+		"description":    "Text_1",
+		"height":         "Float_2",
+		"marker-color":   "Text_3",
+		"name":           "Text_4",
+		"stroke":         "Text_5",
+		"stroke-opacity": "Float_6",
+		"stroke-width":   "Float_7",
+		"fill":           "Text_8",
+		"fill-opacity":   "Float_9",
+	}, res.Schema)
+	assert.Equal(t, map[string]any{
+		"style":           "point",
+		"heightReference": "clamp",
+		"pointColor":      map[string]any{"expression": "${marker-color}"},
+	}, res.Style["marker"])
+}
+
+func TestDecode_CZMLInvalidPositions(t *testing.T) {
+	_, err := Decode(strings.NewReader(`[{"id": "a", "position": {"cartographicDegrees": [0, 139.7, 35.6, 0]}}]`), FormatCZML)
+	assert.ErrorContains(t, err, `packet "a"`)
+}
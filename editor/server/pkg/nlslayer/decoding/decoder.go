@@ -0,0 +1,222 @@
+package decoding
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+type Format string
+
+const (
+	FormatKML       Format = "kml"
+	FormatKMZ       Format = "kmz"
+	FormatShapefile Format = "shapefile"
+	FormatCZML      Format = "czml"
+)
+
+// MaxUncompressedSize is the maximum size of files extracted from KMZ and zipped Shapefiles,
+// which is the same as the size limit of uploaded assets.
+const MaxUncompressedSize = 100 * 1024 * 1024 // about 100MB
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format")
+	ErrNoFeatures        = errors.New("file has no features")
+	ErrTooLarge          = errors.New("uncompressed file is too large")
+)
+
+// FormatFromFilename detects the format from the extension of the file name. Zip files are regarded as zipped Shapefiles.
+func FormatFromFilename(name string) (Format, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".kml":
+		return FormatKML, nil
+	case ".kmz":
+		return FormatKMZ, nil
+	case ".zip":
+		return FormatShapefile, nil
+	case ".czml":
+		return FormatCZML, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+type Result struct {
+	// Name is the name of the document if the file has it.
+	Name              string
+	FeatureCollection *nlslayer.FeatureCollection
+	// Schema is a custom property schema of a sketch layer, which is inferred from the properties of the features.
+	Schema map[string]any
+	// Style is a value of a layer style. It is nil if the file has no styles.
+	Style map[string]any
+}
+
+// Decode converts a file into features of a sketch layer.
+func Decode(r io.Reader, f Format) (*Result, error) {
+	var d *document
+	var err error
+
+	switch f {
+	case FormatKML:
+		d, err = decodeKML(r)
+	case FormatKMZ:
+		d, err = decodeKMZ(r)
+	case FormatShapefile:
+		d, err = decodeShapefile(r)
+	case FormatCZML:
+		d, err = decodeCZML(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", f, err)
+	}
+
+	return d.result()
+}
+
+// document is an intermediate representation of decoded files.
+type document struct {
+	name     string
+	features []feature
+}
+
+type feature struct {
+	geometry   nlslayer.Geometry
+	properties map[string]any
+	style      *featureStyle
+}
+
+func (d *document) add(g nlslayer.Geometry, props map[string]any, s *featureStyle) {
+	if g == nil {
+		return
+	}
+	d.features = append(d.features, feature{geometry: g, properties: props, style: s})
+}
+
+func (d *document) result() (*Result, error) {
+	if len(d.features) == 0 {
+		return nil, ErrNoFeatures
+	}
+
+	styled := false
+	for _, f := range d.features {
+		if f.style != nil {
+			styled = true
+			break
+		}
+	}
+
+	var kinds geometryKinds
+	for i := range d.features {
+		f := &d.features[i]
+		if f.properties == nil {
+			f.properties = map[string]any{}
+		}
+		kinds.add(f.geometry)
+		if styled {
+			f.style.apply(f.geometry, f.properties)
+		}
+	}
+
+	// the schema includes the style properties so that the style referring to them can be validated
+	schema := inferSchema(d.features)
+
+	fc := nlslayer.NewFeatureCollection("FeatureCollection", []nlslayer.Feature{})
+	for _, f := range d.features {
+		nf, err := nlslayer.NewFeature(id.NewFeatureID(), "Feature", f.geometry)
+		if err != nil {
+			return nil, err
+		}
+		nf.UpdateProperties(&f.properties)
+		fc.AddFeature(*nf)
+	}
+
+	res := &Result{
+		Name:              d.name,
+		FeatureCollection: fc,
+		Schema:            schema,
+	}
+	if styled {
+		res.Style = kinds.styleValue()
+	}
+	return res, nil
+}
+
+// inferSchema returns a schema in the same form as sketch layers created in the editor, e.g. {"name": "Text_1", "height": "Float_2"}.
+func inferSchema(features []feature) map[string]any {
+	schema := map[string]any{}
+	for _, f := range features {
+		keys := make([]string, 0, len(f.properties))
+		for k := range f.properties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if _, ok := schema[k]; ok {
+				continue
+			}
+			schema[k] = fmt.Sprintf("%s_%d", schemaType(f.properties[k]), len(schema)+1)
+		}
+	}
+	return schema
+}
+
+func schemaType(v any) string {
+	switch v.(type) {
+	case bool:
+		return "Boolean"
+	case int, int64:
+		return "Int"
+	case float64:
+		return "Float"
+	}
+	return "Text"
+}
+
+type geometryKinds struct {
+	point, lineString, polygon bool
+}
+
+func (k *geometryKinds) add(g nlslayer.Geometry) {
+	switch g := g.(type) {
+	case *nlslayer.Point:
+		k.point = true
+	case *nlslayer.LineString:
+		k.lineString = true
+	case *nlslayer.Polygon, *nlslayer.MultiPolygon:
+		k.polygon = true
+	case *nlslayer.GeometryCollection:
+		for _, g2 := range g.Geometries() {
+			k.add(g2)
+		}
+	}
+}
+
+func ringsToGeometry(polygons [][][][]float64) nlslayer.Geometry {
+	switch len(polygons) {
+	case 0:
+		return nil
+	case 1:
+		return nlslayer.NewPolygon("Polygon", polygons[0])
+	}
+	return nlslayer.NewMultiPolygon("MultiPolygon", polygons)
+}
+
+// closeRing appends the first position to the ring if the ring is not closed, as GeoJSON requires closed rings.
+func closeRing(ring [][]float64) [][]float64 {
+	if len(ring) < 3 {
+		return ring
+	}
+	first, last := ring[0], ring[len(ring)-1]
+	if len(first) >= 2 && len(last) >= 2 && first[0] == last[0] && first[1] == last[1] {
+		return ring
+	}
+	return append(ring, append([]float64{}, first...))
+}
@@ -0,0 +1,65 @@
+package decoding
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatFromFilename(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Format
+		wantErr error
+	}{
+		{name: "a.kml", want: FormatKML},
+		{name: "a.KMZ", want: FormatKMZ},
+		{name: "dir/a.zip", want: FormatShapefile},
+		{name: "a.czml", want: FormatCZML},
+		{name: "a.geojson", wantErr: ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatFromFilename(tt.name)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestDecode_NoFeatures(t *testing.T) {
+	_, err := Decode(strings.NewReader(`[{"id":"document","version":"1.0"}]`), FormatCZML)
+	assert.ErrorIs(t, err, ErrNoFeatures)
+
+	_, err = Decode(strings.NewReader(""), "geojson")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestInferSchema(t *testing.T) {
+	schema := inferSchema([]feature{
+		{properties: map[string]any{"name": "a", "height": 1.5}},
+		{properties: map[string]any{"name": "b", "count": int64(1), "visible": true}},
+	})
+
+	assert.Equal(t, map[string]any{
+		"height":  "Float_1",
+		"name":    "Text_2",
+		"count":   "Int_3",
+		"visible": "Boolean_4",
+	}, schema)
+}
+
+func TestShapePolygon(t *testing.T) {
+	outer := [][]float64{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}
+	hole := [][]float64{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}
+	outer2 := [][]float64{{20, 0}, {20, 10}, {30, 10}, {20, 0}}
+
+	g := shapePolygon([][][]float64{outer, hole})
+	assert.Equal(t, nlslayer.NewPolygon("Polygon", [][][]float64{outer, hole}), g)
+
+	g = shapePolygon([][][]float64{outer, hole, outer2})
+	assert.Equal(t, nlslayer.NewMultiPolygon("MultiPolygon", [][][][]float64{{outer, hole}, {outer2}}), g)
+}
@@ -0,0 +1,315 @@
+package decoding
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearth/server/pkg/kml"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+var errNoKMLInKMZ = errors.New("kmz does not contain a kml file")
+
+func decodeKML(r io.Reader) (*document, error) {
+	var root kml.KML
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+
+	d := &kmlDecoder{
+		styles:    map[string]kml.Style{},
+		styleMaps: map[string]string{},
+	}
+	d.collectStyles(root.Document)
+	for _, f := range root.Folders {
+		d.collectStyles(f)
+	}
+
+	d.doc.name = root.Document.Name
+	if err := d.decodeCollection(root.Document); err != nil {
+		return nil, err
+	}
+	for _, f := range root.Folders {
+		if err := d.decodeCollection(f); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range root.Placemarks {
+		if err := d.decodePlacemark(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return &d.doc, nil
+}
+
+// decodeKMZ decodes doc.kml in the archive, or the first kml file if there is no doc.kml.
+func decodeKMZ(r io.Reader) (*document, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	z, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, err
+	}
+
+	var target *zip.File
+	for _, f := range z.File {
+		if strings.ToLower(path.Ext(f.Name)) != ".kml" {
+			continue
+		}
+		if target == nil || f.Name == "doc.kml" {
+			target = f
+		}
+	}
+	if target == nil {
+		return nil, errNoKMLInKMZ
+	}
+	// the declared size is checked first, and archive/zip fails when the actual size differs from it
+	if target.UncompressedSize64 > MaxUncompressedSize {
+		return nil, ErrTooLarge
+	}
+
+	f, err := target.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return decodeKML(io.LimitReader(f, MaxUncompressedSize))
+}
+
+type kmlDecoder struct {
+	doc       document
+	styles    map[string]kml.Style
+	styleMaps map[string]string
+}
+
+func (d *kmlDecoder) collectStyles(c kml.Collection) {
+	for _, s := range c.Styles {
+		if s.Id != "" {
+			d.styles[s.Id] = s
+		}
+	}
+	for _, m := range c.StyleMaps {
+		for _, p := range m.Pairs {
+			if m.Id != "" && p.Key == "normal" {
+				d.styleMaps[m.Id] = p.StyleUrl
+			}
+		}
+	}
+	for _, f := range c.Folders {
+		d.collectStyles(f)
+	}
+}
+
+func (d *kmlDecoder) decodeCollection(c kml.Collection) error {
+	for _, p := range c.Placemarks {
+		if err := d.decodePlacemark(p); err != nil {
+			return err
+		}
+	}
+	for _, f := range c.Folders {
+		if err := d.decodeCollection(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *kmlDecoder) decodePlacemark(p kml.Placemark) error {
+	g, err := kmlGeometry(p)
+	if err != nil {
+		return fmt.Errorf("placemark %q: %w", p.Name, err)
+	}
+
+	props := map[string]any{}
+	if p.Name != "" {
+		props["name"] = p.Name
+	}
+	if p.Description != "" {
+		props["description"] = p.Description
+	}
+	if ed := p.ExtendedData; ed != nil {
+		for _, data := range ed.Data {
+			if data.Name != "" {
+				props[data.Name] = data.Value
+			}
+		}
+		for _, sd := range ed.SchemaData {
+			for _, data := range sd.SimpleData {
+				if data.Name != "" {
+					props[data.Name] = data.Value
+				}
+			}
+		}
+	}
+
+	d.doc.add(g, props, d.style(p))
+	return nil
+}
+
+// style resolves the style of the placemark. Inline styles are preferred to shared ones, and only local references such as "#id" are supported.
+func (d *kmlDecoder) style(p kml.Placemark) *featureStyle {
+	var s *kml.Style
+	if p.Style != nil {
+		s = p.Style
+	} else if id, ok := strings.CutPrefix(strings.TrimSpace(p.StyleUrl), "#"); ok {
+		if url, ok := d.styleMaps[id]; ok {
+			id = strings.TrimPrefix(url, "#")
+		}
+		if s2, ok := d.styles[id]; ok {
+			s = &s2
+		}
+	}
+	if s == nil {
+		return nil
+	}
+
+	res := &featureStyle{}
+	if s.IconStyle != nil {
+		res.markerColor = parseKMLColor(s.IconStyle.Color)
+	}
+	if s.LineStyle != nil {
+		res.strokeColor = parseKMLColor(s.LineStyle.Color)
+		if s.LineStyle.Width > 0 {
+			res.strokeWidth = &s.LineStyle.Width
+		}
+	}
+	if s.PolyStyle != nil {
+		res.fillColor = parseKMLColor(s.PolyStyle.Color)
+		res.fill = s.PolyStyle.Fill
+		res.stroke = s.PolyStyle.Stroke
+	}
+	return res
+}
+
+func kmlGeometry(p kml.Placemark) (nlslayer.Geometry, error) {
+	var geometries []nlslayer.Geometry
+
+	if p.Point != nil {
+		g, err := kmlPoint(p.Point.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, g)
+	}
+	if p.Polyline != nil {
+		g, err := kmlLineString(p.Polyline.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, g)
+	}
+	if p.Polygon != nil {
+		g, err := kmlPolygon(*p.Polygon)
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, g)
+	}
+
+	if mg := p.MultiGeometry; mg != nil {
+		var polygons [][][][]float64
+		for _, pt := range mg.Points {
+			g, err := kmlPoint(pt.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			geometries = append(geometries, g)
+		}
+		for _, ls := range mg.LineStrings {
+			g, err := kmlLineString(ls.Coordinates)
+			if err != nil {
+				return nil, err
+			}
+			geometries = append(geometries, g)
+		}
+		for _, pg := range mg.Polygons {
+			g, err := kmlPolygon(pg)
+			if err != nil {
+				return nil, err
+			}
+			polygons = append(polygons, g.Coordinates())
+		}
+		if g := ringsToGeometry(polygons); g != nil {
+			geometries = append(geometries, g)
+		}
+	}
+
+	switch len(geometries) {
+	case 0:
+		return nil, nil
+	case 1:
+		return geometries[0], nil
+	}
+	return nlslayer.NewGeometryCollection("GeometryCollection", geometries), nil
+}
+
+func kmlPoint(s string) (*nlslayer.Point, error) {
+	coords, err := parseKMLCoordinates(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(coords) != 1 {
+		return nil, errors.New("point must have one coordinate")
+	}
+	return nlslayer.NewPoint("Point", coords[0]), nil
+}
+
+func kmlLineString(s string) (*nlslayer.LineString, error) {
+	coords, err := parseKMLCoordinates(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(coords) < 2 {
+		return nil, errors.New("line string must have at least two coordinates")
+	}
+	return nlslayer.NewLineString("LineString", coords), nil
+}
+
+func kmlPolygon(p kml.Polygon) (*nlslayer.Polygon, error) {
+	rings := make([][][]float64, 0, len(p.InnerBoundaryIs)+1)
+	for _, b := range append([]kml.BoundaryIs{p.OuterBoundaryIs}, p.InnerBoundaryIs...) {
+		ring, err := parseKMLCoordinates(b.LinearRing.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		if len(ring) < 3 {
+			return nil, errors.New("linear ring must have at least three coordinates")
+		}
+		rings = append(rings, closeRing(ring))
+	}
+	return nlslayer.NewPolygon("Polygon", rings), nil
+}
+
+// parseKMLCoordinates parses tuples separated by whitespaces, such as "139.7,35.6,0 139.8,35.7,0".
+func parseKMLCoordinates(s string) ([][]float64, error) {
+	tuples := strings.Fields(s)
+	res := make([][]float64, 0, len(tuples))
+	for _, t := range tuples {
+		values := strings.Split(t, ",")
+		if len(values) < 2 || len(values) > 3 {
+			return nil, fmt.Errorf("invalid coordinates: %s", t)
+		}
+		c := make([]float64, 0, len(values))
+		for _, v := range values {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid coordinates: %s", t)
+			}
+			c = append(c, f)
+		}
+		res = append(res, c)
+	}
+	return res, nil
+}
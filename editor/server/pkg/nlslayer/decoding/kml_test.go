@@ -0,0 +1,177 @@
+package decoding
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKML = `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document>
+    <name>test</name>
+    <Style id="red">
+      <IconStyle><color>ff0000ff</color></IconStyle>
+      <LineStyle><color>7f00ff00</color><width>3</width></LineStyle>
+      <PolyStyle><color>80ff0000</color><outline>0</outline></PolyStyle>
+    </Style>
+    <StyleMap id="redMap">
+      <Pair><key>normal</key><styleUrl>#red</styleUrl></Pair>
+      <Pair><key>highlight</key><styleUrl>#blue</styleUrl></Pair>
+    </StyleMap>
+    <Placemark>
+      <name>point</name>
+      <description>a point</description>
+      <styleUrl>#red</styleUrl>
+      <ExtendedData>
+        <Data name="kind"><value>station</value></Data>
+        <SchemaData schemaUrl="#s"><SimpleData name="code">A1</SimpleData></SchemaData>
+      </ExtendedData>
+      <Point><coordinates>139.7,35.6,10</coordinates></Point>
+    </Placemark>
+    <Folder>
+      <name>folder</name>
+      <Placemark>
+        <name>line</name>
+        <styleUrl>#redMap</styleUrl>
+        <LineString><coordinates>
+          139.7,35.6 139.8,35.7
+        </coordinates></LineString>
+      </Placemark>
+      <Placemark>
+        <name>polygon</name>
+        <Style><PolyStyle><color>ff00ffff</color></PolyStyle></Style>
+        <Polygon>
+          <outerBoundaryIs><LinearRing><coordinates>0,0 0,1 1,1 1,0</coordinates></LinearRing></outerBoundaryIs>
+        </Polygon>
+      </Placemark>
+    </Folder>
+  </Document>
+</kml>`
+
+func TestDecode_KML(t *testing.T) {
+	res, err := Decode(strings.NewReader(testKML), FormatKML)
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", res.Name)
+	features := res.FeatureCollection.Features()
+	require.Len(t, features, 3)
+
+	assert.Equal(t, nlslayer.NewPoint("Point", []float64{139.7, 35.6, 10}), features[0].Geometry())
+	assert.Equal(t, map[string]any{
+		"name":         "point",
+		"description":  "a point",
+		"kind":         "station",
+		"code":         "A1",
+		"marker-color": "#ff0000",
+	}, *features[0].Properties())
+
+	assert.Equal(t, nlslayer.NewLineString("LineString", [][]float64{{139.7, 35.6}, {139.8, 35.7}}), features[1].Geometry())
+	assert.Equal(t, map[string]any{
+		"name":           "line",
+		"stroke":         "#00ff00",
+		"stroke-opacity": 0.5,
+		"stroke-width":   3.0,
+	}, *features[1].Properties())
+
+	// the ring is closed
+	assert.Equal(t, nlslayer.NewPolygon("Polygon", [][][]float64{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}), features[2].Geometry())
+	assert.Equal(t, map[string]any{
+		"name":           "polygon",
+		"fill":           "#ffff00",
+		"fill-opacity":   1.0,
+		"stroke":         "#ffffff",
+		"stroke-opacity": 1.0,
+		"stroke-width":   1.0,
+	}, *features[2].Properties())
+
+	assert.Equal(t, map[string]any{
+		"code":           "Text_1",
+		"description":    "Text_2",
+		"kind":           "Text_3",
+		"marker-color":   "Text_4",
+		"name":           "Text_5",
+		"stroke":         "Text_6",
+		"stroke-opacity": "Float_7",
+		"stroke-width":   "Float_8",
+		"fill":           "Text_9",
+		"fill-opacity":   "Float_10",
+	}, res.Schema)
+
+	assert.Contains(t, res.Style, "marker")
+	assert.Contains(t, res.Style, "polyline")
+	assert.Contains(t, res.Style, "polygon")
+}
+
+func TestDecode_KMZ(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	w, err := zw.Create("doc.kml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(testKML))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	res, err := Decode(buf, FormatKMZ)
+	require.NoError(t, err)
+	assert.Len(t, res.FeatureCollection.Features(), 3)
+
+	buf = &bytes.Buffer{}
+	zw = zip.NewWriter(buf)
+	_, err = zw.Create("image.png")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = Decode(buf, FormatKMZ)
+	assert.ErrorIs(t, err, errNoKMLInKMZ)
+
+	// the size in the header is checked before decompressing the file
+	buf = &bytes.Buffer{}
+	zw = zip.NewWriter(buf)
+	w, err = zw.CreateRaw(&zip.FileHeader{
+		Name:               "doc.kml",
+		Method:             zip.Store,
+		CompressedSize64:   uint64(len(testKML)),
+		UncompressedSize64: MaxUncompressedSize + 1,
+	})
+	require.NoError(t, err)
+	_, err = w.Write([]byte(testKML))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = Decode(buf, FormatKMZ)
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestDecode_KMLWithoutStyles(t *testing.T) {
+	res, err := Decode(strings.NewReader(`<kml><Placemark><MultiGeometry>
+		<Point><coordinates>1,2</coordinates></Point>
+		<Polygon><outerBoundaryIs><LinearRing><coordinates>0,0 0,1 1,1 0,0</coordinates></LinearRing></outerBoundaryIs></Polygon>
+	</MultiGeometry></Placemark></kml>`), FormatKML)
+	require.NoError(t, err)
+
+	features := res.FeatureCollection.Features()
+	require.Len(t, features, 1)
+	assert.Equal(t, nlslayer.NewGeometryCollection("GeometryCollection", []nlslayer.Geometry{
+		nlslayer.NewPoint("Point", []float64{1, 2}),
+		nlslayer.NewPolygon("Polygon", [][][]float64{{{0, 0}, {0, 1}, {1, 1}, {0, 0}}}),
+	}), features[0].Geometry())
+	assert.Empty(t, *features[0].Properties())
+	assert.Nil(t, res.Style)
+}
+
+func TestDecode_KMLInvalidCoordinates(t *testing.T) {
+	_, err := Decode(strings.NewReader(`<kml><Placemark><name>a</name><Point><coordinates>x,1</coordinates></Point></Placemark></kml>`), FormatKML)
+	assert.ErrorContains(t, err, `placemark "a": invalid coordinates: x,1`)
+}
+
+func TestParseKMLColor(t *testing.T) {
+	assert.Equal(t, &color{hex: "#563412", alpha: 1}, parseKMLColor("ff123456"))
+	assert.Nil(t, parseKMLColor("red"))
+	assert.Nil(t, parseKMLColor(""))
+}
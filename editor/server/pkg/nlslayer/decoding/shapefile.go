@@ -0,0 +1,200 @@
+package decoding
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/shp"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// decodeShapefile decodes a zipped Shapefile. Coordinates are not reprojected, so they should be in WGS 84.
+// Attributes in the DBF file are used as properties.
+func decodeShapefile(r io.Reader) (*document, error) {
+	zr, err := shp.ReadZipFromWithLimit(r, MaxUncompressedSize)
+	if errors.Is(err, shp.ErrTooLarge) {
+		return nil, ErrTooLarge
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = zr.Close()
+	}()
+
+	fields := zr.Fields()
+	d := &document{}
+	for zr.Next() {
+		i, s := zr.Shape()
+		g, err := shapeGeometry(s)
+		if err != nil {
+			return nil, fmt.Errorf("shape %d: %w", i, err)
+		}
+
+		props := map[string]any{}
+		for n, f := range fields {
+			if v, ok := dbfValue(f, zr.Attribute(n)); ok {
+				props[f.String()] = v
+			}
+		}
+
+		d.add(g, props, nil)
+	}
+	if err := zr.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func shapeGeometry(s shp.Shape) (nlslayer.Geometry, error) {
+	switch s := s.(type) {
+	case *shp.Point:
+		return nlslayer.NewPoint("Point", []float64{s.X, s.Y}), nil
+	case *shp.PointM:
+		return nlslayer.NewPoint("Point", []float64{s.X, s.Y}), nil
+	case *shp.PointZ:
+		return nlslayer.NewPoint("Point", []float64{s.X, s.Y, s.Z}), nil
+	case *shp.MultiPoint:
+		return shapeMultiPoint(s.Points, nil), nil
+	case *shp.MultiPointM:
+		return shapeMultiPoint(s.Points, nil), nil
+	case *shp.MultiPointZ:
+		return shapeMultiPoint(s.Points, s.ZArray), nil
+	case *shp.PolyLine:
+		return shapeLineStrings(shapeParts(s.Parts, s.Points, nil)), nil
+	case *shp.PolyLineM:
+		return shapeLineStrings(shapeParts(s.Parts, s.Points, nil)), nil
+	case *shp.PolyLineZ:
+		return shapeLineStrings(shapeParts(s.Parts, s.Points, s.ZArray)), nil
+	case *shp.Polygon:
+		return shapePolygon(shapeParts(s.Parts, s.Points, nil)), nil
+	case *shp.PolygonM:
+		return shapePolygon(shapeParts(s.Parts, s.Points, nil)), nil
+	case *shp.PolygonZ:
+		return shapePolygon(shapeParts(s.Parts, s.Points, s.ZArray)), nil
+	case *shp.Null:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported shape type: %T", s)
+}
+
+func shapeMultiPoint(points []shp.Point, z []float64) nlslayer.Geometry {
+	coords := shapeCoordinates(points, z)
+	if len(coords) == 1 {
+		return nlslayer.NewPoint("Point", coords[0])
+	}
+	geometries := make([]nlslayer.Geometry, 0, len(coords))
+	for _, c := range coords {
+		geometries = append(geometries, nlslayer.NewPoint("Point", c))
+	}
+	return nlslayer.NewGeometryCollection("GeometryCollection", geometries)
+}
+
+func shapeLineStrings(parts [][][]float64) nlslayer.Geometry {
+	if len(parts) == 1 {
+		return nlslayer.NewLineString("LineString", parts[0])
+	}
+	geometries := make([]nlslayer.Geometry, 0, len(parts))
+	for _, p := range parts {
+		geometries = append(geometries, nlslayer.NewLineString("LineString", p))
+	}
+	return nlslayer.NewGeometryCollection("GeometryCollection", geometries)
+}
+
+// shapePolygon groups rings into polygons. In Shapefiles, outer rings are clockwise and holes are counterclockwise.
+func shapePolygon(rings [][][]float64) nlslayer.Geometry {
+	var polygons [][][][]float64
+	for _, r := range rings {
+		r = closeRing(r)
+		if len(polygons) == 0 || ringArea(r) < 0 {
+			polygons = append(polygons, [][][]float64{r})
+			continue
+		}
+		last := len(polygons) - 1
+		polygons[last] = append(polygons[last], r)
+	}
+	return ringsToGeometry(polygons)
+}
+
+// ringArea returns the signed area of the ring, which is negative when the ring is clockwise.
+func ringArea(ring [][]float64) float64 {
+	a := 0.0
+	for i := 0; i+1 < len(ring); i++ {
+		a += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return a / 2
+}
+
+func shapeParts(parts []int32, points []shp.Point, z []float64) [][][]float64 {
+	coords := shapeCoordinates(points, z)
+	res := make([][][]float64, 0, len(parts))
+	for i, start := range parts {
+		end := int32(len(coords))
+		if i+1 < len(parts) {
+			end = parts[i+1]
+		}
+		if start < 0 || start > end || end > int32(len(coords)) {
+			continue
+		}
+		res = append(res, coords[start:end])
+	}
+	return res
+}
+
+func shapeCoordinates(points []shp.Point, z []float64) [][]float64 {
+	res := make([][]float64, 0, len(points))
+	for i, p := range points {
+		if i < len(z) {
+			res = append(res, []float64{p.X, p.Y, z[i]})
+		} else {
+			res = append(res, []float64{p.X, p.Y})
+		}
+	}
+	return res
+}
+
+// dbfValue converts an attribute according to the field type. Empty values are omitted.
+func dbfValue(f shp.Field, v string) (any, bool) {
+	if v == "" {
+		return nil, false
+	}
+
+	switch f.Fieldtype {
+	case 'N', 'F':
+		if f.Precision == 0 {
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return i, true
+			}
+		}
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return n, true
+		}
+	case 'L':
+		switch strings.ToUpper(v) {
+		case "T", "Y":
+			return true, true
+		case "F", "N":
+			return false, true
+		}
+		return nil, false
+	}
+
+	return decodeDBFText(v), true
+}
+
+// decodeDBFText decodes texts that are not UTF-8 as Shift_JIS, which is commonly used in Shapefiles made in Japan.
+func decodeDBFText(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+	if d, err := japanese.ShiftJIS.NewDecoder().String(s); err == nil {
+		return d
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+package decoding
+
+import (
+	"os"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/shp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode_Shapefile(t *testing.T) {
+	f, err := os.Open("../../shp/test_files/ne_110m_admin_0_countries.zip")
+	require.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+
+	res, err := Decode(f, FormatShapefile)
+	require.NoError(t, err)
+
+	features := res.FeatureCollection.Features()
+	assert.Len(t, features, 177)
+	assert.Nil(t, res.Style)
+
+	props := *features[0].Properties()
+	assert.Equal(t, "Fiji", props["SOVEREIGNT"])
+	assert.Equal(t, int64(1), props["scalerank"])
+	assert.Equal(t, "Text_1", res.Schema["ABBREV"])
+	assert.IsType(t, &nlslayer.MultiPolygon{}, features[0].Geometry())
+}
+
+func TestDBFValue(t *testing.T) {
+	assert.Equal(t, []any{int64(12), true}, pair(dbfValue(shp.Field{Fieldtype: 'N'}, "12")))
+	assert.Equal(t, []any{1.5, true}, pair(dbfValue(shp.Field{Fieldtype: 'N', Precision: 1}, "1.5")))
+	assert.Equal(t, []any{true, true}, pair(dbfValue(shp.Field{Fieldtype: 'L'}, "T")))
+	assert.Equal(t, []any{nil, false}, pair(dbfValue(shp.Field{Fieldtype: 'L'}, "?")))
+	assert.Equal(t, []any{nil, false}, pair(dbfValue(shp.Field{Fieldtype: 'C'}, "")))
+	// Shift_JIS
+	assert.Equal(t, []any{"東京", true}, pair(dbfValue(shp.Field{Fieldtype: 'C'}, "\x93\x8c\x8b\x9e")))
+}
+
+func pair(v any, ok bool) []any {
+	return []any{v, ok}
+}
@@ -0,0 +1,156 @@
+package decoding
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+// Styles of features are stored in their properties following the simplestyle spec,
+// and the layer style refers to them with expressions.
+const (
+	propMarkerColor   = "marker-color"
+	propStroke        = "stroke"
+	propStrokeOpacity = "stroke-opacity"
+	propStrokeWidth   = "stroke-width"
+	propFill          = "fill"
+	propFillOpacity   = "fill-opacity"
+)
+
+const (
+	defaultColor       = "#ffffff"
+	defaultStrokeWidth = 1.0
+)
+
+type color struct {
+	hex   string
+	alpha float64
+}
+
+type featureStyle struct {
+	markerColor *color
+	strokeColor *color
+	strokeWidth *float64
+	fillColor   *color
+	fill        *bool
+	stroke      *bool
+}
+
+// apply writes the style into the properties. Defaults are used for missing values so that expressions of the layer style can be evaluated for all features.
+func (s *featureStyle) apply(g nlslayer.Geometry, props map[string]any) {
+	if s == nil {
+		s = &featureStyle{}
+	}
+
+	var kinds geometryKinds
+	kinds.add(g)
+
+	if kinds.point {
+		props[propMarkerColor] = s.markerColor.hexOr(defaultColor)
+	}
+
+	if kinds.lineString || kinds.polygon {
+		strokeOpacity := s.strokeColor.alphaOr(1)
+		// outlines of polygons can be hidden, but lines are always drawn
+		if s.stroke != nil && !*s.stroke && !kinds.lineString {
+			strokeOpacity = 0
+		}
+		props[propStroke] = s.strokeColor.hexOr(defaultColor)
+		props[propStrokeOpacity] = strokeOpacity
+		if s.strokeWidth != nil {
+			props[propStrokeWidth] = *s.strokeWidth
+		} else {
+			props[propStrokeWidth] = defaultStrokeWidth
+		}
+	}
+
+	if kinds.polygon {
+		fillOpacity := s.fillColor.alphaOr(1)
+		if s.fill != nil && !*s.fill {
+			fillOpacity = 0
+		}
+		props[propFill] = s.fillColor.hexOr(defaultColor)
+		props[propFillOpacity] = fillOpacity
+	}
+}
+
+func (c *color) hexOr(def string) string {
+	if c == nil {
+		return def
+	}
+	return c.hex
+}
+
+func (c *color) alphaOr(def float64) float64 {
+	if c == nil {
+		return def
+	}
+	return c.alpha
+}
+
+// styleValue returns a layer style for the geometries, which reads colors and widths from properties of each feature.
+func (k geometryKinds) styleValue() map[string]any {
+	stroke := map[string]any{"expression": fmt.Sprintf("color(${%s},${%s})", propStroke, propStrokeOpacity)}
+	strokeWidth := map[string]any{"expression": fmt.Sprintf("${%s}", propStrokeWidth)}
+
+	res := map[string]any{}
+	if k.point {
+		res["marker"] = map[string]any{
+			"style":           "point",
+			"heightReference": "clamp",
+			"pointColor":      map[string]any{"expression": fmt.Sprintf("${%s}", propMarkerColor)},
+		}
+	}
+	if k.lineString {
+		res["polyline"] = map[string]any{
+			"clampToGround": true,
+			"strokeColor":   stroke,
+			"strokeWidth":   strokeWidth,
+		}
+	}
+	if k.polygon {
+		res["polygon"] = map[string]any{
+			"heightReference": "clamp",
+			"fill":            true,
+			"fillColor":       map[string]any{"expression": fmt.Sprintf("color(${%s},${%s})", propFill, propFillOpacity)},
+			"stroke":          true,
+			"strokeColor":     stroke,
+			"strokeWidth":     strokeWidth,
+		}
+	}
+	return res
+}
+
+// parseKMLColor parses a KML color, which is hex in aabbggrr order.
+func parseKMLColor(s string) *color {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) != 8 {
+		return nil
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return nil
+	}
+	a, b, g, r := v>>24&0xff, v>>16&0xff, v>>8&0xff, v&0xff
+	return &color{
+		hex:   fmt.Sprintf("#%02x%02x%02x", r, g, b),
+		alpha: roundAlpha(float64(a) / 255),
+	}
+}
+
+func rgbaColor(r, g, b, a float64) *color {
+	clamp := func(v float64) int {
+		return int(math.Round(math.Max(0, math.Min(255, v))))
+	}
+	return &color{
+		hex:   fmt.Sprintf("#%02x%02x%02x", clamp(r), clamp(g), clamp(b)),
+		alpha: roundAlpha(a / 255),
+	}
+}
+
+func roundAlpha(a float64) float64 {
+	return math.Round(a*100) / 100
+}
@@ -0,0 +1,133 @@
+package encoding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reearth/reearth/server/pkg/czml"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+const defaultPointPixelSize = 10
+
+// encodeCZML writes a packet per part of the geometries, as a packet can have only one polygon or polyline.
+// When there are several layers, features refer to a packet of their layer as the parent.
+func encodeCZML(w io.Writer, layers []*layer) error {
+	doc := czml.Feature{Id: "document", Version: "1.0"}
+	if len(layers) == 1 {
+		doc.Name = layers[0].name
+	}
+	packets := []czml.Feature{doc}
+
+	for _, l := range layers {
+		parent := ""
+		if len(layers) > 1 {
+			parent = l.id
+			packets = append(packets, czml.Feature{Id: l.id, Name: l.name})
+		}
+		for _, f := range l.features {
+			packets = append(packets, czmlPackets(l, f, parent)...)
+		}
+	}
+
+	return json.NewEncoder(w).Encode(packets)
+}
+
+func czmlPackets(l *layer, f nlslayer.Feature, parent string) []czml.Feature {
+	props := *f.Properties()
+	attrs := map[string]any{}
+	for _, fd := range l.fields {
+		if v, ok := props[fd.name]; ok && v != nil {
+			attrs[fd.name] = v
+		}
+	}
+	if len(attrs) == 0 {
+		attrs = nil
+	}
+	style := styleFromProperties(props)
+
+	var res []czml.Feature
+	newPacket := func() *czml.Feature {
+		p := czml.Feature{
+			Id:         f.ID().String(),
+			Name:       stringProperty(props, "name"),
+			Parent:     parent,
+			Properties: attrs,
+		}
+		if len(res) > 0 {
+			p.Id = fmt.Sprintf("%s_%d", p.Id, len(res))
+		}
+		if desc := stringProperty(props, "description"); desc != "" {
+			p.Description = desc
+		}
+		res = append(res, p)
+		return &res[len(res)-1]
+	}
+
+	pts := splitGeometry(f.Geometry())
+	for _, c := range pts.points {
+		p := newPacket()
+		p.Position = &czml.Position{CartographicDegrees: cartographicDegrees([][]float64{c})}
+		p.Point = &czml.Point{PixelSize: defaultPointPixelSize}
+		if style != nil && style.markerColor != nil {
+			p.Point.Color = &czml.Color{RGBA: style.markerColor.rgba()}
+		}
+	}
+	for _, c := range pts.lineStrings {
+		p := newPacket()
+		p.Polyline = &czml.Polyline{Positions: czml.Position{CartographicDegrees: cartographicDegrees(c)}}
+		if style != nil {
+			p.Polyline.Material = czmlMaterial(style.strokeColor)
+			p.Polyline.Width = style.strokeWidth
+		}
+	}
+	for _, rings := range pts.polygons {
+		if len(rings) == 0 {
+			continue
+		}
+		p := newPacket()
+		p.Polygon = &czml.Polygon{Positions: czml.Position{CartographicDegrees: cartographicDegrees(rings[0])}}
+		if len(rings) > 1 {
+			holes := make([][]float64, 0, len(rings)-1)
+			for _, r := range rings[1:] {
+				holes = append(holes, cartographicDegrees(r))
+			}
+			p.Polygon.Holes = &czml.PositionList{CartographicDegrees: holes}
+		}
+		if style != nil {
+			p.Polygon.Material = czmlMaterial(style.fillColor)
+			if style.strokeColor != nil {
+				outline := true
+				p.Polygon.Stroke = &outline
+				p.Polygon.StrokeColor = &czml.Color{RGBA: style.strokeColor.rgba()}
+				p.Polygon.StrokeWidth = style.strokeWidth
+			}
+		}
+	}
+
+	return res
+}
+
+func czmlMaterial(c *color) *czml.Material {
+	if c == nil {
+		return nil
+	}
+	return &czml.Material{SolidColor: &czml.SolidColor{Color: &czml.Color{RGBA: c.rgba()}}}
+}
+
+// cartographicDegrees flattens positions into longitude, latitude and height. Heights are 0 when omitted.
+func cartographicDegrees(coords [][]float64) []float64 {
+	res := make([]float64, 0, len(coords)*3)
+	for _, c := range coords {
+		if len(c) < 2 {
+			continue
+		}
+		h := 0.0
+		if len(c) > 2 {
+			h = c[2]
+		}
+		res = append(res, c[0], c[1], h)
+	}
+	return res
+}
@@ -0,0 +1,71 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/czml"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/nlslayer/decoding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode_CZML(t *testing.T) {
+	l := sketchLayer("sketch")
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{l}, FormatCZML))
+
+	var packets []czml.Feature
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &packets))
+	assert.Len(t, packets, 4)
+	assert.Equal(t, czml.Feature{Id: "document", Name: "sketch", Version: "1.0"}, packets[0])
+
+	point := packets[1]
+	assert.Equal(t, l.Sketch().FeatureCollection().Features()[0].ID().String(), point.Id)
+	assert.Equal(t, "東京タワー", point.Name)
+	assert.Equal(t, []float64{139.7, 35.6, 0}, point.Position.CartographicDegrees)
+	assert.Equal(t, []int64{255, 0, 0, 255}, point.Point.Color.RGBA)
+	assert.Equal(t, 333.0, point.Properties["height"])
+	assert.Equal(t, true, point.Properties["open"])
+
+	assert.Equal(t, []int64{0, 255, 0, 128}, packets[2].Polyline.Material.SolidColor.Color.RGBA)
+	assert.Equal(t, 3.0, packets[2].Polyline.Width)
+	assert.Len(t, packets[3].Polygon.Holes.CartographicDegrees, 1)
+
+	res, err := decoding.Decode(&buf, decoding.FormatCZML)
+	assert.NoError(t, err)
+	assert.Equal(t, "sketch", res.Name)
+	features := res.FeatureCollection.Features()
+	assert.Len(t, features, 3)
+	assert.Equal(t, 333.0, (*features[0].Properties())["height"])
+	assert.Len(t, features[2].Geometry().(*nlslayer.Polygon).Coordinates(), 2)
+}
+
+func TestEncode_CZML_Layers(t *testing.T) {
+	a, b := sketchLayer("a"), sketchLayer("b")
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{a, b}, FormatCZML))
+
+	var packets []czml.Feature
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &packets))
+	assert.Len(t, packets, 9)
+	assert.Equal(t, "", packets[0].Name)
+	assert.Equal(t, czml.Feature{Id: a.ID().String(), Name: "a"}, packets[1])
+	assert.Equal(t, a.ID().String(), packets[2].Parent)
+	assert.Equal(t, czml.Feature{Id: b.ID().String(), Name: "b"}, packets[5])
+	assert.Equal(t, b.ID().String(), packets[8].Parent)
+}
+
+func TestCZMLPackets_GeometryCollection(t *testing.T) {
+	f := testFeature(nlslayer.NewGeometryCollection("GeometryCollection", []nlslayer.Geometry{
+		nlslayer.NewPoint("Point", []float64{1, 2}),
+		nlslayer.NewPoint("Point", []float64{3, 4, 5}),
+	}), nil)
+
+	packets := czmlPackets(&layer{}, f, "")
+	assert.Len(t, packets, 2)
+	assert.Equal(t, f.ID().String(), packets[0].Id)
+	assert.Equal(t, f.ID().String()+"_1", packets[1].Id)
+	assert.Equal(t, []float64{3, 4, 5}, packets[1].Position.CartographicDegrees)
+}
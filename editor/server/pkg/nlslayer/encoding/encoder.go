@@ -0,0 +1,290 @@
+package encoding
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+type Format string
+
+const (
+	FormatGeoJSON   Format = "geojson"
+	FormatKML       Format = "kml"
+	FormatShapefile Format = "shapefile"
+	FormatCZML      Format = "czml"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format")
+	ErrNoLayers          = errors.New("no layers to export")
+	ErrNotSketchLayer    = errors.New("layer is not a sketch layer")
+)
+
+func FormatFrom(s string) (Format, error) {
+	f := Format(strings.ToLower(s))
+	switch f {
+	case FormatGeoJSON, FormatKML, FormatShapefile, FormatCZML:
+		return f, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Ext returns the extension of exported files. Shapefiles are zipped as they consist of several files.
+func (f Format) Ext() string {
+	switch f {
+	case FormatGeoJSON:
+		return ".geojson"
+	case FormatKML:
+		return ".kml"
+	case FormatShapefile:
+		return ".zip"
+	case FormatCZML:
+		return ".czml"
+	}
+	return ""
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatGeoJSON:
+		return "application/geo+json"
+	case FormatKML:
+		return "application/vnd.google-earth.kml+xml"
+	case FormatShapefile:
+		return "application/zip"
+	case FormatCZML:
+		return "application/json"
+	}
+	return "application/octet-stream"
+}
+
+// Encode writes features of the sketch layers in the format. Properties of the features, including custom properties, are written as attributes.
+func Encode(w io.Writer, layers []*nlslayer.NLSLayerSimple, f Format) error {
+	if len(layers) == 0 {
+		return ErrNoLayers
+	}
+
+	ls := make([]*layer, 0, len(layers))
+	for _, l := range layers {
+		el, err := newLayer(l)
+		if err != nil {
+			return err
+		}
+		ls = append(ls, el)
+	}
+
+	var err error
+	switch f {
+	case FormatGeoJSON:
+		err = encodeGeoJSON(w, ls)
+	case FormatKML:
+		err = encodeKML(w, ls)
+	case FormatShapefile:
+		err = encodeShapefile(w, ls)
+	case FormatCZML:
+		err = encodeCZML(w, ls)
+	default:
+		return ErrUnsupportedFormat
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", f, err)
+	}
+	return nil
+}
+
+// layer is an intermediate representation of a sketch layer to be encoded.
+type layer struct {
+	id       string
+	name     string
+	fields   []field
+	features []nlslayer.Feature
+}
+
+type fieldType int
+
+const (
+	fieldText fieldType = iota
+	fieldInt
+	fieldFloat
+	fieldBoolean
+)
+
+type field struct {
+	name string
+	typ  fieldType
+}
+
+func newLayer(l *nlslayer.NLSLayerSimple) (*layer, error) {
+	if l == nil || !l.IsSketch() || l.Sketch() == nil || l.Sketch().FeatureCollection() == nil {
+		title := ""
+		if l != nil {
+			title = l.Title()
+		}
+		return nil, fmt.Errorf("%w: %q", ErrNotSketchLayer, title)
+	}
+
+	features := l.Sketch().FeatureCollection().Features()
+	return &layer{
+		id:       l.ID().String(),
+		name:     l.Title(),
+		fields:   fields(l.Sketch().CustomPropertySchema(), features),
+		features: features,
+	}, nil
+}
+
+// fields returns fields of the custom property schema in the order of the schema, followed by other properties of the features in alphabetical order.
+func fields(schema *map[string]any, features []nlslayer.Feature) []field {
+	type schemaField struct {
+		field
+		order int
+	}
+
+	var sfs []schemaField
+	known := map[string]struct{}{}
+	if schema != nil {
+		for k, v := range *schema {
+			s, _ := v.(string)
+			t, order := parseSchemaType(s)
+			sfs = append(sfs, schemaField{field: field{name: k, typ: t}, order: order})
+			known[k] = struct{}{}
+		}
+	}
+	sort.Slice(sfs, func(i, j int) bool {
+		if sfs[i].order != sfs[j].order {
+			return sfs[i].order < sfs[j].order
+		}
+		return sfs[i].name < sfs[j].name
+	})
+
+	res := make([]field, 0, len(sfs))
+	for _, sf := range sfs {
+		res = append(res, sf.field)
+	}
+
+	others := map[string]fieldType{}
+	for _, f := range features {
+		for k, v := range *f.Properties() {
+			if _, ok := known[k]; ok || v == nil {
+				continue
+			}
+			t := valueType(v)
+			if prev, ok := others[k]; ok && prev != t {
+				if (prev == fieldInt && t == fieldFloat) || (prev == fieldFloat && t == fieldInt) {
+					t = fieldFloat
+				} else {
+					t = fieldText
+				}
+			}
+			others[k] = t
+		}
+	}
+	keys := make([]string, 0, len(others))
+	for k := range others {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		res = append(res, field{name: k, typ: others[k]})
+	}
+
+	return res
+}
+
+// parseSchemaType parses types of custom property schemas such as "Float_2".
+func parseSchemaType(s string) (fieldType, int) {
+	name, n, _ := strings.Cut(s, "_")
+	order, err := strconv.Atoi(n)
+	if err != nil {
+		order = 0
+	}
+
+	switch name {
+	case "Int":
+		return fieldInt, order
+	case "Float", "Number":
+		return fieldFloat, order
+	case "Boolean":
+		return fieldBoolean, order
+	}
+	return fieldText, order
+}
+
+func valueType(v any) fieldType {
+	switch v.(type) {
+	case bool:
+		return fieldBoolean
+	case int, int32, int64:
+		return fieldInt
+	case float32, float64:
+		return fieldFloat
+	}
+	return fieldText
+}
+
+// formatValue converts a property value into a string for formats whose attributes are texts.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	if b, err := json.Marshal(v); err == nil {
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
+
+func stringProperty(props map[string]any, key string) string {
+	s, _ := props[key].(string)
+	return s
+}
+
+// parts splits the geometry into points, line strings and polygons.
+type parts struct {
+	points      [][]float64
+	lineStrings [][][]float64
+	polygons    [][][][]float64
+}
+
+func (p *parts) add(g nlslayer.Geometry) {
+	switch g := g.(type) {
+	case *nlslayer.Point:
+		p.points = append(p.points, g.Coordinates())
+	case *nlslayer.LineString:
+		p.lineStrings = append(p.lineStrings, g.Coordinates())
+	case *nlslayer.Polygon:
+		p.polygons = append(p.polygons, g.Coordinates())
+	case *nlslayer.MultiPolygon:
+		p.polygons = append(p.polygons, g.Coordinates()...)
+	case *nlslayer.GeometryCollection:
+		for _, g2 := range g.Geometries() {
+			p.add(g2)
+		}
+	}
+}
+
+func splitGeometry(g nlslayer.Geometry) parts {
+	var p parts
+	p.add(g)
+	return p
+}
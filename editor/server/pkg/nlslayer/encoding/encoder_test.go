@@ -0,0 +1,101 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/stretchr/testify/assert"
+)
+
+// sketchLayer returns a sketch layer with a point, a line and a polygon with a hole.
+func sketchLayer(title string) *nlslayer.NLSLayerSimple {
+	schema := map[string]any{"name": "Text_1", "height": "Float_2", "count": "Int_3", "open": "Boolean_4"}
+
+	point := testFeature(nlslayer.NewPoint("Point", []float64{139.7, 35.6}), map[string]any{
+		"name": "東京タワー", "height": 333.0, "count": 1.0, "open": true, "marker-color": "#ff0000",
+	})
+	line := testFeature(nlslayer.NewLineString("LineString", [][]float64{{139.7, 35.6, 10}, {139.8, 35.7, 20}}), map[string]any{
+		"name": "route", "stroke": "#00ff00", "stroke-opacity": 0.5, "stroke-width": 3.0,
+	})
+	polygon := testFeature(nlslayer.NewPolygon("Polygon", [][][]float64{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+	}), map[string]any{"name": "area", "memo": "extra"})
+
+	fc := nlslayer.NewFeatureCollection("FeatureCollection", []nlslayer.Feature{point, line, polygon})
+	return nlslayer.NewNLSLayerSimple().NewID().Scene(id.NewSceneID()).Title(title).
+		IsSketch(true).Sketch(nlslayer.NewSketchInfo(&schema, fc)).MustBuild()
+}
+
+func testFeature(g nlslayer.Geometry, props map[string]any) nlslayer.Feature {
+	f, _ := nlslayer.NewFeature(id.NewFeatureID(), "Feature", g)
+	f.UpdateProperties(&props)
+	return *f
+}
+
+func TestFormatFrom(t *testing.T) {
+	f, err := FormatFrom("KML")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatKML, f)
+	assert.Equal(t, ".kml", f.Ext())
+	assert.Equal(t, ".zip", FormatShapefile.Ext())
+
+	_, err = FormatFrom("gpx")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestEncode_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	assert.ErrorIs(t, Encode(&buf, nil, FormatGeoJSON), ErrNoLayers)
+
+	l := nlslayer.NewNLSLayerSimple().NewID().Scene(id.NewSceneID()).Title("data").MustBuild()
+	assert.ErrorIs(t, Encode(&buf, []*nlslayer.NLSLayerSimple{l}, FormatGeoJSON), ErrNotSketchLayer)
+
+	assert.ErrorIs(t, Encode(&buf, []*nlslayer.NLSLayerSimple{sketchLayer("a")}, "gpx"), ErrUnsupportedFormat)
+}
+
+func TestFields(t *testing.T) {
+	l := sketchLayer("a")
+	got := fields(l.Sketch().CustomPropertySchema(), l.Sketch().FeatureCollection().Features())
+
+	assert.Equal(t, []field{
+		{name: "name", typ: fieldText},
+		{name: "height", typ: fieldFloat},
+		{name: "count", typ: fieldInt},
+		{name: "open", typ: fieldBoolean},
+		{name: "marker-color", typ: fieldText},
+		{name: "memo", typ: fieldText},
+		{name: "stroke", typ: fieldText},
+		{name: "stroke-opacity", typ: fieldFloat},
+		{name: "stroke-width", typ: fieldFloat},
+	}, got)
+}
+
+func TestEncode_GeoJSON(t *testing.T) {
+	l := sketchLayer("sketch")
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{l}, FormatGeoJSON))
+
+	var got map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "FeatureCollection", got["type"])
+	assert.Equal(t, "sketch", got["name"])
+
+	features := got["features"].([]any)
+	assert.Len(t, features, 3)
+	first := features[0].(map[string]any)
+	assert.Equal(t, l.Sketch().FeatureCollection().Features()[0].ID().String(), first["id"])
+	assert.Equal(t, map[string]any{"type": "Point", "coordinates": []any{139.7, 35.6}}, first["geometry"])
+	assert.Equal(t, "東京タワー", first["properties"].(map[string]any)["name"])
+	assert.Equal(t, "Polygon", features[2].(map[string]any)["geometry"].(map[string]any)["type"])
+
+	buf.Reset()
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{l, sketchLayer("other")}, FormatGeoJSON))
+	got = nil
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Nil(t, got["name"])
+	assert.Len(t, got["features"], 6)
+}
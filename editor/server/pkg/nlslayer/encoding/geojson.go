@@ -0,0 +1,67 @@
+package encoding
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Name     string           `json:"name,omitempty"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string         `json:"type"`
+	ID         string         `json:"id"`
+	Geometry   any            `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// encodeGeoJSON writes features of all layers into a FeatureCollection. It is named after the layer when there is only one layer.
+func encodeGeoJSON(w io.Writer, layers []*layer) error {
+	fc := geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []geoJSONFeature{},
+	}
+	if len(layers) == 1 {
+		fc.Name = layers[0].name
+	}
+
+	for _, l := range layers {
+		for _, f := range l.features {
+			fc.Features = append(fc.Features, geoJSONFeature{
+				Type:       "Feature",
+				ID:         f.ID().String(),
+				Geometry:   geoJSONGeometry(f.Geometry()),
+				Properties: *f.Properties(),
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(fc)
+}
+
+func geoJSONGeometry(g nlslayer.Geometry) map[string]any {
+	switch g := g.(type) {
+	case *nlslayer.Point:
+		return map[string]any{"type": "Point", "coordinates": g.Coordinates()}
+	case *nlslayer.LineString:
+		return map[string]any{"type": "LineString", "coordinates": g.Coordinates()}
+	case *nlslayer.Polygon:
+		return map[string]any{"type": "Polygon", "coordinates": g.Coordinates()}
+	case *nlslayer.MultiPolygon:
+		return map[string]any{"type": "MultiPolygon", "coordinates": g.Coordinates()}
+	case *nlslayer.GeometryCollection:
+		geometries := make([]map[string]any, 0, len(g.Geometries()))
+		for _, g2 := range g.Geometries() {
+			if m := geoJSONGeometry(g2); m != nil {
+				geometries = append(geometries, m)
+			}
+		}
+		return map[string]any{"type": "GeometryCollection", "geometries": geometries}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package encoding
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearth/server/pkg/kml"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+)
+
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// encodeKML writes placemarks of a layer into the document, or into a folder per layer when there are several layers.
+// All properties are written as ExtendedData, and styles in the properties are written as inline styles.
+func encodeKML(w io.Writer, layers []*layer) error {
+	root := kml.KML{Xmlns: kmlNamespace}
+	if len(layers) == 1 {
+		root.Document = kmlCollection(layers[0])
+	} else {
+		for _, l := range layers {
+			root.Document.Folders = append(root.Document.Folders, kmlCollection(l))
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	if err := e.Encode(root); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func kmlCollection(l *layer) kml.Collection {
+	c := kml.Collection{Name: l.name}
+	for _, f := range l.features {
+		c.Placemarks = append(c.Placemarks, kmlPlacemark(l, f))
+	}
+	return c
+}
+
+func kmlPlacemark(l *layer, f nlslayer.Feature) kml.Placemark {
+	props := *f.Properties()
+	p := kml.Placemark{
+		Name:        stringProperty(props, "name"),
+		Description: stringProperty(props, "description"),
+		Style:       kmlStyle(styleFromProperties(props)),
+	}
+
+	var data []kml.Data
+	for _, fd := range l.fields {
+		if v, ok := props[fd.name]; ok && v != nil {
+			data = append(data, kml.Data{Name: fd.name, Value: formatValue(v)})
+		}
+	}
+	if len(data) > 0 {
+		p.ExtendedData = &kml.ExtendedData{Data: data}
+	}
+
+	pts := splitGeometry(f.Geometry())
+	if len(pts.points)+len(pts.lineStrings)+len(pts.polygons) > 1 {
+		mg := &kml.MultiGeometry{}
+		for _, c := range pts.points {
+			mg.Points = append(mg.Points, kml.Point{Coordinates: kmlCoordinates([][]float64{c})})
+		}
+		for _, c := range pts.lineStrings {
+			mg.LineStrings = append(mg.LineStrings, kml.LineString{Coordinates: kmlCoordinates(c)})
+		}
+		for _, c := range pts.polygons {
+			mg.Polygons = append(mg.Polygons, kmlPolygon(c))
+		}
+		p.MultiGeometry = mg
+		return p
+	}
+
+	switch {
+	case len(pts.points) == 1:
+		p.Point = &kml.Point{Coordinates: kmlCoordinates(pts.points)}
+	case len(pts.lineStrings) == 1:
+		p.Polyline = &kml.LineString{Coordinates: kmlCoordinates(pts.lineStrings[0])}
+	case len(pts.polygons) == 1:
+		pg := kmlPolygon(pts.polygons[0])
+		p.Polygon = &pg
+	}
+	return p
+}
+
+func kmlPolygon(rings [][][]float64) kml.Polygon {
+	var p kml.Polygon
+	for i, r := range rings {
+		b := kml.BoundaryIs{LinearRing: kml.LinearRing{Coordinates: kmlCoordinates(r)}}
+		if i == 0 {
+			p.OuterBoundaryIs = b
+		} else {
+			p.InnerBoundaryIs = append(p.InnerBoundaryIs, b)
+		}
+	}
+	return p
+}
+
+// kmlCoordinates formats positions as tuples separated by spaces, such as "139.7,35.6,0 139.8,35.7,0".
+func kmlCoordinates(coords [][]float64) string {
+	tuples := make([]string, 0, len(coords))
+	for _, c := range coords {
+		values := make([]string, 0, len(c))
+		for _, v := range c {
+			values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+		tuples = append(tuples, strings.Join(values, ","))
+	}
+	return strings.Join(tuples, " ")
+}
+
+func kmlStyle(s *featureStyle) *kml.Style {
+	if s == nil {
+		return nil
+	}
+
+	res := &kml.Style{}
+	if s.markerColor != nil {
+		res.IconStyle = &kml.IconStyle{Color: s.markerColor.kml()}
+	}
+	if s.strokeColor != nil || s.strokeWidth > 0 {
+		res.LineStyle = &kml.LineStyle{Width: s.strokeWidth}
+		if s.strokeColor != nil {
+			res.LineStyle.Color = s.strokeColor.kml()
+		}
+	}
+	if s.fillColor != nil {
+		res.PolyStyle = &kml.PolyStyle{Color: s.fillColor.kml()}
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package encoding
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/nlslayer/decoding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode_KML(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{sketchLayer("sketch")}, FormatKML))
+
+	s := buf.String()
+	assert.Contains(t, s, `<kml xmlns="http://www.opengis.net/kml/2.2">`)
+	assert.Contains(t, s, `<Data name="height">`)
+	assert.Contains(t, s, `<coordinates>139.7,35.6,10 139.8,35.7,20</coordinates>`)
+	assert.Contains(t, s, `<color>ff0000ff</color>`)
+	assert.Contains(t, s, `<innerBoundaryIs>`)
+	assert.NotContains(t, s, `<MultiGeometry>`)
+
+	res, err := decoding.Decode(&buf, decoding.FormatKML)
+	assert.NoError(t, err)
+	assert.Equal(t, "sketch", res.Name)
+
+	features := res.FeatureCollection.Features()
+	assert.Len(t, features, 3)
+	props := *features[0].Properties()
+	assert.Equal(t, "東京タワー", props["name"])
+	assert.Equal(t, "333", props["height"])
+	assert.Equal(t, "true", props["open"])
+	assert.Equal(t, "#ff0000", props["marker-color"])
+
+	line := *features[1].Properties()
+	assert.Equal(t, "#00ff00", line["stroke"])
+	assert.Equal(t, 0.5, line["stroke-opacity"])
+	assert.Equal(t, 3.0, line["stroke-width"])
+
+	assert.Equal(t, "extra", (*features[2].Properties())["memo"])
+	assert.Len(t, features[2].Geometry().(*nlslayer.Polygon).Coordinates(), 2)
+}
+
+func TestEncode_KML_Layers(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{sketchLayer("a"), sketchLayer("b")}, FormatKML))
+
+	s := buf.String()
+	assert.Contains(t, s, "<Folder>\n      <name>a</name>")
+	assert.Contains(t, s, "<Folder>\n      <name>b</name>")
+
+	res, err := decoding.Decode(&buf, decoding.FormatKML)
+	assert.NoError(t, err)
+	assert.Len(t, res.FeatureCollection.Features(), 6)
+}
@@ -0,0 +1,389 @@
+package encoding
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reearth/reearth/server/pkg/shp"
+)
+
+const (
+	// wgs84PRJ is the coordinate system of sketch features.
+	wgs84PRJ = `GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]]`
+
+	dbfFieldNameLength = 10
+	dbfMaxTextLength   = 254
+	dbfIntLength       = 20
+	dbfFloatLength     = 24
+	dbfFloatPrecision  = 15
+)
+
+// encodeShapefile writes a zip that contains Shapefiles of the layers. As a Shapefile has only one shape type,
+// points, lines and polygons of a layer are written into separate Shapefiles suffixed with their types.
+// Heights are not written, and texts are encoded in UTF-8 as declared in the CPG file.
+func encodeShapefile(w io.Writer, layers []*layer) error {
+	zw := zip.NewWriter(w)
+	names := map[string]struct{}{}
+
+	for _, l := range layers {
+		base := shapefileName(l.name)
+		sets := shapeSets(l)
+		for _, s := range sets {
+			name := base
+			if len(sets) > 1 {
+				name += "_" + s.suffix
+			}
+			name = uniqueName(names, name)
+
+			if err := s.write(zw, name, l.fields); err != nil {
+				return fmt.Errorf("layer %q: %w", l.name, err)
+			}
+		}
+	}
+
+	return zw.Close()
+}
+
+type shapeRecord struct {
+	shape shp.Shape
+	props map[string]any
+}
+
+type shapeSet struct {
+	suffix  string
+	typ     shp.ShapeType
+	records []shapeRecord
+}
+
+// shapeSets groups shapes of the layer by their types. Points in a geometry collection are written as separate records with the same attributes.
+// An empty point Shapefile is returned for a layer without features so that its attributes are still exported.
+func shapeSets(l *layer) []*shapeSet {
+	points := &shapeSet{suffix: "point", typ: shp.POINT}
+	lines := &shapeSet{suffix: "line", typ: shp.POLYLINE}
+	polygons := &shapeSet{suffix: "polygon", typ: shp.POLYGON}
+
+	for _, f := range l.features {
+		props := *f.Properties()
+		pts := splitGeometry(f.Geometry())
+
+		for _, c := range pts.points {
+			if p, ok := shapePoint(c); ok {
+				points.records = append(points.records, shapeRecord{shape: &p, props: props})
+			}
+		}
+
+		var lineParts [][]shp.Point
+		for _, ls := range pts.lineStrings {
+			if part := shapePoints(ls); len(part) >= 2 {
+				lineParts = append(lineParts, part)
+			}
+		}
+		if len(lineParts) > 0 {
+			lines.records = append(lines.records, shapeRecord{shape: shp.NewPolyLine(lineParts), props: props})
+		}
+
+		var rings [][]shp.Point
+		for _, pg := range pts.polygons {
+			for i, r := range pg {
+				ring := shapePoints(r)
+				if len(ring) < 3 {
+					continue
+				}
+				// outer rings must be clockwise and holes must be counterclockwise
+				if clockwise := ringArea(ring) < 0; clockwise != (i == 0) {
+					ring = reverseRing(ring)
+				}
+				rings = append(rings, ring)
+			}
+		}
+		if len(rings) > 0 {
+			polygons.records = append(polygons.records, shapeRecord{shape: (*shp.Polygon)(shp.NewPolyLine(rings)), props: props})
+		}
+	}
+
+	var res []*shapeSet
+	for _, s := range []*shapeSet{points, lines, polygons} {
+		if len(s.records) > 0 {
+			res = append(res, s)
+		}
+	}
+	if len(res) == 0 {
+		res = append(res, points)
+	}
+	return res
+}
+
+func (s *shapeSet) write(zw *zip.Writer, name string, fields []field) error {
+	shpBuf, shxBuf, dbfBuf := &seekBuffer{}, &seekBuffer{}, &seekBuffer{}
+
+	w, err := shp.CreateFrom(shpBuf, s.typ)
+	if err != nil {
+		return err
+	}
+	if err := w.SetIndex(shxBuf); err != nil {
+		return err
+	}
+
+	dfs := dbfFields(fields, s.records)
+	shpFields := make([]shp.Field, 0, len(dfs))
+	for _, df := range dfs {
+		shpFields = append(shpFields, df.shp)
+	}
+	if err := w.SetFields(dbfBuf, shpFields); err != nil {
+		return err
+	}
+
+	for _, r := range s.records {
+		row, err := w.Write(r.shape)
+		if err != nil {
+			return err
+		}
+		for i, df := range dfs {
+			v, ok := df.value(r.props[df.name])
+			if !ok {
+				continue
+			}
+			if err := w.WriteAttribute(int(row), i, v); err != nil {
+				return err
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	files := []struct {
+		ext  string
+		data []byte
+	}{
+		{".shp", shpBuf.Bytes()},
+		{".shx", shxBuf.Bytes()},
+		{".dbf", dbfBuf.Bytes()},
+		{".prj", []byte(wgs84PRJ)},
+		{".cpg", []byte("UTF-8")},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(name + f.ext)
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(f.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type dbfField struct {
+	field
+	shp shp.Field
+}
+
+// dbfFields returns DBF fields for the fields. Names are truncated as they are limited to 10 bytes, and texts have the length of the longest value.
+func dbfFields(fields []field, records []shapeRecord) []dbfField {
+	used := map[string]struct{}{}
+	res := make([]dbfField, 0, len(fields))
+
+	for _, f := range fields {
+		name := dbfFieldName(used, f.name)
+		df := dbfField{field: f}
+
+		switch f.typ {
+		case fieldInt:
+			df.shp = shp.NumberField(name, dbfIntLength)
+		case fieldFloat:
+			df.shp = shp.FloatField(name, dbfFloatLength, dbfFloatPrecision)
+		case fieldBoolean:
+			df.shp = shp.LogicalField(name)
+		default:
+			size := 1
+			for _, r := range records {
+				if v, ok := df.value(r.props[f.name]); ok {
+					size = max(size, len(v.(string)))
+				}
+			}
+			df.shp = shp.StringField(name, uint8(size))
+		}
+
+		res = append(res, df)
+	}
+	return res
+}
+
+// value converts the property into a value of the field. Values that cannot be converted are left blank.
+func (f dbfField) value(v any) (any, bool) {
+	if v == nil {
+		return nil, false
+	}
+
+	switch f.typ {
+	case fieldInt:
+		switch v := v.(type) {
+		case int, int32, int64:
+			return v, true
+		case float64:
+			if v == math.Trunc(v) && math.Abs(v) < 1e18 {
+				return int64(v), true
+			}
+		case string:
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return i, true
+			}
+		}
+		return nil, false
+	case fieldFloat:
+		// floats are formatted here so that they are not padded with the precision of the field
+		n, ok := number(v)
+		if !ok || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, false
+		}
+		s := strconv.FormatFloat(n, 'f', -1, 64)
+		if len(s) > dbfFloatLength {
+			s = strconv.FormatFloat(n, 'g', dbfFloatLength-8, 64)
+		}
+		return s, true
+	case fieldBoolean:
+		switch v := v.(type) {
+		case bool:
+			return v, true
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return b, true
+			}
+		}
+		return nil, false
+	}
+
+	return truncateString(formatValue(v), dbfMaxTextLength), true
+}
+
+func dbfFieldName(used map[string]struct{}, name string) string {
+	base := truncateString(name, dbfFieldNameLength)
+	if base == "" {
+		base = "field"
+	}
+	res := base
+	for i := 2; ; i++ {
+		if _, ok := used[strings.ToLower(res)]; !ok {
+			break
+		}
+		suffix := "_" + strconv.Itoa(i)
+		res = truncateString(base, dbfFieldNameLength-len(suffix)) + suffix
+	}
+	used[strings.ToLower(res)] = struct{}{}
+	return res
+}
+
+// truncateString truncates the string to n bytes without breaking runes.
+func truncateString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// shapefileName returns a file name for the layer, replacing characters that cannot be used in file names.
+func shapefileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" {
+		return "layer"
+	}
+	return name
+}
+
+func uniqueName(used map[string]struct{}, name string) string {
+	res := name
+	for i := 2; ; i++ {
+		if _, ok := used[strings.ToLower(res)]; !ok {
+			break
+		}
+		res = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[strings.ToLower(res)] = struct{}{}
+	return res
+}
+
+func shapePoint(c []float64) (shp.Point, bool) {
+	if len(c) < 2 {
+		return shp.Point{}, false
+	}
+	return shp.Point{X: c[0], Y: c[1]}, true
+}
+
+func shapePoints(coords [][]float64) []shp.Point {
+	res := make([]shp.Point, 0, len(coords))
+	for _, c := range coords {
+		if p, ok := shapePoint(c); ok {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// ringArea returns the signed area of the ring, which is negative when the ring is clockwise.
+func ringArea(ring []shp.Point) float64 {
+	a := 0.0
+	for i := 0; i+1 < len(ring); i++ {
+		a += ring[i].X*ring[i+1].Y - ring[i+1].X*ring[i].Y
+	}
+	return a / 2
+}
+
+func reverseRing(ring []shp.Point) []shp.Point {
+	res := make([]shp.Point, len(ring))
+	for i, p := range ring {
+		res[len(ring)-1-i] = p
+	}
+	return res
+}
+
+// seekBuffer is an in-memory io.WriteSeeker, as the Shapefile writer seeks back to write headers.
+type seekBuffer struct {
+	buf []byte
+	pos int
+}
+
+func (b *seekBuffer) Write(p []byte) (int, error) {
+	if end := b.pos + len(p); end > len(b.buf) {
+		b.buf = append(b.buf, make([]byte, end-len(b.buf))...)
+	}
+	copy(b.buf[b.pos:], p)
+	b.pos += len(p)
+	return len(p), nil
+}
+
+func (b *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = int64(b.pos) + offset
+	case io.SeekEnd:
+		pos = int64(len(b.buf)) + offset
+	default:
+		return 0, fmt.Errorf("invalid whence: %d", whence)
+	}
+	if pos < 0 {
+		return 0, fmt.Errorf("negative position: %d", pos)
+	}
+	b.pos = int(pos)
+	return pos, nil
+}
+
+func (b *seekBuffer) Bytes() []byte {
+	return b.buf
+}
@@ -0,0 +1,98 @@
+package encoding
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/nlslayer/decoding"
+	"github.com/reearth/reearth/server/pkg/shp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode_Shapefile(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{sketchLayer("sketch/1"), sketchLayer("sketch/1")}, FormatShapefile))
+
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+
+	var names []string
+	files := map[string][]byte{}
+	for _, f := range z.File {
+		names = append(names, f.Name)
+		var b bytes.Buffer
+		r, err := f.Open()
+		assert.NoError(t, err)
+		_, err = b.ReadFrom(r)
+		assert.NoError(t, err)
+		files[f.Name] = b.Bytes()
+	}
+	assert.Contains(t, names, "sketch_1_point.shp")
+	assert.Contains(t, names, "sketch_1_line.dbf")
+	assert.Contains(t, names, "sketch_1_polygon.shx")
+	assert.Contains(t, names, "sketch_1_polygon_2.prj")
+	assert.Contains(t, names, "sketch_1_point_2.cpg")
+	assert.Len(t, names, 30)
+
+	res, err := decoding.Decode(bytes.NewReader(zipOf(t, files, "sketch_1_point")), decoding.FormatShapefile)
+	assert.NoError(t, err)
+	features := res.FeatureCollection.Features()
+	assert.Len(t, features, 1)
+	assert.Equal(t, []float64{139.7, 35.6}, features[0].Geometry().(*nlslayer.Point).Coordinates())
+	props := *features[0].Properties()
+	assert.Equal(t, "東京タワー", props["name"])
+	assert.Equal(t, 333.0, props["height"])
+	assert.Equal(t, int64(1), props["count"])
+	assert.Equal(t, true, props["open"])
+	assert.Equal(t, "#ff0000", props["marker-col"])
+
+	res, err = decoding.Decode(bytes.NewReader(zipOf(t, files, "sketch_1_polygon")), decoding.FormatShapefile)
+	assert.NoError(t, err)
+	features = res.FeatureCollection.Features()
+	assert.Equal(t, [][][]float64{
+		{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+		{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}},
+	}, features[0].Geometry().(*nlslayer.Polygon).Coordinates())
+	assert.Equal(t, "extra", (*features[0].Properties())["memo"])
+}
+
+func TestEncode_Shapefile_Empty(t *testing.T) {
+	schema := map[string]any{"name": "Text_1"}
+	l := nlslayer.NewNLSLayerSimple().NewID().Scene(id.NewSceneID()).Title("").IsSketch(true).
+		Sketch(nlslayer.NewSketchInfo(&schema, nlslayer.NewFeatureCollection("FeatureCollection", nil))).MustBuild()
+
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, []*nlslayer.NLSLayerSimple{l}, FormatShapefile))
+
+	zr, err := shp.ReadZipFrom(&buf)
+	assert.NoError(t, err)
+	assert.False(t, zr.Next())
+	assert.Equal(t, "name", zr.Fields()[0].String())
+}
+
+func TestDBFFieldName(t *testing.T) {
+	used := map[string]struct{}{}
+	assert.Equal(t, "name", dbfFieldName(used, "name"))
+	assert.Equal(t, "stroke-wid", dbfFieldName(used, "stroke-width"))
+	assert.Equal(t, "stroke-w_2", dbfFieldName(used, "stroke-widths"))
+	assert.Equal(t, "名前", dbfFieldName(used, "名前"))
+	assert.Equal(t, "建物の", dbfFieldName(used, "建物の高さ"))
+	assert.Equal(t, "field", dbfFieldName(used, ""))
+}
+
+// zipOf returns a zip that contains files of a Shapefile.
+func zipOf(t *testing.T, files map[string][]byte, name string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, ext := range []string{".shp", ".shx", ".dbf", ".prj", ".cpg"} {
+		w, err := zw.Create(name + ext)
+		assert.NoError(t, err)
+		_, err = w.Write(files[name+ext])
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,112 @@
+package encoding
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Styles of features are read from their properties following the simplestyle spec, which is also used when files are imported.
+const (
+	propMarkerColor   = "marker-color"
+	propStroke        = "stroke"
+	propStrokeOpacity = "stroke-opacity"
+	propStrokeWidth   = "stroke-width"
+	propFill          = "fill"
+	propFillOpacity   = "fill-opacity"
+)
+
+type color struct {
+	r, g, b uint8
+	alpha   float64
+}
+
+type featureStyle struct {
+	markerColor *color
+	strokeColor *color
+	strokeWidth float64
+	fillColor   *color
+}
+
+// styleFromProperties returns nil when the properties have no styles.
+func styleFromProperties(props map[string]any) *featureStyle {
+	s := featureStyle{
+		markerColor: propertyColor(props, propMarkerColor, ""),
+		strokeColor: propertyColor(props, propStroke, propStrokeOpacity),
+		fillColor:   propertyColor(props, propFill, propFillOpacity),
+	}
+	if w, ok := numberProperty(props, propStrokeWidth); ok && w > 0 {
+		s.strokeWidth = w
+	}
+	if s == (featureStyle{}) {
+		return nil
+	}
+	return &s
+}
+
+func propertyColor(props map[string]any, key, opacityKey string) *color {
+	c := parseHexColor(stringProperty(props, key))
+	if c == nil {
+		return nil
+	}
+	if opacityKey != "" {
+		if a, ok := numberProperty(props, opacityKey); ok {
+			c.alpha = math.Max(0, math.Min(1, a))
+		}
+	}
+	return c
+}
+
+func numberProperty(props map[string]any, key string) (float64, bool) {
+	return number(props[key])
+}
+
+// number converts numbers and numeric strings into float64.
+func number(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// parseHexColor parses colors such as "#ff0000" and "#f00".
+func parseHexColor(s string) *color {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return nil
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return nil
+	}
+	return &color{r: uint8(v >> 16), g: uint8(v >> 8), b: uint8(v), alpha: 1}
+}
+
+func (c *color) alpha8() uint8 {
+	return uint8(math.Round(c.alpha * 255))
+}
+
+// kml returns the color in aabbggrr order.
+func (c *color) kml() string {
+	return fmt.Sprintf("%02x%02x%02x%02x", c.alpha8(), c.b, c.g, c.r)
+}
+
+func (c *color) rgba() []int64 {
+	return []int64{int64(c.r), int64(c.g), int64(c.b), int64(c.alpha8())}
+}
@@ -29,6 +29,16 @@ func (fc *FeatureCollection) Features() []Feature {
 	return append([]Feature{}, fc.features...)
 }
 
+func (fc *FeatureCollection) Clone() *FeatureCollection {
+	if fc == nil {
+		return nil
+	}
+	return &FeatureCollection{
+		featureCollectionType: fc.featureCollectionType,
+		features:              append([]Feature{}, fc.features...),
+	}
+}
+
 func (fc *FeatureCollection) AddFeature(feature Feature) {
 	if fc == nil {
 		return
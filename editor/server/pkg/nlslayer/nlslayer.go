@@ -221,6 +221,10 @@ func (l *layerBase) Clone() *layerBase {
 		cloned.infobox = l.infobox.Clone()
 	}
 
+	if l.photoOverlay != nil {
+		cloned.photoOverlay = l.photoOverlay.Clone()
+	}
+
 	if l.sketch != nil {
 		cloned.sketch = l.sketch.Clone()
 	}
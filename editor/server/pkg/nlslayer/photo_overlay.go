@@ -32,3 +32,13 @@ func (i *PhotoOverlay) PropertyRef() *id.PropertyID {
 	pid := i.property
 	return &pid
 }
+
+func (i *PhotoOverlay) Clone() *PhotoOverlay {
+	if i == nil {
+		return nil
+	}
+	return &PhotoOverlay{
+		id:       i.id,
+		property: i.property,
+	}
+}
@@ -1,5 +1,14 @@
 package nlslayer
 
+import (
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+// sketchFeatureProperties are properties that the editor sets to every sketch feature.
+var sketchFeatureProperties = []string{"id", "type", "extrudedHeight", "positions"}
+
 type SketchInfo struct {
 	customPropertySchema *map[string]any
 	featureCollection    *FeatureCollection
@@ -16,6 +25,19 @@ func (s *SketchInfo) CustomPropertySchema() *map[string]any {
 	return s.customPropertySchema
 }
 
+// Attributes returns names of the properties that features of the sketch can have, which are custom properties and properties set by the editor.
+func (s *SketchInfo) Attributes() []string {
+	if s == nil {
+		return nil
+	}
+	var custom []string
+	if s.customPropertySchema != nil {
+		custom = lo.Keys(*s.customPropertySchema)
+		sort.Strings(custom)
+	}
+	return lo.Uniq(append(append([]string{}, sketchFeatureProperties...), custom...))
+}
+
 func (s *SketchInfo) FeatureCollection() *FeatureCollection {
 	return s.featureCollection
 }
@@ -31,6 +53,6 @@ func (s *SketchInfo) Clone() *SketchInfo {
 
 	return &SketchInfo{
 		customPropertySchema: s.customPropertySchema,
-		featureCollection:    s.featureCollection,
+		featureCollection:    s.featureCollection.Clone(),
 	}
 }
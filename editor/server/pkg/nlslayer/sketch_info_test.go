@@ -56,3 +56,11 @@ func TestSketchInfoClone(t *testing.T) {
 
 	assert.Equal(t, si, si2)
 }
+
+func TestSketchInfo_Attributes(t *testing.T) {
+	schema := map[string]any{"name": "TEXT_1", "height": "NUMBER_2", "id": "TEXT_3"}
+	si := NewSketchInfo(&schema, nil)
+	assert.Equal(t, []string{"id", "type", "extrudedHeight", "positions", "height", "name"}, si.Attributes())
+	assert.Equal(t, []string{"id", "type", "extrudedHeight", "positions"}, NewSketchInfo(nil, nil).Attributes())
+	assert.Nil(t, (*SketchInfo)(nil).Attributes())
+}
@@ -0,0 +1,70 @@
+package revision
+
+import (
+	"errors"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+type Builder struct {
+	r *Revision
+}
+
+func New() *Builder {
+	return &Builder{r: &Revision{}}
+}
+
+func (b *Builder) Build() (*Revision, error) {
+	if b.r.id.IsNil() {
+		return nil, id.ErrInvalidID
+	}
+	if b.r.scene.IsNil() {
+		return nil, id.ErrInvalidID
+	}
+	// the snapshot can be omitted, such as when revisions are listed
+	if b.r.snapshot != nil && b.r.snapshot.Scene().ID() != b.r.scene {
+		return nil, ErrInvalidSnapshot
+	}
+	return b.r, nil
+}
+
+func (b *Builder) MustBuild() *Revision {
+	r, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (b *Builder) ID(id id.RevisionID) *Builder {
+	b.r.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.r.id = id.NewRevisionID()
+	return b
+}
+
+func (b *Builder) Scene(scene id.SceneID) *Builder {
+	b.r.scene = scene
+	return b
+}
+
+func (b *Builder) Action(action string) *Builder {
+	b.r.action = action
+	return b
+}
+
+func (b *Builder) User(user *accountdomain.UserID) *Builder {
+	b.r.user = user.CloneRef()
+	return b
+}
+
+func (b *Builder) Snapshot(s *Snapshot) *Builder {
+	b.r.snapshot = s
+	return b
+}
@@ -0,0 +1,37 @@
+package revision
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	s := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).MustBuild()
+	snapshot := NewSnapshot(s, nil, nil, nil, nil)
+	uid := accountdomain.NewUserID()
+
+	r, err := New().NewID().Scene(s.ID()).Action("updateScene").User(&uid).Snapshot(snapshot).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, s.ID(), r.Scene())
+	assert.Equal(t, "updateScene", r.Action())
+	assert.Equal(t, &uid, r.User())
+	assert.Same(t, snapshot, r.Snapshot())
+	assert.Equal(t, r.ID().Timestamp(), r.CreatedAt())
+
+	_, err = New().Scene(s.ID()).Snapshot(snapshot).Build()
+	assert.ErrorIs(t, err, id.ErrInvalidID)
+
+	_, err = New().NewID().Snapshot(snapshot).Build()
+	assert.ErrorIs(t, err, id.ErrInvalidID)
+
+	r, err = New().NewID().Scene(s.ID()).Build()
+	assert.NoError(t, err)
+	assert.Nil(t, r.Snapshot())
+
+	_, err = New().NewID().Scene(id.NewSceneID()).Snapshot(snapshot).Build()
+	assert.ErrorIs(t, err, ErrInvalidSnapshot)
+}
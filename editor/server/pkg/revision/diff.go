@@ -0,0 +1,125 @@
+package revision
+
+import (
+	"reflect"
+	"time"
+)
+
+type ObjectType string
+
+const (
+	ObjectTypeScene    ObjectType = "scene"
+	ObjectTypeNLSLayer ObjectType = "nlsLayer"
+	ObjectTypeStyle    ObjectType = "style"
+	ObjectTypeStory    ObjectType = "story"
+	ObjectTypeProperty ObjectType = "property"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeAdded    ChangeType = "added"
+	ChangeTypeRemoved  ChangeType = "removed"
+	ChangeTypeModified ChangeType = "modified"
+)
+
+type Change struct {
+	ObjectType ObjectType
+	ID         string
+	Type       ChangeType
+}
+
+// Diff returns objects added, removed or modified from the snapshot to another snapshot.
+// Update times and the scene version are ignored, so an object saved without changes is not reported as modified.
+func Diff(from, to *Snapshot) []Change {
+	from, to = from.normalize(), to.normalize()
+
+	var res []Change
+	res = append(res, diff(ObjectTypeScene, from.sceneObjects(), to.sceneObjects())...)
+	res = append(res, diff(ObjectTypeNLSLayer, from.nlsLayerObjects(), to.nlsLayerObjects())...)
+	res = append(res, diff(ObjectTypeStyle, from.styleObjects(), to.styleObjects())...)
+	res = append(res, diff(ObjectTypeStory, from.storyObjects(), to.storyObjects())...)
+	res = append(res, diff(ObjectTypeProperty, from.propertyObjects(), to.propertyObjects())...)
+	return res
+}
+
+// objects keeps the order of the objects so that changes are reported in a stable order.
+type objects struct {
+	ids  []string
+	data map[string]any
+}
+
+func (o *objects) add(id string, v any) {
+	if o.data == nil {
+		o.data = map[string]any{}
+	}
+	o.ids = append(o.ids, id)
+	o.data[id] = v
+}
+
+func diff(t ObjectType, from, to objects) []Change {
+	var res []Change
+	for _, k := range to.ids {
+		f, ok := from.data[k]
+		if !ok {
+			res = append(res, Change{ObjectType: t, ID: k, Type: ChangeTypeAdded})
+		} else if !reflect.DeepEqual(f, to.data[k]) {
+			res = append(res, Change{ObjectType: t, ID: k, Type: ChangeTypeModified})
+		}
+	}
+	for _, k := range from.ids {
+		if _, ok := to.data[k]; !ok {
+			res = append(res, Change{ObjectType: t, ID: k, Type: ChangeTypeRemoved})
+		}
+	}
+	return res
+}
+
+func (s *Snapshot) sceneObjects() (res objects) {
+	if s.Scene() != nil {
+		res.add(s.Scene().ID().String(), s.Scene())
+	}
+	return
+}
+
+func (s *Snapshot) nlsLayerObjects() (res objects) {
+	for _, l := range s.NLSLayers() {
+		res.add((*l).ID().String(), *l)
+	}
+	return
+}
+
+func (s *Snapshot) styleObjects() (res objects) {
+	for _, st := range s.Styles() {
+		res.add(st.ID().String(), st)
+	}
+	return
+}
+
+func (s *Snapshot) storyObjects() (res objects) {
+	for _, st := range s.Stories() {
+		res.add(st.Id().String(), st)
+	}
+	return
+}
+
+func (s *Snapshot) propertyObjects() (res objects) {
+	for _, p := range s.Properties() {
+		res.add(p.ID().String(), p)
+	}
+	return
+}
+
+// normalize returns a copy of the snapshot without update times and the scene version.
+func (s *Snapshot) normalize() *Snapshot {
+	s = s.Clone()
+	if s == nil {
+		return nil
+	}
+	s.scene.SetUpdatedAt(time.Time{})
+	s.scene.SetVersion(0)
+	for _, st := range s.stories {
+		st.SetUpdatedAt(time.Time{})
+	}
+	return s
+}
@@ -0,0 +1,37 @@
+package revision
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/storytelling"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	s := scene.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).MustBuild()
+	style1 := scene.NewStyle().NewID().Scene(s.ID()).Name("a").Value(&scene.StyleValue{"color": "red"}).MustBuild()
+	style2 := scene.NewStyle().NewID().Scene(s.ID()).Name("b").Value(&scene.StyleValue{}).MustBuild()
+	story := storytelling.NewStory().NewID().Scene(s.ID()).Property(id.NewPropertyID()).Pages(storytelling.NewPageList(nil)).MustBuild()
+
+	from := NewSnapshot(s, nil, scene.StyleList{style1, style2}, storytelling.StoryList{story}, nil)
+	assert.Empty(t, Diff(from, from))
+
+	// the snapshot is not affected by changes of the objects
+	style1.UpdateValue(&scene.StyleValue{"color": "blue"})
+	assert.Equal(t, &scene.StyleValue{"color": "red"}, from.Styles()[0].Value())
+
+	style3 := scene.NewStyle().NewID().Scene(s.ID()).Name("c").Value(&scene.StyleValue{}).MustBuild()
+	// update times are ignored
+	story.SetUpdatedAt(story.UpdatedAt().Add(time.Hour))
+	to := NewSnapshot(s, nil, scene.StyleList{style1, style3}, storytelling.StoryList{story}, nil)
+
+	assert.Equal(t, []Change{
+		{ObjectType: ObjectTypeStyle, ID: style1.ID().String(), Type: ChangeTypeModified},
+		{ObjectType: ObjectTypeStyle, ID: style3.ID().String(), Type: ChangeTypeAdded},
+		{ObjectType: ObjectTypeStyle, ID: style2.ID().String(), Type: ChangeTypeRemoved},
+	}, Diff(from, to))
+}
@@ -0,0 +1,73 @@
+package revision
+
+import (
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+// Revision is a snapshot of a scene and its layers, styles, stories and properties recorded after a change.
+type Revision struct {
+	id       id.RevisionID
+	scene    id.SceneID
+	action   string
+	user     *accountdomain.UserID
+	snapshot *Snapshot
+}
+
+func (r *Revision) ID() id.RevisionID {
+	if r == nil {
+		return id.RevisionID{}
+	}
+	return r.id
+}
+
+func (r *Revision) Scene() id.SceneID {
+	if r == nil {
+		return id.SceneID{}
+	}
+	return r.scene
+}
+
+// Action is the name of the operation that made the revision, such as "updateStyle".
+func (r *Revision) Action() string {
+	if r == nil {
+		return ""
+	}
+	return r.action
+}
+
+func (r *Revision) User() *accountdomain.UserID {
+	if r == nil {
+		return nil
+	}
+	return r.user.CloneRef()
+}
+
+// Snapshot returns nil when the revision is loaded without its snapshot, such as in lists.
+func (r *Revision) Snapshot() *Snapshot {
+	if r == nil {
+		return nil
+	}
+	return r.snapshot
+}
+
+func (r *Revision) CreatedAt() time.Time {
+	if r == nil {
+		return time.Time{}
+	}
+	return r.id.Timestamp()
+}
+
+type List []*Revision
+
+func (l List) IDs() id.RevisionIDList {
+	res := make(id.RevisionIDList, 0, len(l))
+	for _, r := range l {
+		if r != nil {
+			res = append(res, r.ID())
+		}
+	}
+	return res
+}
@@ -0,0 +1,121 @@
+package revision
+
+import (
+	"sort"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearth/server/pkg/nlslayer"
+	"github.com/reearth/reearth/server/pkg/property"
+	"github.com/reearth/reearth/server/pkg/scene"
+	"github.com/reearth/reearth/server/pkg/storytelling"
+)
+
+// Snapshot is the state of the scene graph. Objects are copied when the snapshot is made so that later changes do not affect it.
+type Snapshot struct {
+	scene      *scene.Scene
+	nlsLayers  nlslayer.NLSLayerList
+	styles     scene.StyleList
+	stories    storytelling.StoryList
+	properties property.List
+}
+
+func NewSnapshot(s *scene.Scene, layers nlslayer.NLSLayerList, styles scene.StyleList, stories storytelling.StoryList, properties property.List) *Snapshot {
+	res := &Snapshot{scene: s.Clone()}
+	for _, l := range layers {
+		if l == nil || *l == nil {
+			continue
+		}
+		if l2, ok := (*l).Clone().(nlslayer.NLSLayer); ok {
+			res.nlsLayers = append(res.nlsLayers, &l2)
+		}
+	}
+	for _, s := range styles {
+		if s != nil {
+			res.styles = append(res.styles, s.Clone())
+		}
+	}
+	// repositories do not return styles in a fixed order
+	sort.SliceStable(res.styles, func(i, j int) bool {
+		return res.styles[i].ID().String() < res.styles[j].ID().String()
+	})
+	for _, s := range stories.Sorted() {
+		res.stories = append(res.stories, s.Clone())
+	}
+	for _, p := range properties {
+		if p != nil {
+			res.properties = append(res.properties, p.Clone())
+		}
+	}
+	return res
+}
+
+func (s *Snapshot) Scene() *scene.Scene {
+	if s == nil {
+		return nil
+	}
+	return s.scene
+}
+
+func (s *Snapshot) NLSLayers() nlslayer.NLSLayerList {
+	if s == nil {
+		return nil
+	}
+	return s.nlsLayers
+}
+
+func (s *Snapshot) Styles() scene.StyleList {
+	if s == nil {
+		return nil
+	}
+	return s.styles
+}
+
+func (s *Snapshot) Stories() storytelling.StoryList {
+	if s == nil {
+		return nil
+	}
+	return s.stories
+}
+
+func (s *Snapshot) Properties() property.List {
+	if s == nil {
+		return nil
+	}
+	return s.properties
+}
+
+// Clone returns a deep copy of the snapshot, such as to restore it without changing the revision.
+func (s *Snapshot) Clone() *Snapshot {
+	if s == nil {
+		return nil
+	}
+	return NewSnapshot(s.scene, s.nlsLayers, s.styles, s.stories, s.properties)
+}
+
+func (s *Snapshot) NLSLayerIDs() id.NLSLayerIDList {
+	res := make(id.NLSLayerIDList, 0, len(s.NLSLayers()))
+	for _, l := range s.NLSLayers() {
+		res = append(res, (*l).ID())
+	}
+	return res
+}
+
+func (s *Snapshot) StyleIDs() id.StyleIDList {
+	res := make(id.StyleIDList, 0, len(s.Styles()))
+	for _, st := range s.Styles() {
+		res = append(res, st.ID())
+	}
+	return res
+}
+
+func (s *Snapshot) StoryIDs() id.StoryIDList {
+	res := make(id.StoryIDList, 0, len(s.Stories()))
+	for _, st := range s.Stories() {
+		res = append(res, st.Id())
+	}
+	return res
+}
+
+func (s *Snapshot) PropertyIDs() id.PropertyIDList {
+	return id.PropertyIDList(s.Properties().IDs())
+}
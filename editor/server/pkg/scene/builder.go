@@ -67,6 +67,11 @@ func (b *Builder) UpdatedAt(updatedAt time.Time) *Builder {
 	return b
 }
 
+func (b *Builder) Version(version int) *Builder {
+	b.scene.version = version
+	return b
+}
+
 func (b *Builder) Widgets(widgets *Widgets) *Builder {
 	b.scene.widgets = widgets
 	return b
@@ -25,6 +25,7 @@ type Builder struct {
 	nlsLayer    *nlslayer.NLSLayerList
 	layerStyles *scene.StyleList
 	story       *storytelling.Story
+	stories     storytelling.StoryList
 
 	exportType bool
 }
@@ -69,6 +70,15 @@ func (b *Builder) WithStory(s *storytelling.Story) *Builder {
 	return b
 }
 
+// WithStories adds the stories to the scene in the order of the list. It is used to publish all public stories of the scene with the project.
+func (b *Builder) WithStories(stories storytelling.StoryList) *Builder {
+	if b == nil {
+		return nil
+	}
+	b.stories = stories
+	return b
+}
+
 // Build this is used to publish projects and stories
 func (b *Builder) Build(ctx context.Context, w io.Writer, publishedAt time.Time, coreSupport bool, enableGa bool, trackingId string) error {
 	if b == nil || b.scene == nil {
@@ -81,13 +91,21 @@ func (b *Builder) Build(ctx context.Context, w io.Writer, publishedAt time.Time,
 	}
 
 	if b.story != nil {
-		story, err := b.buildStory(ctx)
+		story, err := b.buildStory(ctx, b.story)
 		if err != nil {
 			return err
 		}
 		res.Story = story
 	}
 
+	if b.stories != nil {
+		stories, err := b.buildStories(ctx)
+		if err != nil {
+			return err
+		}
+		res.Stories = stories
+	}
+
 	if b.nlsLayer != nil {
 		nlsLayers, err := b.buildNLSLayers(ctx)
 		if err != nil {
@@ -119,13 +137,21 @@ func (b *Builder) BuildResult(ctx context.Context, publishedAt time.Time, coreSu
 	}
 
 	if b.story != nil {
-		story, err := b.buildStory(ctx)
+		story, err := b.buildStory(ctx, b.story)
 		if err != nil {
 			return nil, errors.New("Fail buildStory :" + err.Error())
 		}
 		sceneData.Story = story
 	}
 
+	if b.stories != nil {
+		stories, err := b.buildStories(ctx)
+		if err != nil {
+			return nil, errors.New("Fail buildStories :" + err.Error())
+		}
+		sceneData.Stories = stories
+	}
+
 	if b.nlsLayer != nil {
 		nlsLayers, err := b.buildNLSLayers(ctx)
 		if err != nil {
@@ -159,18 +185,34 @@ func (b *Builder) buildScene(ctx context.Context, publishedAt time.Time, coreSup
 	return b.sceneJSON(ctx, publishedAt, p, coreSupport, enableGa, trackingId)
 }
 
-func (b *Builder) buildStory(ctx context.Context) (*storyJSON, error) {
+func (b *Builder) buildStory(ctx context.Context, s *storytelling.Story) (*storyJSON, error) {
 	if b == nil {
 		return nil, nil
 	}
 
 	// properties
-	p, err := b.ploader(ctx, b.story.Properties()...)
+	p, err := b.ploader(ctx, s.Properties()...)
 	if err != nil {
 		return nil, err
 	}
 
-	return b.storyJSON(ctx, p)
+	return b.storyJSON(ctx, s, p)
+}
+
+func (b *Builder) buildStories(ctx context.Context) ([]*storyJSON, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	res := make([]*storyJSON, 0, len(b.stories))
+	for _, s := range b.stories.Sorted() {
+		story, err := b.buildStory(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, story)
+	}
+	return res, nil
 }
 
 func (b *Builder) buildNLSLayers(ctx context.Context) ([]*nlsLayerJSON, error) {
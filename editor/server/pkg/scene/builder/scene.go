@@ -18,6 +18,7 @@ type sceneJSON struct {
 	Widgets           []*widgetJSON           `json:"widgets"`
 	WidgetAlignSystem *widgetAlignSystemJSON  `json:"widgetAlignSystem"`
 	Story             *storyJSON              `json:"story,omitempty"`
+	Stories           []*storyJSON            `json:"stories,omitempty"`
 	NLSLayers         []*nlsLayerJSON         `json:"nlsLayers"`
 	LayerStyles       []*layerStylesJSON      `json:"layerStyles"`
 	CoreSupport       bool                    `json:"coreSupport"`
@@ -11,6 +11,7 @@ import (
 type storyJSON struct {
 	ID            string       `json:"id"`
 	Title         string       `json:"title"`
+	Alias         string       `json:"alias,omitempty"`
 	Property      propertyJSON `json:"property"`
 	Pages         []pageJSON   `json:"pages"`
 	PanelPosition string       `json:"position"`
@@ -35,23 +36,24 @@ type blockJSON struct {
 	PluginId    string                  `json:"pluginId"`
 }
 
-func (b *Builder) storyJSON(ctx context.Context, p []*property.Property) (*storyJSON, error) {
-	if b.story == nil {
+func (b *Builder) storyJSON(ctx context.Context, s *storytelling.Story, p []*property.Property) (*storyJSON, error) {
+	if s == nil {
 		return nil, nil
 	}
 
 	return &storyJSON{
-		ID:       b.story.Id().String(),
-		Title:    b.story.Title(),
-		Property: b.property(ctx, findProperty(p, b.story.Property())),
-		Pages: lo.FilterMap(b.story.Pages().Pages(), func(page *storytelling.Page, _ int) (pageJSON, bool) {
+		ID:       s.Id().String(),
+		Title:    s.Title(),
+		Alias:    s.Alias(),
+		Property: b.property(ctx, findProperty(p, s.Property())),
+		Pages: lo.FilterMap(s.Pages().Pages(), func(page *storytelling.Page, _ int) (pageJSON, bool) {
 			if page == nil {
 				return pageJSON{}, false
 			}
 			return b.pageJSON(ctx, *page, p), true
 		}),
-		PanelPosition: string(b.story.PanelPosition()),
-		BgColor:       b.story.BgColor(),
+		PanelPosition: string(s.PanelPosition()),
+		BgColor:       s.BgColor(),
 	}, nil
 }
 
@@ -140,3 +140,14 @@ func (p *Plugins) PluginByName(name string) *Plugin {
 	}
 	return nil
 }
+
+func (p *Plugins) Clone() *Plugins {
+	if p == nil {
+		return nil
+	}
+	plugins := make([]*Plugin, 0, len(p.plugins))
+	for _, p := range p.plugins {
+		plugins = append(plugins, p.Clone())
+	}
+	return &Plugins{plugins: plugins}
+}
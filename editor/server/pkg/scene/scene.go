@@ -19,6 +19,7 @@ type Scene struct {
 	updatedAt time.Time
 	property  id.PropertyID
 	styles    *StyleList
+	version   int
 }
 
 func (s *Scene) ID() id.SceneID {
@@ -102,6 +103,21 @@ func (s *Scene) SetUpdatedAt(updatedAt time.Time) {
 	s.updatedAt = updatedAt
 }
 
+// Version is incremented on every change of the scene graph, and is used to detect concurrent changes.
+func (s *Scene) Version() int {
+	if s == nil {
+		return 0
+	}
+	return s.version
+}
+
+func (s *Scene) SetVersion(version int) {
+	if s == nil {
+		return
+	}
+	s.version = version
+}
+
 func (s *Scene) Properties() []id.PropertyID {
 	if s == nil {
 		return nil
@@ -111,3 +127,21 @@ func (s *Scene) Properties() []id.PropertyID {
 	ids = append(ids, s.widgets.Properties()...)
 	return ids
 }
+
+// Clone returns a deep copy of the scene, such as to keep it as a revision.
+func (s *Scene) Clone() *Scene {
+	if s == nil {
+		return nil
+	}
+	return &Scene{
+		id:        s.id,
+		project:   s.project,
+		workspace: s.workspace,
+		widgets:   s.widgets.Clone(),
+		plugins:   s.plugins.Clone(),
+		updatedAt: s.updatedAt,
+		property:  s.property,
+		styles:    s.styles,
+		version:   s.version,
+	}
+}
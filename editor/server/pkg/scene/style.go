@@ -3,10 +3,11 @@ package scene
 import "github.com/reearth/reearth/server/pkg/id"
 
 type Style struct {
-	id    id.StyleID
-	name  string
-	value *StyleValue
-	scene id.SceneID
+	id     id.StyleID
+	name   string
+	value  *StyleValue
+	scene  id.SceneID
+	preset *id.StylePresetID
 }
 
 func (s *Style) ID() id.StyleID {
@@ -48,10 +49,47 @@ func (l *Style) Scene() id.SceneID {
 	return l.scene
 }
 
+// Preset returns the preset that the style is linked to. The value of a linked style is replaced when the preset is updated.
+func (s *Style) Preset() *id.StylePresetID {
+	if s == nil {
+		return nil
+	}
+	return s.preset.CloneRef()
+}
+
+// LinkPreset copies the value of the preset and links the style to it.
+func (s *Style) LinkPreset(p *StylePreset) {
+	if s == nil || p == nil {
+		return
+	}
+	s.preset = p.ID().Ref()
+	s.value = p.Value().Clone()
+}
+
+func (s *Style) UnlinkPreset() {
+	if s == nil {
+		return
+	}
+	s.preset = nil
+}
+
 func (s *Style) Duplicate() *Style {
 	if s == nil {
 		return nil
 	}
 
-	return NewStyle().NewID().Name(s.name).Value(s.value).Scene(s.scene).MustBuild()
+	return NewStyle().NewID().Name(s.name).Value(s.value).Scene(s.scene).Preset(s.preset.CloneRef()).MustBuild()
+}
+
+func (s *Style) Clone() *Style {
+	if s == nil {
+		return nil
+	}
+	return &Style{
+		id:     s.id,
+		name:   s.name,
+		value:  s.value.Clone(),
+		scene:  s.scene,
+		preset: s.preset.CloneRef(),
+	}
 }
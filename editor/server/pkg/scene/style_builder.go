@@ -49,3 +49,8 @@ func (b *StyleBuilder) Name(n string) *StyleBuilder {
 	b.s.name = n
 	return b
 }
+
+func (b *StyleBuilder) Preset(p *id.StylePresetID) *StyleBuilder {
+	b.s.preset = p
+	return b
+}
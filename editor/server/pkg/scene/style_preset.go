@@ -0,0 +1,100 @@
+package scene
+
+import (
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+// StylePreset is a style shared in a workspace. Styles of scenes created from a preset are linked to it and follow its value.
+type StylePreset struct {
+	id          id.StylePresetID
+	workspace   accountdomain.WorkspaceID
+	name        string
+	description string
+	value       *StyleValue
+	updatedAt   time.Time
+}
+
+func (p *StylePreset) ID() id.StylePresetID {
+	if p == nil {
+		return id.StylePresetID{}
+	}
+	return p.id
+}
+
+func (p *StylePreset) Workspace() accountdomain.WorkspaceID {
+	if p == nil {
+		return accountdomain.WorkspaceID{}
+	}
+	return p.workspace
+}
+
+func (p *StylePreset) Name() string {
+	if p == nil {
+		return ""
+	}
+	return p.name
+}
+
+func (p *StylePreset) Description() string {
+	if p == nil {
+		return ""
+	}
+	return p.description
+}
+
+func (p *StylePreset) Value() *StyleValue {
+	if p == nil {
+		return nil
+	}
+	return p.value
+}
+
+func (p *StylePreset) CreatedAt() time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	return p.id.Timestamp()
+}
+
+func (p *StylePreset) UpdatedAt() time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	if p.updatedAt.IsZero() {
+		return p.CreatedAt()
+	}
+	return p.updatedAt
+}
+
+func (p *StylePreset) Rename(name string) {
+	if p == nil {
+		return
+	}
+	p.name = name
+}
+
+func (p *StylePreset) SetDescription(description string) {
+	if p == nil {
+		return
+	}
+	p.description = description
+}
+
+func (p *StylePreset) UpdateValue(v *StyleValue) {
+	if p == nil {
+		return
+	}
+	p.value = v
+}
+
+func (p *StylePreset) SetUpdatedAt(t time.Time) {
+	if p == nil {
+		return
+	}
+	p.updatedAt = t
+}
+
+type StylePresetList []*StylePreset
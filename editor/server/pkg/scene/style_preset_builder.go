@@ -0,0 +1,72 @@
+package scene
+
+import (
+	"time"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type StylePresetBuilder struct {
+	p *StylePreset
+}
+
+func NewStylePreset() *StylePresetBuilder {
+	return &StylePresetBuilder{p: &StylePreset{}}
+}
+
+func (b *StylePresetBuilder) Build() (*StylePreset, error) {
+	if b.p.id.IsNil() {
+		return nil, id.ErrInvalidID
+	}
+	if b.p.workspace.IsNil() {
+		return nil, id.ErrInvalidID
+	}
+	if b.p.value == nil {
+		b.p.value = &StyleValue{}
+	}
+	return b.p, nil
+}
+
+func (b *StylePresetBuilder) MustBuild() *StylePreset {
+	p, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (b *StylePresetBuilder) ID(id id.StylePresetID) *StylePresetBuilder {
+	b.p.id = id
+	return b
+}
+
+func (b *StylePresetBuilder) NewID() *StylePresetBuilder {
+	b.p.id = id.NewStylePresetID()
+	return b
+}
+
+func (b *StylePresetBuilder) Workspace(workspace accountdomain.WorkspaceID) *StylePresetBuilder {
+	b.p.workspace = workspace
+	return b
+}
+
+func (b *StylePresetBuilder) Name(name string) *StylePresetBuilder {
+	b.p.name = name
+	return b
+}
+
+func (b *StylePresetBuilder) Description(description string) *StylePresetBuilder {
+	b.p.description = description
+	return b
+}
+
+func (b *StylePresetBuilder) Value(v *StyleValue) *StylePresetBuilder {
+	b.p.value = v
+	return b
+}
+
+func (b *StylePresetBuilder) UpdatedAt(t time.Time) *StylePresetBuilder {
+	b.p.updatedAt = t
+	return b
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,3 +26,17 @@ func TestStyle_Duplicate(t *testing.T) {
 	assert.Equal(t, original.Value(), duplicated.Value())
 	assert.Equal(t, original.Scene(), duplicated.Scene())
 }
+
+func TestStyle_LinkPreset(t *testing.T) {
+	preset := NewStylePreset().NewID().Workspace(accountdomain.NewWorkspaceID()).Value(&StyleValue{"key": "preset"}).MustBuild()
+	s := NewStyle().NewID().Value(&StyleValue{"key": "value"}).Scene(id.NewSceneID()).MustBuild()
+
+	s.LinkPreset(preset)
+	assert.Equal(t, preset.ID().Ref(), s.Preset())
+	assert.Equal(t, preset.Value(), s.Value())
+	assert.Equal(t, preset.ID().Ref(), s.Duplicate().Preset())
+
+	s.UnlinkPreset()
+	assert.Nil(t, s.Preset())
+	assert.Equal(t, &StyleValue{"key": "preset"}, s.Value())
+}
@@ -1,3 +1,14 @@
 package scene
 
 type StyleValue map[string]any
+
+func (v *StyleValue) Clone() *StyleValue {
+	if v == nil {
+		return nil
+	}
+	res := make(StyleValue, len(*v))
+	for k, v := range *v {
+		res[k] = v
+	}
+	return &res
+}
@@ -0,0 +1,182 @@
+package styleexpr
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenVariable
+	tokenOperator
+)
+
+type token struct {
+	typ tokenType
+	// val is the content of strings and variables without quotes and braces, or the source of the other tokens.
+	val string
+	// pos is the position in characters rather than bytes so that it is understandable in expressions with multibyte attribute names.
+	pos int
+}
+
+func (t token) String() string {
+	switch t.typ {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.val)
+	case tokenVariable:
+		return fmt.Sprintf("${%s}", t.val)
+	}
+	return fmt.Sprintf("%q", t.val)
+}
+
+// operators are ordered so that longer operators are matched first.
+var operators = []string{
+	"===", "!==",
+	"==", "!=", "<=", ">=", "&&", "||", "=~", "!~",
+	"<", ">", "+", "-", "*", "/", "%", "!", "?", ":", "(", ")", "[", "]", ",", ".", "=",
+}
+
+func tokenize(src string) ([]token, error) {
+	pos := func(i int) int {
+		return utf8.RuneCountInString(src[:i])
+	}
+
+	var res []token
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case strings.HasPrefix(src[i:], "${"):
+			end, ok := variableEnd(src, i)
+			if !ok {
+				return nil, fmt.Errorf("unterminated variable at %d", pos(i))
+			}
+			v := strings.TrimSpace(src[i+2 : end])
+			if v == "" {
+				return nil, fmt.Errorf("empty variable at %d", pos(i))
+			}
+			res = append(res, token{typ: tokenVariable, val: v, pos: pos(i)})
+			i = end + 1
+		case r == '\'' || r == '"':
+			s, end, ok := readString(src, i)
+			if !ok {
+				return nil, fmt.Errorf("unterminated string at %d", pos(i))
+			}
+			res = append(res, token{typ: tokenString, val: s, pos: pos(i)})
+			i = end
+		case isDigit(r) || r == '.' && i+1 < len(src) && isDigit(rune(src[i+1])):
+			end := readNumber(src, i)
+			res = append(res, token{typ: tokenNumber, val: src[i:end], pos: pos(i)})
+			i = end
+		case isIdentStart(r):
+			end := i + size
+			for end < len(src) {
+				r, size := utf8.DecodeRuneInString(src[end:])
+				if !isIdentStart(r) && !isDigit(r) {
+					break
+				}
+				end += size
+			}
+			res = append(res, token{typ: tokenIdent, val: src[i:end], pos: pos(i)})
+			i = end
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at %d", r, pos(i))
+			}
+			res = append(res, token{typ: tokenOperator, val: op, pos: pos(i)})
+			i += len(op)
+		}
+	}
+	return append(res, token{typ: tokenEOF, pos: pos(len(src))}), nil
+}
+
+// variableEnd returns the position of the brace closing the variable starting at i. Braces in quotes are skipped.
+func variableEnd(src string, i int) (int, bool) {
+	var quote byte
+	for j := i + 2; j < len(src); j++ {
+		c := src[j]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				j++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '}':
+			return j, true
+		}
+	}
+	return 0, false
+}
+
+// readString returns the content of the quoted string starting at i and the position after the closing quote.
+func readString(src string, i int) (string, int, bool) {
+	quote := src[i]
+	var b strings.Builder
+	for j := i + 1; j < len(src); j++ {
+		c := src[j]
+		switch {
+		case c == '\\' && j+1 < len(src):
+			j++
+			b.WriteByte(src[j])
+		case c == quote:
+			return b.String(), j + 1, true
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, false
+}
+
+func readNumber(src string, i int) int {
+	j := i
+	for j < len(src) && isDigit(rune(src[j])) {
+		j++
+	}
+	if j < len(src) && src[j] == '.' {
+		j++
+		for j < len(src) && isDigit(rune(src[j])) {
+			j++
+		}
+	}
+	if j < len(src) && (src[j] == 'e' || src[j] == 'E') {
+		k := j + 1
+		if k < len(src) && (src[k] == '+' || src[k] == '-') {
+			k++
+		}
+		if k < len(src) && isDigit(rune(src[k])) {
+			j = k
+			for j < len(src) && isDigit(rune(src[j])) {
+				j++
+			}
+		}
+	}
+	return j
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
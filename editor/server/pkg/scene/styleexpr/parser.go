@@ -0,0 +1,387 @@
+// Package styleexpr parses and validates expressions of the Cesium 3D Tiles styling language used in layer styles.
+package styleexpr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Expression is a parsed style expression.
+type Expression struct {
+	src       string
+	variables []string
+}
+
+func (e *Expression) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.src
+}
+
+// Variables returns the contents of the variables such as "height" of ${height} in the order of appearance.
+// Variables in string literals such as '${name} m' are included.
+func (e *Expression) Variables() []string {
+	if e == nil || len(e.variables) == 0 {
+		return nil
+	}
+	return lo.Uniq(e.variables)
+}
+
+// Attributes returns names of the feature attributes referred by the variables.
+func (e *Expression) Attributes() []string {
+	return lo.Uniq(lo.FilterMap(e.Variables(), func(v string, _ int) (string, bool) {
+		a := attributeName(v)
+		return a, a != ""
+	}))
+}
+
+type arity struct {
+	min, max int
+}
+
+// functions are built-in functions of the styling language and the numbers of their arguments.
+var functions = map[string]arity{
+	"abs": {1, 1}, "sqrt": {1, 1}, "cos": {1, 1}, "sin": {1, 1}, "tan": {1, 1},
+	"acos": {1, 1}, "asin": {1, 1}, "atan": {1, 1}, "radians": {1, 1}, "degrees": {1, 1},
+	"sign": {1, 1}, "floor": {1, 1}, "ceil": {1, 1}, "round": {1, 1}, "exp": {1, 1},
+	"exp2": {1, 1}, "log": {1, 1}, "log2": {1, 1}, "fract": {1, 1}, "length": {1, 1},
+	"normalize": {1, 1}, "isNaN": {1, 1}, "isFinite": {1, 1},
+	"isExactClass": {1, 1}, "isClass": {1, 1}, "getExactClassName": {0, 0},
+	"atan2": {2, 2}, "pow": {2, 2}, "min": {2, 2}, "max": {2, 2},
+	"distance": {2, 2}, "dot": {2, 2}, "cross": {2, 2},
+	"clamp": {3, 3}, "mix": {3, 3},
+	"String": {0, 1}, "Boolean": {0, 1}, "Number": {0, 1},
+	"color": {0, 2}, "rgb": {3, 3}, "rgba": {4, 4}, "hsl": {3, 3}, "hsla": {4, 4},
+	"vec2": {0, 2}, "vec3": {0, 3}, "vec4": {0, 4}, "regExp": {0, 2},
+}
+
+// methods are functions called on values such as regExp('a').test(${name}).
+var methods = map[string]arity{
+	"test": {1, 1}, "exec": {1, 1}, "toString": {0, 0},
+}
+
+var constants = []string{"true", "false", "null", "undefined", "NaN", "Infinity"}
+
+var namespaces = map[string][]string{
+	"Math": {"PI", "E"},
+}
+
+// Parse parses the expression and returns an error that describes the position of the first syntax error.
+// Operators that are not supported by the styling language such as "==" are reported as errors.
+func Parse(src string) (*Expression, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().typ == tokenEOF {
+		return nil, fmt.Errorf("empty expression")
+	}
+	if err := p.expression(); err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, unexpected(t)
+	}
+	return &Expression{src: src, variables: p.variables}, nil
+}
+
+type parser struct {
+	tokens    []token
+	pos       int
+	variables []string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOperator(ops ...string) bool {
+	t := p.peek()
+	return t.typ == tokenOperator && lo.Contains(ops, t.val)
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOperator(op) {
+		return fmt.Errorf("expected %q but got %s at %d", op, p.peek(), p.peek().pos)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) expression() error {
+	if err := p.binary(0); err != nil {
+		return err
+	}
+	if !p.isOperator("?") {
+		return nil
+	}
+	p.next()
+	if err := p.expression(); err != nil {
+		return err
+	}
+	if err := p.expect(":"); err != nil {
+		return err
+	}
+	return p.expression()
+}
+
+// binaryLevels are binary operators from the lowest precedence.
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"===", "!==", "=~", "!~"},
+	{"<", ">", "<=", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+var unsupportedOperators = map[string]string{
+	"==": "===",
+	"!=": "!==",
+	"=":  "===",
+}
+
+func (p *parser) binary(level int) error {
+	if level == len(binaryLevels) {
+		return p.unary()
+	}
+	if err := p.binary(level + 1); err != nil {
+		return err
+	}
+	for {
+		if t := p.peek(); t.typ == tokenOperator {
+			if alt, ok := unsupportedOperators[t.val]; ok {
+				return fmt.Errorf("operator %q at %d is not supported, use %q instead", t.val, t.pos, alt)
+			}
+		}
+		if !p.isOperator(binaryLevels[level]...) {
+			return nil
+		}
+		p.next()
+		if err := p.binary(level + 1); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) unary() error {
+	if p.isOperator("!", "-", "+") {
+		p.next()
+		return p.unary()
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() error {
+	if err := p.primary(); err != nil {
+		return err
+	}
+	for {
+		switch {
+		case p.isOperator("."):
+			p.next()
+			t := p.next()
+			if t.typ != tokenIdent {
+				return unexpected(t)
+			}
+			if !p.isOperator("(") {
+				continue
+			}
+			a, ok := methods[t.val]
+			if !ok {
+				return fmt.Errorf("unknown function %q at %d", t.val, t.pos)
+			}
+			if err := p.arguments(t, a); err != nil {
+				return err
+			}
+		case p.isOperator("["):
+			p.next()
+			if err := p.expression(); err != nil {
+				return err
+			}
+			if err := p.expect("]"); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (p *parser) primary() error {
+	t := p.next()
+	switch t.typ {
+	case tokenNumber:
+		return nil
+	case tokenString:
+		p.variables = append(p.variables, stringVariables(t.val)...)
+		return nil
+	case tokenVariable:
+		p.variables = append(p.variables, t.val)
+		return nil
+	case tokenIdent:
+		return p.identifier(t)
+	case tokenOperator:
+		switch t.val {
+		case "(":
+			if err := p.expression(); err != nil {
+				return err
+			}
+			return p.expect(")")
+		case "[":
+			return p.list("]")
+		}
+	}
+	return unexpected(t)
+}
+
+func (p *parser) identifier(t token) error {
+	if p.isOperator("(") {
+		a, ok := functions[t.val]
+		if !ok {
+			return fmt.Errorf("unknown function %q at %d", t.val, t.pos)
+		}
+		return p.arguments(t, a)
+	}
+	if lo.Contains(constants, t.val) {
+		return nil
+	}
+	if members, ok := namespaces[t.val]; ok {
+		if err := p.expect("."); err != nil {
+			return err
+		}
+		m := p.next()
+		if m.typ != tokenIdent || !lo.Contains(members, m.val) {
+			return fmt.Errorf("unknown constant %s.%s at %d", t.val, m.val, t.pos)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown identifier %q at %d, use ${%s} to refer to an attribute", t.val, t.pos, t.val)
+}
+
+func (p *parser) arguments(fn token, a arity) error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	start := p.pos
+	if err := p.list(")"); err != nil {
+		return err
+	}
+	if n := countArguments(p.tokens[start:p.pos]); n < a.min || n > a.max {
+		if a.min == a.max {
+			return fmt.Errorf("function %q at %d takes %d arguments but got %d", fn.val, fn.pos, a.min, n)
+		}
+		return fmt.Errorf("function %q at %d takes %d to %d arguments but got %d", fn.val, fn.pos, a.min, a.max, n)
+	}
+	return nil
+}
+
+// list parses comma-separated expressions until the closing operator.
+func (p *parser) list(end string) error {
+	if p.isOperator(end) {
+		p.next()
+		return nil
+	}
+	for {
+		if err := p.expression(); err != nil {
+			return err
+		}
+		if p.isOperator(end) {
+			p.next()
+			return nil
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+	}
+}
+
+// countArguments counts top-level commas of the tokens of arguments including the closing parenthesis.
+func countArguments(tokens []token) int {
+	if len(tokens) <= 1 {
+		return 0
+	}
+	n, depth := 1, 0
+	for _, t := range tokens[:len(tokens)-1] {
+		if t.typ != tokenOperator {
+			continue
+		}
+		switch t.val {
+		case "(", "[":
+			depth++
+		case ")", "]":
+			depth--
+		case ",":
+			if depth == 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func unexpected(t token) error {
+	return fmt.Errorf("unexpected %s at %d", t, t.pos)
+}
+
+// stringVariables returns variables embedded in the string literal.
+func stringVariables(s string) []string {
+	var res []string
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			return res
+		}
+		end, ok := variableEnd(s, i)
+		if !ok {
+			return res
+		}
+		res = append(res, strings.TrimSpace(s[i+2:end]))
+		s = s[end+1:]
+	}
+}
+
+// attributeName returns the name of the attribute referred by the variable such as "name" of ${feature['name']}.
+// Contents of variables are names of attributes except for those that refer to the whole feature or JSONPath.
+func attributeName(v string) string {
+	switch {
+	case v == "feature" || v == "$" || v == "tiles3d_tileset_time":
+		return ""
+	case strings.HasPrefix(v, "feature."):
+		return firstSegment(v[len("feature."):])
+	case strings.HasPrefix(v, "feature["):
+		return firstSegment(v[len("feature"):])
+	case strings.HasPrefix(v, "$."):
+		return firstSegment(v[len("$."):])
+	case strings.HasPrefix(v, "$["):
+		return firstSegment(v[len("$"):])
+	}
+	return v
+}
+
+// firstSegment returns the first key of paths such as "a.b" and "['a'].b".
+func firstSegment(path string) string {
+	if strings.HasPrefix(path, "[") {
+		end := strings.Index(path, "]")
+		if end < 0 {
+			return ""
+		}
+		return strings.Trim(strings.TrimSpace(path[1:end]), `'"`)
+	}
+	if i := strings.IndexAny(path, ".["); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
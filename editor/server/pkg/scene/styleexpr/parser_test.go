@@ -0,0 +1,58 @@
+package styleexpr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		src       string
+		variables []string
+		err       string
+	}{
+		{name: "variable", src: "${height}", variables: []string{"height"}},
+		{name: "comparison", src: "${計測高さ} >= 180 && ${type} === 'building'", variables: []string{"計測高さ", "type"}},
+		{name: "ternary", src: "${a} > 0 ? color('red') : color('#00ff00', 0.5)", variables: []string{"a"}},
+		{name: "functions", src: "clamp(abs(${a}) * Math.PI, 0, 10) + -1.5e3 % 2", variables: []string{"a"}},
+		{name: "regexp", src: "regExp('^1').test(${code}) || ${code} =~ regExp('2$')", variables: []string{"code"}},
+		{name: "string variables", src: "'${name} (${height} m)'", variables: []string{"name", "height"}},
+		{name: "member and index", src: "vec3(1, 2, 3).x + ${feature['a b']}[0] + ${b}.length", variables: []string{"feature['a b']", "b"}},
+		{name: "array", src: "[1, ${a}, 'x'][1] !== undefined", variables: []string{"a"}},
+		{name: "constants", src: "isNaN(NaN) && Infinity > 0 && null === null && !false"},
+		{name: "empty", src: " ", err: "empty expression"},
+		{name: "equality", src: "${a} == 1", err: `operator "==" at 5 is not supported, use "===" instead`},
+		{name: "assignment", src: "${a} = 1", err: `operator "=" at 5 is not supported, use "===" instead`},
+		{name: "bare identifier", src: "height > 10", err: `unknown identifier "height" at 0, use ${height} to refer to an attribute`},
+		{name: "unknown function", src: "colour('red')", err: `unknown function "colour" at 0`},
+		{name: "arity", src: "rgb(1, 2)", err: `function "rgb" at 0 takes 3 arguments but got 2`},
+		{name: "unterminated string", src: "'abc", err: "unterminated string at 0"},
+		{name: "unterminated variable", src: "${abc", err: "unterminated variable at 0"},
+		{name: "empty variable", src: "${} > 1", err: "empty variable at 0"},
+		{name: "unclosed parenthesis", src: "(${a} + 1", err: `expected ")" but got end of expression at 9`},
+		{name: "trailing operator", src: "${a} >", err: "unexpected end of expression at 6"},
+		{name: "missing operator", src: "${a} 1", err: `unexpected "1" at 5`},
+		{name: "unknown constant", src: "Math.TAU", err: "unknown constant Math.TAU at 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.src)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.variables, got.Variables())
+		})
+	}
+}
+
+func TestExpression_Attributes(t *testing.T) {
+	e, err := Parse("${a} + ${feature.b} + ${feature['c']}.d + ${$.e.f} + ${feature} + ${tiles3d_tileset_time} + ${a}")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "e"}, e.Attributes())
+}
@@ -0,0 +1,224 @@
+package styleexpr
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type IssueType string
+
+const (
+	IssueTypeSyntax           IssueType = "syntax"
+	IssueTypeUnknownAttribute IssueType = "unknownAttribute"
+)
+
+// Issue is a problem of an expression in a style.
+type Issue struct {
+	// Path is the location of the expression in the style such as "3dtiles.color.expression.conditions[0][0]".
+	Path    string
+	Type    IssueType
+	Message string
+	// Attribute and Suggestion are set for unknown attributes. Suggestion is the most similar known attribute if any.
+	Attribute  string
+	Suggestion string
+}
+
+func (i Issue) Error() string {
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+type Issues []Issue
+
+func (l Issues) Error() string {
+	return "invalid style: " + strings.Join(lo.Map(l, func(i Issue, _ int) string { return i.Error() }), "; ")
+}
+
+// Syntax returns only syntax errors, which break the style regardless of layers.
+func (l Issues) Syntax() Issues {
+	return lo.Filter(l, func(i Issue, _ int) bool { return i.Type == IssueTypeSyntax })
+}
+
+// Err returns the issues as an error, or nil when there are no issues.
+func (l Issues) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+const (
+	expressionKey = "expression"
+	conditionsKey = "conditions"
+)
+
+// Validate parses expressions in the style and checks that they refer only to the attributes.
+// Expressions are values of "expression" keys, which are strings or objects with "conditions" that have pairs of a condition and an expression.
+// Attributes are not checked when attributes is nil, as attributes of layers that load external data are unknown.
+func Validate(style map[string]any, attributes []string) Issues {
+	v := validator{}
+	if attributes != nil {
+		v.attributes = lo.SliceToMap(attributes, func(a string) (string, struct{}) { return a, struct{}{} })
+		v.candidates = attributes
+	}
+	v.object("", style)
+	return v.issues
+}
+
+type validator struct {
+	attributes map[string]struct{}
+	candidates []string
+	issues     Issues
+}
+
+func (v *validator) value(path string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		v.object(path, value)
+	case []any:
+		for i, e := range value {
+			v.value(index(path, i), e)
+		}
+	}
+}
+
+func (v *validator) object(path string, obj map[string]any) {
+	// keys are sorted so that issues are reported in a stable order
+	keys := lo.Keys(obj)
+	sort.Strings(keys)
+	for _, k := range keys {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		if k == expressionKey {
+			v.expressionContainer(p, obj[k])
+		} else {
+			v.value(p, obj[k])
+		}
+	}
+}
+
+func (v *validator) expressionContainer(path string, value any) {
+	switch value := value.(type) {
+	case string:
+		v.expression(path, value)
+		return
+	case float64, int, int64, bool:
+		return
+	case map[string]any:
+		if conditions, ok := value[conditionsKey]; ok {
+			v.conditions(path+"."+conditionsKey, conditions)
+			return
+		}
+	}
+	v.syntax(path, fmt.Sprintf("expression must be a string or have %q", conditionsKey))
+}
+
+func (v *validator) conditions(path string, value any) {
+	conditions, ok := value.([]any)
+	if !ok {
+		v.syntax(path, "conditions must be an array")
+		return
+	}
+	for i, c := range conditions {
+		p := index(path, i)
+		pair, ok := c.([]any)
+		if !ok || len(pair) != 2 {
+			v.syntax(p, "condition must be a pair of a condition and an expression")
+			continue
+		}
+		for j, e := range pair {
+			switch e := e.(type) {
+			case string:
+				v.expression(index(p, j), e)
+			case float64, int, int64, bool:
+			default:
+				v.syntax(index(p, j), "condition must be a string")
+			}
+		}
+	}
+}
+
+func (v *validator) expression(path, src string) {
+	e, err := Parse(src)
+	if err != nil {
+		v.syntax(path, err.Error())
+		return
+	}
+	if v.attributes == nil {
+		return
+	}
+	for _, a := range e.Attributes() {
+		if v.known(a) {
+			continue
+		}
+		i := Issue{
+			Path:      path,
+			Type:      IssueTypeUnknownAttribute,
+			Attribute: a,
+			Message:   fmt.Sprintf("unknown attribute %q", a),
+		}
+		if s := suggest(a, v.candidates); s != "" {
+			i.Suggestion = s
+			i.Message += fmt.Sprintf(", did you mean %q?", s)
+		}
+		v.issues = append(v.issues, i)
+	}
+}
+
+// known also accepts names of nested values such as "a.b" when "a" is known.
+func (v *validator) known(a string) bool {
+	if _, ok := v.attributes[a]; ok {
+		return true
+	}
+	_, ok := v.attributes[firstSegment(a)]
+	return ok
+}
+
+func (v *validator) syntax(path, msg string) {
+	v.issues = append(v.issues, Issue{Path: path, Type: IssueTypeSyntax, Message: msg})
+}
+
+func index(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// suggest returns the candidate closest to the name when its edit distance is small enough to be a typo.
+func suggest(name string, candidates []string) string {
+	best, bestDist := "", 0
+	for _, c := range candidates {
+		d := distance(strings.ToLower(name), strings.ToLower(c))
+		if best == "" || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	if best == "" || bestDist > max(1, len([]rune(name))/3) {
+		return ""
+	}
+	return best
+}
+
+// distance returns the Levenshtein distance between the strings.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
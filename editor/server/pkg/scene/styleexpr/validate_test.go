@@ -0,0 +1,81 @@
+package styleexpr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	style := map[string]any{
+		"3dtiles": map[string]any{
+			"color": map[string]any{
+				"expression": map[string]any{
+					"conditions": []any{
+						[]any{"${用途} === '業務施設'", "color('#ff0000')"},
+						[]any{"${用途} == '住宅'", "color('#00ff00')"},
+						[]any{"true", "color('#ffffff')"},
+					},
+				},
+			},
+			"colorBlendMode": "highlight",
+		},
+		"marker": map[string]any{
+			"pointSize":  map[string]any{"expression": "${heigth} / 10"},
+			"pointColor": map[string]any{"expression": 1.0},
+			"label":      map[string]any{"expression": map[string]any{"value": "x"}},
+		},
+	}
+
+	t.Run("without attributes", func(t *testing.T) {
+		assert.Equal(t, Issues{
+			{
+				Path:    "3dtiles.color.expression.conditions[1][0]",
+				Type:    IssueTypeSyntax,
+				Message: `operator "==" at 6 is not supported, use "===" instead`,
+			},
+			{
+				Path:    "marker.label.expression",
+				Type:    IssueTypeSyntax,
+				Message: `expression must be a string or have "conditions"`,
+			},
+		}, Validate(style, nil))
+	})
+
+	t.Run("with attributes", func(t *testing.T) {
+		got := Validate(style, []string{"用途", "height"})
+		assert.Equal(t, Issue{
+			Path:       "marker.pointSize.expression",
+			Type:       IssueTypeUnknownAttribute,
+			Message:    `unknown attribute "heigth", did you mean "height"?`,
+			Attribute:  "heigth",
+			Suggestion: "height",
+		}, got[2])
+		assert.Len(t, got, 3)
+		assert.Len(t, got.Syntax(), 2)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		got := Validate(map[string]any{
+			"polygon": map[string]any{
+				"fillColor":   map[string]any{"expression": "color(${fill},${fill-opacity})"},
+				"strokeColor": "#ffffff",
+			},
+		}, []string{"fill", "fill-opacity"})
+		assert.Empty(t, got)
+		assert.NoError(t, got.Err())
+	})
+}
+
+func TestIssues_Error(t *testing.T) {
+	assert.EqualError(t, Issues{
+		{Path: "a.expression", Message: "x"},
+		{Path: "b.expression", Message: "y"},
+	}.Err(), "invalid style: a.expression: x; b.expression: y")
+}
+
+func TestSuggest(t *testing.T) {
+	assert.Equal(t, "height", suggest("Height", []string{"name", "height"}))
+	assert.Equal(t, "", suggest("area", []string{"name", "height"}))
+	assert.Equal(t, "", suggest("a", nil))
+}
@@ -118,3 +118,13 @@ func (w *WidgetAlignSystem) SetZone(t WidgetZoneType, z *WidgetZone) {
 		w.outer = z
 	}
 }
+
+func (was *WidgetAlignSystem) Clone() *WidgetAlignSystem {
+	if was == nil {
+		return nil
+	}
+	return &WidgetAlignSystem{
+		inner: was.inner.Clone(),
+		outer: was.outer.Clone(),
+	}
+}
@@ -197,3 +197,17 @@ func (p WidgetAreaPadding) Left() int {
 func (p WidgetAreaPadding) Right() int {
 	return p.right
 }
+
+func (a *WidgetArea) Clone() *WidgetArea {
+	if a == nil {
+		return nil
+	}
+	return &WidgetArea{
+		widgetIds:  a.widgetIds.Clone(),
+		align:      a.align,
+		padding:    util.CloneRef(a.padding),
+		gap:        util.CloneRef(a.gap),
+		centered:   a.centered,
+		background: util.CloneRef(a.background),
+	}
+}
@@ -87,3 +87,14 @@ func (s *WidgetSection) SetArea(t WidgetAreaType, a *WidgetArea) {
 		s.bottom = a
 	}
 }
+
+func (s *WidgetSection) Clone() *WidgetSection {
+	if s == nil {
+		return nil
+	}
+	return &WidgetSection{
+		top:    s.top.Clone(),
+		middle: s.middle.Clone(),
+		bottom: s.bottom.Clone(),
+	}
+}
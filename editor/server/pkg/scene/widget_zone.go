@@ -84,3 +84,14 @@ func (z *WidgetZone) SetSection(t WidgetSectionType, s *WidgetSection) {
 		z.right = s
 	}
 }
+
+func (z *WidgetZone) Clone() *WidgetZone {
+	if z == nil {
+		return nil
+	}
+	return &WidgetZone{
+		left:   z.left.Clone(),
+		center: z.center.Clone(),
+		right:  z.right.Clone(),
+	}
+}
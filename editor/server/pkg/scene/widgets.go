@@ -142,3 +142,17 @@ func (w *Widgets) Properties() []id.PropertyID {
 	}
 	return res
 }
+
+func (w *Widgets) Clone() *Widgets {
+	if w == nil {
+		return nil
+	}
+	widgets := make([]*Widget, 0, len(w.widgets))
+	for _, w := range w.widgets {
+		widgets = append(widgets, w.Clone())
+	}
+	return &Widgets{
+		widgets: widgets,
+		align:   w.align.Clone(),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
+	"strings"
 )
 
 // SequentialReader is the interface that allows reading shapes and attributes one after another. It also embeds io.Closer.
@@ -20,7 +22,6 @@ type SequentialReader interface {
 	// encountered any errors, nil is returned for the Shape.
 	Shape() (int, Shape)
 
-	/* Note: not used
 	// Attribute returns the value of the n-th attribute in the current row. If
 	// the SequentialReader encountered any errors, the empty string is
 	// returned.
@@ -28,13 +29,12 @@ type SequentialReader interface {
 
 	// Fields returns the fields of the database. If the SequentialReader
 	// encountered any errors, nil is returned.
-	Fields() []Field*/
+	Fields() []Field
 
 	// Err returns the last non-EOF error encountered.
 	Err() error
 }
 
-/* Note: not used
 // Attributes returns all attributes of the shape that sr was last advanced to.
 func Attributes(sr SequentialReader) []string {
 	if sr.Err() != nil {
@@ -50,13 +50,13 @@ func Attributes(sr SequentialReader) []string {
 // AttributeCount returns the number of fields of the database.
 func AttributeCount(sr SequentialReader) int {
 	return len(sr.Fields())
-}*/
+}
 
 // seqReader implements SequentialReader based on external io.ReadCloser
 // instances
 type seqReader struct {
-	shp/*, dbf*/ io.ReadCloser
-	err error
+	shp, dbf io.ReadCloser
+	err      error
 
 	geometryType ShapeType
 	bbox         Box
@@ -65,12 +65,11 @@ type seqReader struct {
 	num        int32
 	filelength int64
 
-	/* Note: not used
 	dbfFields       []Field
 	dbfNumRecords   int32
 	dbfHeaderLength int16
 	dbfRecordLength int16
-	dbfRow          []byte*/
+	dbfRow          []byte
 }
 
 // Read and parse headers in the Shapefile. This will fill out GeometryType,
@@ -117,12 +116,11 @@ func (sr *seqReader) readHeaders() {
 		return
 	}
 
-	/* Note: not used
 	// dbf header
-	er = &errReader{Reader: sr.dbf}
 	if sr.dbf == nil {
 		return
 	}
+	er = &errReader{Reader: sr.dbf}
 	_, err = io.CopyN(io.Discard, er, 4)
 	if err != nil {
 		sr.err = err
@@ -169,7 +167,7 @@ func (sr *seqReader) readHeaders() {
 		sr.err = fmt.Errorf("Field descriptor array terminator not found")
 		return
 	}
-	sr.dbfRow = make([]byte, sr.dbfRecordLength)*/
+	sr.dbfRow = make([]byte, sr.dbfRecordLength)
 }
 
 // Next implements a method of interface SequentialReader for seqReader.
@@ -234,14 +232,16 @@ func (sr *seqReader) Next() bool {
 		sr.err = fmt.Errorf("error when discarding bytes on sequential read: %v", ce)
 		return false
 	}
-	/* Note: not used
+	if sr.dbf == nil {
+		return sr.err == nil
+	}
 	if _, err := io.ReadFull(sr.dbf, sr.dbfRow); err != nil {
 		sr.err = fmt.Errorf("error when reading DBF row: %v", err)
 		return false
 	}
 	if sr.dbfRow[0] != 0x20 && sr.dbfRow[0] != 0x2a {
 		sr.err = fmt.Errorf("Attribute row %d starts with incorrect deletion indicator", num)
-	}*/
+	}
 	return sr.err == nil
 }
 
@@ -250,10 +250,9 @@ func (sr *seqReader) Shape() (int, Shape) {
 	return int(sr.num) - 1, sr.shape
 }
 
-/* Note: not used
 // Attribute implements a method of interface SequentialReader for seqReader.
 func (sr *seqReader) Attribute(n int) string {
-	if sr.err != nil {
+	if sr.err != nil || sr.dbfRow == nil || n < 0 || n >= len(sr.dbfFields) {
 		return ""
 	}
 	start := 1
@@ -263,7 +262,7 @@ func (sr *seqReader) Attribute(n int) string {
 	}
 	s := string(sr.dbfRow[start : start+int(sr.dbfFields[f].Size)])
 	return strings.Trim(s, " ")
-}*/
+}
 
 // Err returns the first non-EOF error that was encountered.
 func (sr *seqReader) Err() error {
@@ -278,23 +277,24 @@ func (sr *seqReader) Close() error {
 	if err := sr.shp.Close(); err != nil {
 		return err
 	}
-	/* Note: not used
-	if err := sr.dbf.Close(); err != nil {
-		return err
-	}*/
+	if sr.dbf != nil {
+		if err := sr.dbf.Close(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-/* Note: not used
 // Fields returns a slice of the fields that are present in the DBF table.
 func (sr *seqReader) Fields() []Field {
 	return sr.dbfFields
-}*/
+}
 
 // SequentialReaderFromExt returns a new SequentialReader that interprets shp
-// as a source of shapes whose attributes can be retrieved from dbf.
-func SequentialReaderFromExt(shp /*, dbf*/ io.ReadCloser) SequentialReader {
-	sr := &seqReader{shp: shp /*, dbf: dbf*/}
+// as a source of shapes whose attributes can be retrieved from dbf. dbf may be
+// nil, in which case no attributes are read.
+func SequentialReaderFromExt(shp, dbf io.ReadCloser) SequentialReader {
+	sr := &seqReader{shp: shp, dbf: dbf}
 	sr.readHeaders()
 	return sr
 }
@@ -2,16 +2,26 @@ package shp
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"math"
+	"strconv"
+	"time"
 )
 
 // Writer is the type that is used to write a new shapefile.
 type Writer struct {
 	shp          io.WriteSeeker
+	shx          io.WriteSeeker
 	GeometryType ShapeType
 	num          int32
 	bbox         Box
+
+	dbf             io.WriteSeeker
+	dbfFields       []Field
+	dbfHeaderLength int16
+	dbfRecordLength int16
 }
 
 func CreateFrom(ws io.WriteSeeker, t ShapeType) (*Writer, error) {
@@ -26,6 +36,102 @@ func CreateFrom(ws io.WriteSeeker, t ShapeType) (*Writer, error) {
 	return w, nil
 }
 
+// SetIndex sets the writer of the SHX file, which indexes records of the SHP file.
+// It must be called before writing shapes.
+func (w *Writer) SetIndex(ws io.WriteSeeker) error {
+	if w.num != 0 {
+		return errors.New("attempted to set index after writing")
+	}
+	if _, err := ws.Seek(100, io.SeekStart); err != nil {
+		return err
+	}
+	w.shx = ws
+	return nil
+}
+
+// SetFields sets the writer of the DBF file and its fields.
+// Records are written for shapes that are already written, and their attributes are left blank.
+func (w *Writer) SetFields(ws io.WriteSeeker, fields []Field) error {
+	if w.dbf != nil {
+		return errors.New("attempted to set fields after writing")
+	}
+	w.dbf = ws
+	w.dbfFields = fields
+
+	// the first byte of records is the deletion flag
+	w.dbfRecordLength = 1
+	for _, f := range fields {
+		w.dbfRecordLength += int16(f.Size)
+	}
+	// the header ends with a terminator
+	w.dbfHeaderLength = int16(len(fields)*32 + 33)
+
+	// the header is written on Close
+	if _, err := ws.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := binary.Write(ws, binary.LittleEndian, make([]byte, w.dbfHeaderLength)); err != nil {
+		return err
+	}
+	for n := int32(0); n < w.num; n++ {
+		if err := w.writeEmptyRecord(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteAttribute writes the value to the field of the record of the DBF file.
+// Supported values are strings, integers, float64 and bool.
+func (w *Writer) WriteAttribute(row int, field int, value any) error {
+	if w.dbf == nil {
+		return errors.New("initialize DBF by using SetFields first")
+	}
+	if field < 0 || field >= len(w.dbfFields) {
+		return fmt.Errorf("field %d is out of range", field)
+	}
+	if row < 0 || row >= int(w.num) {
+		return fmt.Errorf("row %d is out of range", row)
+	}
+
+	var buf []byte
+	switch v := value.(type) {
+	case int:
+		buf = []byte(strconv.Itoa(v))
+	case int32:
+		buf = []byte(strconv.FormatInt(int64(v), 10))
+	case int64:
+		buf = []byte(strconv.FormatInt(v, 10))
+	case float64:
+		precision := w.dbfFields[field].Precision
+		buf = []byte(strconv.FormatFloat(v, 'f', int(precision), 64))
+	case bool:
+		if v {
+			buf = []byte("T")
+		} else {
+			buf = []byte("F")
+		}
+	case string:
+		buf = []byte(v)
+	default:
+		return fmt.Errorf("unsupported value type: %T", v)
+	}
+
+	if sz := int(w.dbfFields[field].Size); len(buf) > sz {
+		return fmt.Errorf("unable to write field %d: %q exceeds field length %d", field, buf, sz)
+	}
+
+	seekTo := 1 + int64(w.dbfHeaderLength) + int64(row)*int64(w.dbfRecordLength)
+	for n := 0; n < field; n++ {
+		seekTo += int64(w.dbfFields[n].Size)
+	}
+	if _, err := w.dbf.Seek(seekTo, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := w.dbf.Write(buf)
+	return err
+}
+
 // Write shape to the writer.
 // Returns the index of the written object
 // which can be used in WriteAttribute.
@@ -75,14 +181,85 @@ func (w *Writer) Write(shape Shape) (int32, error) {
 	if err != nil {
 		return 0, err
 	}
+
+	if w.shx != nil {
+		// offset and content length in 16-bit words
+		err = binary.Write(w.shx, binary.BigEndian, []int32{int32((start - 8) / 2), length})
+		if err != nil {
+			return 0, err
+		}
+	}
+	if w.dbf != nil {
+		if err := w.writeEmptyRecord(); err != nil {
+			return 0, err
+		}
+	}
 	return w.num - 1, nil
 }
 
-// Close closes the writer.
+// Close writes headers of the files. The underlying writers are not closed.
 func (w *Writer) Close() error {
+	if w.shx != nil {
+		if err := w.writeHeader(w.shx); err != nil {
+			return err
+		}
+	}
+	if w.dbf != nil {
+		if err := w.writeDbfHeader(); err != nil {
+			return err
+		}
+	}
 	return w.writeHeader(w.shp)
 }
 
+// writeEmptyRecord appends a record whose fields are filled with spaces.
+func (w *Writer) writeEmptyRecord() error {
+	if _, err := w.dbf.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+	buf := make([]byte, w.dbfRecordLength)
+	for i := range buf {
+		buf[i] = ' '
+	}
+	_, err := w.dbf.Write(buf)
+	return err
+}
+
+func (w *Writer) writeDbfHeader() error {
+	if _, err := w.dbf.Seek(0, io.SeekEnd); err != nil {
+		return err
+	}
+	// end of file marker
+	if _, err := w.dbf.Write([]byte{0x1a}); err != nil {
+		return err
+	}
+	if _, err := w.dbf.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	// version and date of last update (years since 1900, month, day)
+	now := time.Now()
+	if err := binary.Write(w.dbf, binary.LittleEndian, []byte{3, byte(now.Year() - 1900), byte(now.Month()), byte(now.Day())}); err != nil {
+		return err
+	}
+	if err := binary.Write(w.dbf, binary.LittleEndian, w.num); err != nil {
+		return err
+	}
+	if err := binary.Write(w.dbf, binary.LittleEndian, []int16{w.dbfHeaderLength, w.dbfRecordLength}); err != nil {
+		return err
+	}
+	if err := binary.Write(w.dbf, binary.LittleEndian, make([]byte, 20)); err != nil {
+		return err
+	}
+	for _, f := range w.dbfFields {
+		if err := binary.Write(w.dbf, binary.LittleEndian, f); err != nil {
+			return err
+		}
+	}
+	// header terminator
+	_, err := w.dbf.Write([]byte{0x0d})
+	return err
+}
+
 // writeHeader writes SHP to ws.
 func (w *Writer) writeHeader(ws io.WriteSeeker) error {
 	filelength, _ := ws.Seek(0, io.SeekEnd)
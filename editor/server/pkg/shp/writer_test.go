@@ -151,3 +151,75 @@ func TestWriter(t *testing.T) {
 	}
 
 }
+
+func TestWriter_SetFields(t *testing.T) {
+	dir := t.TempDir()
+	shpFile, err := os.Create(dir + "/attr.shp")
+	assert.NoError(t, err)
+	shxFile, err := os.Create(dir + "/attr.shx")
+	assert.NoError(t, err)
+	dbfFile, err := os.Create(dir + "/attr.dbf")
+	assert.NoError(t, err)
+
+	w, err := CreateFrom(shpFile, POINT)
+	assert.NoError(t, err)
+	assert.NoError(t, w.SetIndex(shxFile))
+	assert.NoError(t, w.SetFields(dbfFile, []Field{
+		StringField("name", 10),
+		NumberField("count", 5),
+		FloatField("height", 10, 2),
+		LogicalField("visible"),
+	}))
+
+	_, err = w.Write(&Point{1, 2})
+	assert.NoError(t, err)
+	_, err = w.Write(&Point{3, 4})
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.WriteAttribute(0, 0, "東京"))
+	assert.NoError(t, w.WriteAttribute(0, 1, 10))
+	assert.NoError(t, w.WriteAttribute(0, 2, 1.5))
+	assert.NoError(t, w.WriteAttribute(0, 3, true))
+	assert.NoError(t, w.WriteAttribute(1, 0, "Osaka"))
+	assert.Error(t, w.WriteAttribute(1, 0, "too long value"))
+	assert.Error(t, w.WriteAttribute(2, 0, "x"))
+	assert.Error(t, w.WriteAttribute(0, 1, []int{}))
+	assert.NoError(t, w.Close())
+
+	for _, f := range []*os.File{shpFile, shxFile, dbfFile} {
+		assert.NoError(t, f.Close())
+	}
+
+	shx, err := os.ReadFile(dir + "/attr.shx")
+	assert.NoError(t, err)
+	assert.Equal(t, 100+2*8, len(shx))
+
+	shpR, err := os.Open(dir + "/attr.shp")
+	assert.NoError(t, err)
+	dbfR, err := os.Open(dir + "/attr.dbf")
+	assert.NoError(t, err)
+	r := SequentialReaderFromExt(shpR, dbfR)
+	defer func() {
+		_ = r.Close()
+	}()
+
+	assert.Equal(t, []string{"name", "count", "height", "visible"}, []string{
+		r.Fields()[0].String(), r.Fields()[1].String(), r.Fields()[2].String(), r.Fields()[3].String(),
+	})
+
+	var rows [][]string
+	for r.Next() {
+		_, s := r.Shape()
+		assert.IsType(t, &Point{}, s)
+		row := make([]string, 0, 4)
+		for i := range r.Fields() {
+			row = append(row, r.Attribute(i))
+		}
+		rows = append(rows, row)
+	}
+	assert.NoError(t, r.Err())
+	assert.Equal(t, [][]string{
+		{"東京", "10", "1.50", "T"},
+		{"Osaka", "", "", ""},
+	}, rows)
+}
@@ -3,11 +3,15 @@ package shp
 import (
 	"archive/zip"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 )
 
+// ErrTooLarge is returned when a file in the archive is larger than the limit.
+var ErrTooLarge = errors.New("file in archive is too large")
+
 // ZipReader provides an interface for reading Shapefiles that are compressed in a ZIP archive.
 type ZipReader struct {
 	sr SequentialReader
@@ -15,19 +19,39 @@ type ZipReader struct {
 }
 
 // openFromZIP is convenience function for opening the file called name that is
-// compressed in z for reading.
-func openFromZIP(z *zip.Reader, name string) (io.ReadCloser, error) {
+// compressed in z for reading. Files larger than limit are not opened unless limit is 0.
+func openFromZIP(z *zip.Reader, name string, limit int64) (io.ReadCloser, error) {
 	for _, f := range z.File {
 		if f.Name == name {
-			return f.Open()
-
+			if limit <= 0 {
+				return f.Open()
+			}
+			if f.UncompressedSize64 > uint64(limit) {
+				return nil, ErrTooLarge
+			}
+			r, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			return limitedReadCloser{Reader: io.LimitReader(r, limit), Closer: r}, nil
 		}
 	}
 	return nil, fmt.Errorf("No such file in archive: %s", name)
 }
 
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
 // ReadZipFrom read zip file from io.Reader, zip file must contain only one shape file
 func ReadZipFrom(r io.Reader) (*ZipReader, error) {
+	return ReadZipFromWithLimit(r, 0)
+}
+
+// ReadZipFromWithLimit is the same as ReadZipFrom, but ErrTooLarge is returned when the uncompressed
+// shape file or DBF file is larger than limit bytes. It protects from zip bombs. 0 means no limit.
+func ReadZipFromWithLimit(r io.Reader, limit int64) (*ZipReader, error) {
 	zipBytes, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
@@ -46,15 +70,18 @@ func ReadZipFrom(r io.Reader) (*ZipReader, error) {
 	if len(shapeFiles) > 1 {
 		return nil, fmt.Errorf("archive does contain multiple .shp files")
 	}
-	shp, err := openFromZIP(zr.z, shapeFiles[0].Name)
+	shp, err := openFromZIP(zr.z, shapeFiles[0].Name, limit)
 	if err != nil {
 		return nil, err
 	}
-	/* Note: not used
 	withoutExt := strings.TrimSuffix(shapeFiles[0].Name, ".shp")
-	// dbf is optional, so no error checking here
-	dbf, _ := openFromZIP(zr.z, withoutExt+".dbf")*/
-	zr.sr = SequentialReaderFromExt(shp /*, dbf*/)
+	// dbf is optional, so only the size limit is checked here
+	dbf, err := openFromZIP(zr.z, withoutExt+".dbf", limit)
+	if errors.Is(err, ErrTooLarge) {
+		_ = shp.Close()
+		return nil, err
+	}
+	zr.sr = SequentialReaderFromExt(shp, dbf)
 	return zr, nil
 }
 
@@ -88,7 +115,6 @@ func (zr *ZipReader) Shape() (int, Shape) {
 	return zr.sr.Shape()
 }
 
-/* Note: not used
 // Attribute returns the n-th field of the last row that was read. If there
 // were any errors before, the empty string is returned.
 func (zr *ZipReader) Attribute(n int) string {
@@ -99,7 +125,7 @@ func (zr *ZipReader) Attribute(n int) string {
 // DBF table.
 func (zr *ZipReader) Fields() []Field {
 	return zr.sr.Fields()
-}*/
+}
 
 // Err returns the last non-EOF error that was encountered by this ZipReader.
 func (zr *ZipReader) Err() error {
@@ -33,6 +33,63 @@ func TestReadZipFrom(t *testing.T) {
 	assert.Equal(t, 177, len(shps))
 }
 
+func TestReadZipFromWithLimit(t *testing.T) {
+	ior, err := os.Open("test_files/ne_110m_admin_0_countries.zip")
+	assert.Nil(t, err)
+	defer func() {
+		_ = ior.Close()
+	}()
+
+	_, err = ReadZipFromWithLimit(ior, 1024)
+	assert.ErrorIs(t, err, ErrTooLarge)
+
+	// only the DBF file is too large
+	_, err = ior.Seek(0, 0)
+	assert.Nil(t, err)
+	_, err = ReadZipFromWithLimit(ior, 200*1024)
+	assert.ErrorIs(t, err, ErrTooLarge)
+
+	_, err = ior.Seek(0, 0)
+	assert.Nil(t, err)
+	zr, err := ReadZipFromWithLimit(ior, 10*1024*1024)
+	assert.Nil(t, err)
+	defer func() {
+		_ = zr.Close()
+	}()
+
+	n := 0
+	for zr.Next() {
+		n++
+	}
+	assert.Nil(t, zr.Err())
+	assert.Equal(t, 177, n)
+}
+
+func TestZipReader_Attribute(t *testing.T) {
+	ior, err := os.Open("test_files/ne_110m_admin_0_countries.zip")
+	assert.Nil(t, err)
+	defer func() {
+		_ = ior.Close()
+	}()
+
+	zr, err := ReadZipFrom(ior)
+	assert.Nil(t, err)
+	defer func() {
+		_ = zr.Close()
+	}()
+
+	fields := zr.Fields()
+	assert.Equal(t, 94, len(fields))
+	assert.Equal(t, "SOVEREIGNT", fields[3].String())
+	assert.Equal(t, byte('C'), fields[3].Fieldtype)
+
+	assert.True(t, zr.Next())
+	assert.Equal(t, "Fiji", zr.Attribute(3))
+	assert.Equal(t, "1", zr.Attribute(1))
+	assert.Equal(t, "", zr.Attribute(len(fields)))
+	assert.Equal(t, len(fields), len(Attributes(zr.sr)))
+}
+
 func TestReadZipFromWrongScenarios(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -84,3 +84,17 @@ func (l *PageList) Properties() id.PropertyIDList {
 	}
 	return ids
 }
+
+func (l *PageList) Clone() *PageList {
+	if l == nil {
+		return nil
+	}
+	var pages []*Page
+	if l.pages != nil {
+		pages = make([]*Page, 0, len(l.pages))
+		for _, p := range l.pages {
+			pages = append(pages, p.Clone())
+		}
+	}
+	return &PageList{pages: pages}
+}
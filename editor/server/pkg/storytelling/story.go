@@ -23,6 +23,7 @@ type Story struct {
 	property      id.PropertyID
 	scene         id.SceneID
 	title         string
+	index         int
 	pages         *PageList
 	panelPosition Position
 	bgColor       string
@@ -66,6 +67,15 @@ func (s *Story) Title() string {
 	return s.title
 }
 
+// Index is the position of the story among stories of the scene.
+func (s *Story) Index() int {
+	return s.index
+}
+
+func (s *Story) SetIndex(index int) {
+	s.index = index
+}
+
 func (s *Story) Alias() string {
 	return s.alias
 }
@@ -259,3 +269,13 @@ func (s *Story) MatchWithPublicName(name string) bool {
 func CheckAliasPattern(alias string) bool {
 	return alias != "" && aliasRegexp.Match([]byte(alias))
 }
+
+func (s *Story) Clone() *Story {
+	if s == nil {
+		return nil
+	}
+	s2 := *s
+	s2.pages = s.pages.Clone()
+	s2.publishedAt = util.CloneRef(s.publishedAt)
+	return &s2
+}
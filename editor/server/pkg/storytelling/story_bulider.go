@@ -56,6 +56,11 @@ func (b *StoryBuilder) Scene(scene id.SceneID) *StoryBuilder {
 	return b
 }
 
+func (b *StoryBuilder) Index(index int) *StoryBuilder {
+	b.s.index = index
+	return b
+}
+
 func (b *StoryBuilder) Pages(pages *PageList) *StoryBuilder {
 	b.s.pages = pages
 	return b
@@ -1,3 +1,99 @@
 package storytelling
 
+import (
+	"sort"
+
+	"github.com/reearth/reearth/server/pkg/id"
+)
+
 type StoryList []*Story
+
+// Sorted returns stories in the order of their indexes. Stories with the same index, such as ones saved before stories were ordered, are ordered by their creation.
+func (l StoryList) Sorted() StoryList {
+	res := make(StoryList, 0, len(l))
+	for _, s := range l {
+		if s != nil {
+			res = append(res, s)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Index() != res[j].Index() {
+			return res[i].Index() < res[j].Index()
+		}
+		return res[i].Id().Compare(res[j].Id()) < 0
+	})
+	return res
+}
+
+func (l StoryList) Story(sid id.StoryID) *Story {
+	if i := l.IndexOf(sid); i >= 0 {
+		return l[i]
+	}
+	return nil
+}
+
+func (l StoryList) IndexOf(sid id.StoryID) int {
+	for i, s := range l {
+		if s != nil && s.Id() == sid {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddAt inserts the story at the index, or appends it when the index is nil or out of range. Indexes of the stories are updated.
+func (l StoryList) AddAt(s *Story, index *int) StoryList {
+	res := l.Sorted()
+	if s == nil {
+		return res
+	}
+	if index == nil || *index < 0 || len(res) <= *index {
+		res = append(res, s)
+	} else {
+		res = append(res[:*index], append(StoryList{s}, res[*index:]...)...)
+	}
+	res.reindex()
+	return res
+}
+
+// Move moves the story to the index. The story is moved to the end when the index is out of range. Indexes of the stories are updated.
+func (l StoryList) Move(sid id.StoryID, index int) StoryList {
+	res := l.Sorted()
+	i := res.IndexOf(sid)
+	if i < 0 {
+		return res
+	}
+	s := res[i]
+	res = append(res[:i], res[i+1:]...)
+	if index < 0 || len(res) < index {
+		index = len(res)
+	}
+	return res.AddAt(s, &index)
+}
+
+// Remove removes the story. Indexes of the rest of the stories are updated.
+func (l StoryList) Remove(sid id.StoryID) StoryList {
+	res := l.Sorted()
+	if i := res.IndexOf(sid); i >= 0 {
+		res = append(res[:i], res[i+1:]...)
+	}
+	res.reindex()
+	return res
+}
+
+// Public returns stories published as public in order. Limited stories are excluded as they are protected with basic auth.
+func (l StoryList) Public() StoryList {
+	res := StoryList{}
+	for _, s := range l.Sorted() {
+		if s.Status() == PublishmentStatusPublic {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func (l StoryList) reindex() {
+	for i, s := range l {
+		s.SetIndex(i)
+	}
+}
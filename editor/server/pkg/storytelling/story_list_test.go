@@ -0,0 +1,56 @@
+package storytelling
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth/server/pkg/id"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoryList(t *testing.T) {
+	s1 := &Story{id: id.NewStoryID(), status: PublishmentStatusPublic}
+	s2 := &Story{id: id.NewStoryID(), status: PublishmentStatusPrivate}
+	s3 := &Story{id: id.NewStoryID(), status: PublishmentStatusLimited}
+	ids := func(l StoryList) []id.StoryID {
+		return lo.Map(l, func(s *Story, _ int) id.StoryID { return s.Id() })
+	}
+	indexes := func(l StoryList) []int {
+		return lo.Map(l, func(s *Story, _ int) int { return s.Index() })
+	}
+
+	var l StoryList
+	assert.Nil(t, l.Story(s1.Id()))
+	assert.Equal(t, -1, l.IndexOf(s1.Id()))
+	assert.NotPanics(t, func() {
+		l.AddAt(nil, nil)
+		l.Remove(s1.Id())
+		l.Move(s1.Id(), 0)
+	})
+
+	// stories with the same index are ordered by their creation
+	l = StoryList{s3, nil, s1, s2}
+	assert.Equal(t, []id.StoryID{s1.Id(), s2.Id(), s3.Id()}, ids(l.Sorted()))
+
+	l = StoryList{}.AddAt(s1, nil).AddAt(s2, lo.ToPtr(0)).AddAt(s3, lo.ToPtr(1))
+	assert.Equal(t, []id.StoryID{s2.Id(), s3.Id(), s1.Id()}, ids(l))
+	assert.Equal(t, []int{0, 1, 2}, indexes(l))
+	assert.Equal(t, s3, l.Story(s3.Id()))
+	assert.Equal(t, 1, l.IndexOf(s3.Id()))
+
+	l = l.Move(s2.Id(), 2)
+	assert.Equal(t, []id.StoryID{s3.Id(), s1.Id(), s2.Id()}, ids(l))
+	assert.Equal(t, []int{0, 1, 2}, indexes(l))
+
+	l = l.Move(s2.Id(), 0)
+	assert.Equal(t, []id.StoryID{s2.Id(), s3.Id(), s1.Id()}, ids(l))
+
+	l = l.Move(s2.Id(), 10)
+	assert.Equal(t, []id.StoryID{s3.Id(), s1.Id(), s2.Id()}, ids(l))
+
+	assert.Equal(t, []id.StoryID{s1.Id()}, ids(l.Public()))
+
+	l = l.Remove(s3.Id())
+	assert.Equal(t, []id.StoryID{s1.Id(), s2.Id()}, ids(l))
+	assert.Equal(t, []int{0, 1}, indexes(l))
+}
@@ -3,9 +3,11 @@ package citygml
 import (
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/eukarya-inc/reearth-plateauview/server/geo/spatialid"
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearthx/log"
 	"github.com/samber/lo"
@@ -19,6 +21,17 @@ type Config struct {
 	WorkerProject      string `json:"workerProject"`
 	DataCatalogAPIURL  string `json:"dataCatalogApiUrl"`
 	PackerTimeout      uint   `json:"packerTimeout"`
+	// GeoidModels maps names of additional geoid models to paths of their grid files.
+	GeoidModels map[string]string `json:"geoidModels"`
+	// LocalWorker runs the packer worker on this machine instead of Cloud Build.
+	LocalWorker       bool   `json:"localWorker"`
+	LocalWorkerBinary string `json:"localWorkerBinary"`
+	LocalWorkerLogDir string `json:"localWorkerLogDir"`
+	LocalWorkerWait   bool   `json:"localWorkerWait"`
+	// PackerStorageDir stores pack files in the local directory instead of the bucket.
+	PackerStorageDir string `json:"packerStorageDir"`
+	// PackerPrefix is the prefix of pack files in the bucket. They are stored at the root of the bucket if empty.
+	PackerPrefix string `json:"packerPrefix"`
 }
 
 var httpClient = &http.Client{
@@ -26,7 +39,16 @@ var httpClient = &http.Client{
 }
 
 func Echo(conf Config, g *echo.Group) error {
-	p := newPacker(conf)
+	for name, path := range conf.GeoidModels {
+		if err := LoadGeoidModel(name, path); err != nil {
+			return err
+		}
+	}
+
+	p, err := newPacker(conf)
+	if err != nil {
+		return err
+	}
 	dc := NewDataCatalogAPI(httpClient, conf.DataCatalogAPIURL)
 
 	// すでに存在したらダウンロードできるエンドポイント
@@ -51,12 +73,30 @@ func Echo(conf Config, g *echo.Group) error {
 	// id を返す
 	g.POST("/pack", p.handlePackRequest)
 
+	// ジョブ一覧を返す。status で絞り込みできる
+	g.GET("/pack", p.handleListJobs)
+
+	// 受付済み・処理中のジョブをキャンセルする
+	g.DELETE("/pack/:id", func(c echo.Context) error {
+		return p.handleCancel(c, c.Param("id"))
+	})
+
+	// 失敗・キャンセルしたジョブを同じ内容で再実行する
+	g.POST("/pack/:id/retry", func(c echo.Context) error {
+		return p.handleRetry(c, c.Param("id"))
+	})
+
 	g.GET("/attributes", attributeHandler(p.conf.Domain))
 	g.GET("/features", featureHandler(p.conf.Domain))
 	g.GET("/spatialid_attributes", spatialIDAttributesHandler(dc))
+	g.GET("/spatialid", spatialIDHandler(p.conf.Domain))
 
 	// ジオイド高取得API
 	g.GET("/geoid_height", GeoidHanlder)
+	g.POST("/geoid_height", GeoidBatchHandler)
+
+	// 地域メッシュコード変換API
+	g.GET("/meshcode", MeshCodeHandler)
 
 	return nil
 }
@@ -210,6 +250,83 @@ func spatialIDAttributesHandler(dc *dataCatalogAPI) echo.HandlerFunc {
 	}
 }
 
+func spatialIDHandler(domain string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		citygmlURL := c.QueryParam("url")
+		u, err := url.Parse(citygmlURL)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"url":   citygmlURL,
+				"error": "invalid url",
+			})
+		}
+
+		if domain != "" && u.Host != domain {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"url":   citygmlURL,
+				"error": "invalid domain",
+			})
+		}
+
+		ids := strings.Split(c.QueryParam("id"), ",")
+		if len(ids) == 0 || (len(ids) == 1 && ids[0] == "") {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"error": "id parameter is required",
+			})
+		}
+
+		z, err := strconv.Atoi(c.QueryParam("zoom"))
+		if err != nil || z < 0 || z > spatialid.MaxZoom {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"error": "invalid zoom",
+			})
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, citygmlURL, nil)
+		if err != nil {
+			log.Errorfc(ctx, "citygml: failed to create request: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]any{
+				"url":   citygmlURL,
+				"error": "internal",
+			})
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			log.Errorfc(c.Request().Context(), "citygml: failed to fetch: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]any{
+				"url":   citygmlURL,
+				"error": "cannot fetch",
+			})
+		}
+
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"url":   citygmlURL,
+				"error": "cannot fetch",
+			})
+		}
+
+		features, err := SpatialIDs(resp.Body, ids, z)
+		if err != nil {
+			log.Errorfc(ctx, "citygml: failed to compute spatial IDs: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]any{
+				"url":   citygmlURL,
+				"error": "internal",
+			})
+		}
+		if features == nil {
+			features = []FeatureSpatialIDs{}
+		}
+
+		return c.JSON(http.StatusOK, map[string]any{
+			"features": features,
+		})
+	}
+}
+
 func featureHandler(domain string) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
@@ -235,6 +352,26 @@ func featureHandler(domain string) echo.HandlerFunc {
 				"error": "sid parameter is required",
 			})
 		}
+
+		// format=geojson returns a GeoJSON FeatureCollection instead of gml:ids
+		format := c.QueryParam("format")
+		if format != "" && format != "geojson" {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"error": "invalid format",
+			})
+		}
+		geometry := c.QueryParam("geometry")
+		if geometry != "" && !IsValidFeatureGeometry(geometry) {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"error": "invalid geometry",
+			})
+		}
+		if _, err := newLOD1SolidFilter(ids); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"error": "invalid sid",
+			})
+		}
+
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, citygmlURL, nil)
 		if err != nil {
 			log.Errorfc(ctx, "citygml: failed to create request: %v", err)
@@ -261,6 +398,24 @@ func featureHandler(domain string) echo.HandlerFunc {
 			})
 		}
 
+		if format == "geojson" {
+			var resolver CodeResolver
+			if c.QueryParam("skip_code_list_fetch") == "" {
+				resolver = &fetchCodeResolver{
+					client: httpClient,
+					url:    citygmlURL,
+				}
+			}
+
+			c.Response().Header().Set(echo.HeaderContentType, "application/geo+json")
+			c.Response().WriteHeader(http.StatusOK)
+			if err := FeaturesGeoJSON(c.Response(), resp.Body, ids, geometry, resolver); err != nil {
+				// the status code has been already sent
+				log.Errorfc(ctx, "citygml: failed to write features as geojson: %v", err)
+			}
+			return nil
+		}
+
 		features, err := Features(resp.Body, ids)
 		if err != nil {
 			log.Errorfc(ctx, "citygml: failed to get features: %v", err)
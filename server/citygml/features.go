@@ -15,13 +15,9 @@ import (
 )
 
 func Features(r io.Reader, spatialIDs []string) ([]string, error) {
-	var filter lod1SolidFilter
-	for _, sid := range spatialIDs {
-		v, err := spatialid.Parse(sid)
-		if err != nil {
-			return nil, fmt.Errorf("invalid spatialID: %w", err)
-		}
-		filter.Bounds = append(filter.Bounds, v.Bounds())
+	filter, err := newLOD1SolidFilter(spatialIDs)
+	if err != nil {
+		return nil, err
 	}
 	dec := xmlb.NewDecoder(r, make([]byte, 32*1024))
 	fs := &featureScanner{
@@ -52,6 +48,17 @@ type lod1SolidFilter struct {
 	Bounds []geo.Bounds3
 }
 
+func newLOD1SolidFilter(spatialIDs []string) (filter lod1SolidFilter, _ error) {
+	for _, sid := range spatialIDs {
+		v, err := spatialid.Parse(sid)
+		if err != nil {
+			return filter, fmt.Errorf("invalid spatialID: %w", err)
+		}
+		filter.Bounds = append(filter.Bounds, v.Bounds())
+	}
+	return filter, nil
+}
+
 func (f *lod1SolidFilter) IsIntersect(faces []geo.Polygon3) bool {
 	if len(faces) == 0 {
 		return false
@@ -84,36 +91,9 @@ type lod1SolidHandler struct {
 
 func (h *lod1SolidHandler) HandleAttr(dec *xmlb.Decoder, el xml.StartElement) error {
 	if el.Name.Local == "lod1Solid" {
-		if h.points != nil {
-			h.points = h.points[:0]
-		}
-		if h.faces != nil {
-			h.faces = h.faces[:0]
-		}
-		s := &tagScanner{
-			dec: dec,
-			tag: "gml:posList",
-		}
-		for s.Scan() {
-			tok, err := dec.Peek()
-			if err != nil {
-				return err
-			}
-			if tok.Type() != xmlb.CharData {
-				return fmt.Errorf("invalid posList")
-			}
-			cd, err := tok.CharData()
-			if err != nil {
-				return err
-			}
-			begin := len(h.points)
-			h.points, err = parsePosList(h.points, unsafe.String(&cd[0], len(cd)))
-			if err != nil {
-				return fmt.Errorf("parse posList: %w", err)
-			}
-			h.faces = append(h.faces, h.points[begin:])
-		}
-		if err := s.Err(); err != nil {
+		var err error
+		h.points, h.faces, err = readLOD1Solid(dec, h.points[:0], h.faces[:0])
+		if err != nil {
 			return err
 		}
 		if !h.Filter.IsIntersect(h.faces) {
@@ -125,6 +105,37 @@ func (h *lod1SolidHandler) HandleAttr(dec *xmlb.Decoder, el xml.StartElement) er
 	}
 }
 
+// readLOD1Solid reads all faces of the lod1Solid element and appends them to faces, reusing points as the buffer.
+func readLOD1Solid(dec *xmlb.Decoder, points []geo.Point3, faces []geo.Polygon3) ([]geo.Point3, []geo.Polygon3, error) {
+	s := &tagScanner{
+		dec: dec,
+		tag: "gml:posList",
+	}
+	for s.Scan() {
+		tok, err := dec.Peek()
+		if err != nil {
+			return nil, nil, err
+		}
+		if tok.Type() != xmlb.CharData {
+			return nil, nil, fmt.Errorf("invalid posList")
+		}
+		cd, err := tok.CharData()
+		if err != nil {
+			return nil, nil, err
+		}
+		begin := len(points)
+		points, err = parsePosList(points, unsafe.String(&cd[0], len(cd)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("parse posList: %w", err)
+		}
+		faces = append(faces, points[begin:])
+	}
+	if err := s.Err(); err != nil {
+		return nil, nil, err
+	}
+	return points, faces, nil
+}
+
 func parsePosList(dest []geo.Point3, t string) ([]geo.Point3, error) {
 	n := strings.Count(t, " ") + 1
 	if n%3 != 0 {
@@ -0,0 +1,138 @@
+package citygml
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+	"github.com/orisano/gosax/xmlb"
+	geojson "github.com/paulmach/go.geojson"
+)
+
+const (
+	// FeatureGeometryFootprint is a 2D polygon of the bottom face of the LOD1 solid.
+	FeatureGeometryFootprint = "footprint"
+	// FeatureGeometryLOD1 is a 3D multi polygon consisting of all faces of the LOD1 solid.
+	FeatureGeometryLOD1 = "lod1"
+)
+
+func IsValidFeatureGeometry(g string) bool {
+	return g == FeatureGeometryFootprint || g == FeatureGeometryLOD1
+}
+
+// FeaturesGeoJSON writes features whose LOD1 solid intersects the spatial IDs to w as a GeoJSON FeatureCollection.
+// Each feature has the attributes extracted by Attributes as its properties.
+// Unlike Features, features that do not have a LOD1 solid are not written because they have no geometry.
+func FeaturesGeoJSON(w io.Writer, r io.Reader, spatialIDs []string, geometry string, resolver CodeResolver) error {
+	if geometry == "" {
+		geometry = FeatureGeometryFootprint
+	}
+	if !IsValidFeatureGeometry(geometry) {
+		return fmt.Errorf("invalid geometry: %s", geometry)
+	}
+
+	filter, err := newLOD1SolidFilter(spatialIDs)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(`{"type":"FeatureCollection","features":[`); err != nil {
+		return err
+	}
+
+	dec := xmlb.NewDecoder(r, make([]byte, 32*1024))
+	fs := &featureScanner{
+		Dec: dec,
+	}
+	thCache := map[string]tagHandler{}
+	count := 0
+	for fs.Scan() {
+		id, el := fs.Feature()
+		tag := tagName(el.Name)
+		if _, ok := thCache[tag]; !ok {
+			thCache[tag] = toTagHandler(tag, schemaDefs, resolver)
+		}
+		fah, err := newFeatureAttributeHandler(fs.ns, id, tag, thCache[tag])
+		if err != nil {
+			return err
+		}
+		h := lod1SolidHandler{
+			Next:   fah,
+			Filter: filter,
+		}
+		ok, err := processFeature(dec, &h)
+		if err != nil {
+			return err
+		}
+		if !ok || len(h.faces) == 0 {
+			continue
+		}
+
+		f := featureGeoJSON(id, h.faces, geometry, fah.Val)
+		b, err := json.Marshal(f)
+		if err != nil {
+			return fmt.Errorf("failed to marshal feature %s: %w", id, err)
+		}
+		if count > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err := bw.Write(b); err != nil {
+			return err
+		}
+		count++
+	}
+	if err := fs.Err(); err != nil {
+		return err
+	}
+
+	if _, err := bw.WriteString("]}"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func featureGeoJSON(id string, faces []geo.Polygon3, geometry string, properties map[string]any) *geojson.Feature {
+	var f *geojson.Feature
+	if geometry == FeatureGeometryLOD1 {
+		polygons := make([][][][]float64, 0, len(faces))
+		for _, face := range faces {
+			polygons = append(polygons, [][][]float64{ring3(face)})
+		}
+		f = geojson.NewMultiPolygonFeature(polygons...)
+	} else {
+		so := geo.ReconstructLOD1Solid(geo.Polyhedron(faces))
+		f = geojson.NewPolygonFeature([][][]float64{ring2(so.Bottom)})
+	}
+	f.ID = id
+	f.Properties = properties
+	return f
+}
+
+// ring2 returns a closed linear ring in GeoJSON coordinates.
+func ring2(po geo.Polygon2) [][]float64 {
+	res := make([][]float64, 0, len(po)+1)
+	for _, p := range po {
+		res = append(res, []float64{p.X, p.Y})
+	}
+	if len(po) > 0 {
+		res = append(res, []float64{po[0].X, po[0].Y})
+	}
+	return res
+}
+
+// ring3 returns a closed linear ring with heights in GeoJSON coordinates.
+func ring3(po geo.Polygon3) [][]float64 {
+	res := make([][]float64, 0, len(po)+1)
+	for _, p := range po {
+		res = append(res, []float64{p.X, p.Y, p.Z})
+	}
+	if len(po) > 0 {
+		res = append(res, []float64{po[0].X, po[0].Y, po[0].Z})
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package citygml
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/jarcoal/httpmock"
 	"github.com/labstack/echo/v4"
+	geojson "github.com/paulmach/go.geojson"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -112,3 +114,100 @@ func TestFeaturesHandler(t *testing.T) {
 	}
 	assert.Equal(t, expected, j)
 }
+
+func TestFeaturesGeoJSON(t *testing.T) {
+	b, err := os.ReadFile("testdata/" + testdata)
+	require.NoError(t, err)
+
+	t.Run("footprint", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := FeaturesGeoJSON(buf, bytes.NewReader(b), []string{"/18/0/231815/103921"}, "", nil)
+		require.NoError(t, err)
+
+		fc, err := geojson.UnmarshalFeatureCollection(buf.Bytes())
+		require.NoError(t, err)
+		require.Len(t, fc.Features, 2)
+		assert.Equal(t, "bldg_53e2a9a9-d512-408f-8250-eae30b7523d6", fc.Features[0].ID)
+		assert.Equal(t, "bldg_2eb12f7a-c5d9-4145-9609-a6a0f5824368", fc.Features[1].ID)
+
+		f := fc.Features[0]
+		assert.Equal(t, geojson.GeometryPolygon, f.Geometry.Type)
+		ring := f.Geometry.Polygon[0]
+		assert.Equal(t, ring[0], ring[len(ring)-1])
+		assert.Len(t, ring[0], 2)
+		assert.InDelta(t, 138.3, ring[0][0], 0.5) // lng
+		assert.InDelta(t, 34.9, ring[0][1], 0.5)  // lat
+		assert.Equal(t, "bldg_53e2a9a9-d512-408f-8250-eae30b7523d6", f.Properties["gml:id"])
+		assert.Equal(t, "bldg:Building", f.Properties["feature_type"])
+	})
+
+	t.Run("lod1", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := FeaturesGeoJSON(buf, bytes.NewReader(b), []string{"/18/0/231815/103921"}, FeatureGeometryLOD1, nil)
+		require.NoError(t, err)
+
+		fc, err := geojson.UnmarshalFeatureCollection(buf.Bytes())
+		require.NoError(t, err)
+		require.Len(t, fc.Features, 2)
+		f := fc.Features[0]
+		assert.Equal(t, geojson.GeometryMultiPolygon, f.Geometry.Type)
+		assert.Greater(t, len(f.Geometry.MultiPolygon), 4)
+		assert.Len(t, f.Geometry.MultiPolygon[0][0][0], 3)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := FeaturesGeoJSON(buf, bytes.NewReader(b), []string{"/1/0/0/0"}, "", nil)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"FeatureCollection","features":[]}`, buf.String())
+	})
+
+	t.Run("invalid geometry", func(t *testing.T) {
+		err := FeaturesGeoJSON(io.Discard, bytes.NewReader(b), []string{"/1/0/0/0"}, "lod2", nil)
+		require.Error(t, err)
+	})
+}
+
+func TestFeaturesHandler_GeoJSON(t *testing.T) {
+	citygmlURL := "http://example.com/udx/bldg/52382287_bldg_6697_psc_op.gml"
+	citygml, err := os.ReadFile("testdata/" + testdata)
+	require.NoError(t, err)
+	httpmock.RegisterResponder(http.MethodGet, citygmlURL, httpmock.NewBytesResponder(http.StatusOK, citygml))
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	u := &url.URL{Path: "/features"}
+	q := url.Values{}
+	q.Set("url", citygmlURL)
+	q.Set("sid", "/18/0/231813/103922")
+	q.Set("format", "geojson")
+	q.Set("skip_code_list_fetch", "true")
+	u.RawQuery = q.Encode()
+
+	req := httptest.NewRequest(http.MethodGet, u.String(), nil)
+	rec := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	assert.NoError(t, featureHandler("")(c))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/geo+json", rec.Header().Get(echo.HeaderContentType))
+
+	fc, err := geojson.UnmarshalFeatureCollection(rec.Body.Bytes())
+	require.NoError(t, err)
+	ids := make([]any, 0, len(fc.Features))
+	for _, f := range fc.Features {
+		ids = append(ids, f.ID)
+	}
+	assert.Equal(t, []any{
+		"bldg_c77c3e2b-ffdc-4b1a-91bf-185a1b46a4d1",
+		"bldg_4ee4bc68-d60e-494f-8d4f-57c68f0cb312",
+	}, ids)
+
+	q.Set("geometry", "lod2")
+	u.RawQuery = q.Encode()
+	rec = httptest.NewRecorder()
+	c = e.NewContext(httptest.NewRequest(http.MethodGet, u.String(), nil), rec)
+	assert.NoError(t, featureHandler("")(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
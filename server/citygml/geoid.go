@@ -1,8 +1,16 @@
 package citygml
 
 import (
+	"compress/gzip"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
 
 	japangeoid "github.com/eukarya-inc/japan-geoid-go"
 	"github.com/eukarya-inc/japan-geoid-go/gsigeoid2011"
@@ -10,10 +18,81 @@ import (
 	"github.com/samber/lo"
 )
 
+const (
+	// GeoidModelGSIGEO2011 is the Japanese geoid model 2011 (Ver.2.2) embedded in the binary.
+	GeoidModelGSIGEO2011 = "gsigeo2011"
+	defaultGeoidModel    = GeoidModelGSIGEO2011
+
+	geoidTargetOrthometric = "orthometric"
+	geoidTargetEllipsoidal = "ellipsoidal"
+
+	maxGeoidBatchPoints = 100_000
+)
+
 var geoid *japangeoid.MemoryGrid
 
+// geoidModels are registered only on startup, so they are not guarded.
+var geoidModels = map[string]japangeoid.Grid{}
+
 func init() {
 	geoid = lo.Must(gsigeoid2011.Load())
+	geoidModels[GeoidModelGSIGEO2011] = geoid
+}
+
+// LoadGeoidModel loads a geoid grid file and registers it as the model name.
+// The file is a GSI ASCII grid (.asc) or the binary format of japan-geoid-go (.bin), optionally gzipped (.gz).
+func LoadGeoidModel(name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open geoid model %s: %w", name, err)
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	p := path
+	if strings.HasSuffix(p, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("failed to open geoid model %s: %w", name, err)
+		}
+		defer gr.Close()
+		r = gr
+		p = strings.TrimSuffix(p, ".gz")
+	}
+
+	var g *japangeoid.MemoryGrid
+	switch {
+	case strings.HasSuffix(p, ".asc"):
+		g, err = japangeoid.FromAsc(r)
+	case strings.HasSuffix(p, ".bin"):
+		g, err = japangeoid.FromBinary(r)
+	default:
+		return fmt.Errorf("unsupported geoid model file: %s", path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load geoid model %s: %w", name, err)
+	}
+
+	geoidModels[name] = g
+	return nil
+}
+
+// GeoidModels returns the names of the available geoid models.
+func GeoidModels() []string {
+	names := make([]string, 0, len(geoidModels))
+	for n := range geoidModels {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func geoidModel(name string) (japangeoid.Grid, string, bool) {
+	if name == "" {
+		name = defaultGeoidModel
+	}
+	g, ok := geoidModels[name]
+	return g, name, ok
 }
 
 func GeoidHanlder(c echo.Context) error {
@@ -33,7 +112,12 @@ func GeoidHanlder(c echo.Context) error {
 		return c.JSON(400, map[string]any{"error": "lng must be a float"})
 	}
 
-	height := geoid.GetHeight(lngFloat, latFloat)
+	g, _, ok := geoidModel(c.QueryParam("model"))
+	if !ok {
+		return c.JSON(400, map[string]any{"error": "unknown model"})
+	}
+
+	height := g.GetHeight(lngFloat, latFloat)
 
 	return c.JSON(200, map[string]any{
 		"lat":          latFloat,
@@ -43,3 +127,160 @@ func GeoidHanlder(c echo.Context) error {
 		"geoid": fmt.Sprintf("%.3f", height),
 	})
 }
+
+type geoidBatchRequest struct {
+	// Model is the name of the geoid model. The default is gsigeo2011.
+	Model string `json:"model"`
+	// To is the height system to convert heights into: orthometric or ellipsoidal.
+	// It is required when positions have heights.
+	To string `json:"to"`
+	// Coordinates is a list of [lng, lat] or [lng, lat, height].
+	Coordinates [][]float64 `json:"coordinates"`
+	// GeoJSON is a GeoJSON object whose positions with heights are converted.
+	GeoJSON map[string]any `json:"geojson"`
+}
+
+type geoidBatchPoint struct {
+	Lng         float64  `json:"lng"`
+	Lat         float64  `json:"lat"`
+	GeoidHeight *float64 `json:"geoid_height"`
+	Height      *float64 `json:"height,omitempty"`
+}
+
+// GeoidBatchHandler returns geoid heights of many points at once and converts their heights
+// between orthometric heights and ellipsoidal heights.
+// Points outside of the geoid model have null geoid heights and their heights are not converted.
+func GeoidBatchHandler(c echo.Context) error {
+	var req geoidBatchRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "invalid body"})
+	}
+
+	g, model, ok := geoidModel(req.Model)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "unknown model"})
+	}
+	if req.To != "" && req.To != geoidTargetOrthometric && req.To != geoidTargetEllipsoidal {
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "to must be orthometric or ellipsoidal"})
+	}
+	if (req.Coordinates == nil) == (req.GeoJSON == nil) {
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "either coordinates or geojson is required"})
+	}
+
+	if req.GeoJSON != nil {
+		if req.To == "" {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "to is required"})
+		}
+		if n := countGeoJSONPositions(req.GeoJSON); n > maxGeoidBatchPoints {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "too many points"})
+		}
+		if err := convertGeoJSONHeights(req.GeoJSON, g, req.To); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": err.Error()})
+		}
+		return c.JSON(http.StatusOK, map[string]any{
+			"model":   model,
+			"geojson": req.GeoJSON,
+		})
+	}
+
+	if len(req.Coordinates) > maxGeoidBatchPoints {
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "too many points"})
+	}
+
+	points := make([]geoidBatchPoint, 0, len(req.Coordinates))
+	for i, co := range req.Coordinates {
+		if len(co) != 2 && len(co) != 3 {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": fmt.Sprintf("invalid coordinate at %d", i)})
+		}
+		if len(co) == 3 && req.To == "" {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "to is required"})
+		}
+
+		p := geoidBatchPoint{Lng: co[0], Lat: co[1]}
+		if gh := g.GetHeight(co[0], co[1]); !math.IsNaN(gh) {
+			p.GeoidHeight = &gh
+			if len(co) == 3 {
+				h := convertHeight(co[2], gh, req.To)
+				p.Height = &h
+			}
+		}
+		points = append(points, p)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"model":  model,
+		"points": points,
+	})
+}
+
+func convertHeight(h, geoidHeight float64, to string) float64 {
+	if to == geoidTargetEllipsoidal {
+		return h + geoidHeight
+	}
+	return h - geoidHeight
+}
+
+// convertGeoJSONHeights converts heights of all positions in the GeoJSON object in place.
+// Positions without heights are left as is.
+func convertGeoJSONHeights(obj map[string]any, g japangeoid.Grid, to string) error {
+	var err error
+	walkGeoJSONPositions(obj, func(p []any) {
+		if err != nil || len(p) < 3 {
+			return
+		}
+		lng, ok1 := p[0].(float64)
+		lat, ok2 := p[1].(float64)
+		h, ok3 := p[2].(float64)
+		if !ok1 || !ok2 || !ok3 {
+			err = fmt.Errorf("invalid position: %v", p)
+			return
+		}
+		gh := g.GetHeight(lng, lat)
+		if math.IsNaN(gh) {
+			err = fmt.Errorf("position out of the geoid model: %v", p)
+			return
+		}
+		p[2] = convertHeight(h, gh, to)
+	})
+	return err
+}
+
+func countGeoJSONPositions(obj map[string]any) int {
+	n := 0
+	walkGeoJSONPositions(obj, func([]any) { n++ })
+	return n
+}
+
+// walkGeoJSONPositions calls f with every position in coordinates of geometries, geometry collections, features and feature collections.
+func walkGeoJSONPositions(obj map[string]any, f func([]any)) {
+	if co, ok := obj["coordinates"].([]any); ok {
+		walkPositions(co, f)
+	}
+	if g, ok := obj["geometry"].(map[string]any); ok {
+		walkGeoJSONPositions(g, f)
+	}
+	for _, k := range []string{"geometries", "features"} {
+		if children, ok := obj[k].([]any); ok {
+			for _, c := range children {
+				if c, ok := c.(map[string]any); ok {
+					walkGeoJSONPositions(c, f)
+				}
+			}
+		}
+	}
+}
+
+func walkPositions(co []any, f func([]any)) {
+	if len(co) == 0 {
+		return
+	}
+	if _, ok := co[0].(float64); ok {
+		f(co)
+		return
+	}
+	for _, c := range co {
+		if c, ok := c.([]any); ok {
+			walkPositions(c, f)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package citygml
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoidBatchHandler(t *testing.T) {
+	// 39.473871 is the geoid height at this point in gsigeo2011
+	const lng, lat, gh = 138.2839817085188, 37.12378643088312, 39.473871
+
+	post := func(t *testing.T, body string) (int, map[string]any) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, "/geoid_height", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		require.NoError(t, GeoidBatchHandler(c))
+		var j map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &j))
+		return rec.Code, j
+	}
+
+	t.Run("coordinates", func(t *testing.T) {
+		code, j := post(t, `{"to":"orthometric","coordinates":[[138.2839817085188,37.12378643088312,100],[138.2839817085188,37.12378643088312],[10,10,100]]}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "gsigeo2011", j["model"])
+
+		points := j["points"].([]any)
+		require.Len(t, points, 3)
+		p0 := points[0].(map[string]any)
+		assert.InDelta(t, gh, p0["geoid_height"], 1e-6)
+		assert.InDelta(t, 100-gh, p0["height"], 1e-6)
+		p1 := points[1].(map[string]any)
+		assert.InDelta(t, gh, p1["geoid_height"], 1e-6)
+		assert.NotContains(t, p1, "height")
+		p2 := points[2].(map[string]any)
+		assert.Nil(t, p2["geoid_height"])
+		assert.NotContains(t, p2, "height")
+	})
+
+	t.Run("geojson", func(t *testing.T) {
+		code, j := post(t, `{"to":"ellipsoidal","geojson":{"type":"FeatureCollection","features":[
+			{"type":"Feature","properties":{"name":"a"},"geometry":{"type":"Point","coordinates":[138.2839817085188,37.12378643088312,10]}},
+			{"type":"Feature","properties":null,"geometry":{"type":"LineString","coordinates":[[138.2839817085188,37.12378643088312,20],[138.2839817085188,37.12378643088312]]}}
+		]}}`)
+		assert.Equal(t, http.StatusOK, code)
+
+		features := j["geojson"].(map[string]any)["features"].([]any)
+		f0 := features[0].(map[string]any)
+		assert.Equal(t, map[string]any{"name": "a"}, f0["properties"])
+		c0 := f0["geometry"].(map[string]any)["coordinates"].([]any)
+		assert.InDelta(t, 10+gh, c0[2], 1e-6)
+		c1 := features[1].(map[string]any)["geometry"].(map[string]any)["coordinates"].([]any)
+		assert.InDelta(t, 20+gh, c1[0].([]any)[2], 1e-6)
+		assert.Len(t, c1[1], 2)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, body := range []string{
+			`{"model":"unknown","coordinates":[[138,37]]}`,
+			`{"to":"dynamic","coordinates":[[138,37]]}`,
+			`{"coordinates":[[138,37,100]]}`,
+			`{"to":"orthometric"}`,
+			`{"to":"orthometric","geojson":{"type":"Point","coordinates":[10,10,0]}}`,
+		} {
+			code, j := post(t, body)
+			assert.Equal(t, http.StatusBadRequest, code, body)
+			assert.Contains(t, j, "error")
+		}
+	})
+}
+
+func TestGeoidModels(t *testing.T) {
+	assert.Contains(t, GeoidModels(), GeoidModelGSIGEO2011)
+	assert.Error(t, LoadGeoidModel("test", "testdata/unknown.asc"))
+}
@@ -0,0 +1,145 @@
+package citygml
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+	"github.com/eukarya-inc/reearth-plateauview/server/geo/jisx0410"
+	"github.com/labstack/echo/v4"
+)
+
+// MeshCodeHandler handles the following queries for JIS X 0410 mesh codes:
+//   - code: the level, bounds, parent, children and neighbors of the mesh code
+//   - lng, lat and level: the same as code for the mesh code containing the point
+//   - bbox and level: the mesh codes covering the bbox
+//   - polygon and level: the mesh codes intersecting the polygon
+func MeshCodeHandler(c echo.Context) error {
+	code := c.QueryParam("code")
+	bbox := c.QueryParam("bbox")
+	polygon := c.QueryParam("polygon")
+
+	var level int
+	if l := c.QueryParam("level"); l != "" {
+		var err error
+		level, err = strconv.Atoi(l)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "level must be an integer"})
+		}
+	}
+
+	if code == "" && bbox == "" && polygon == "" {
+		lng, lat := c.QueryParam("lng"), c.QueryParam("lat")
+		if lng == "" || lat == "" || level == 0 {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "code, bbox, polygon or lng, lat and level are required"})
+		}
+
+		lngFloat, err := strconv.ParseFloat(lng, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "lng must be a float"})
+		}
+		latFloat, err := strconv.ParseFloat(lat, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "lat must be a float"})
+		}
+
+		code, err = jisx0410.Encode(lngFloat, latFloat, level)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": err.Error()})
+		}
+	}
+
+	if code != "" {
+		res, err := meshCodeInfo(code)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": err.Error()})
+		}
+		return c.JSON(http.StatusOK, res)
+	}
+
+	if level == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "level is required"})
+	}
+
+	var codes []string
+	if bbox != "" {
+		b, err := parseFloats(bbox)
+		if err != nil || len(b) != 4 {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "bbox must be minLng,minLat,maxLng,maxLat"})
+		}
+
+		codes, err = jisx0410.Cover(geo.Bounds2{
+			Min: geo.Point2{X: b[0], Y: b[1]},
+			Max: geo.Point2{X: b[2], Y: b[3]},
+		}, level)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": err.Error()})
+		}
+	} else {
+		p, err := parseFloats(polygon)
+		if err != nil || len(p)%2 != 0 {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": "polygon must be lng,lat,lng,lat,..."})
+		}
+
+		po := make(geo.Polygon2, 0, len(p)/2)
+		for i := 0; i < len(p); i += 2 {
+			po = append(po, geo.Point2{X: p[i], Y: p[i+1]})
+		}
+
+		codes, err = jisx0410.CoverPolygon(po, level)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]any{"error": err.Error()})
+		}
+	}
+
+	if codes == nil {
+		codes = []string{}
+	}
+	return c.JSON(http.StatusOK, map[string]any{
+		"level": level,
+		"codes": codes,
+	})
+}
+
+func meshCodeInfo(code string) (map[string]any, error) {
+	m, err := jisx0410.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]any{
+		"code":   code,
+		"level":  m.Level,
+		"bounds": []float64{m.Bounds.Min.X, m.Bounds.Min.Y, m.Bounds.Max.X, m.Bounds.Max.Y},
+	}
+	// 2x and 5x meshes do not have hierarchy and neighbors
+	if m.Level == 0 {
+		return res, nil
+	}
+
+	if p, err := jisx0410.Parent(code); err == nil {
+		res["parent"] = p
+	}
+	if children, err := jisx0410.Children(code); err == nil {
+		res["children"] = children
+	}
+	if neighbors, err := jisx0410.Neighbors(code); err == nil {
+		res["neighbors"] = neighbors
+	}
+	return res, nil
+}
+
+func parseFloats(s string) ([]float64, error) {
+	parts := strings.Split(s, ",")
+	res := make([]float64, 0, len(parts))
+	for _, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number: %s", p)
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package citygml
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeshCodeHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    map[string]string
+		status   int
+		expected map[string]any
+	}{
+		{
+			name:   "point",
+			query:  map[string]string{"lng": "139.7671", "lat": "35.6812", "level": "4"},
+			status: http.StatusOK,
+			expected: map[string]any{
+				"code":      "533946113",
+				"level":     float64(4),
+				"bounds":    []any{139.7625, 35.67916666666667, 139.76874999999998, 35.68333333333334},
+				"parent":    "53394611",
+				"children":  []any{"5339461131", "5339461132", "5339461133", "5339461134"},
+				"neighbors": []any{"533946102", "533946111", "533946112", "533946104", "533946114", "533946202", "533946211", "533946212"},
+			},
+		},
+		{
+			name:   "bbox",
+			query:  map[string]string{"bbox": "139.76,35.68,139.78,35.69", "level": "3"},
+			status: http.StatusOK,
+			expected: map[string]any{
+				"level": float64(3),
+				"codes": []any{"53394610", "53394611", "53394612", "53394620", "53394621", "53394622"},
+			},
+		},
+		{
+			name:   "polygon",
+			query:  map[string]string{"polygon": "139.7625,35.675,139.775,35.675,139.7625,35.6833333", "level": "3"},
+			status: http.StatusOK,
+			expected: map[string]any{
+				"level": float64(3),
+				"codes": []any{"53394611"},
+			},
+		},
+		{
+			name:     "missing params",
+			query:    map[string]string{"lng": "139.7671"},
+			status:   http.StatusBadRequest,
+			expected: map[string]any{"error": "code, bbox, polygon or lng, lat and level are required"},
+		},
+		{
+			name:     "invalid bbox",
+			query:    map[string]string{"bbox": "139.76,35.68", "level": "3"},
+			status:   http.StatusBadRequest,
+			expected: map[string]any{"error": "bbox must be minLng,minLat,maxLng,maxLat"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := url.Values{}
+			for k, v := range tt.query {
+				q.Set(k, v)
+			}
+			u := &url.URL{Path: "/meshcode", RawQuery: q.Encode()}
+
+			req := httptest.NewRequest(http.MethodGet, u.String(), nil)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			assert.NoError(t, MeshCodeHandler(c))
+
+			assert.Equal(t, tt.status, rec.Code)
+			var j map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &j))
+			assert.Equal(t, tt.expected, j)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,58 +16,86 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"github.com/eukarya-inc/reearth-plateauview/server/cmsintegration/gcptaskrunner"
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearthx/log"
 	"google.golang.org/api/cloudbuild/v1"
-	"google.golang.org/api/googleapi"
 )
 
 const (
-	PackStatusAccepted  = "accepted"
-	PackStatusSucceeded = "succeeded"
+	PackStatusAccepted   = "accepted"
+	PackStatusProcessing = "processing"
+	PackStatusSucceeded  = "succeeded"
+	PackStatusFailed     = "failed"
+	PackStatusCanceled   = "canceled"
 
 	timeoutSignedURL = 10 * time.Minute
 	urlsCountLimit   = 100
 )
 
 type packer struct {
-	conf   Config
-	bucket *storage.BucketHandle
-	build  *cloudbuild.Service
+	conf  Config
+	store PackStorage
+	build *cloudbuild.Service
 }
 
-func newPacker(conf Config) *packer {
+type signedURLer interface {
+	SignedURL(key string, expires time.Duration) (string, error)
+}
+
+func newPacker(conf Config) (*packer, error) {
 	ctx := context.Background()
-	gcs, _ := storage.NewClient(ctx)
-	bucket := gcs.Bucket(conf.Bucket)
+	var store PackStorage
+	if conf.PackerStorageDir != "" {
+		s, err := NewLocalPackStorage(conf.PackerStorageDir)
+		if err != nil {
+			return nil, err
+		}
+		store = s
+	} else {
+		gcs, _ := storage.NewClient(ctx)
+		store = NewGCSPackStorage(gcs.Bucket(conf.Bucket), conf.PackerPrefix)
+	}
 	build, _ := cloudbuild.NewService(ctx)
 	return &packer{
-		conf:   conf,
-		bucket: bucket,
-		build:  build,
-	}
+		conf:  conf,
+		store: store,
+		build: build,
+	}, nil
 }
 
 func (p *packer) handleGetZip(c echo.Context, hash string) error {
 	ctx := c.Request().Context()
-	obj := p.bucket.Object(hash + ".zip")
-	attrs, err := obj.Attrs(ctx)
-	if errors.Is(err, storage.ErrObjectNotExist) {
+	obj, err := p.store.Attrs(ctx, hash+".zip")
+	if errors.Is(err, ErrPackNotFound) {
 		return c.JSON(http.StatusNotFound, map[string]any{"error": "not found"})
 	}
+	if err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to get metadata: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to get metadata"})
+	}
 
-	if status := getStatus(attrs.Metadata); status != PackStatusSucceeded {
+	if status := getStatus(obj.Metadata); status != PackStatusSucceeded {
 		return c.JSON(http.StatusBadRequest, map[string]any{
 			"error":  "invalid status",
 			"status": status,
 		})
 	}
 
-	signedURL, err := p.bucket.SignedURL(obj.ObjectName(), &storage.SignedURLOptions{
-		Method:  http.MethodGet,
-		Expires: time.Now().Add(timeoutSignedURL),
-	})
+	s, ok := p.store.(signedURLer)
+	if !ok {
+		// the storage cannot be accessed directly, so the server sends the file
+		r, err := p.store.NewReader(ctx, obj.Key)
+		if err != nil {
+			log.Errorfc(ctx, "citygml: packer: failed to open zip: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to open zip"})
+		}
+		defer r.Close()
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", obj.Key))
+		return c.Stream(http.StatusOK, "application/zip", r)
+	}
 
+	signedURL, err := s.SignedURL(obj.Key, timeoutSignedURL)
 	if err != nil {
 		log.Errorfc(ctx, "citygml: packer: failed to issue signed url: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]any{
@@ -79,24 +108,160 @@ func (p *packer) handleGetZip(c echo.Context, hash string) error {
 
 func (p *packer) handleGetStatus(c echo.Context, hash string) error {
 	ctx := c.Request().Context()
-	attrs, err := p.bucket.Object(hash + ".zip").Attrs(ctx)
-	if errors.Is(err, storage.ErrObjectNotExist) {
+	obj, err := p.store.Attrs(ctx, hash+".zip")
+	if errors.Is(err, ErrPackNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]any{"error": "not found"})
+	}
+	if err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to get metadata: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to get metadata"})
+	}
+
+	return c.JSON(http.StatusOK, packStatus(obj.Metadata))
+}
+
+// handleListJobs returns pack jobs in descending order of creation. They can be filtered with the status query param.
+func (p *packer) handleListJobs(c echo.Context) error {
+	ctx := c.Request().Context()
+	status := c.QueryParam("status")
+
+	objs, err := p.store.List(ctx, ".zip")
+	if err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to list jobs: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to list jobs"})
+	}
+
+	slices.SortStableFunc(objs, func(a, b *PackObject) int {
+		if c := b.Created.Compare(a.Created); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	jobs := make([]map[string]any, 0, len(objs))
+	for _, obj := range objs {
+		if status != "" && getStatus(obj.Metadata) != status {
+			continue
+		}
+		job := packStatus(obj.Metadata)
+		job["id"] = strings.TrimSuffix(obj.Key, ".zip")
+		job["createdAt"] = obj.Created.Format(time.RFC3339Nano)
+		job["updatedAt"] = obj.Updated.Format(time.RFC3339Nano)
+		jobs = append(jobs, job)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{"jobs": jobs})
+}
+
+// handleCancel marks an accepted or processing job as canceled. The worker stops when it notices the status.
+func (p *packer) handleCancel(c echo.Context, hash string) error {
+	ctx := c.Request().Context()
+	err := p.store.UpdateMetadata(ctx, hash+".zip", Status(PackStatusCanceled), func(m map[string]string) bool {
+		s := getStatus(m)
+		return s == PackStatusAccepted || s == PackStatusProcessing
+	})
+	if err != nil {
+		return p.updateStatusError(c, hash, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"id":     hash,
+		"status": PackStatusCanceled,
+	})
+}
+
+// handleRetry enqueues a failed or canceled job again with the same request.
+func (p *packer) handleRetry(c echo.Context, hash string) error {
+	ctx := c.Request().Context()
+	obj, err := p.store.Attrs(ctx, hash+".zip")
+	if errors.Is(err, ErrPackNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]any{"error": "not found"})
+	}
+	if err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to get metadata: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to get metadata"})
+	}
+
+	prevStatus := getStatus(obj.Metadata)
+	if prevStatus != PackStatusFailed && prevStatus != PackStatusCanceled {
+		return c.JSON(http.StatusBadRequest, map[string]any{
+			"error":  "invalid status",
+			"status": prevStatus,
+		})
+	}
+
+	packReq, err := p.readRequest(ctx, hash)
+	if errors.Is(err, ErrPackNotFound) {
+		// jobs requested before requests were saved
+		return c.JSON(http.StatusBadRequest, map[string]any{"error": "request of the job not found"})
+	}
+	if err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to read request: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to read request"})
+	}
+	packReq.Dest = p.store.URL(obj.Key)
+	if packReq.Source != "" {
+		packReq.Source = p.store.URL(hash + ".txt")
+	}
+	packReq.Timeout = time.Duration(p.conf.PackerTimeout) * time.Second
+
+	// clear the status of the last run
+	metadata := Status(PackStatusAccepted)
+	metadata["startedAt"] = ""
+	metadata["total"] = ""
+	metadata["processed"] = ""
+	err = p.store.UpdateMetadata(ctx, obj.Key, metadata, func(m map[string]string) bool {
+		return getStatus(m) == prevStatus
+	})
+	if err != nil {
+		return p.updateStatusError(c, hash, err)
+	}
+
+	if err := p.packAsync(ctx, packReq); err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to enqueue pack job: %v", err)
+
+		if err := p.store.UpdateMetadata(ctx, obj.Key, Status(prevStatus), nil); err != nil {
+			log.Errorfc(ctx, "citygml: packer: failed to restore status: %v", err)
+		}
+
+		return c.JSON(http.StatusInternalServerError, map[string]any{
+			"error": "failed to enqueue pack job",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{"id": hash})
+}
+
+func (p *packer) updateStatusError(c echo.Context, hash string, err error) error {
+	ctx := c.Request().Context()
+	if errors.Is(err, ErrPackNotFound) {
 		return c.JSON(http.StatusNotFound, map[string]any{"error": "not found"})
 	}
-	status := getStatus(attrs.Metadata)
+	if errors.Is(err, ErrPackConditionNotMet) {
+		resp := map[string]any{"error": "invalid status"}
+		if obj, err := p.store.Attrs(ctx, hash+".zip"); err == nil {
+			resp["status"] = getStatus(obj.Metadata)
+		}
+		return c.JSON(http.StatusBadRequest, resp)
+	}
+	log.Errorfc(ctx, "citygml: packer: failed to update status: %v", err)
+	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to update status"})
+}
+
+func packStatus(metadata map[string]string) map[string]any {
+	status := getStatus(metadata)
 	resp := map[string]any{
 		"status": status,
 	}
-	if startedAt, ok := attrs.Metadata["startedAt"]; ok {
+	if startedAt, ok := metadata["startedAt"]; ok {
 		resp["startedAt"] = startedAt
 	}
 	if status == PackStatusSucceeded {
 		resp["progress"] = 1.0
-	} else if progress, ok := getProgress(attrs.Metadata); ok {
+	} else if progress, ok := getProgress(metadata); ok {
 		resp["progress"] = progress
 	}
-
-	return c.JSON(http.StatusOK, resp)
+	return resp
 }
 
 func getProgress(metadata map[string]string) (float64, bool) {
@@ -123,6 +288,10 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 	ctx := c.Request().Context()
 	var req struct {
 		URLs []string `json:"urls"`
+		// optional: GML files are trimmed to city objects in the area
+		SpatialIDs []string  `json:"spatialIds"`
+		MeshCodes  []string  `json:"meshCodes"`
+		BBox       []float64 `json:"bbox"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]any{
@@ -135,6 +304,19 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 			"error": "no urls provided",
 		})
 	}
+	if _, err := NewAreaFilter(req.SpatialIDs, req.MeshCodes, req.BBox); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]any{
+			"error":  "invalid area",
+			"reason": err.Error(),
+		})
+	}
+	for _, s := range append(slices.Clone(req.SpatialIDs), req.MeshCodes...) {
+		if strings.Contains(s, ",") {
+			return c.JSON(http.StatusBadRequest, map[string]any{
+				"error": "invalid area",
+			})
+		}
+	}
 
 	// validate urls
 	for _, citygmlURL := range req.URLs {
@@ -156,8 +338,7 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 	// sort urls and calculate hash
 	slices.Sort(req.URLs)
 	req.URLs = slices.Compact(req.URLs)
-	checksum := sha256.Sum256([]byte(strings.Join(req.URLs, ",")))
-	hash := hex.EncodeToString(checksum[:])
+	hash := packHash(req.URLs, req.SpatialIDs, req.MeshCodes, req.BBox)
 
 	var resp struct {
 		ID string `json:"id"`
@@ -165,13 +346,9 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 	resp.ID = hash
 
 	// check if the object already exists
-	obj := p.bucket.Object(hash + ".zip").If(storage.Conditions{DoesNotExist: true})
-	w := obj.NewWriter(ctx)
-	w.ObjectAttrs.Metadata = Status(PackStatusAccepted)
-	_, _ = w.Write(nil)
-	if err := w.Close(); err != nil {
-		var gErr *googleapi.Error
-		if !(errors.As(err, &gErr) && gErr.Code == http.StatusPreconditionFailed) {
+	key := hash + ".zip"
+	if err := p.store.Create(ctx, key, Status(PackStatusAccepted)); err != nil {
+		if !errors.Is(err, ErrPackExists) {
 			log.Errorfc(ctx, "citygml: packer: failed to write metadata: %v", err)
 			return c.JSON(http.StatusInternalServerError, map[string]any{
 				"error": "failed to write metadata",
@@ -184,8 +361,8 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 	urls := req.URLs
 	source := ""
 	if len(req.URLs) > urlsCountLimit {
-		urlsObj := p.bucket.Object(hash + ".txt")
-		w := urlsObj.NewWriter(ctx)
+		urlsKey := hash + ".txt"
+		w := p.store.NewWriter(ctx, urlsKey, nil, nil)
 		for _, u := range req.URLs {
 			_, _ = w.Write([]byte(u + "\n"))
 		}
@@ -197,23 +374,31 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 		}
 
 		urls = nil
-		source = toURL(urlsObj)
+		source = p.store.URL(urlsKey)
 	}
 
 	// enqueue pack job
 	packReq := PackAsyncRequest{
-		Dest:    toURL(obj),
-		Domain:  p.conf.Domain,
-		URLs:    urls,
-		Source:  source,
-		Timeout: time.Duration(p.conf.PackerTimeout) * time.Second,
+		Dest:       p.store.URL(key),
+		Domain:     p.conf.Domain,
+		URLs:       urls,
+		Source:     source,
+		Timeout:    time.Duration(p.conf.PackerTimeout) * time.Second,
+		SpatialIDs: req.SpatialIDs,
+		MeshCodes:  req.MeshCodes,
+		BBox:       req.BBox,
+	}
+
+	// the request is kept to retry the job
+	if err := p.writeRequest(ctx, hash, packReq); err != nil {
+		log.Errorfc(ctx, "citygml: packer: failed to write request, the job cannot be retried: %v", err)
 	}
 
 	if err := p.packAsync(ctx, packReq); err != nil {
 		log.Errorfc(ctx, "citygml: packer: failed to enqueue pack job: %v", err)
 
 		// delete object to prevent orphaned objects
-		if err := obj.Delete(ctx); err != nil {
+		if err := p.store.Delete(ctx, key); err != nil {
 			log.Errorfc(ctx, "citygml: packer: failed to delete object: %v", err)
 		}
 
@@ -225,6 +410,30 @@ func (p *packer) handlePackRequest(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+func (p *packer) writeRequest(ctx context.Context, hash string, req PackAsyncRequest) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := p.store.NewWriter(ctx, hash+".json", nil, nil)
+	if err := json.NewEncoder(w).Encode(req); err != nil {
+		// abort the write
+		cancel()
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (p *packer) readRequest(ctx context.Context, hash string) (req PackAsyncRequest, err error) {
+	r, err := p.store.NewReader(ctx, hash+".json")
+	if err != nil {
+		return req, err
+	}
+	defer r.Close()
+	err = json.NewDecoder(r).Decode(&req)
+	return
+}
+
 func (p *packer) packAsync(ctx context.Context, req PackAsyncRequest) error {
 	for _, u := range req.URLs {
 		if strings.Contains(u, ",") {
@@ -241,10 +450,36 @@ func (p *packer) packAsync(ctx context.Context, req PackAsyncRequest) error {
 	if req.Source != "" {
 		args = append(args, "-source", req.Source)
 	}
+	if len(req.SpatialIDs) > 0 {
+		args = append(args, "-sid", strings.Join(req.SpatialIDs, ","))
+	}
+	if len(req.MeshCodes) > 0 {
+		args = append(args, "-mesh", strings.Join(req.MeshCodes, ","))
+	}
+	if len(req.BBox) > 0 {
+		args = append(args, "-bbox", joinFloats(req.BBox))
+	}
 	if len(req.URLs) > 0 {
 		args = append(args, strings.Join(req.URLs, ","))
 	}
 
+	if p.conf.LocalWorker {
+		if err := gcptaskrunner.RunLocal(ctx, gcptaskrunner.Task{
+			Image: p.conf.CityGMLPackerImage,
+			Args:  args,
+		}, gcptaskrunner.Config{
+			Tags:        []string{"citygml-packer"},
+			Timeout:     "86400s", // 1 day
+			LocalBinary: p.conf.LocalWorkerBinary,
+			LocalLogDir: p.conf.LocalWorkerLogDir,
+			LocalWait:   p.conf.LocalWorkerWait,
+		}); err != nil {
+			return fmt.Errorf("run local worker: %w", err)
+		}
+		log.Debugfc(ctx, "citygml: packer: started local pack job")
+		return nil
+	}
+
 	build := &cloudbuild.Build{
 		Timeout:  "86400s", // 1 day
 		QueueTtl: "86400s", // 1 day
@@ -285,14 +520,33 @@ func getStatus(metadata map[string]string) string {
 	return metadata["status"]
 }
 
-func toURL(obj *storage.ObjectHandle) string {
-	return "gs://" + obj.BucketName() + "/" + obj.ObjectName()
+type PackAsyncRequest struct {
+	Dest       string        `json:"dest"`
+	Domain     string        `json:"domain"`
+	Timeout    time.Duration `json:"timeout,omitempty"`
+	Source     string        `json:"source"`
+	URLs       []string      `json:"urls"`
+	SpatialIDs []string      `json:"spatialIds,omitempty"`
+	MeshCodes  []string      `json:"meshCodes,omitempty"`
+	BBox       []float64     `json:"bbox,omitempty"`
 }
 
-type PackAsyncRequest struct {
-	Dest    string        `json:"dest"`
-	Domain  string        `json:"domain"`
-	Timeout time.Duration `json:"timeout,omitempty"`
-	Source  string        `json:"source"`
-	URLs    []string      `json:"urls"`
+// packHash returns the pack ID. Requests without any area have the same ID as before the area was supported.
+func packHash(urls, spatialIDs, meshCodes []string, bbox []float64) string {
+	key := strings.Join(urls, ",")
+	if len(spatialIDs) > 0 || len(meshCodes) > 0 || len(bbox) > 0 {
+		spatialIDs = slices.Compact(slices.Sorted(slices.Values(spatialIDs)))
+		meshCodes = slices.Compact(slices.Sorted(slices.Values(meshCodes)))
+		key += "|sid:" + strings.Join(spatialIDs, ",") + "|mesh:" + strings.Join(meshCodes, ",") + "|bbox:" + joinFloats(bbox)
+	}
+	checksum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(checksum[:])
+}
+
+func joinFloats(f []float64) string {
+	s := make([]string, 0, len(f))
+	for _, v := range f {
+		s = append(s, strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return strings.Join(s, ",")
 }
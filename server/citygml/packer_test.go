@@ -0,0 +1,123 @@
+package citygml
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackHash(t *testing.T) {
+	urls := []string{"https://example.com/a.gml", "https://example.com/b.gml"}
+	checksum := sha256.Sum256([]byte("https://example.com/a.gml,https://example.com/b.gml"))
+	assert.Equal(t, hex.EncodeToString(checksum[:]), packHash(urls, nil, nil, nil))
+
+	h := packHash(urls, []string{"/18/0/1/2", "/18/0/1/1"}, nil, nil)
+	assert.NotEqual(t, packHash(urls, nil, nil, nil), h)
+	assert.Equal(t, h, packHash(urls, []string{"/18/0/1/1", "/18/0/1/2", "/18/0/1/1"}, nil, nil))
+	assert.NotEqual(t, h, packHash(urls, nil, []string{"/18/0/1/1", "/18/0/1/2"}, nil))
+	assert.NotEqual(t, packHash(urls, nil, nil, []float64{1, 2, 3, 4}), packHash(urls, nil, nil, []float64{1, 2, 3, 5}))
+}
+
+func TestPackJobs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	e := echo.New()
+	require.NoError(t, Echo(Config{
+		PackerStorageDir: dir,
+		LocalWorker:      true,
+		// the worker does nothing
+		LocalWorkerBinary: "true",
+	}, e.Group("/citygml")))
+	s, err := NewLocalPackStorage(dir)
+	require.NoError(t, err)
+
+	do := func(t *testing.T, method, target, body string) (int, map[string]any) {
+		t.Helper()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		var j map[string]any
+		if strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &j))
+		}
+		return rec.Code, j
+	}
+
+	code, j := do(t, http.MethodPost, "/citygml/pack", `{"urls":["https://example.com/a.gml"]}`)
+	require.Equal(t, http.StatusOK, code)
+	id := j["id"].(string)
+
+	require.NoError(t, s.Create(ctx, "done.zip", Status(PackStatusSucceeded)))
+	w := s.NewWriter(ctx, "done.zip", Status(PackStatusSucceeded), nil)
+	_, _ = w.Write([]byte("zip"))
+	require.NoError(t, w.Close())
+
+	t.Run("list", func(t *testing.T) {
+		code, j := do(t, http.MethodGet, "/citygml/pack", "")
+		assert.Equal(t, http.StatusOK, code)
+		jobs := j["jobs"].([]any)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "done", jobs[0].(map[string]any)["id"])
+		assert.Equal(t, 1.0, jobs[0].(map[string]any)["progress"])
+		assert.Equal(t, id, jobs[1].(map[string]any)["id"])
+		assert.Equal(t, PackStatusAccepted, jobs[1].(map[string]any)["status"])
+		assert.Contains(t, jobs[1], "createdAt")
+
+		_, j = do(t, http.MethodGet, "/citygml/pack?status=accepted", "")
+		assert.Len(t, j["jobs"], 1)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/citygml/pack/done.zip", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "zip", rec.Body.String())
+	})
+
+	t.Run("retry running job", func(t *testing.T) {
+		code, j := do(t, http.MethodPost, "/citygml/pack/"+id+"/retry", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, PackStatusAccepted, j["status"])
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		code, j := do(t, http.MethodDelete, "/citygml/pack/"+id, "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, PackStatusCanceled, j["status"])
+
+		code, j = do(t, http.MethodDelete, "/citygml/pack/"+id, "")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, PackStatusCanceled, j["status"])
+
+		code, _ = do(t, http.MethodDelete, "/citygml/pack/unknown", "")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		require.NoError(t, s.UpdateMetadata(ctx, id+".zip", map[string]string{"startedAt": "2024-01-01T00:00:00Z", "total": "10", "processed": "5"}, nil))
+
+		code, j := do(t, http.MethodPost, "/citygml/pack/"+id+"/retry", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, id, j["id"])
+
+		code, j = do(t, http.MethodGet, "/citygml/pack/"+id+"/status", "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, map[string]any{"status": PackStatusAccepted}, j)
+
+		// jobs without saved requests cannot be retried
+		require.NoError(t, s.Create(ctx, "old.zip", Status(PackStatusFailed)))
+		code, _ = do(t, http.MethodPost, "/citygml/pack/old/retry", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
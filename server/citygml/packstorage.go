@@ -0,0 +1,94 @@
+package citygml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	ErrPackNotFound        = errors.New("pack object not found")
+	ErrPackExists          = errors.New("pack object already exists")
+	ErrPackConditionNotMet = errors.New("pack object condition not met")
+)
+
+// PackStorage stores pack zip files and their metadata such as status and progress.
+// Keys are object names such as "<id>.zip".
+type PackStorage interface {
+	// Attrs returns ErrPackNotFound if the object does not exist.
+	Attrs(ctx context.Context, key string) (*PackObject, error)
+	// Create creates an empty object with the metadata. It returns ErrPackExists if the object already exists.
+	Create(ctx context.Context, key string, metadata map[string]string) error
+	// UpdateMetadata merges metadata into the metadata of the object. Keys with empty values are removed.
+	// If cond is not nil and returns false for the current metadata, it returns ErrPackConditionNotMet.
+	UpdateMetadata(ctx context.Context, key string, metadata map[string]string, cond func(map[string]string) bool) error
+	// NewWriter returns a writer that replaces the content and the metadata of the object when it is closed.
+	// If cond is not nil and returns false for the metadata of the object when the writer is closed, Close returns ErrPackConditionNotMet and the object is not replaced.
+	// Canceling ctx aborts the write.
+	NewWriter(ctx context.Context, key string, metadata map[string]string, cond func(map[string]string) bool) io.WriteCloser
+	NewReader(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// List returns objects whose keys end with suffix.
+	List(ctx context.Context, suffix string) ([]*PackObject, error)
+	// URL returns the URL of the object, which is passed to the packer worker.
+	URL(key string) string
+}
+
+type PackObject struct {
+	Key      string
+	Metadata map[string]string
+	Created  time.Time
+	Updated  time.Time
+}
+
+// OpenPackStorage returns the storage and the key of the object URL: gs://<bucket>/<key> or file:///<dir>/<key>.
+func OpenPackStorage(ctx context.Context, objectURL string) (PackStorage, string, error) {
+	u, err := url.Parse(objectURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid url(%s): %w", objectURL, err)
+	}
+
+	switch u.Scheme {
+	case "gs":
+		key := strings.TrimPrefix(u.Path, "/")
+		if u.Host == "" || key == "" {
+			return nil, "", fmt.Errorf("invalid url(%s): bucket and object are required", objectURL)
+		}
+		gcs, err := storage.NewClient(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("storage.NewClient: %w", err)
+		}
+		return NewGCSPackStorage(gcs.Bucket(u.Host), ""), key, nil
+	case "file":
+		p := filepath.FromSlash(u.Path)
+		s, err := NewLocalPackStorage(filepath.Dir(p))
+		if err != nil {
+			return nil, "", err
+		}
+		return s, filepath.Base(p), nil
+	}
+
+	return nil, "", fmt.Errorf("invalid url(%s): must be gs:// or file://", objectURL)
+}
+
+func mergeMetadata(dst, src map[string]string) map[string]string {
+	res := make(map[string]string, len(dst)+len(src))
+	for k, v := range dst {
+		res[k] = v
+	}
+	for k, v := range src {
+		if v == "" {
+			delete(res, k)
+			continue
+		}
+		res[k] = v
+	}
+	return res
+}
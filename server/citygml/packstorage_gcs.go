@@ -0,0 +1,235 @@
+package citygml
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+)
+
+const gcsPackTempSuffix = ".tmp"
+
+// GCSPackStorage stores pack files as objects under the prefix of the bucket.
+type GCSPackStorage struct {
+	bucket *storage.BucketHandle
+	prefix string
+}
+
+var _ PackStorage = (*GCSPackStorage)(nil)
+
+func NewGCSPackStorage(bucket *storage.BucketHandle, prefix string) *GCSPackStorage {
+	return &GCSPackStorage{bucket: bucket, prefix: strings.Trim(prefix, "/")}
+}
+
+func (s *GCSPackStorage) Attrs(ctx context.Context, key string) (*PackObject, error) {
+	attrs, err := s.bucket.Object(s.name(key)).Attrs(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, ErrPackNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.packObject(attrs), nil
+}
+
+func (s *GCSPackStorage) Create(ctx context.Context, key string, metadata map[string]string) error {
+	w := s.bucket.Object(s.name(key)).If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	w.ObjectAttrs.Metadata = metadata
+	_, _ = w.Write(nil)
+	if err := w.Close(); err != nil {
+		if isPreconditionFailed(err) {
+			return ErrPackExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *GCSPackStorage) UpdateMetadata(ctx context.Context, key string, metadata map[string]string, cond func(map[string]string) bool) error {
+	obj := s.bucket.Object(s.name(key))
+	if cond != nil {
+		attrs, err := obj.Attrs(ctx)
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return ErrPackNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if !cond(attrs.Metadata) {
+			return ErrPackConditionNotMet
+		}
+		obj = obj.If(storage.Conditions{GenerationMatch: attrs.Generation, MetagenerationMatch: attrs.Metageneration})
+	}
+
+	_, err := obj.Update(ctx, storage.ObjectAttrsToUpdate{Metadata: metadata})
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return ErrPackNotFound
+	}
+	if isPreconditionFailed(err) {
+		return ErrPackConditionNotMet
+	}
+	return err
+}
+
+// NewWriter writes the content to a temporary object when cond is not nil, as preconditions of uploads cannot be decided after they start.
+// The temporary object is copied to the object on Close with preconditions of the generation and the metageneration checked by cond.
+func (s *GCSPackStorage) NewWriter(ctx context.Context, key string, metadata map[string]string, cond func(map[string]string) bool) io.WriteCloser {
+	if cond == nil {
+		w := s.bucket.Object(s.name(key)).NewWriter(ctx)
+		w.ObjectAttrs.Metadata = metadata
+		return w
+	}
+
+	tmp := s.bucket.Object(s.name(key) + gcsPackTempSuffix)
+	return &gcsPackWriter{
+		Writer:   tmp.NewWriter(ctx),
+		ctx:      ctx,
+		obj:      s.bucket.Object(s.name(key)),
+		tmp:      tmp,
+		metadata: metadata,
+		cond:     cond,
+	}
+}
+
+func (s *GCSPackStorage) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := s.bucket.Object(s.name(key)).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, ErrPackNotFound
+	}
+	return r, err
+}
+
+func (s *GCSPackStorage) Delete(ctx context.Context, key string) error {
+	err := s.bucket.Object(s.name(key)).Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return ErrPackNotFound
+	}
+	return err
+}
+
+func (s *GCSPackStorage) List(ctx context.Context, suffix string) ([]*PackObject, error) {
+	// only pack files directly under the prefix are listed as "*" of globs does not match "/"
+	q := &storage.Query{
+		Prefix:    s.name(""),
+		MatchGlob: globEscape(s.name("")) + "*" + globEscape(suffix),
+	}
+	if err := q.SetAttrSelection([]string{"Name", "Metadata", "Created", "Updated"}); err != nil {
+		return nil, err
+	}
+
+	var res []*PackObject
+	it := s.bucket.Objects(ctx, q)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s.packObject(attrs))
+	}
+	return res, nil
+}
+
+func (s *GCSPackStorage) URL(key string) string {
+	return "gs://" + s.bucket.BucketName() + "/" + s.name(key)
+}
+
+// SignedURL issues a URL to download the object directly from GCS.
+func (s *GCSPackStorage) SignedURL(key string, expires time.Duration) (string, error) {
+	return s.bucket.SignedURL(s.name(key), &storage.SignedURLOptions{
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(expires),
+	})
+}
+
+func (s *GCSPackStorage) name(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return s.prefix + "/" + key
+}
+
+func (s *GCSPackStorage) packObject(attrs *storage.ObjectAttrs) *PackObject {
+	return &PackObject{
+		Key:      strings.TrimPrefix(attrs.Name, s.name("")),
+		Metadata: attrs.Metadata,
+		Created:  attrs.Created,
+		Updated:  attrs.Updated,
+	}
+}
+
+type gcsPackWriter struct {
+	*storage.Writer
+	ctx      context.Context
+	obj      *storage.ObjectHandle
+	tmp      *storage.ObjectHandle
+	metadata map[string]string
+	cond     func(map[string]string) bool
+	closed   bool
+	err      error
+}
+
+func (w *gcsPackWriter) Close() error {
+	if w.closed {
+		return w.err
+	}
+	w.closed = true
+	w.err = w.commit()
+	return w.err
+}
+
+func (w *gcsPackWriter) commit() error {
+	if err := w.Writer.Close(); err != nil {
+		return err
+	}
+	defer func() {
+		// the temporary object is no longer needed whether it is copied or not
+		_ = w.tmp.Delete(context.WithoutCancel(w.ctx))
+	}()
+
+	attrs, err := w.obj.Attrs(w.ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return ErrPackNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !w.cond(attrs.Metadata) {
+		return ErrPackConditionNotMet
+	}
+
+	c := w.obj.If(storage.Conditions{GenerationMatch: attrs.Generation, MetagenerationMatch: attrs.Metageneration}).CopierFrom(w.tmp)
+	c.Metadata = w.metadata
+	if _, err := c.Run(w.ctx); err != nil {
+		if isPreconditionFailed(err) {
+			return ErrPackConditionNotMet
+		}
+		return err
+	}
+	return nil
+}
+
+// globEscape escapes special characters of glob patterns of GCS.
+func globEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`\*?[]{}`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isPreconditionFailed(err error) bool {
+	var gErr *googleapi.Error
+	return errors.As(err, &gErr) && gErr.Code == http.StatusPreconditionFailed
+}
@@ -0,0 +1,99 @@
+package citygml
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+func TestGCSPackStorage_List(t *testing.T) {
+	var query map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/b/bucket/o", r.URL.Path)
+		query = map[string]string{
+			"prefix":    r.URL.Query().Get("prefix"),
+			"matchGlob": r.URL.Query().Get("matchGlob"),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{"name":"packs/a.zip","metadata":{"status":"succeeded"},"timeCreated":"2024-01-01T00:00:00Z"}]}`))
+	}))
+	defer ts.Close()
+
+	gcs, err := storage.NewClient(context.Background(), option.WithEndpoint(ts.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+	s := NewGCSPackStorage(gcs.Bucket("bucket"), "/packs/")
+
+	objs, err := s.List(context.Background(), ".zip")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"prefix": "packs/", "matchGlob": "packs/*.zip"}, query)
+	assert.Equal(t, []string{"a.zip"}, packObjectKeys(objs))
+	assert.Equal(t, map[string]string{"status": "succeeded"}, objs[0].Metadata)
+
+	assert.Equal(t, "gs://bucket/packs/a.zip", s.URL("a.zip"))
+	assert.Equal(t, "gs://bucket/a.zip", NewGCSPackStorage(gcs.Bucket("bucket"), "").URL("a.zip"))
+}
+
+func TestGCSPackStorage_NewWriter(t *testing.T) {
+	var requests []string
+	var copied bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/upload/"):
+			_, _ = w.Write([]byte(`{"bucket":"bucket","name":"packs/a.zip.tmp","generation":"1"}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"bucket":"bucket","name":"packs/a.zip","generation":"5","metageneration":"7","metadata":{"status":"processing"}}`))
+		case r.Method == http.MethodPost && strings.Contains(r.URL.Path, "/rewriteTo/"):
+			assert.Equal(t, "5", r.URL.Query().Get("ifGenerationMatch"))
+			assert.Equal(t, "7", r.URL.Query().Get("ifMetagenerationMatch"))
+			if !copied {
+				// the status is changed after it is checked
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"error":{"code":412,"message":"precondition failed"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"done":true,"resource":{"bucket":"bucket","name":"packs/a.zip"}}`))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	gcs, err := storage.NewClient(context.Background(), option.WithEndpoint(ts.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+	s := NewGCSPackStorage(gcs.Bucket("bucket"), "packs")
+	isProcessing := func(m map[string]string) bool { return getStatus(m) == PackStatusProcessing }
+
+	w := s.NewWriter(context.Background(), "a.zip", Status(PackStatusSucceeded), isProcessing)
+	_, err = w.Write([]byte("zip"))
+	require.NoError(t, err)
+	assert.ErrorIs(t, w.Close(), ErrPackConditionNotMet)
+	assert.ErrorIs(t, w.Close(), ErrPackConditionNotMet)
+	assert.Equal(t, []string{
+		"POST /upload/storage/v1/b/bucket/o",
+		"GET /b/bucket/o/packs/a.zip",
+		"POST /b/bucket/o/packs/a.zip.tmp/rewriteTo/b/bucket/o/packs/a.zip",
+		"DELETE /b/bucket/o/packs/a.zip.tmp",
+	}, requests)
+
+	copied = true
+	w = s.NewWriter(context.Background(), "a.zip", Status(PackStatusSucceeded), isProcessing)
+	_, err = w.Write([]byte("zip"))
+	require.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	w = s.NewWriter(context.Background(), "a.zip", Status(PackStatusSucceeded), func(map[string]string) bool { return false })
+	assert.ErrorIs(t, w.Close(), ErrPackConditionNotMet)
+}
+
+func TestGlobEscape(t *testing.T) {
+	assert.Equal(t, `a\*b\?c\[d\]\{e\}\\.zip`, globEscape(`a*b?c[d]{e}\.zip`))
+}
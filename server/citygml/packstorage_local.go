@@ -0,0 +1,353 @@
+package citygml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	localPackMetaSuffix = ".meta.json"
+	localPackTempSuffix = ".tmp"
+	localPackLockFile   = ".lock"
+)
+
+// LocalPackStorage stores pack objects as files in a directory. Metadata of each object is stored in "<key>.meta.json".
+// Changes are serialized with a lock file in the directory, so conditions of UpdateMetadata also hold against other processes such as the packer worker.
+// The lock file is only supported on Unix; conditions are only guaranteed within a process on other platforms.
+type LocalPackStorage struct {
+	dir  string
+	lock sync.Mutex
+}
+
+var _ PackStorage = (*LocalPackStorage)(nil)
+
+type localPackMeta struct {
+	Metadata map[string]string `json:"metadata"`
+	Created  time.Time         `json:"created"`
+	Updated  time.Time         `json:"updated"`
+}
+
+func NewLocalPackStorage(dir string) (*LocalPackStorage, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pack storage dir: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create pack storage dir: %w", err)
+	}
+	return &LocalPackStorage{dir: dir}, nil
+}
+
+func (s *LocalPackStorage) Attrs(ctx context.Context, key string) (*PackObject, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(p); err != nil {
+		return nil, notFound(err)
+	}
+	m, err := readLocalPackMeta(p)
+	if err != nil {
+		return nil, err
+	}
+	return &PackObject{
+		Key:      key,
+		Metadata: m.Metadata,
+		Created:  m.Created,
+		Updated:  m.Updated,
+	}, nil
+}
+
+func (s *LocalPackStorage) Create(ctx context.Context, key string, metadata map[string]string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.lockDir()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if errors.Is(err, fs.ErrExist) {
+		return ErrPackExists
+	}
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	return writeLocalPackMeta(p, localPackMeta{
+		Metadata: mergeMetadata(nil, metadata),
+		Created:  now,
+		Updated:  now,
+	})
+}
+
+func (s *LocalPackStorage) UpdateMetadata(ctx context.Context, key string, metadata map[string]string, cond func(map[string]string) bool) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.lockDir()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, err := os.Stat(p); err != nil {
+		return notFound(err)
+	}
+	m, err := readLocalPackMeta(p)
+	if err != nil {
+		return err
+	}
+	if cond != nil && !cond(m.Metadata) {
+		return ErrPackConditionNotMet
+	}
+
+	m.Metadata = mergeMetadata(m.Metadata, metadata)
+	m.Updated = time.Now()
+	return writeLocalPackMeta(p, m)
+}
+
+func (s *LocalPackStorage) NewWriter(ctx context.Context, key string, metadata map[string]string, cond func(map[string]string) bool) io.WriteCloser {
+	w := &localPackWriter{ctx: ctx, s: s, key: key, metadata: metadata, cond: cond}
+	p, err := s.path(key)
+	if err != nil {
+		w.err = err
+		return w
+	}
+	w.f, w.err = os.CreateTemp(s.dir, filepath.Base(p)+".*"+localPackTempSuffix)
+	return w
+}
+
+func (s *LocalPackStorage) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	return f, nil
+}
+
+func (s *LocalPackStorage) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := s.lockDir()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := os.Remove(p); err != nil {
+		return notFound(err)
+	}
+	if err := os.Remove(p + localPackMetaSuffix); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalPackStorage) List(ctx context.Context, suffix string) ([]*PackObject, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*PackObject
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || name == localPackLockFile || !strings.HasSuffix(name, suffix) ||
+			strings.HasSuffix(name, localPackMetaSuffix) || strings.HasSuffix(name, localPackTempSuffix) {
+			continue
+		}
+		obj, err := s.Attrs(ctx, name)
+		if errors.Is(err, ErrPackNotFound) {
+			// deleted while listing
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, obj)
+	}
+	return res, nil
+}
+
+func (s *LocalPackStorage) URL(key string) string {
+	return "file://" + filepath.ToSlash(filepath.Join(s.dir, key))
+}
+
+// lockDir locks the directory within the process and against other processes, and returns the function to unlock it.
+func (s *LocalPackStorage) lockDir() (func(), error) {
+	s.lock.Lock()
+	f, err := os.OpenFile(filepath.Join(s.dir, localPackLockFile), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		s.lock.Unlock()
+		return nil, fmt.Errorf("failed to open the lock file: %w", err)
+	}
+	if err := lockFile(f); err != nil {
+		_ = f.Close()
+		s.lock.Unlock()
+		return nil, fmt.Errorf("failed to lock: %w", err)
+	}
+	return func() {
+		_ = unlockFile(f)
+		_ = f.Close()
+		s.lock.Unlock()
+	}, nil
+}
+
+func (s *LocalPackStorage) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || key == "." || key == ".." || key == localPackLockFile {
+		return "", fmt.Errorf("invalid key: %s", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+type localPackWriter struct {
+	ctx      context.Context
+	s        *LocalPackStorage
+	key      string
+	metadata map[string]string
+	cond     func(map[string]string) bool
+	f        *os.File
+	err      error
+}
+
+func (w *localPackWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return w.f.Write(p)
+}
+
+func (w *localPackWriter) Close() error {
+	if w.f == nil {
+		return w.err
+	}
+	f := w.f
+	w.f = nil
+
+	if err := f.Close(); err != nil && w.err == nil {
+		w.err = err
+	}
+	if w.err == nil {
+		w.err = w.ctx.Err()
+	}
+	if w.err != nil {
+		_ = os.Remove(f.Name())
+		return w.err
+	}
+
+	p, _ := w.s.path(w.key)
+
+	unlock, err := w.s.lockDir()
+	if err != nil {
+		_ = os.Remove(f.Name())
+		w.err = err
+		return err
+	}
+	defer unlock()
+
+	created := time.Now()
+	m, err := readLocalPackMeta(p)
+	if err == nil {
+		created = m.Created
+	}
+	if w.cond != nil {
+		// the condition is checked under the lock so that the status changed by others is not overwritten
+		if _, sErr := os.Stat(p); sErr != nil {
+			err = notFound(sErr)
+		} else if err == nil && !w.cond(m.Metadata) {
+			err = ErrPackConditionNotMet
+		}
+		if err != nil {
+			_ = os.Remove(f.Name())
+			w.err = err
+			return err
+		}
+	}
+	if err := os.Rename(f.Name(), p); err != nil {
+		_ = os.Remove(f.Name())
+		w.err = err
+		return err
+	}
+	w.err = writeLocalPackMeta(p, localPackMeta{
+		Metadata: mergeMetadata(nil, w.metadata),
+		Created:  created,
+		Updated:  time.Now(),
+	})
+	return w.err
+}
+
+func readLocalPackMeta(p string) (localPackMeta, error) {
+	var m localPackMeta
+	b, err := os.ReadFile(p + localPackMetaSuffix)
+	if errors.Is(err, fs.ErrNotExist) {
+		// objects written without metadata
+		return m, nil
+	}
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("invalid metadata of %s: %w", filepath.Base(p), err)
+	}
+	return m, nil
+}
+
+// writeLocalPackMeta replaces the metadata file atomically so that other processes do not read a partial file.
+func writeLocalPackMeta(p string, m localPackMeta) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(p), filepath.Base(p)+localPackMetaSuffix+".*"+localPackTempSuffix)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), p+localPackMetaSuffix)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+func notFound(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrPackNotFound
+	}
+	return err
+}
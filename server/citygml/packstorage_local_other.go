@@ -0,0 +1,14 @@
+//go:build !unix
+
+package citygml
+
+import "os"
+
+// files are not locked on platforms other than Unix, where only the lock within the process is available.
+func lockFile(f *os.File) error {
+	return nil
+}
+
+func unlockFile(f *os.File) error {
+	return nil
+}
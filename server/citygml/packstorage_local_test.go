@@ -0,0 +1,118 @@
+package citygml
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalPackStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewLocalPackStorage(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Create(ctx, "a.zip", Status(PackStatusAccepted)))
+	assert.ErrorIs(t, s.Create(ctx, "a.zip", Status(PackStatusAccepted)), ErrPackExists)
+	assert.Equal(t, "file://"+filepath.ToSlash(filepath.Join(dir, "a.zip")), s.URL("a.zip"))
+
+	obj, err := s.Attrs(ctx, "a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, "a.zip", obj.Key)
+	assert.Equal(t, map[string]string{"status": PackStatusAccepted}, obj.Metadata)
+	assert.False(t, obj.Created.IsZero())
+
+	_, err = s.Attrs(ctx, "b.zip")
+	assert.ErrorIs(t, err, ErrPackNotFound)
+	_, err = s.Attrs(ctx, "../a.zip")
+	assert.Error(t, err)
+
+	// update metadata
+	isAccepted := func(m map[string]string) bool { return getStatus(m) == PackStatusAccepted }
+	require.NoError(t, s.UpdateMetadata(ctx, "a.zip", map[string]string{"status": PackStatusProcessing, "total": "10"}, isAccepted))
+	assert.ErrorIs(t, s.UpdateMetadata(ctx, "a.zip", Status(PackStatusProcessing), isAccepted), ErrPackConditionNotMet)
+	assert.ErrorIs(t, s.UpdateMetadata(ctx, "b.zip", Status(PackStatusProcessing), nil), ErrPackNotFound)
+	require.NoError(t, s.UpdateMetadata(ctx, "a.zip", map[string]string{"total": "", "processed": "1"}, nil))
+	obj, err = s.Attrs(ctx, "a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"status": PackStatusProcessing, "processed": "1"}, obj.Metadata)
+
+	// aborted writes do not replace the object
+	wctx, abort := context.WithCancel(ctx)
+	w := s.NewWriter(wctx, "a.zip", Status(PackStatusSucceeded), nil)
+	_, err = w.Write([]byte("aborted"))
+	require.NoError(t, err)
+	abort()
+	assert.ErrorIs(t, w.Close(), context.Canceled)
+	assert.Equal(t, "", readPackObject(t, s, "a.zip"))
+
+	// writes are not committed when the condition is not met on close
+	w = s.NewWriter(ctx, "a.zip", Status(PackStatusSucceeded), isAccepted)
+	_, err = w.Write([]byte("canceled"))
+	require.NoError(t, err)
+	assert.ErrorIs(t, w.Close(), ErrPackConditionNotMet)
+	assert.Equal(t, "", readPackObject(t, s, "a.zip"))
+
+	isProcessing := func(m map[string]string) bool { return getStatus(m) == PackStatusProcessing }
+	w = s.NewWriter(ctx, "a.zip", Status(PackStatusSucceeded), isProcessing)
+	_, err = w.Write([]byte("zip"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.Equal(t, "zip", readPackObject(t, s, "a.zip"))
+	obj2, err := s.Attrs(ctx, "a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"status": PackStatusSucceeded}, obj2.Metadata)
+	assert.Equal(t, obj.Created, obj2.Created)
+
+	// list
+	w = s.NewWriter(ctx, "a.txt", nil, nil)
+	_, _ = w.Write([]byte("https://example.com/a.gml\n"))
+	require.NoError(t, w.Close())
+	require.NoError(t, s.Create(ctx, "c.zip", Status(PackStatusAccepted)))
+	objs, err := s.List(ctx, ".zip")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.zip", "c.zip"}, packObjectKeys(objs))
+
+	// delete
+	require.NoError(t, s.Delete(ctx, "c.zip"))
+	assert.ErrorIs(t, s.Delete(ctx, "c.zip"), ErrPackNotFound)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 5) // a.zip, a.txt, their metadata and the lock file
+}
+
+func TestOpenPackStorage(t *testing.T) {
+	dir := t.TempDir()
+	s, key, err := OpenPackStorage(context.Background(), "file://"+filepath.ToSlash(filepath.Join(dir, "a.zip")))
+	require.NoError(t, err)
+	assert.Equal(t, "a.zip", key)
+	assert.Equal(t, "file://"+filepath.ToSlash(filepath.Join(dir, "a.zip")), s.URL(key))
+
+	_, _, err = OpenPackStorage(context.Background(), "https://example.com/a.zip")
+	assert.Error(t, err)
+	_, _, err = OpenPackStorage(context.Background(), "gs://bucket")
+	assert.Error(t, err)
+}
+
+func readPackObject(t *testing.T, s PackStorage, key string) string {
+	t.Helper()
+	r, err := s.NewReader(context.Background(), key)
+	require.NoError(t, err)
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func packObjectKeys(objs []*PackObject) []string {
+	res := make([]string, 0, len(objs))
+	for _, o := range objs {
+		res = append(res, o.Key)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+//go:build unix
+
+package citygml
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalPackStorage_Lock(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// storages of the same directory, such as ones of the server and the worker, exclude each other
+	s1, err := NewLocalPackStorage(dir)
+	require.NoError(t, err)
+	s2, err := NewLocalPackStorage(dir)
+	require.NoError(t, err)
+	require.NoError(t, s1.Create(ctx, "a.zip", Status(PackStatusAccepted)))
+
+	unlock, err := s1.lockDir()
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- s2.UpdateMetadata(ctx, "a.zip", Status(PackStatusProcessing), func(m map[string]string) bool {
+			return getStatus(m) == PackStatusAccepted
+		})
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("metadata is updated while locked")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	unlock()
+	require.NoError(t, <-done)
+	obj, err := s1.Attrs(ctx, "a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, PackStatusProcessing, getStatus(obj.Metadata))
+}
@@ -0,0 +1,81 @@
+package citygml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+	"github.com/eukarya-inc/reearth-plateauview/server/geo/spatialid"
+	"github.com/orisano/gosax/xmlb"
+)
+
+type FeatureSpatialIDs struct {
+	ID         string   `json:"id"`
+	SpatialIDs []string `json:"spatialIds"`
+}
+
+// SpatialIDs returns the spatial IDs at the zoom level that intersect the LOD1 solid of each feature with the gml:ids.
+// This is the reverse of SpatialIDAttributes. Features that are not found or do not have a LOD1 solid are omitted.
+func SpatialIDs(r io.Reader, gmlIDs []string, z int) ([]FeatureSpatialIDs, error) {
+	if z < 0 || z > spatialid.MaxZoom {
+		return nil, fmt.Errorf("invalid zoom: %d", z)
+	}
+
+	dec := xmlb.NewDecoder(r, make([]byte, 32*1024))
+	fs := &featureScanner{
+		Dec: dec,
+	}
+	var res []FeatureSpatialIDs
+	found := 0
+	h := &lod1FacesHandler{}
+	for fs.Scan() {
+		id, _ := fs.Feature()
+		if !slices.Contains(gmlIDs, id) {
+			if err := dec.Skip(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		found++
+		h.faces = h.faces[:0]
+		if _, err := processFeature(dec, h); err != nil {
+			return nil, err
+		}
+		if len(h.faces) > 0 {
+			vs, err := spatialid.CoverLOD1Solid(geo.ReconstructLOD1Solid(geo.Polyhedron(h.faces)), z)
+			if err != nil {
+				return nil, fmt.Errorf("feature %s: %w", id, err)
+			}
+			sids := make([]string, 0, len(vs))
+			for _, v := range vs {
+				sids = append(sids, v.String())
+			}
+			res = append(res, FeatureSpatialIDs{ID: id, SpatialIDs: sids})
+		}
+		if found == len(gmlIDs) {
+			return res, nil
+		}
+	}
+	if err := fs.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// lod1FacesHandler collects faces of the LOD1 solid of a feature and skips other attributes.
+type lod1FacesHandler struct {
+	faces  []geo.Polygon3
+	points []geo.Point3
+}
+
+func (h *lod1FacesHandler) HandleAttr(dec *xmlb.Decoder, el xml.StartElement) error {
+	if el.Name.Local != "lod1Solid" {
+		return dec.Skip()
+	}
+	var err error
+	h.points, h.faces, err = readLOD1Solid(dec, h.points[:0], h.faces[:0])
+	return err
+}
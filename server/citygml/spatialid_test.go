@@ -0,0 +1,84 @@
+package citygml
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpatialIDs(t *testing.T) {
+	b, err := os.ReadFile("testdata/" + testdata)
+	require.NoError(t, err)
+
+	ids := []string{
+		"bldg_53e2a9a9-d512-408f-8250-eae30b7523d6",
+		"bldg_c77c3e2b-ffdc-4b1a-91bf-185a1b46a4d1",
+		"not_found",
+	}
+	res, err := SpatialIDs(bytes.NewReader(b), ids, 18)
+	require.NoError(t, err)
+	assert.Equal(t, []FeatureSpatialIDs{
+		{
+			ID:         "bldg_53e2a9a9-d512-408f-8250-eae30b7523d6",
+			SpatialIDs: []string{"18/0/231814/103920", "18/0/231815/103920", "18/0/231814/103921", "18/0/231815/103921"},
+		},
+		{
+			ID:         "bldg_c77c3e2b-ffdc-4b1a-91bf-185a1b46a4d1",
+			SpatialIDs: []string{"18/0/231813/103922"},
+		},
+	}, res)
+
+	// the features are found by the spatial IDs again
+	for _, f := range res {
+		fs, err := Features(bytes.NewReader(b), f.SpatialIDs)
+		require.NoError(t, err)
+		assert.Contains(t, fs, f.ID)
+	}
+
+	_, err = SpatialIDs(bytes.NewReader(b), ids, 26)
+	assert.Error(t, err)
+}
+
+func TestSpatialIDHandler(t *testing.T) {
+	citygmlURL := "http://example.com/udx/bldg/52382287_bldg_6697_psc_op.gml"
+	citygml, err := os.ReadFile("testdata/" + testdata)
+	require.NoError(t, err)
+	httpmock.RegisterResponder(http.MethodGet, citygmlURL, httpmock.NewBytesResponder(http.StatusOK, citygml))
+	httpmock.Activate()
+	defer httpmock.Deactivate()
+
+	u := &url.URL{Path: "/spatialid"}
+	q := url.Values{}
+	q.Set("url", citygmlURL)
+	q.Set("id", "bldg_c77c3e2b-ffdc-4b1a-91bf-185a1b46a4d1")
+	q.Set("zoom", "18")
+	u.RawQuery = q.Encode()
+
+	req := httptest.NewRequest(http.MethodGet, u.String(), nil)
+	rec := httptest.NewRecorder()
+	e := echo.New()
+	c := e.NewContext(req, rec)
+	assert.NoError(t, spatialIDHandler("")(c))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var j map[string]any
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &j))
+	assert.Equal(t, map[string]any{
+		"features": []any{
+			map[string]any{
+				"id":         "bldg_c77c3e2b-ffdc-4b1a-91bf-185a1b46a4d1",
+				"spatialIds": []any{"18/0/231813/103922"},
+			},
+		},
+	}, j)
+}
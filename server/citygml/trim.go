@@ -0,0 +1,376 @@
+package citygml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+	"github.com/eukarya-inc/reearth-plateauview/server/geo/jisx0410"
+	"github.com/orisano/gosax/xmlb"
+)
+
+// AreaFilter selects city objects by spatial IDs, mesh codes and a bbox.
+// City objects that have a LOD1 solid are tested in the same way as Features.
+// Other city objects are tested by the 2D bounding box of all of their coordinates.
+type AreaFilter struct {
+	filter lod1SolidFilter
+}
+
+// NewAreaFilter creates an AreaFilter. bbox is [minLng, minLat, maxLng, maxLat] or
+// [minLng, minLat, minHeight, maxLng, maxLat, maxHeight].
+func NewAreaFilter(spatialIDs, meshCodes []string, bbox []float64) (*AreaFilter, error) {
+	filter, err := newLOD1SolidFilter(spatialIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range meshCodes {
+		mc, err := jisx0410.Parse(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mesh code: %w", err)
+		}
+		filter.Bounds = append(filter.Bounds, geo.Bounds3{
+			Min: geo.Point3{X: mc.Bounds.Min.X, Y: mc.Bounds.Min.Y, Z: math.Inf(-1)},
+			Max: geo.Point3{X: mc.Bounds.Max.X, Y: mc.Bounds.Max.Y, Z: math.Inf(1)},
+		})
+	}
+
+	if len(bbox) > 0 {
+		b, err := bboxToBounds(bbox)
+		if err != nil {
+			return nil, err
+		}
+		filter.Bounds = append(filter.Bounds, b)
+	}
+
+	return &AreaFilter{filter: filter}, nil
+}
+
+func bboxToBounds(bbox []float64) (geo.Bounds3, error) {
+	var b geo.Bounds3
+	switch len(bbox) {
+	case 4:
+		b = geo.Bounds3{
+			Min: geo.Point3{X: bbox[0], Y: bbox[1], Z: math.Inf(-1)},
+			Max: geo.Point3{X: bbox[2], Y: bbox[3], Z: math.Inf(1)},
+		}
+	case 6:
+		b = geo.Bounds3{
+			Min: geo.Point3{X: bbox[0], Y: bbox[1], Z: bbox[2]},
+			Max: geo.Point3{X: bbox[3], Y: bbox[4], Z: bbox[5]},
+		}
+	default:
+		return b, fmt.Errorf("invalid bbox: length must be 4 or 6")
+	}
+	if b.Min.X > b.Max.X || b.Min.Y > b.Max.Y || b.Min.Z > b.Max.Z {
+		return b, fmt.Errorf("invalid bbox: min must be less than max")
+	}
+	return b, nil
+}
+
+func (f *AreaFilter) IsEmpty() bool {
+	return f == nil || len(f.filter.Bounds) == 0
+}
+
+func (f *AreaFilter) isIntersect(faces []geo.Polygon3, points []geo.Point3) bool {
+	if len(faces) > 0 {
+		return f.filter.IsIntersect(faces)
+	}
+	if len(points) == 0 {
+		return false
+	}
+
+	pb := geo.Polygon3(points).Bounds().ToXY()
+	for _, b := range f.filter.Bounds {
+		if bb := b.ToXY(); pb.Min.X <= bb.Max.X && pb.Max.X >= bb.Min.X && pb.Min.Y <= bb.Max.Y && pb.Max.Y >= bb.Min.Y {
+			return true
+		}
+	}
+	return false
+}
+
+type TrimResult struct {
+	Total int
+	Kept  int
+}
+
+// Trim writes the CityGML read from r to w, keeping only core:cityObjectMember elements that intersect the filter.
+// app:surfaceDataMember elements in top-level app:appearanceMember elements are also kept only when they target the kept city objects.
+// Other parts of the document, including gml:boundedBy, are written as is.
+// r is read twice, so it must be seekable.
+func Trim(w io.Writer, r io.ReadSeeker, f *AreaFilter) (res TrimResult, err error) {
+	keep, ids, err := scanCityObjectMembers(r, f)
+	if err != nil {
+		return res, fmt.Errorf("scan: %w", err)
+	}
+
+	res.Total = len(keep)
+	for _, k := range keep {
+		if k {
+			res.Kept++
+		}
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return res, err
+	}
+
+	if err := writeTrimmed(w, r, keep, ids); err != nil {
+		return res, fmt.Errorf("write: %w", err)
+	}
+	return res, nil
+}
+
+// scanCityObjectMembers returns whether each core:cityObjectMember is kept and gml:ids of all elements in the kept members.
+func scanCityObjectMembers(r io.Reader, f *AreaFilter) ([]bool, map[string]struct{}, error) {
+	dec := xmlb.NewDecoder(r, make([]byte, 32*1024))
+	ids := map[string]struct{}{}
+
+	var keep []bool
+	var memberIDs []string
+	var faces []geo.Polygon3
+	var points []geo.Point3
+	depth := 0
+	inMember := false
+	solidDepth := 0
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch tok.Type() {
+		case xmlb.StartElement:
+			depth++
+			el := tok.StartElementBytes()
+			local := string(el.Name.Local())
+
+			if depth == 2 && local == "cityObjectMember" {
+				inMember = true
+				memberIDs = memberIDs[:0]
+				faces = faces[:0]
+				points = points[:0]
+			}
+			if !inMember {
+				break
+			}
+
+			if id, err := el.Attrs.Get("gml:id"); err == nil && len(id) > 0 {
+				memberIDs = append(memberIDs, string(id))
+			}
+
+			switch local {
+			case "lod1Solid":
+				if solidDepth == 0 {
+					solidDepth = depth
+				}
+			case "posList":
+				t, err := dec.Text()
+				if err != nil {
+					return nil, nil, err
+				}
+				if t == "" {
+					break
+				}
+				if solidDepth > 0 {
+					begin := len(points)
+					points, err = parsePosList(points, t)
+					if err != nil {
+						return nil, nil, fmt.Errorf("parse posList: %w", err)
+					}
+					faces = append(faces, points[begin:])
+				} else {
+					points = parseCoordinates(points, t)
+				}
+			}
+		case xmlb.EndElement:
+			if inMember && depth == 2 {
+				k := f.isIntersect(faces, points)
+				keep = append(keep, k)
+				if k {
+					for _, id := range memberIDs {
+						ids[id] = struct{}{}
+					}
+				}
+				inMember = false
+			}
+			depth--
+			if depth < solidDepth {
+				solidDepth = 0
+			}
+		}
+	}
+
+	return keep, ids, nil
+}
+
+func writeTrimmed(w io.Writer, r io.Reader, keep []bool, ids map[string]struct{}) error {
+	dec := xmlb.NewDecoder(r, make([]byte, 32*1024))
+
+	depth := 0
+	member := -1
+	skipDepth := 0
+	inAppearance := false
+	var sdm *surfaceDataMember
+
+	write := func(b []byte) error {
+		if sdm != nil {
+			sdm.buf.Write(b)
+			return nil
+		}
+		_, err := w.Write(b)
+		return err
+	}
+
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch tok.Type() {
+		case xmlb.StartElement:
+			depth++
+			if skipDepth > 0 {
+				continue
+			}
+
+			el := tok.StartElementBytes()
+			local := string(el.Name.Local())
+
+			if depth == 2 {
+				switch local {
+				case "cityObjectMember":
+					member++
+					if member >= len(keep) || !keep[member] {
+						skipDepth = depth
+						continue
+					}
+				case "appearanceMember":
+					inAppearance = true
+				}
+			}
+
+			if inAppearance {
+				if local == "surfaceDataMember" && sdm == nil {
+					sdm = &surfaceDataMember{depth: depth}
+				} else if sdm != nil {
+					sdm.addRefFromAttrs(el.Attrs)
+				}
+			}
+
+			if err := write(tok.Bytes); err != nil {
+				return err
+			}
+
+			// peeking the text may overwrite the buffer of the token, so the token must be written first
+			if sdm != nil && local == "target" {
+				t, err := dec.Text()
+				if err != nil {
+					return err
+				}
+				sdm.addRef(t)
+			}
+		case xmlb.EndElement:
+			d := depth
+			depth--
+
+			if skipDepth > 0 {
+				if d == skipDepth {
+					skipDepth = 0
+				}
+				continue
+			}
+
+			// self-closing tags emit the same bytes as both start and end
+			if isEndTag(tok) {
+				if err := write(tok.Bytes); err != nil {
+					return err
+				}
+			}
+
+			if sdm != nil && d == sdm.depth {
+				s := sdm
+				sdm = nil
+				if s.isReferred(ids) {
+					if _, err := w.Write(s.buf.Bytes()); err != nil {
+						return err
+					}
+				}
+			}
+			if d == 2 {
+				inAppearance = false
+			}
+		default:
+			if skipDepth > 0 {
+				continue
+			}
+			if err := write(tok.Bytes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type surfaceDataMember struct {
+	depth int
+	buf   bytes.Buffer
+	refs  []string
+}
+
+func (s *surfaceDataMember) addRefFromAttrs(attrs xmlb.AttributesBytes) {
+	for _, k := range []string{"uri", "ring"} {
+		if v, err := attrs.Get(k); err == nil {
+			s.addRef(string(v))
+		}
+	}
+}
+
+func (s *surfaceDataMember) addRef(r string) {
+	if r = strings.TrimPrefix(strings.TrimSpace(r), "#"); r != "" {
+		s.refs = append(s.refs, r)
+	}
+}
+
+func (s *surfaceDataMember) isReferred(ids map[string]struct{}) bool {
+	for _, r := range s.refs {
+		if _, ok := ids[r]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isEndTag(tok xmlb.Token) bool {
+	return bytes.HasPrefix(tok.Bytes, []byte("</"))
+}
+
+// parseCoordinates parses gml:posList leniently and appends all points to dest.
+// Unlike parsePosList, it accepts line strings and keeps the closing point of rings.
+func parseCoordinates(dest []geo.Point3, t string) []geo.Point3 {
+	fields := strings.Fields(t)
+	for i := 0; i+2 < len(fields); i += 3 {
+		y, err1 := strconv.ParseFloat(fields[i], 64)
+		x, err2 := strconv.ParseFloat(fields[i+1], 64)
+		z, err3 := strconv.ParseFloat(fields[i+2], 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		dest = append(dest, geo.Point3{X: x, Y: y, Z: z})
+	}
+	return dest
+}
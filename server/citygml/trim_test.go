@@ -0,0 +1,127 @@
+package citygml
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrim(t *testing.T) {
+	b, err := os.ReadFile("testdata/" + testdata)
+	require.NoError(t, err)
+
+	f, err := NewAreaFilter([]string{"/18/0/231815/103921"}, nil, nil)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	res, err := Trim(buf, bytes.NewReader(b), f)
+	require.NoError(t, err)
+	assert.Equal(t, TrimResult{Total: 4, Kept: 2}, res)
+	assert.Equal(t, 2, strings.Count(buf.String(), "<core:cityObjectMember>"))
+
+	fs, err := Features(bytes.NewReader(buf.Bytes()), []string{"/0/0/0/0"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"bldg_53e2a9a9-d512-408f-8250-eae30b7523d6",
+		"bldg_2eb12f7a-c5d9-4145-9609-a6a0f5824368",
+	}, fs)
+}
+
+func TestTrim_Appearance(t *testing.T) {
+	gml := `<?xml version="1.0" encoding="UTF-8"?>
+<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0" xmlns:gml="http://www.opengis.net/gml" xmlns:app="http://www.opengis.net/citygml/appearance/2.0" xmlns:tran="http://www.opengis.net/citygml/transportation/2.0">
+	<core:cityObjectMember>
+		<tran:Road gml:id="road1">
+			<gml:Polygon gml:id="poly1"><gml:exterior><gml:LinearRing><gml:posList>35.0 139.0 0 35.0 139.1 0 35.1 139.1 0 35.0 139.0 0</gml:posList></gml:LinearRing></gml:exterior></gml:Polygon>
+		</tran:Road>
+	</core:cityObjectMember>
+	<core:cityObjectMember>
+		<tran:Road gml:id="road2">
+			<gml:Polygon gml:id="poly2"><gml:exterior><gml:LinearRing><gml:posList>36.0 140.0 0 36.0 140.1 0 36.1 140.1 0 36.0 140.0 0</gml:posList></gml:LinearRing></gml:exterior></gml:Polygon>
+		</tran:Road>
+	</core:cityObjectMember>
+	<core:cityObjectMember xlink:href="#road3"/>
+	<app:appearanceMember>
+		<app:Appearance>
+			<app:surfaceDataMember>
+				<app:ParameterizedTexture>
+					<app:imageURI>a.jpg</app:imageURI>
+					<app:target uri="#poly1"/>
+				</app:ParameterizedTexture>
+			</app:surfaceDataMember>
+			<app:surfaceDataMember>
+				<app:ParameterizedTexture>
+					<app:imageURI>b.jpg</app:imageURI>
+					<app:target uri="#poly2"/>
+				</app:ParameterizedTexture>
+			</app:surfaceDataMember>
+			<app:surfaceDataMember>
+				<app:X3DMaterial>
+					<app:target>#poly1</app:target>
+				</app:X3DMaterial>
+			</app:surfaceDataMember>
+		</app:Appearance>
+	</app:appearanceMember>
+</core:CityModel>
+`
+
+	f, err := NewAreaFilter(nil, nil, []float64{138.95, 34.95, 139.05, 35.05})
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	res, err := Trim(buf, strings.NewReader(gml), f)
+	require.NoError(t, err)
+	assert.Equal(t, TrimResult{Total: 3, Kept: 1}, res)
+
+	out := buf.String()
+	assert.Contains(t, out, `gml:id="road1"`)
+	assert.NotContains(t, out, `gml:id="road2"`)
+	assert.NotContains(t, out, `#road3`)
+	assert.Contains(t, out, "a.jpg")
+	assert.NotContains(t, out, "b.jpg")
+	assert.Contains(t, out, "<app:target>#poly1</app:target>")
+	assert.True(t, strings.HasPrefix(out, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.True(t, strings.HasSuffix(out, "</core:CityModel>\n"))
+}
+
+func TestWriteTrimmed_ShortRead(t *testing.T) {
+	// the document is larger than the buffer of the decoder so that the buffer is compacted while texts of targets are peeked
+	sb := &strings.Builder{}
+	sb.WriteString(`<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0" xmlns:app="http://www.opengis.net/citygml/appearance/2.0"><app:appearanceMember><app:Appearance>`)
+	ids := map[string]struct{}{}
+	for i := range 5000 {
+		fmt.Fprintf(sb, "<app:surfaceDataMember><app:X3DMaterial><app:target>#poly%d</app:target></app:X3DMaterial></app:surfaceDataMember>\n", i)
+		ids[fmt.Sprintf("poly%d", i)] = struct{}{}
+	}
+	sb.WriteString(`</app:Appearance></app:appearanceMember></core:CityModel>`)
+	gml := sb.String()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeTrimmed(buf, iotest.OneByteReader(strings.NewReader(gml)), nil, ids))
+	assert.Equal(t, gml, buf.String())
+}
+
+func TestNewAreaFilter(t *testing.T) {
+	f, err := NewAreaFilter(nil, []string{"53394611"}, nil)
+	require.NoError(t, err)
+	assert.False(t, f.IsEmpty())
+
+	f, err = NewAreaFilter(nil, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, f.IsEmpty())
+
+	_, err = NewAreaFilter(nil, []string{"x"}, nil)
+	assert.Error(t, err)
+	_, err = NewAreaFilter(nil, nil, []float64{1, 2, 3})
+	assert.Error(t, err)
+	_, err = NewAreaFilter(nil, nil, []float64{2, 2, 1, 3})
+	assert.Error(t, err)
+	_, err = NewAreaFilter([]string{"invalid"}, nil, nil)
+	assert.Error(t, err)
+}
@@ -32,6 +32,12 @@ type Config struct {
 	TaskImage  string
 	GCPProject string
 	GCPRegion  string
+	// local task runner
+	LocalTaskRunner   bool
+	LocalWorkerBinary string
+	LocalWorkerLogDir string
+	// LocalWorkerWait makes requests wait for local tasks to finish, which is useful for debugging.
+	LocalWorkerWait bool
 	// Flow
 	FlowBaseURL string
 	FlowToken   string
@@ -35,7 +35,23 @@ func NewServices(c Config) (s *Services, _ error) {
 	}
 	s.PCMS = pcms
 
-	if c.GCPProject != "" {
+	if c.LocalTaskRunner {
+		s.TaskRunner = gcptaskrunner.NewGCPTaskRunner(gcptaskrunner.Config{
+			Service: gcptaskrunner.ServiceLocal,
+			Task: gcptaskrunner.Task{
+				Image: c.WorkerImage,
+			},
+			Env: map[string]string{
+				"REEARTH_CMS_URL":   c.CMSBaseURL,
+				"REEARTH_CMS_TOKEN": c.CMSToken,
+				"NO_COLOR":          "true",
+			},
+			Timeout:     "86400s", // 1 day
+			LocalBinary: c.LocalBinary,
+			LocalLogDir: c.LocalLogDir,
+			LocalWait:   c.LocalWait,
+		})
+	} else if c.GCPProject != "" {
 		image := c.WorkerImage
 
 		s.TaskRunner = gcptaskrunner.NewGCPTaskRunner(gcptaskrunner.Config{
@@ -17,6 +17,10 @@ type Config struct {
 	CMSSystemProject string
 	CMSIntegration   string
 	WorkerImage      string
+	LocalTaskRunner  bool
+	LocalBinary      string
+	LocalLogDir      string
+	LocalWait        bool
 }
 
 func WebhookHandler(conf Config) (cmswebhook.Handler, error) {
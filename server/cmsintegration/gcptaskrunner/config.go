@@ -19,6 +19,11 @@ type Config struct {
 	DiskSizeGb  int64
 	Timeout     string
 	QueueTtl    string
+	// Local
+	LocalBinary string
+	LocalDocker string
+	LocalLogDir string
+	LocalWait   bool
 }
 
 func MergeConfigs(conf ...Config) Config {
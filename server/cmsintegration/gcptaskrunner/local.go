@@ -0,0 +1,230 @@
+package gcptaskrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/reearth/reearthx/log"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultDockerCommand = "docker"
+	localLogTailSize     = 4 * 1024
+	localWaitDelay       = 10 * time.Second
+)
+
+// LocalExitError is returned by RunLocal when the task exits with a non-zero status or times out.
+type LocalExitError struct {
+	ExitCode int
+	TimedOut bool
+	// Log is the tail of the output of the task.
+	Log string
+}
+
+func (e *LocalExitError) Error() string {
+	if e.TimedOut {
+		return "task timed out"
+	}
+	return fmt.Sprintf("task exited with status %d", e.ExitCode)
+}
+
+// RunLocal runs the task on this machine: the worker binary at conf.LocalBinary if set, otherwise task.Image with Docker.
+// Stdout and stderr are written to a file in conf.LocalLogDir if set.
+// If conf.LocalWait is true, it waits for the task and returns its exit status as LocalExitError.
+// Otherwise it returns after the task starts, in the same way as the cloud services, and the exit status is only logged.
+func RunLocal(ctx context.Context, task Task, conf Config) error {
+	name, args, err := localCommand(task, conf)
+	if err != nil {
+		return err
+	}
+
+	if !conf.LocalWait {
+		// the task should keep running after the request that started it is finished
+		ctx = context.WithoutCancel(ctx)
+	}
+
+	p, err := startLocal(ctx, name, args, mergeEnv(conf.Env, task.Env), conf)
+	if err != nil {
+		return err
+	}
+
+	if conf.LocalWait {
+		return p.Wait()
+	}
+
+	go func() {
+		if err := p.Wait(); err != nil {
+			log.Errorfc(ctx, "gcptaskrunner: local task failed: %s: %v\n%s", name, err, tailLog(err))
+			return
+		}
+		log.Infofc(ctx, "gcptaskrunner: local task finished: %s", name)
+	}()
+	return nil
+}
+
+func localCommand(task Task, conf Config) (string, []string, error) {
+	if conf.LocalBinary != "" {
+		return conf.LocalBinary, task.Args, nil
+	}
+
+	if task.Image == "" {
+		return "", nil, ErrImageEmpty
+	}
+
+	docker := conf.LocalDocker
+	if docker == "" {
+		docker = defaultDockerCommand
+	}
+
+	// only names are passed so that values such as tokens do not appear in the process list; values are read from cmd.Env
+	args := []string{"run", "--rm"}
+	keys := lo.Keys(mergeEnv(conf.Env, task.Env))
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "-e", k)
+	}
+	args = append(args, task.Image)
+	args = append(args, task.Args...)
+	return docker, args, nil
+}
+
+type localProcess struct {
+	ctx     context.Context
+	cmd     *exec.Cmd
+	cancel  context.CancelFunc
+	tail    *tailBuffer
+	logFile *os.File
+}
+
+func startLocal(ctx context.Context, name string, args []string, env map[string]string, conf Config) (*localProcess, error) {
+	cancel := context.CancelFunc(func() {})
+	if d := parseDuration(conf.Timeout); d > 0 {
+		ctx, cancel = context.WithTimeout(ctx, d)
+	}
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(os.Environ(), envToSortedSlices(env)...)
+	// child processes may keep stdout open after the task is killed
+	cmd.WaitDelay = localWaitDelay
+
+	p := &localProcess{
+		ctx:    ctx,
+		cmd:    cmd,
+		cancel: cancel,
+		tail:   &tailBuffer{size: localLogTailSize},
+	}
+
+	var w io.Writer = p.tail
+	if conf.LocalLogDir != "" {
+		f, err := createLocalLogFile(conf.LocalLogDir, conf.Tags)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		p.logFile = f
+		w = io.MultiWriter(p.tail, f)
+	}
+	cmd.Stdout = w
+	cmd.Stderr = w
+
+	if err := cmd.Start(); err != nil {
+		p.close()
+		return nil, fmt.Errorf("failed to start task: %w", err)
+	}
+
+	log.Infofc(ctx, "gcptaskrunner: local task started: %s (pid %d)", name, cmd.Process.Pid)
+	return p, nil
+}
+
+func (p *localProcess) Wait() error {
+	defer p.close()
+
+	err := p.cmd.Wait()
+	if err == nil {
+		return nil
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return err
+	}
+	return &LocalExitError{
+		ExitCode: exitErr.ExitCode(),
+		TimedOut: errors.Is(p.ctx.Err(), context.DeadlineExceeded),
+		Log:      p.tail.String(),
+	}
+}
+
+func (p *localProcess) close() {
+	p.cancel()
+	if p.logFile != nil {
+		_ = p.logFile.Close()
+	}
+}
+
+func createLocalLogFile(dir string, tags []string) (*os.File, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log dir: %w", err)
+	}
+
+	name := "task"
+	if len(tags) > 0 {
+		name = strings.Join(tags, "-")
+	}
+	name = fmt.Sprintf("%s-%s.log", name, time.Now().Format("20060102-150405.000000000"))
+
+	f, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log file: %w", err)
+	}
+	return f, nil
+}
+
+func tailLog(err error) string {
+	var exitErr *LocalExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Log
+	}
+	return ""
+}
+
+func envToSortedSlices(env map[string]string) []string {
+	envs := envToSlices(env)
+	sort.Strings(envs)
+	return envs
+}
+
+// tailBuffer keeps only the last size bytes written to it.
+type tailBuffer struct {
+	size int
+	buf  []byte
+	lock sync.Mutex
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - b.size; over > 0 {
+		b.buf = append(b.buf[:0], b.buf[over:]...)
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return string(b.buf)
+}
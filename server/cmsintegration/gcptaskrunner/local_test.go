@@ -0,0 +1,121 @@
+package gcptaskrunner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		dir := t.TempDir()
+		err := RunLocal(ctx, Task{
+			Args: []string{"-c", "echo $KEY $KEY2"},
+			Env:  map[string]string{"KEY2": "val2"},
+		}, Config{
+			Env:         map[string]string{"KEY": "val"},
+			Tags:        []string{"test"},
+			LocalBinary: "sh",
+			LocalLogDir: dir,
+			LocalWait:   true,
+		})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "test-*.log"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		b, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Equal(t, "val val2\n", string(b))
+	})
+
+	t.Run("exit status", func(t *testing.T) {
+		err := RunLocal(ctx, Task{
+			Args: []string{"-c", "echo failed >&2; exit 3"},
+		}, Config{
+			LocalBinary: "sh",
+			LocalWait:   true,
+		})
+		assert.Equal(t, &LocalExitError{ExitCode: 3, Log: "failed\n"}, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		err := RunLocal(ctx, Task{
+			Args: []string{"-c", "exec sleep 10"},
+		}, Config{
+			LocalBinary: "sh",
+			LocalWait:   true,
+			Timeout:     "1s",
+		})
+		var exitErr *LocalExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.True(t, exitErr.TimedOut)
+	})
+
+	t.Run("docker", func(t *testing.T) {
+		dir := t.TempDir()
+		docker := filepath.Join(dir, "docker")
+		require.NoError(t, os.WriteFile(docker, []byte("#!/bin/sh\necho \"$@\" $KEY\n"), 0755))
+
+		err := RunLocal(ctx, Task{
+			Image: "img",
+			Args:  []string{"cmd"},
+		}, Config{
+			Env:         map[string]string{"KEY": "secret"},
+			Tags:        []string{"test"},
+			LocalDocker: docker,
+			LocalLogDir: dir,
+			LocalWait:   true,
+		})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "test-*.log"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		b, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		// the value is passed with the environment, not with the arguments
+		assert.Equal(t, "run --rm -e KEY img cmd secret\n", string(b))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := RunLocal(ctx, Task{}, Config{
+			LocalBinary: filepath.Join(t.TempDir(), "not-found"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("no image", func(t *testing.T) {
+		err := RunLocal(ctx, Task{}, Config{})
+		assert.Equal(t, ErrImageEmpty, err)
+	})
+}
+
+func TestLocalCommand(t *testing.T) {
+	name, args, err := localCommand(Task{
+		Image: "img",
+		Args:  []string{"extract-maxlod", "--wetrun"},
+		Env:   map[string]string{"B": "2"},
+	}, Config{
+		Env: map[string]string{"A": "1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "docker", name)
+	assert.Equal(t, []string{"run", "--rm", "-e", "A", "-e", "B", "img", "extract-maxlod", "--wetrun"}, args)
+
+	name, args, err = localCommand(Task{
+		Image: "img",
+		Args:  []string{"extract-maxlod"},
+	}, Config{
+		LocalBinary: "./worker",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "./worker", name)
+	assert.Equal(t, []string{"extract-maxlod"}, args)
+}
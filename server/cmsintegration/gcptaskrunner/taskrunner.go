@@ -16,6 +16,7 @@ const (
 	ServiceCloudBatch   Service = "cloudbatch"
 	ServiceCloudBuild   Service = "cloudbuild"
 	ServiceCloudRunJobs Service = "cloudrunjobs"
+	ServiceLocal        Service = "local"
 )
 
 type TaskRunner interface {
@@ -51,6 +52,8 @@ func (r *GCPTaskRunner) Run(ctx context.Context, task Task, conf *Config) error
 		return RunCloudBatch(ctx, t, c)
 	case ServiceCloudRunJobs:
 		return RunCloudRunJobs(ctx, t, c)
+	case ServiceLocal:
+		return RunLocal(ctx, t, c)
 	}
 
 	return nil
@@ -23,6 +23,10 @@ type Config struct {
 	CloudBuildProject     string
 	CloudBuildRegion      string
 	CloudBuildDiskSizeGb  int64
+	// local
+	LocalWorkerBinary string
+	LocalWorkerLogDir string
+	LocalWorkerWait   bool
 }
 
 var reReiwa = regexp.MustCompile(`令和([0-9]+?)年度?`)
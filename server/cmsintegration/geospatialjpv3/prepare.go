@@ -7,6 +7,8 @@ import (
 func Prepare(ctx context.Context, itemID, projectID string, conf Config, featureTypes []string) error {
 	if conf.BuildType == "cloudrunjobs" {
 		return prepareWithCloudRunJobs(ctx, itemID, projectID, conf.CloudRunJobsJobName, featureTypes)
+	} else if conf.BuildType == "local" {
+		return prepareLocally(ctx, itemID, projectID, conf, featureTypes)
 	} else {
 		return prepareOnCloudBuild(ctx, prepareOnCloudBuildConfig{
 			City:                  itemID,
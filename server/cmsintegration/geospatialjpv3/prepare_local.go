@@ -0,0 +1,39 @@
+package geospatialjpv3
+
+import (
+	"context"
+	"strings"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/cmsintegration/gcptaskrunner"
+	"github.com/reearth/reearthx/log"
+)
+
+func prepareLocally(ctx context.Context, itemID, projectID string, conf Config, featureTypes []string) error {
+	image := conf.CloudBuildImage
+	if image == "" {
+		image = defaultDockerImage
+	}
+
+	log.Debugfc(ctx, "geospatialjp webhook: prepare (local): %s", itemID)
+
+	return gcptaskrunner.RunLocal(ctx, gcptaskrunner.Task{
+		Image: image,
+		Args: []string{
+			"prepare-gspatialjp",
+			"--city=" + itemID,
+			"--project=" + projectID,
+			"--feature-types=" + strings.Join(featureTypes, ","),
+			"--wetrun",
+		},
+		Env: map[string]string{
+			"REEARTH_CMS_URL":   conf.CMSBase,
+			"REEARTH_CMS_TOKEN": conf.CMSToken,
+			"NO_COLOR":          "true",
+		},
+	}, gcptaskrunner.Config{
+		Tags:        []string{"prepare-geospatialjp"},
+		LocalBinary: conf.LocalWorkerBinary,
+		LocalLogDir: conf.LocalWorkerLogDir,
+		LocalWait:   conf.LocalWorkerWait,
+	})
+}
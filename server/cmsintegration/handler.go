@@ -119,6 +119,10 @@ func maxlodConfig(conf Config) cmsintmaxlod.Config {
 		GCPProject:       conf.GCPProject,
 		GCPRegion:        conf.GCPRegion,
 		WorkerImage:      conf.TaskImage,
+		LocalTaskRunner:  conf.LocalTaskRunner,
+		LocalBinary:      conf.LocalWorkerBinary,
+		LocalLogDir:      conf.LocalWorkerLogDir,
+		LocalWait:        conf.LocalWorkerWait,
 	}
 }
 
@@ -148,6 +152,11 @@ func geospatialjpv2Config(conf Config) geospatialjpv2.Config {
 }
 
 func geospatialjpv3Config(conf Config) geospatialjpv3.Config {
+	buildType := conf.GeospatialjpBuildType
+	if conf.LocalTaskRunner {
+		buildType = "local"
+	}
+
 	return geospatialjpv3.Config{
 		CMSBase:               conf.CMSBaseURL,
 		CMSToken:              conf.CMSToken,
@@ -156,13 +165,16 @@ func geospatialjpv3Config(conf Config) geospatialjpv3.Config {
 		CkanBase:              conf.CkanBaseURL,
 		CkanOrg:               conf.CkanOrg,
 		CkanToken:             conf.CkanToken,
-		BuildType:             conf.GeospatialjpBuildType,
+		BuildType:             buildType,
 		CloudRunJobsJobName:   conf.GeospatialjpCloudRunJobsJobName,
 		CloudBuildImage:       conf.TaskImage,
 		CloudBuildMachineType: conf.GeospatialjpCloudBuildMachineType,
 		CloudBuildProject:     conf.GeospatialjpCloudBuildProject,
 		CloudBuildRegion:      conf.GeospatialjpCloudBuildRegion,
 		CloudBuildDiskSizeGb:  conf.GeospatialjpCloudBuildDiskSizeGb,
+		LocalWorkerBinary:     conf.LocalWorkerBinary,
+		LocalWorkerLogDir:     conf.LocalWorkerLogDir,
+		LocalWorkerWait:       conf.LocalWorkerWait,
 	}
 }
 
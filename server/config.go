@@ -31,72 +31,85 @@ func init() {
 const configPrefix = "REEARTH_PLATEAUVIEW"
 
 type Config struct {
-	Port                               uint     `default:"8080" envconfig:"PORT"`
-	Host                               string   `default:"http://localhost:8080"`
-	GOOGLE_CLOUD_PROJECT               string   `envconfig:"GOOGLE_CLOUD_PROJECT" pp:",omitempty"`
-	GOOGLE_CLOUD_REGION                string   `envconfig:"GOOGLE_CLOUD_REGION" pp:",omitempty"`
-	Debug                              bool     `pp:",omitempty"`
-	Origin                             []string `pp:",omitempty"`
-	Secret                             string   `pp:",omitempty"`
-	Delegate_URL                       string   `pp:",omitempty"`
-	CMS_Webhook_Secret                 string   `pp:",omitempty"`
-	CMS_BaseURL                        string   `pp:",omitempty"`
-	CMS_Token                          string   `pp:",omitempty"`
-	CMS_IntegrationID                  string   `pp:",omitempty"`
-	CMS_PlateauProject                 string   `pp:",omitempty"`
-	CMS_SystemProject                  string   `pp:",omitempty"`
-	CMS_TokenProject                   string   `pp:",omitempty"`
-	FME_BaseURL                        string   `pp:",omitempty"`
-	FME_BaseURL_V2                     string   `pp:",omitempty"`
-	FME_URL_V3                         string   `pp:",omitempty"`
-	FME_Mock                           bool     `pp:",omitempty"`
-	FME_Token                          string   `pp:",omitempty"`
-	FME_SkipQualityCheck               bool     `pp:",omitempty"`
-	Ckan_BaseURL                       string   `pp:",omitempty"`
-	Ckan_Org                           string   `pp:",omitempty"`
-	Ckan_Token                         string   `pp:",omitempty"`
-	Ckan_Private                       bool     `pp:",omitempty"`
-	SDK_Token                          string   `pp:",omitempty"`
-	SendGrid_APIKey                    string   `pp:",omitempty"`
-	Opinion_From                       string   `pp:",omitempty"`
-	Opinion_FromName                   string   `pp:",omitempty"`
-	Opinion_To                         string   `pp:",omitempty"`
-	Opinion_ToName                     string   `pp:",omitempty"`
-	Sidebar_Token                      string   `pp:",omitempty"`
-	Share_Disable                      bool     `pp:",omitempty"`
-	CMSINT_TaskImage                   string   `pp:",omitempty"`
-	Geospatialjp_Publication_Disable   bool     `pp:",omitempty"`
-	Geospatialjp_CatalocCheck_Disable  bool     `pp:",omitempty"`
-	Geospatialjp_BuildType             string   `pp:",omitempty"`
-	Geospatialjp_JobName               string   `pp:",omitempty"`
-	Geospatialjp_CloudBuildImage       string   `pp:",omitempty"`
-	Geospatialjp_CloudBuildMachineType string   `pp:",omitempty"`
-	Geospatialjp_CloudBuildProject     string   `pp:",omitempty"`
-	Geospatialjp_CloudBuildRegion      string   `pp:",omitempty"`
-	Geospatialjp_CloudBuildDiskSizeGb  int64    `pp:",omitempty"`
-	DataConv_Disable                   bool     `pp:",omitempty"`
-	Indexer_Delegate                   bool     `pp:",omitempty"`
-	DataCatalog_DisableCache           bool     `pp:",omitempty"`
-	DataCatalog_CacheUpdateKey         string   `pp:",omitempty"`
-	DataCatalog_PlaygroundEndpoint     string   `pp:",omitempty"`
-	DataCatalog_CacheTTL               int      `pp:",omitempty"`
-	DataCatalog_GQL_MaxComplexity      int      `pp:",omitempty"`
-	DataCatalog_PanicOnInit            bool     `pp:",omitempty"`
-	DataCatalog_GeocodingAppID         string   `pp:",omitempty"`
-	DataCatalog_DiskCache              bool     `pp:",omitempty"`
-	DataCatalog_Debug                  bool     `pp:",omitempty"`
-	GCParcent                          int      `pp:",omitempty"`
-	CityGML_Domain                     string   `pp:",omitempty"`
-	CityGML_Bucket                     string   `pp:",omitempty"`
-	CityGML_CityGMLPackerImage         string   `pp:",omitempty"`
-	CityGML_WorkerRegion               string   `pp:",omitempty"`
-	CityGML_WorkerProject              string   `pp:",omitempty"`
-	CityGML_PackerTimeout              uint     `pp:",omitempty"`
-	Flow_BaseURL                       string   `pp:",omitempty"`
-	Flow_Token                         string   `pp:",omitempty"`
-	Tiles_Cache_Control                string   `pp:",omitempty"`
-	Chiitiler_URL                      string   `pp:",omitempty"`
-	Chiitiler_Bucket                   string   `pp:",omitempty"`
+	Port                               uint              `default:"8080" envconfig:"PORT"`
+	Host                               string            `default:"http://localhost:8080"`
+	GOOGLE_CLOUD_PROJECT               string            `envconfig:"GOOGLE_CLOUD_PROJECT" pp:",omitempty"`
+	GOOGLE_CLOUD_REGION                string            `envconfig:"GOOGLE_CLOUD_REGION" pp:",omitempty"`
+	Debug                              bool              `pp:",omitempty"`
+	Origin                             []string          `pp:",omitempty"`
+	Secret                             string            `pp:",omitempty"`
+	Delegate_URL                       string            `pp:",omitempty"`
+	CMS_Webhook_Secret                 string            `pp:",omitempty"`
+	CMS_BaseURL                        string            `pp:",omitempty"`
+	CMS_Token                          string            `pp:",omitempty"`
+	CMS_IntegrationID                  string            `pp:",omitempty"`
+	CMS_PlateauProject                 string            `pp:",omitempty"`
+	CMS_SystemProject                  string            `pp:",omitempty"`
+	CMS_TokenProject                   string            `pp:",omitempty"`
+	FME_BaseURL                        string            `pp:",omitempty"`
+	FME_BaseURL_V2                     string            `pp:",omitempty"`
+	FME_URL_V3                         string            `pp:",omitempty"`
+	FME_Mock                           bool              `pp:",omitempty"`
+	FME_Token                          string            `pp:",omitempty"`
+	FME_SkipQualityCheck               bool              `pp:",omitempty"`
+	Ckan_BaseURL                       string            `pp:",omitempty"`
+	Ckan_Org                           string            `pp:",omitempty"`
+	Ckan_Token                         string            `pp:",omitempty"`
+	Ckan_Private                       bool              `pp:",omitempty"`
+	SDK_Token                          string            `pp:",omitempty"`
+	SendGrid_APIKey                    string            `pp:",omitempty"`
+	Opinion_From                       string            `pp:",omitempty"`
+	Opinion_FromName                   string            `pp:",omitempty"`
+	Opinion_To                         string            `pp:",omitempty"`
+	Opinion_ToName                     string            `pp:",omitempty"`
+	Sidebar_Token                      string            `pp:",omitempty"`
+	Share_Disable                      bool              `pp:",omitempty"`
+	CMSINT_TaskImage                   string            `pp:",omitempty"`
+	Worker_Local                       bool              `pp:",omitempty"`
+	Worker_LocalBinary                 string            `pp:",omitempty"`
+	Worker_LocalLogDir                 string            `pp:",omitempty"`
+	Worker_LocalWait                   bool              `pp:",omitempty"`
+	Geospatialjp_Publication_Disable   bool              `pp:",omitempty"`
+	Geospatialjp_CatalocCheck_Disable  bool              `pp:",omitempty"`
+	Geospatialjp_BuildType             string            `pp:",omitempty"`
+	Geospatialjp_JobName               string            `pp:",omitempty"`
+	Geospatialjp_CloudBuildImage       string            `pp:",omitempty"`
+	Geospatialjp_CloudBuildMachineType string            `pp:",omitempty"`
+	Geospatialjp_CloudBuildProject     string            `pp:",omitempty"`
+	Geospatialjp_CloudBuildRegion      string            `pp:",omitempty"`
+	Geospatialjp_CloudBuildDiskSizeGb  int64             `pp:",omitempty"`
+	DataConv_Disable                   bool              `pp:",omitempty"`
+	Indexer_Delegate                   bool              `pp:",omitempty"`
+	DataCatalog_DisableCache           bool              `pp:",omitempty"`
+	DataCatalog_CacheUpdateKey         string            `pp:",omitempty"`
+	DataCatalog_PlaygroundEndpoint     string            `pp:",omitempty"`
+	DataCatalog_CacheTTL               int               `pp:",omitempty"`
+	DataCatalog_GQL_MaxComplexity      int               `pp:",omitempty"`
+	DataCatalog_PanicOnInit            bool              `pp:",omitempty"`
+	DataCatalog_GeocodingAppID         string            `pp:",omitempty"`
+	DataCatalog_DiskCache              bool              `pp:",omitempty"`
+	DataCatalog_Debug                  bool              `pp:",omitempty"`
+	DataCatalog_SnapshotURL            string            `pp:",omitempty"`
+	DataCatalog_SnapshotRetention      int               `pp:",omitempty"`
+	DataCatalog_SearchDictionary       string            `pp:",omitempty"`
+	DataCatalog_Remotes                []string          `pp:",omitempty"`
+	DataCatalog_RemoteTokens           []string          `pp:",omitempty"`
+	DataCatalog_RemoteTTL              int               `pp:",omitempty"`
+	GCParcent                          int               `pp:",omitempty"`
+	CityGML_Domain                     string            `pp:",omitempty"`
+	CityGML_Bucket                     string            `pp:",omitempty"`
+	CityGML_CityGMLPackerImage         string            `pp:",omitempty"`
+	CityGML_WorkerRegion               string            `pp:",omitempty"`
+	CityGML_WorkerProject              string            `pp:",omitempty"`
+	CityGML_PackerTimeout              uint              `pp:",omitempty"`
+	CityGML_GeoidModels                map[string]string `pp:",omitempty"`
+	CityGML_PackerStorageDir           string            `pp:",omitempty"`
+	CityGML_PackerPrefix               string            `pp:",omitempty"`
+	Flow_BaseURL                       string            `pp:",omitempty"`
+	Flow_Token                         string            `pp:",omitempty"`
+	Tiles_Cache_Control                string            `pp:",omitempty"`
+	Chiitiler_URL                      string            `pp:",omitempty"`
+	Chiitiler_Bucket                   string            `pp:",omitempty"`
 }
 
 func NewConfig() (*Config, error) {
@@ -161,6 +174,10 @@ func (c *Config) CMSIntegration() cmsintegration.Config {
 		TaskImage:                         c.Geospatialjp_CloudBuildImage, // TODO: change env var name
 		GCPProject:                        cloudBuildProject,
 		GCPRegion:                         cloudBuildRegion,
+		LocalTaskRunner:                   c.Worker_Local,
+		LocalWorkerBinary:                 c.Worker_LocalBinary,
+		LocalWorkerLogDir:                 c.Worker_LocalLogDir,
+		LocalWorkerWait:                   c.Worker_LocalWait,
 		FlowBaseURL:                       c.Flow_BaseURL,
 		FlowToken:                         c.Flow_Token,
 	}
@@ -215,6 +232,12 @@ func (c *Config) DataCatalog() datacatalog.Config {
 		CacheTTL:             c.DataCatalog_CacheTTL,
 		ErrorOnInit:          c.DataCatalog_PanicOnInit,
 		GeocodingAppID:       c.DataCatalog_GeocodingAppID,
+		SnapshotURL:          c.DataCatalog_SnapshotURL,
+		SnapshotRetention:    c.DataCatalog_SnapshotRetention,
+		SearchDictionary:     c.DataCatalog_SearchDictionary,
+		Remotes:              c.DataCatalog_Remotes,
+		RemoteTokens:         c.DataCatalog_RemoteTokens,
+		RemoteTTL:            c.DataCatalog_RemoteTTL,
 	}
 }
 
@@ -260,5 +283,12 @@ func (c *Config) CityGML() citygml.Config {
 		WorkerProject:      workProject,
 		DataCatalogAPIURL:  c.LocalURL("/datacatalog"),
 		PackerTimeout:      c.CityGML_PackerTimeout,
+		GeoidModels:        c.CityGML_GeoidModels,
+		LocalWorker:        c.Worker_Local,
+		LocalWorkerBinary:  c.Worker_LocalBinary,
+		LocalWorkerLogDir:  c.Worker_LocalLogDir,
+		LocalWorkerWait:    c.Worker_LocalWait,
+		PackerStorageDir:   c.CityGML_PackerStorageDir,
+		PackerPrefix:       c.CityGML_PackerPrefix,
 	}
 }
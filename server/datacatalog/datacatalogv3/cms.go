@@ -144,6 +144,7 @@ func (c *CMS) GetAll(ctx context.Context) (*AllData, error) {
 		return nil, fmt.Errorf("failed to get geospatialjp data items: %w", res.B)
 	} else {
 		all.GeospatialjpDataItems = res.A
+		fillMeshCodes(ctx, all.GeospatialjpDataItems)
 	}
 
 	all.Plateau = make(map[string][]*PlateauFeatureItem)
@@ -503,6 +503,8 @@ type GeospatialjpDataItem struct {
 	CityGML  string `json:"citygml,omitempty" cms:"citygml,asset"`
 	MaxLOD   string `json:"maxlod,omitempty" cms:"maxlod,asset"`
 	HasIndex bool   `json:"has_index,omitempty" cms:"-"`
+	// MeshCodes are mesh codes of CityGML files read from the max LOD file.
+	MeshCodes []string `json:"mesh_codes,omitempty" cms:"-"`
 }
 
 func GeospatialjpDataItemFrom(item *cms.Item) *GeospatialjpDataItem {
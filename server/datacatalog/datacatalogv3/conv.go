@@ -46,6 +46,13 @@ func (all *AllData) Into() (res *plateauapi.InMemoryRepoContext, warning []strin
 		if res.Areas.FindByCodeAndType(city.Code, plateauapi.AreaTypeCity) == nil {
 			res.Areas.Append(plateauapi.AreaTypeCity, []plateauapi.Area{city})
 		}
+
+		if cityItem.CityNameEn != "" {
+			if res.AreaReadings == nil {
+				res.AreaReadings = map[plateauapi.AreaCode][]string{}
+			}
+			res.AreaReadings[city.Code] = []string{cityItem.CityNameEn}
+		}
 	}
 
 	res.Years = ic.Years()
@@ -44,6 +44,7 @@ func toCityGMLs(all *AllData, regYear int) (map[plateauapi.ID]*plateauapi.CityGM
 				CMSURL:      all.CMSInfo.ItemBaseURL(cityModel),
 				MaxLODURLs:  []string{data.MaxLOD},
 				CityGMLURLs: []string{data.CityGML},
+				MeshCodes:   data.MeshCodes,
 			}),
 		}
 
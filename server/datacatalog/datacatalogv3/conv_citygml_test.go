@@ -31,9 +31,10 @@ func TestToCityGMLs(t *testing.T) {
 		},
 		GeospatialjpDataItems: []*GeospatialjpDataItem{
 			{
-				City:    "city1",
-				CityGML: "https://example.com/city1.gml",
-				MaxLOD:  "https://example.com/maxlod1.csv",
+				City:      "city1",
+				CityGML:   "https://example.com/city1.gml",
+				MaxLOD:    "https://example.com/maxlod1.csv",
+				MeshCodes: []string{"53394611", "53394612"},
 			},
 		},
 		Plateau: map[string][]*PlateauFeatureItem{
@@ -87,6 +88,7 @@ func TestToCityGMLs(t *testing.T) {
 					"https://example.com/maxlod1.csv",
 					"https://example.com/maxlod2.csv",
 				},
+				MeshCodes: []string{"53394611", "53394612"},
 			},
 		},
 	}
@@ -150,6 +150,7 @@ type Admin struct {
 	CityGMLAssetID string
 	CityGMLURLs    []string
 	MaxLODURLs     []string
+	MeshCodes      []string
 }
 
 func adminFrom(admin Admin) any {
@@ -175,6 +176,7 @@ func adminFrom(admin Admin) any {
 		CityGMLAssetID: admin.CityGMLAssetID,
 		CityGMLURLs:    admin.CityGMLURLs,
 		MaxLODURLs:     admin.MaxLODURLs,
+		MeshCodes:      admin.MeshCodes,
 		CreatedAt:      lo.EmptyableToPtr(admin.CreatedAt),
 		UpdatedAt:      lo.EmptyableToPtr(admin.UpdatedAt),
 	}
@@ -0,0 +1,108 @@
+package datacatalogv3
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/reearth/reearthx/log"
+	"github.com/samber/lo"
+	"github.com/spkg/bom"
+	"golang.org/x/sync/errgroup"
+)
+
+// meshCodesClient fetches max LOD files. A slow host must not stall updates of the whole catalog.
+var meshCodesClient = &http.Client{
+	Timeout: 30 * time.Second,
+}
+
+// meshCodesCache caches mesh codes by URLs of max LOD files. Uploaded assets are not changed, so the cache is valid across updates.
+var meshCodesCache sync.Map
+
+// fillMeshCodes sets mesh codes of CityGML files read from max LOD files to the items, which are used for spatial conditions.
+// Items whose max LOD files are not available have no mesh codes.
+func fillMeshCodes(ctx context.Context, items []*GeospatialjpDataItem) {
+	errg := errgroup.Group{}
+	errg.SetLimit(10)
+
+	for _, item := range items {
+		if item == nil || item.MaxLOD == "" || len(item.MeshCodes) > 0 {
+			continue
+		}
+
+		errg.Go(func() error {
+			codes, err := fetchMeshCodes(ctx, item.MaxLOD)
+			if err != nil {
+				log.Warnfc(ctx, "datacatalogv3: failed to get mesh codes from %s: %v", item.MaxLOD, err)
+				return nil
+			}
+			item.MeshCodes = codes
+			return nil
+		})
+	}
+
+	_ = errg.Wait()
+}
+
+func fetchMeshCodes(ctx context.Context, url string) ([]string, error) {
+	if codes, ok := meshCodesCache.Load(url); ok {
+		return codes.([]string), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	res, err := meshCodesClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status code: %d", res.StatusCode)
+	}
+
+	codes, err := meshCodesFromMaxLOD(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	meshCodesCache.Store(url, codes)
+	return codes, nil
+}
+
+// meshCodesFromMaxLOD returns sorted mesh codes in the max LOD CSV, whose rows are "code,type,maxLod(,path)".
+func meshCodesFromMaxLOD(r io.Reader) ([]string, error) {
+	c := csv.NewReader(bom.NewReader(r))
+	c.FieldsPerRecord = -1
+
+	var res []string
+	for {
+		record, err := c.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+
+		// skip the header
+		if len(record) == 0 || record[0] == "" || record[0][0] < '0' || record[0][0] > '9' {
+			continue
+		}
+		res = append(res, record[0])
+	}
+
+	res = lo.Uniq(res)
+	slices.Sort(res)
+	return res, nil
+}
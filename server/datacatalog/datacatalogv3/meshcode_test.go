@@ -0,0 +1,44 @@
+package datacatalogv3
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFillMeshCodes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	meshCodesCache.Clear()
+
+	httpmock.RegisterResponder("GET", "https://example.com/maxlod.csv", httpmock.NewStringResponder(200,
+		"code,type,maxlod,path\n53394612,bldg,2,udx/bldg/53394612_bldg_6697_op.gml\n53394611,bldg,1,udx/bldg/53394611_bldg_6697_op.gml\n53394611,tran,1,udx/tran/53394611_tran_6697_op.gml\n",
+	))
+	httpmock.RegisterResponder("GET", "https://example.com/notfound.csv", httpmock.NewStringResponder(404, ""))
+
+	items := []*GeospatialjpDataItem{
+		{MaxLOD: "https://example.com/maxlod.csv"},
+		{MaxLOD: "https://example.com/notfound.csv"},
+		{},
+	}
+	fillMeshCodes(context.Background(), items)
+	assert.Equal(t, []string{"53394611", "53394612"}, items[0].MeshCodes)
+	assert.Nil(t, items[1].MeshCodes)
+	assert.Nil(t, items[2].MeshCodes)
+
+	// max LOD files are fetched only once
+	items = []*GeospatialjpDataItem{{MaxLOD: "https://example.com/maxlod.csv"}}
+	fillMeshCodes(context.Background(), items)
+	assert.Equal(t, []string{"53394611", "53394612"}, items[0].MeshCodes)
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["GET https://example.com/maxlod.csv"])
+}
+
+func TestMeshCodesFromMaxLOD(t *testing.T) {
+	codes, err := meshCodesFromMaxLOD(strings.NewReader("\ufeffcode,type,maxlod\n53394611,bldg,2\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"53394611"}, codes)
+}
@@ -0,0 +1,324 @@
+package datacatalog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/plateauapi"
+	"github.com/eukarya-inc/reearth-plateauview/server/govpolygon"
+	"github.com/samber/lo"
+)
+
+var dcatContext = map[string]any{
+	"dcat": "http://www.w3.org/ns/dcat#",
+	"dct":  "http://purl.org/dc/terms/",
+	"foaf": "http://xmlns.com/foaf/0.1/",
+	"locn": "http://www.w3.org/ns/locn#",
+	"skos": "http://www.w3.org/2004/02/skos/core#",
+	"xsd":  "http://www.w3.org/2001/XMLSchema#",
+	"dcat:bbox": map[string]any{
+		"@type": "http://www.opengis.net/ont/geosparql#wktLiteral",
+	},
+	"dcat:startDate": map[string]any{"@type": "xsd:date"},
+	"dcat:endDate":   map[string]any{"@type": "xsd:date"},
+	"dct:issued":     map[string]any{"@type": "xsd:gYear"},
+	"dcat:accessURL": map[string]any{"@type": "@id"},
+	"dcat:downloadURL": map[string]any{
+		"@type": "@id",
+	},
+}
+
+type DCATCatalog struct {
+	Context   map[string]any `json:"@context"`
+	ID        string         `json:"@id"`
+	Type      string         `json:"@type"`
+	Title     string         `json:"dct:title"`
+	Publisher *DCATAgent     `json:"dct:publisher"`
+	Datasets  []*DCATDataset `json:"dcat:dataset"`
+}
+
+type DCATAgent struct {
+	Type string `json:"@type"`
+	Name string `json:"foaf:name"`
+}
+
+type DCATDataset struct {
+	ID            string              `json:"@id"`
+	Type          string              `json:"@type"`
+	Identifier    string              `json:"dct:identifier"`
+	Title         string              `json:"dct:title"`
+	Description   string              `json:"dct:description,omitempty"`
+	Keywords      []string            `json:"dcat:keyword,omitempty"`
+	Issued        string              `json:"dct:issued,omitempty"`
+	LandingPage   string              `json:"dcat:landingPage,omitempty"`
+	Spatial       *DCATLocation       `json:"dct:spatial,omitempty"`
+	Temporal      *DCATPeriodOfTime   `json:"dct:temporal,omitempty"`
+	Distributions []*DCATDistribution `json:"dcat:distribution"`
+}
+
+type DCATLocation struct {
+	Type       string `json:"@type"`
+	Identifier string `json:"dct:identifier"`
+	Label      string `json:"skos:prefLabel,omitempty"`
+	BBox       string `json:"dcat:bbox,omitempty"`
+}
+
+type DCATPeriodOfTime struct {
+	Type      string `json:"@type"`
+	StartDate string `json:"dcat:startDate"`
+	EndDate   string `json:"dcat:endDate"`
+}
+
+type DCATDistribution struct {
+	ID          string `json:"@id"`
+	Type        string `json:"@type"`
+	Title       string `json:"dct:title"`
+	AccessURL   string `json:"dcat:accessURL"`
+	DownloadURL string `json:"dcat:downloadURL,omitempty"`
+	Format      string `json:"dct:format,omitempty"`
+	MediaType   string `json:"dcat:mediaType,omitempty"`
+}
+
+// FetchDCATCatalog maps datasets, their items and CityGML datasets of the repo to DCAT. catalogURL is used as the base of IRIs.
+func FetchDCATCatalog(ctx context.Context, r plateauapi.Repo, catalogURL string, bounds govpolygon.AreaBounds) (*DCATCatalog, error) {
+	ds, err := r.Datasets(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch datasets: %w", err)
+	}
+
+	areas, err := fetchAreaMap(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := r.DatasetTypes(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dataset types: %w", err)
+	}
+	typeNames := lo.SliceToMap(types, func(t plateauapi.DatasetType) (plateauapi.ID, string) {
+		return t.GetID(), t.GetName()
+	})
+
+	res := &DCATCatalog{
+		Context: dcatContext,
+		ID:      catalogURL,
+		Type:    "dcat:Catalog",
+		Title:   "PLATEAU データカタログ",
+		Publisher: &DCATAgent{
+			Type: "foaf:Organization",
+			Name: "国土交通省",
+		},
+		Datasets: []*DCATDataset{},
+	}
+
+	for _, d := range ds {
+		dd := &DCATDataset{
+			ID:          dcatIRI(catalogURL, "datasets", string(d.GetID())),
+			Type:        "dcat:Dataset",
+			Identifier:  string(d.GetID()),
+			Title:       d.GetName(),
+			Description: lo.FromPtr(d.GetDescription()),
+			Keywords:    lo.Compact(append([]string{typeNames[d.GetTypeID()], d.GetTypeCode()}, d.GetGroups()...)),
+			Issued:      dcatYear(d.GetRegisterationYear()),
+			LandingPage: lo.FromPtr(d.GetOpenDataURL()),
+			Spatial:     dcatLocation(areas, bounds, plateauapi.MostDetailedAreaCodeFrom(d)),
+			Temporal:    dcatFiscalYear(d.GetYear()),
+		}
+
+		for _, it := range d.GetItems() {
+			if it.GetURL() == "" {
+				continue
+			}
+			title := it.GetName()
+			if title == "" {
+				title = d.GetName()
+			}
+			dd.Distributions = append(dd.Distributions, &DCATDistribution{
+				ID:          dcatIRI(catalogURL, "items", string(it.GetID())),
+				Type:        "dcat:Distribution",
+				Title:       title,
+				AccessURL:   it.GetURL(),
+				DownloadURL: dcatDownloadURL(it.GetFormat(), it.GetURL()),
+				Format:      string(it.GetFormat()),
+				MediaType:   datasetFormatMediaType(it.GetFormat()),
+			})
+		}
+		if dd.Distributions == nil {
+			dd.Distributions = []*DCATDistribution{}
+		}
+
+		res.Datasets = append(res.Datasets, dd)
+	}
+
+	citygml, err := fetchCityGMLDatasets(ctx, r, areas)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range citygml {
+		code := c.CityCode
+		name := "CityGML"
+		if a := areas[code]; a != nil {
+			name = fmt.Sprintf("%s CityGML", a.GetName())
+		}
+
+		dd := &DCATDataset{
+			ID:         dcatIRI(catalogURL, "datasets", string(c.ID)),
+			Type:       "dcat:Dataset",
+			Identifier: string(c.ID),
+			Title:      name,
+			Keywords:   append([]string{"CityGML"}, c.FeatureTypes...),
+			Issued:     dcatYear(c.RegistrationYear),
+			Spatial:    dcatLocation(areas, bounds, &code),
+			Temporal:   dcatFiscalYear(c.Year),
+			Distributions: []*DCATDistribution{
+				{
+					ID:          dcatIRI(catalogURL, "datasets", string(c.ID), "zip"),
+					Type:        "dcat:Distribution",
+					Title:       name,
+					AccessURL:   c.URL,
+					DownloadURL: c.URL,
+					Format:      "CityGML",
+					MediaType:   "application/zip",
+				},
+			},
+		}
+
+		for i, u := range c.MetadataZipUrls {
+			dd.Distributions = append(dd.Distributions, &DCATDistribution{
+				ID:          dcatIRI(catalogURL, "datasets", string(c.ID), fmt.Sprintf("metadata%d", i)),
+				Type:        "dcat:Distribution",
+				Title:       fmt.Sprintf("%s メタデータ", name),
+				AccessURL:   u,
+				DownloadURL: u,
+				MediaType:   "application/zip",
+			})
+		}
+
+		res.Datasets = append(res.Datasets, dd)
+	}
+
+	return res, nil
+}
+
+// fetchAreaMap returns all areas by their codes.
+func fetchAreaMap(ctx context.Context, r plateauapi.Repo) (map[plateauapi.AreaCode]plateauapi.Area, error) {
+	areas, err := r.Areas(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch areas: %w", err)
+	}
+	return lo.SliceToMap(areas, func(a plateauapi.Area) (plateauapi.AreaCode, plateauapi.Area) {
+		return a.GetCode(), a
+	}), nil
+}
+
+// fetchCityGMLDatasets returns CityGML datasets of the cities in the order of city codes. They are fetched at once to avoid a request for each city.
+func fetchCityGMLDatasets(ctx context.Context, r plateauapi.Repo, areas map[plateauapi.AreaCode]plateauapi.Area) ([]*plateauapi.CityGMLDataset, error) {
+	codes := lo.Keys(areas)
+	slices.Sort(codes)
+
+	ids := lo.FilterMap(codes, func(code plateauapi.AreaCode, _ int) (plateauapi.ID, bool) {
+		city, _ := areas[code].(*plateauapi.City)
+		if city == nil || city.CitygmlID == nil {
+			return "", false
+		}
+		return *city.CitygmlID, true
+	})
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	nodes, err := r.Nodes(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch citygml: %w", err)
+	}
+
+	return lo.FilterMap(nodes, func(n plateauapi.Node, _ int) (*plateauapi.CityGMLDataset, bool) {
+		c, _ := n.(*plateauapi.CityGMLDataset)
+		return c, c != nil && c.URL != ""
+	}), nil
+}
+
+func dcatIRI(base string, p ...string) string {
+	return strings.TrimSuffix(base, "/") + "#" + strings.Join(p, "/")
+}
+
+func dcatLocation(areas map[plateauapi.AreaCode]plateauapi.Area, bounds govpolygon.AreaBounds, code *plateauapi.AreaCode) *DCATLocation {
+	if code == nil {
+		return nil
+	}
+
+	l := &DCATLocation{
+		Type:       "dct:Location",
+		Identifier: code.String(),
+	}
+	if a := areas[*code]; a != nil {
+		l.Label = a.GetName()
+	}
+	if b, ok := bounds.Get(code.String()); ok {
+		l.BBox = fmt.Sprintf(
+			"POLYGON((%[1]g %[2]g,%[3]g %[2]g,%[3]g %[4]g,%[1]g %[4]g,%[1]g %[2]g))",
+			b[0], b[1], b[2], b[3],
+		)
+	}
+	return l
+}
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+// fiscalYearRange returns the first and the last moment of the Japanese fiscal year, which starts in April.
+func fiscalYearRange(year int) (time.Time, time.Time) {
+	start := time.Date(year, time.April, 1, 0, 0, 0, 0, jst)
+	return start, start.AddDate(1, 0, 0).Add(-time.Second)
+}
+
+func dcatFiscalYear(year int) *DCATPeriodOfTime {
+	if year <= 0 {
+		return nil
+	}
+	start, end := fiscalYearRange(year)
+	return &DCATPeriodOfTime{
+		Type:      "dct:PeriodOfTime",
+		StartDate: start.Format(time.DateOnly),
+		EndDate:   end.Format(time.DateOnly),
+	}
+}
+
+func dcatYear(year int) string {
+	if year <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%04d", year)
+}
+
+// dcatDownloadURL returns the URL if it is a single file that can be downloaded.
+func dcatDownloadURL(f plateauapi.DatasetFormat, u string) string {
+	switch f {
+	case plateauapi.DatasetFormatCSV, plateauapi.DatasetFormatCzml, plateauapi.DatasetFormatGeojson, plateauapi.DatasetFormatGltf:
+		return u
+	}
+	return ""
+}
+
+func datasetFormatMediaType(f plateauapi.DatasetFormat) string {
+	switch f {
+	case plateauapi.DatasetFormatCSV:
+		return "text/csv"
+	case plateauapi.DatasetFormatCzml, plateauapi.DatasetFormatCesium3dtiles:
+		return "application/json"
+	case plateauapi.DatasetFormatGeojson:
+		return "application/geo+json"
+	case plateauapi.DatasetFormatGltf:
+		return "model/gltf-binary"
+	case plateauapi.DatasetFormatGtfsRealtime:
+		return "application/x-protobuf"
+	case plateauapi.DatasetFormatMvt:
+		return "application/vnd.mapbox-vector-tile"
+	case plateauapi.DatasetFormatTms, plateauapi.DatasetFormatTiles:
+		return "image/png"
+	}
+	return ""
+}
@@ -0,0 +1,123 @@
+package datacatalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/plateauapi"
+	"github.com/eukarya-inc/reearth-plateauview/server/govpolygon"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchDCATCatalog(t *testing.T) {
+	r := &nodeCountingRepo{Repo: testRepo()}
+	res, err := FetchDCATCatalog(context.Background(), r, "https://example.com/dcat/", testAreaBounds)
+	require.NoError(t, err)
+
+	// CityGML datasets are fetched at once
+	assert.Equal(t, 0, r.node)
+	assert.Equal(t, 1, r.nodes)
+
+	location := &DCATLocation{
+		Type:       "dct:Location",
+		Identifier: "13101",
+		Label:      "千代田区",
+		BBox:       "POLYGON((139.7 35.6,139.8 35.6,139.8 35.7,139.7 35.7,139.7 35.6))",
+	}
+	temporal := &DCATPeriodOfTime{Type: "dct:PeriodOfTime", StartDate: "2023-04-01", EndDate: "2024-03-31"}
+
+	assert.Equal(t, "https://example.com/dcat/", res.ID)
+	assert.Equal(t, []*DCATDataset{
+		{
+			ID:         "https://example.com/dcat#datasets/d_1",
+			Type:       "dcat:Dataset",
+			Identifier: "d_1",
+			Title:      "建築物モデル（千代田区）",
+			Keywords:   []string{"建築物モデル", "bldg"},
+			Spatial:    location,
+			Temporal:   temporal,
+			Distributions: []*DCATDistribution{{
+				ID:        "https://example.com/dcat#items/di_1",
+				Type:      "dcat:Distribution",
+				Title:     "LOD1",
+				AccessURL: "https://example.com/tileset.json",
+				Format:    "CESIUM3DTILES",
+				MediaType: "application/json",
+			}},
+		},
+		{
+			ID:         "https://example.com/dcat#datasets/cg_13101",
+			Type:       "dcat:Dataset",
+			Identifier: "cg_13101",
+			Title:      "千代田区 CityGML",
+			Keywords:   []string{"CityGML", "bldg"},
+			Spatial:    location,
+			Temporal:   temporal,
+			Distributions: []*DCATDistribution{{
+				ID:          "https://example.com/dcat#datasets/cg_13101/zip",
+				Type:        "dcat:Distribution",
+				Title:       "千代田区 CityGML",
+				AccessURL:   "https://example.com/citygml.zip",
+				DownloadURL: "https://example.com/citygml.zip",
+				Format:      "CityGML",
+				MediaType:   "application/zip",
+			}},
+		},
+	}, res.Datasets)
+}
+
+type nodeCountingRepo struct {
+	plateauapi.Repo
+	node  int
+	nodes int
+}
+
+func (r *nodeCountingRepo) Node(ctx context.Context, id plateauapi.ID) (plateauapi.Node, error) {
+	r.node++
+	return r.Repo.Node(ctx, id)
+}
+
+func (r *nodeCountingRepo) Nodes(ctx context.Context, ids []plateauapi.ID) ([]plateauapi.Node, error) {
+	r.nodes++
+	return r.Repo.Nodes(ctx, ids)
+}
+
+var testAreaBounds = govpolygon.AreaBounds{"13101": {139.7, 35.6, 139.8, 35.7}}
+
+func testRepo() *plateauapi.InMemoryRepo {
+	return plateauapi.NewInMemoryRepo(&plateauapi.InMemoryRepoContext{
+		Areas: plateauapi.Areas{
+			plateauapi.AreaTypePrefecture: []plateauapi.Area{&plateauapi.Prefecture{ID: "p_13", Code: "13", Name: "東京都", Type: plateauapi.AreaTypePrefecture}},
+			plateauapi.AreaTypeCity: []plateauapi.Area{&plateauapi.City{
+				ID: "c_13101", Code: "13101", Name: "千代田区", Type: plateauapi.AreaTypeCity,
+				PrefectureID: "p_13", PrefectureCode: "13", ParentID: lo.ToPtr(plateauapi.ID("p_13")), CitygmlID: lo.ToPtr(plateauapi.ID("cg_13101")),
+			}},
+		},
+		DatasetTypes: plateauapi.DatasetTypes{
+			plateauapi.DatasetTypeCategoryPlateau: []plateauapi.DatasetType{&plateauapi.PlateauDatasetType{
+				ID: "dt_bldg", Code: "bldg", Name: "建築物モデル", Category: plateauapi.DatasetTypeCategoryPlateau, Year: 2023,
+			}},
+		},
+		Datasets: plateauapi.Datasets{
+			plateauapi.DatasetTypeCategoryPlateau: []plateauapi.Dataset{&plateauapi.PlateauDataset{
+				ID: "d_1", Name: "建築物モデル（千代田区）", Year: 2023, TypeID: "dt_bldg", TypeCode: "bldg",
+				PrefectureID: lo.ToPtr(plateauapi.ID("p_13")), PrefectureCode: lo.ToPtr(plateauapi.AreaCode("13")),
+				CityID: lo.ToPtr(plateauapi.ID("c_13101")), CityCode: lo.ToPtr(plateauapi.AreaCode("13101")),
+				Items: []*plateauapi.PlateauDatasetItem{{
+					ID: "di_1", Name: "LOD1", Format: plateauapi.DatasetFormatCesium3dtiles, URL: "https://example.com/tileset.json", ParentID: "d_1", Lod: lo.ToPtr(1),
+				}},
+			}},
+		},
+		PlateauSpecs: []plateauapi.PlateauSpec{{
+			ID: "ps_3", MajorVersion: 3, Year: 2023,
+			MinorVersions: []*plateauapi.PlateauSpecMinor{{ID: "ps_3.2", Name: "第3.2版", Version: "3.2", MajorVersion: 3, Year: 2023, ParentID: "ps_3"}},
+		}},
+		Years: []int{2023},
+		CityGML: map[plateauapi.ID]*plateauapi.CityGMLDataset{"cg_13101": {
+			ID: "cg_13101", Year: 2023, CityCode: "13101", CityID: "c_13101", PrefectureCode: "13", PrefectureID: "p_13",
+			PlateauSpecMinorID: "ps_3.2", URL: "https://example.com/citygml.zip", FeatureTypes: []string{"bldg"}, MetadataZipUrls: []string{},
+		}},
+	})
+}
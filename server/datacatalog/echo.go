@@ -22,6 +22,18 @@ type Config struct {
 	GeocodingAppID       string
 	DiskCache            bool // for debugging
 	Debug                bool // for debugging
+	// SnapshotURL is gs://<bucket>/<prefix> or file:///<dir> to persist snapshots of the catalog
+	SnapshotURL string
+	// SnapshotRetention is how long old snapshots are kept in days. Snapshots are kept forever if it is zero.
+	SnapshotRetention int
+	// SearchDictionary is a path to a JSON file of synonyms and readings added to the built-in search dictionary
+	SearchDictionary string
+	// Remotes are other PLATEAU APIs merged into the catalog of all projects, in the form of <namespace>=<GraphQL endpoint URL>
+	Remotes []string
+	// RemoteTokens are tokens for the remotes in the form of <namespace>=<token>
+	RemoteTokens []string
+	// RemoteTTL is how long catalogs of the remotes are cached in seconds
+	RemoteTTL int
 	// v2
 	DisableCache bool
 	CacheTTL     int
@@ -0,0 +1,172 @@
+package datacatalog
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/plateauapi"
+	"github.com/labstack/echo/v4"
+)
+
+type changesFeedFormat string
+
+const (
+	changesFeedFormatAtom changesFeedFormat = "atom"
+	changesFeedFormatRSS  changesFeedFormat = "rss"
+	changesFeedLimit                        = 100
+)
+
+var changeTypeTitles = map[plateauapi.DatasetChangeType]string{
+	plateauapi.DatasetChangeTypeAdded:    "追加",
+	plateauapi.DatasetChangeTypeRemoved:  "削除",
+	plateauapi.DatasetChangeTypeModified: "更新",
+}
+
+func (h *reposHandler) ChangesFeedHandler(format changesFeedFormat) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		merged, err := h.prepareMergedRepo(c, false)
+		if err != nil {
+			return err
+		}
+
+		changes, err := merged.Changes(c.Request().Context(), nil)
+		if err != nil {
+			return err
+		}
+		if len(changes) > changesFeedLimit {
+			changes = changes[:changesFeedLimit]
+		}
+
+		pid := c.Param(pidParamName)
+		self := c.Scheme() + "://" + c.Request().Host + c.Request().URL.Path
+
+		var feed any
+		contentType := "application/atom+xml; charset=utf-8"
+		if format == changesFeedFormatRSS {
+			feed = rssFeedFromChanges(pid, self, changes)
+			contentType = "application/rss+xml; charset=utf-8"
+		} else {
+			feed = atomFeedFromChanges(pid, self, changes)
+		}
+
+		b, err := xml.MarshalIndent(feed, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return c.Blob(http.StatusOK, contentType, append([]byte(xml.Header), b...))
+	}
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID      string `xml:"id"`
+	Title   string `xml:"title"`
+	Updated string `xml:"updated"`
+	Summary string `xml:"summary"`
+}
+
+func atomFeedFromChanges(pid, self string, changes []*plateauapi.DatasetChange) *atomFeed {
+	f := &atomFeed{
+		ID:    self,
+		Title: changesFeedTitle(pid),
+		Link:  atomLink{Href: self, Rel: "self"},
+	}
+
+	// the newest change is the first one
+	updated := time.Unix(0, 0)
+	if len(changes) > 0 {
+		updated = changes[0].ChangedAt
+	}
+	f.Updated = updated.UTC().Format(time.RFC3339)
+
+	for _, ch := range changes {
+		f.Entries = append(f.Entries, atomEntry{
+			ID:      changeEntryID(ch),
+			Title:   changeEntryTitle(ch),
+			Updated: ch.ChangedAt.UTC().Format(time.RFC3339),
+			Summary: changeEntrySummary(ch),
+		})
+	}
+	return f
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+func rssFeedFromChanges(pid, self string, changes []*plateauapi.DatasetChange) *rssFeed {
+	f := &rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       changesFeedTitle(pid),
+			Link:        self,
+			Description: "データカタログの更新によって検出されたデータセットの変更",
+		},
+	}
+
+	for _, ch := range changes {
+		f.Channel.Items = append(f.Channel.Items, rssItem{
+			Title:       changeEntryTitle(ch),
+			Description: changeEntrySummary(ch),
+			GUID:        rssGUID{Value: changeEntryID(ch)},
+			PubDate:     ch.ChangedAt.UTC().Format(time.RFC1123Z),
+		})
+	}
+	return f
+}
+
+func changesFeedTitle(pid string) string {
+	if pid == "" {
+		return "PLATEAU データカタログの変更"
+	}
+	return fmt.Sprintf("PLATEAU データカタログの変更 (%s)", pid)
+}
+
+func changeEntryID(ch *plateauapi.DatasetChange) string {
+	return fmt.Sprintf("urn:plateau:change:%s:%s:%s:%d", ch.Project, ch.DatasetID, ch.Type, ch.ChangedAt.UnixMilli())
+}
+
+func changeEntryTitle(ch *plateauapi.DatasetChange) string {
+	return fmt.Sprintf("[%s] %s", changeTypeTitles[ch.Type], ch.Dataset.GetName())
+}
+
+func changeEntrySummary(ch *plateauapi.DatasetChange) string {
+	return fmt.Sprintf("%s: %s (ID: %s, 種類: %s, 年度: %d)", ch.Project, ch.Dataset.GetName(), ch.DatasetID, ch.Dataset.GetTypeCode(), ch.Dataset.GetYear())
+}
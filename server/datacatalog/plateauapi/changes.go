@@ -0,0 +1,129 @@
+package plateauapi
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// maxChangeHistory is the number of changes kept for each project.
+const maxChangeHistory = 1000
+
+// DiffDatasets returns changes of datasets between two repos. Datasets are compared as they are seen from the public API.
+func DiffDatasets(ctx context.Context, project string, prev, next Repo, at time.Time) ([]*DatasetChange, error) {
+	prevDatasets, err := prev.Datasets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	nextDatasets, err := next.Datasets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	prevMap := make(map[ID]Dataset, len(prevDatasets))
+	for _, d := range prevDatasets {
+		prevMap[d.GetID()] = d
+	}
+
+	var res []*DatasetChange
+	for _, d := range nextDatasets {
+		id := d.GetID()
+		p, ok := prevMap[id]
+		delete(prevMap, id)
+
+		var ty DatasetChangeType
+		if !ok {
+			ty = DatasetChangeTypeAdded
+		} else if !reflect.DeepEqual(p, d) {
+			ty = DatasetChangeTypeModified
+		} else {
+			continue
+		}
+
+		res = append(res, &DatasetChange{DatasetID: id, Type: ty, ChangedAt: at, Project: project, Dataset: d})
+	}
+
+	for _, d := range prevDatasets {
+		if _, ok := prevMap[d.GetID()]; !ok {
+			continue
+		}
+		res = append(res, &DatasetChange{DatasetID: d.GetID(), Type: DatasetChangeTypeRemoved, ChangedAt: at, Project: project, Dataset: d})
+	}
+
+	return res, nil
+}
+
+// changeHistory keeps the latest changes in the order of detection.
+type changeHistory []*DatasetChange
+
+func (h changeHistory) add(changes []*DatasetChange) changeHistory {
+	h = append(h, changes...)
+	if len(h) > maxChangeHistory {
+		h = slices.Clone(h[len(h)-maxChangeHistory:])
+	}
+	return h
+}
+
+// datasetChangeJSON is a DatasetChange in persisted histories. The dataset is kept with its category so that it is decoded with the concrete type.
+type datasetChangeJSON struct {
+	DatasetID ID                `json:"datasetId"`
+	Type      DatasetChangeType `json:"type"`
+	ChangedAt time.Time         `json:"changedAt"`
+	Project   string            `json:"project"`
+	Dataset   Datasets          `json:"dataset"`
+}
+
+func (h changeHistory) MarshalJSON() ([]byte, error) {
+	res := make([]datasetChangeJSON, 0, len(h))
+	for _, c := range h {
+		res = append(res, datasetChangeJSON{
+			DatasetID: c.DatasetID,
+			Type:      c.Type,
+			ChangedAt: c.ChangedAt,
+			Project:   c.Project,
+			Dataset:   Datasets{DatasetTypeCategoryFromDataset(c.Dataset): []Dataset{c.Dataset}},
+		})
+	}
+	return json.Marshal(res)
+}
+
+func (h *changeHistory) UnmarshalJSON(b []byte) error {
+	var changes []datasetChangeJSON
+	if err := json.Unmarshal(b, &changes); err != nil {
+		return err
+	}
+
+	res := make(changeHistory, 0, len(changes))
+	for _, c := range changes {
+		ds := c.Dataset.All()
+		if len(ds) != 1 {
+			return fmt.Errorf("change of %s must have one dataset", c.DatasetID)
+		}
+		res = append(res, &DatasetChange{DatasetID: c.DatasetID, Type: c.Type, ChangedAt: c.ChangedAt, Project: c.Project, Dataset: ds[0]})
+	}
+	*h = res
+	return nil
+}
+
+// since returns changes after the time. Changes are sorted by sortChanges.
+func (h changeHistory) since(t *time.Time) []*DatasetChange {
+	res := make([]*DatasetChange, 0, len(h))
+	for _, c := range h {
+		if t == nil || c.ChangedAt.After(*t) {
+			res = append(res, c)
+		}
+	}
+	sortChanges(res)
+	return res
+}
+
+// sortChanges sorts changes newest first. Changes detected at the same time are sorted by projects and dataset IDs.
+func sortChanges(changes []*DatasetChange) {
+	slices.SortFunc(changes, func(a, b *DatasetChange) int {
+		return cmp.Or(b.ChangedAt.Compare(a.ChangedAt), cmp.Compare(a.Project, b.Project), cmp.Compare(a.DatasetID, b.DatasetID))
+	})
+}
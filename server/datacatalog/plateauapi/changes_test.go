@@ -0,0 +1,103 @@
+package plateauapi
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDatasets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	prev := NewInMemoryRepo(&InMemoryRepoContext{
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{
+				&PlateauDataset{ID: "1", Name: "a", Year: 2023},
+				&PlateauDataset{ID: "2", Name: "b", Year: 2023},
+				&PlateauDataset{ID: "3", Name: "c", Year: 2023},
+			},
+		},
+	})
+	next := NewInMemoryRepo(&InMemoryRepoContext{
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{
+				&PlateauDataset{ID: "1", Name: "a", Year: 2023},
+				&PlateauDataset{ID: "3", Name: "c", Year: 2023, Description: lo.ToPtr("desc")},
+				&PlateauDataset{ID: "4", Name: "d", Year: 2023},
+			},
+		},
+	})
+
+	res, err := DiffDatasets(ctx, "prj", prev, next, now)
+	require.NoError(t, err)
+	assert.Equal(t, []*DatasetChange{
+		{DatasetID: "3", Type: DatasetChangeTypeModified, ChangedAt: now, Project: "prj", Dataset: &PlateauDataset{ID: "3", Name: "c", Year: 2023, Description: lo.ToPtr("desc")}},
+		{DatasetID: "4", Type: DatasetChangeTypeAdded, ChangedAt: now, Project: "prj", Dataset: &PlateauDataset{ID: "4", Name: "d", Year: 2023}},
+		{DatasetID: "2", Type: DatasetChangeTypeRemoved, ChangedAt: now, Project: "prj", Dataset: &PlateauDataset{ID: "2", Name: "b", Year: 2023}},
+	}, res)
+}
+
+func TestRepos_Changes(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	version := 0
+	r := NewRepos(func(ctx context.Context, project string) (*ReposUpdateResult, error) {
+		version++
+		ds := []Dataset{&PlateauDataset{ID: "1", Name: "a", Year: 2023}}
+		if version > 1 {
+			ds = append(ds, &PlateauDataset{ID: ID(strconv.Itoa(version)), Year: 2023})
+		}
+		return &ReposUpdateResult{
+			Repo: NewInMemoryRepo(&InMemoryRepoContext{
+				Datasets: Datasets{DatasetTypeCategoryPlateau: ds},
+			}),
+		}, nil
+	})
+	r.now = func() time.Time { return now }
+
+	// initial load does not record changes
+	_, err := r.Update(ctx, "prj")
+	require.NoError(t, err)
+	changes, err := r.Repo("prj").Changes(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	now = now.Add(time.Minute)
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+	t1 := now
+
+	now = now.Add(time.Minute)
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+	t2 := now
+
+	changes, err = r.Repo("prj").Changes(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []ID{"2", "3", "2"}, lo.Map(changes, func(c *DatasetChange, _ int) ID { return c.DatasetID }))
+	assert.Equal(t, []DatasetChangeType{DatasetChangeTypeRemoved, DatasetChangeTypeAdded, DatasetChangeTypeAdded}, lo.Map(changes, func(c *DatasetChange, _ int) DatasetChangeType { return c.Type }))
+	assert.Equal(t, []time.Time{t2, t2, t1}, lo.Map(changes, func(c *DatasetChange, _ int) time.Time { return c.ChangedAt }))
+
+	changes, err = r.Repo("prj").Changes(ctx, &t1)
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+
+	// merged repos
+	changes, err = NewMerger(r.Repo("prj"), NewInMemoryRepo(&InMemoryRepoContext{})).Changes(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, changes, 3)
+}
+
+func TestChangeHistory(t *testing.T) {
+	var h changeHistory
+	for i := 0; i < maxChangeHistory+10; i++ {
+		h = h.add([]*DatasetChange{{DatasetID: "x", ChangedAt: time.Unix(int64(i), 0)}})
+	}
+	assert.Len(t, h, maxChangeHistory)
+	assert.Equal(t, time.Unix(10, 0), h[0].ChangedAt)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
+	geojson "github.com/paulmach/go.geojson"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
@@ -17,6 +19,8 @@ type InMemoryRepoContext struct {
 	PlateauSpecs []PlateauSpec          `json:"plateauSpecs"`
 	Years        []int                  `json:"years"`
 	CityGML      map[ID]*CityGMLDataset `json:"cityGML"`
+	// AreaReadings are readings of area names in kana or romaji, which are used for search.
+	AreaReadings map[AreaCode][]string `json:"areaReadings,omitempty"`
 }
 
 // InMemoryRepo is a repository that stores all data in memory.
@@ -25,6 +29,10 @@ type InMemoryRepo struct {
 	ctx                 *InMemoryRepoContext
 	areasForDataTypes   map[string]map[AreaCode]bool
 	areasWithoutDataset map[ID]struct{}
+	areasByCode         map[AreaCode]Area
+	// areaFeatures are municipality polygons for spatial conditions. If nil, polygons of govpolygon are used.
+	areaFeatures   []*geojson.Feature
+	areaFootprints *areaFootprintsCache
 }
 
 var _ Repo = (*InMemoryRepo)(nil)
@@ -42,10 +50,28 @@ func (c *InMemoryRepo) Name() string {
 	return fmt.Sprintf("inmemory(%s)", c.ctx.Name)
 }
 
+func (c *InMemoryRepo) Context() *InMemoryRepoContext {
+	return c.ctx
+}
+
 func (c *InMemoryRepo) SetContext(ctx *InMemoryRepoContext) {
 	c.ctx = ctx
 	c.areasForDataTypes = areasForDatasetTypes(ctx.Datasets.All())
 	c.areasWithoutDataset = areasWithoutDataset(ctx.Datasets, ctx.Areas)
+	c.areasByCode = lo.SliceToMap(ctx.Areas.All(), func(a Area) (AreaCode, Area) {
+		return a.GetCode(), a
+	})
+	c.areaFootprints = &areaFootprintsCache{}
+}
+
+// SetAreaFeatures replaces municipality polygons used for spatial conditions. Each feature should have a "code" property.
+func (c *InMemoryRepo) SetAreaFeatures(features []*geojson.Feature) {
+	c.areaFeatures = features
+	c.areaFootprints = &areaFootprintsCache{}
+}
+
+func (c *InMemoryRepo) footprints() areaFootprints {
+	return c.areaFootprints.get(c.areaFeatures, c.ctx)
 }
 
 func (c *InMemoryRepo) Node(ctx context.Context, id ID) (Node, error) {
@@ -112,6 +138,15 @@ func (c *InMemoryRepo) Area(ctx context.Context, code AreaCode) (Area, error) {
 
 func (c *InMemoryRepo) Areas(ctx context.Context, input *AreasInput) (res []Area, _ error) {
 	inp := lo.FromPtr(input)
+	sf, err := newSpatialFilter(inp.Bbox, inp.Point, inp.Geometry)
+	if err != nil {
+		return nil, err
+	}
+	var fps areaFootprints
+	if sf != nil {
+		fps = c.footprints()
+	}
+
 	types := c.getDatasetTypeCodes(inp.DatasetTypes, inp.Categories)
 
 	var codes []AreaCode
@@ -126,7 +161,7 @@ func (c *InMemoryRepo) Areas(ctx context.Context, input *AreasInput) (res []Area
 	}
 
 	res = c.ctx.Areas.Filter(func(a Area) bool {
-		if !filterArea(a, inp, c.areasWithoutDataset) {
+		if !filterArea(a, inp, c.areasWithoutDataset, c.ctx.AreaReadings) {
 			return false
 		}
 
@@ -134,9 +169,13 @@ func (c *InMemoryRepo) Areas(ctx context.Context, input *AreasInput) (res []Area
 			return false
 		}
 
+		if sf != nil && !sf.test(fps[a.GetCode()]) {
+			return false
+		}
+
 		return true
 	})
-	return
+	return rankAreas(res, inp.SearchTokens, c.ctx.AreaReadings), nil
 }
 
 func (c *InMemoryRepo) DatasetTypes(ctx context.Context, input *DatasetTypesInput) (res []DatasetType, _ error) {
@@ -147,14 +186,64 @@ func (c *InMemoryRepo) DatasetTypes(ctx context.Context, input *DatasetTypesInpu
 }
 
 func (c *InMemoryRepo) Datasets(ctx context.Context, input *DatasetsInput) (res []Dataset, _ error) {
+	ds, err := c.filterDatasets(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return removeAdminFromDatasets(ctx, ds), nil
+}
+
+func (c *InMemoryRepo) DatasetsConnection(ctx context.Context, input *DatasetsInput, first *int, after *string, orderBy *DatasetOrder) (*DatasetConnection, error) {
+	ds, err := c.filterDatasets(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := paginateDatasets(ds, input, first, after, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// admin is removed only from the datasets in the page
+	res.Nodes = removeAdminFromDatasets(ctx, res.Nodes)
+	for i, e := range res.Edges {
+		e.Node = res.Nodes[i]
+	}
+	return res, nil
+}
+
+func (c *InMemoryRepo) filterDatasets(ctx context.Context, input *DatasetsInput) ([]Dataset, error) {
 	if input == nil {
 		input = &DatasetsInput{}
 	}
 
+	sf, err := newSpatialFilter(input.Bbox, input.Point, input.Geometry)
+	if err != nil {
+		return nil, err
+	}
+	var fps areaFootprints
+	if sf != nil {
+		fps = c.footprints()
+	}
+
 	stages := allowAdminStages(ctx)
-	return removeAdminFromDatasets(ctx, c.ctx.Datasets.Filter(func(t Dataset) bool {
-		return filterDataset(t, *input, stages)
-	})), nil
+	res := c.ctx.Datasets.Filter(func(t Dataset) bool {
+		// datasets without areas do not match spatial conditions
+		return filterDataset(t, *input, stages) && (sf == nil || sf.test(fps.get(MostDetailedAreaCodeFrom(t))))
+	})
+	return searchDatasets(res, input.SearchTokens, c.areasByCode, c.ctx.AreaReadings), nil
+}
+
+var _ searchScorer = (*InMemoryRepo)(nil)
+
+// searchScore scores the node with areas and readings of the repo so that Merger can rank results of repos together.
+func (c *InMemoryRepo) searchScore(n Node, tokens []string) float64 {
+	return nodeSearchScore(n, tokens, c.areasByCode, c.ctx.AreaReadings)
+}
+
+// Changes returns no changes as InMemoryRepo does not keep history. Changes are recorded by RepoWrapper.
+func (c *InMemoryRepo) Changes(ctx context.Context, since *time.Time) ([]*DatasetChange, error) {
+	return []*DatasetChange{}, nil
 }
 
 func (c *InMemoryRepo) PlateauSpecs(ctx context.Context) ([]*PlateauSpec, error) {
@@ -191,7 +280,7 @@ func areasForDatasetTypes(ds []Dataset) map[string]map[AreaCode]bool {
 		datasetTypeCode := d.GetTypeCode()
 
 		codes := areaCodesFrom(d)
-		code := mostDetailedAreaCodeFrom(d)
+		code := MostDetailedAreaCodeFrom(d)
 
 		for _, c := range codes {
 			mostDetailed := code != nil && c == *code
@@ -96,24 +96,11 @@ func filterDataset(d Dataset, input DatasetsInput, stages []string) bool {
 		return false
 	}
 
-	text := append(
-		[]string{
-			d.GetName(),
-			lo.FromPtr(d.GetDescription()),
-		},
-		d.GetGroups()...,
-	)
 	var spec string
 	switch d2 := d.(type) {
 	case *PlateauDataset:
 		if d2 != nil {
 			spec = string(d2.PlateauSpecMinorID)
-			if d2.Subname != nil {
-				text = append(text, *d2.Subname)
-			}
-			if d2.Subcode != nil {
-				text = append(text, *d2.Subcode)
-			}
 		}
 	case PlateauDataset:
 		spec = string(d2.PlateauSpecMinorID)
@@ -127,17 +114,6 @@ func filterDataset(d Dataset, input DatasetsInput, stages []string) bool {
 		return false
 	}
 
-	if len(input.SearchTokens) > 0 {
-		// all tokens must be included in at least one of the text
-		if lo.SomeBy(input.SearchTokens, func(t string) bool {
-			return lo.EveryBy(text, func(t2 string) bool {
-				return t2 == "" || !strings.Contains(t2, t)
-			})
-		}) {
-			return false
-		}
-	}
-
 	if lo.FromPtr(input.GroupedOnly) && len(d.GetGroups()) == 0 {
 		return false
 	}
@@ -279,7 +255,8 @@ func areaCodesFrom(d Dataset) []AreaCode {
 	return nil
 }
 
-func mostDetailedAreaCodeFrom(d Dataset) *AreaCode {
+// MostDetailedAreaCodeFrom returns the code of the most detailed area that the dataset belongs to: the ward, the city or the prefecture.
+func MostDetailedAreaCodeFrom(d Dataset) *AreaCode {
 	if c := d.GetWardCode(); c != nil {
 		return util.CloneRef(c)
 	}
@@ -292,7 +269,7 @@ func mostDetailedAreaCodeFrom(d Dataset) *AreaCode {
 	return nil
 }
 
-func filterArea(area Area, input AreasInput, areasWithoutDataset map[ID]struct{}) bool {
+func filterArea(area Area, input AreasInput, areasWithoutDataset map[ID]struct{}, readings map[AreaCode][]string) bool {
 	if area == nil {
 		return false
 	}
@@ -310,14 +287,11 @@ func filterArea(area Area, input AreasInput, areasWithoutDataset map[ID]struct{}
 		}
 	}
 
-	testName := func(name string) bool {
-		return len(input.SearchTokens) == 0 || lo.SomeBy(input.SearchTokens, func(t string) bool {
-			return strings.Contains(name, t)
-		})
-	}
-
-	if !testName(area.GetName()) {
-		return false
+	if len(input.SearchTokens) > 0 {
+		// at least one of the tokens must match
+		if _, ok := currentSearcher().Score(areaSearchDocument(area, readings), input.SearchTokens, false); !ok {
+			return false
+		}
 	}
 
 	if input.ParentCode != nil {
@@ -340,6 +314,79 @@ func filterArea(area Area, input AreasInput, areasWithoutDataset map[ID]struct{}
 	return true
 }
 
+// datasetSearchDocument returns texts of the dataset to be searched. Names and readings of the areas of the dataset are also included.
+func datasetSearchDocument(d Dataset, areas map[AreaCode]Area, readings map[AreaCode][]string) searchDocument {
+	doc := searchDocument{
+		Name:  d.GetName(),
+		Texts: append([]string{lo.FromPtr(d.GetDescription())}, d.GetGroups()...),
+	}
+	if d2, ok := d.(*PlateauDataset); ok && d2 != nil {
+		doc.Texts = append(doc.Texts, lo.FromPtr(d2.Subname), lo.FromPtr(d2.Subcode))
+	}
+
+	for _, code := range areaCodesFrom(d) {
+		if a := areas[code]; a != nil {
+			doc.Names = append(doc.Names, a.GetName())
+		}
+		doc.Readings = append(doc.Readings, readings[code]...)
+	}
+	return doc
+}
+
+// nodeSearchScore returns the score of a dataset or an area for the tokens.
+func nodeSearchScore(n Node, tokens []string, areas map[AreaCode]Area, readings map[AreaCode][]string) float64 {
+	var doc searchDocument
+	switch n := n.(type) {
+	case Dataset:
+		doc = datasetSearchDocument(n, areas, readings)
+	case Area:
+		doc = areaSearchDocument(n, readings)
+	default:
+		return 0
+	}
+	score, _ := currentSearcher().Score(doc, tokens, false)
+	return score
+}
+
+func areaSearchDocument(area Area, readings map[AreaCode][]string) searchDocument {
+	return searchDocument{
+		Name:     area.GetName(),
+		Readings: readings[area.GetCode()],
+	}
+}
+
+// searchDatasets returns datasets that match all the tokens in descending order of relevance.
+func searchDatasets(ds []Dataset, tokens []string, areas map[AreaCode]Area, readings map[AreaCode][]string) []Dataset {
+	if len(tokens) == 0 {
+		return ds
+	}
+
+	s := currentSearcher()
+	res := make([]Dataset, 0, len(ds))
+	scores := make([]float64, 0, len(ds))
+	for _, d := range ds {
+		if score, ok := s.Score(datasetSearchDocument(d, areas, readings), tokens, true); ok {
+			res = append(res, d)
+			scores = append(scores, score)
+		}
+	}
+	return sortBySearchScore(res, scores)
+}
+
+// rankAreas sorts areas in descending order of relevance for the tokens.
+func rankAreas(areas []Area, tokens []string, readings map[AreaCode][]string) []Area {
+	if len(tokens) == 0 {
+		return areas
+	}
+
+	s := currentSearcher()
+	scores := lo.Map(areas, func(a Area, _ int) float64 {
+		score, _ := s.Score(areaSearchDocument(a, readings), tokens, false)
+		return score
+	})
+	return sortBySearchScore(areas, scores)
+}
+
 func filterDatasetType(ty DatasetType, input DatasetTypesInput) bool {
 	if ty == nil || input.Category != nil && *input.Category != ty.GetCategory() {
 		return false
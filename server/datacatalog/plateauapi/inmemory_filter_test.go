@@ -198,7 +198,7 @@ func TestFilterArea(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			actual := filterArea(tc.area, tc.input, tc.areasWithoutDataset)
+			actual := filterArea(tc.area, tc.input, tc.areasWithoutDataset, nil)
 			assert.Equal(t, tc.expected, actual)
 		})
 	}
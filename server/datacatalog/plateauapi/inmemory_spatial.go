@@ -0,0 +1,287 @@
+package plateauapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+	"github.com/eukarya-inc/reearth-plateauview/server/geo/jisx0410"
+	"github.com/eukarya-inc/reearth-plateauview/server/govpolygon"
+	geojson "github.com/paulmach/go.geojson"
+)
+
+// spatialFilter is a geographic condition of DatasetsInput and AreasInput. All of the conditions must be satisfied.
+type spatialFilter struct {
+	bbox     *geo.Bounds2
+	point    *geo.Point2
+	geometry []geo.Polygon2
+}
+
+func newSpatialFilter(bbox, point []float64, geometry any) (*spatialFilter, error) {
+	if bbox == nil && point == nil && geometry == nil {
+		return nil, nil
+	}
+
+	f := &spatialFilter{}
+	if bbox != nil {
+		if len(bbox) != 4 || bbox[0] > bbox[2] || bbox[1] > bbox[3] {
+			return nil, fmt.Errorf("invalid bbox: must be [minLng, minLat, maxLng, maxLat]")
+		}
+		f.bbox = &geo.Bounds2{
+			Min: geo.Point2{X: bbox[0], Y: bbox[1]},
+			Max: geo.Point2{X: bbox[2], Y: bbox[3]},
+		}
+	}
+	if point != nil {
+		if len(point) != 2 {
+			return nil, fmt.Errorf("invalid point: must be [lng, lat]")
+		}
+		f.point = &geo.Point2{X: point[0], Y: point[1]}
+	}
+	if geometry != nil {
+		polygons, err := polygonsFromGeoJSON(geometry)
+		if err != nil {
+			return nil, err
+		}
+		f.geometry = polygons
+	}
+	return f, nil
+}
+
+func polygonsFromGeoJSON(geometry any) ([]geo.Polygon2, error) {
+	b, err := json.Marshal(geometry)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+	g, err := geojson.UnmarshalGeometry(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+	if !g.IsPolygon() && !g.IsMultiPolygon() {
+		return nil, fmt.Errorf("invalid geometry: must be Polygon or MultiPolygon")
+	}
+	res := outerRings(g)
+	if len(res) == 0 {
+		return nil, fmt.Errorf("invalid geometry: empty")
+	}
+	return res, nil
+}
+
+// outerRings returns the outer rings of a Polygon or MultiPolygon. Holes are ignored.
+func outerRings(g *geojson.Geometry) (res []geo.Polygon2) {
+	polygons := g.MultiPolygon
+	if g.IsPolygon() {
+		polygons = [][][][]float64{g.Polygon}
+	}
+	for _, polygon := range polygons {
+		if len(polygon) == 0 {
+			continue
+		}
+		ring := make(geo.Polygon2, 0, len(polygon[0]))
+		for _, p := range polygon[0] {
+			if len(p) < 2 {
+				continue
+			}
+			ring = append(ring, geo.Point2{X: p[0], Y: p[1]})
+		}
+		// the last position of GeoJSON rings is the same as the first one
+		if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+			ring = ring[:len(ring)-1]
+		}
+		if len(ring) >= 3 {
+			res = append(res, ring)
+		}
+	}
+	return
+}
+
+func (f *spatialFilter) test(fp *footprint) bool {
+	if f == nil {
+		return true
+	}
+	if fp == nil {
+		return false
+	}
+	if f.bbox != nil && !fp.intersects(f.bbox.Polygon(), *f.bbox) {
+		return false
+	}
+	if f.point != nil && !fp.contains(*f.point) {
+		return false
+	}
+	if f.geometry != nil {
+		found := false
+		for _, po := range f.geometry {
+			if fp.intersects(po, po.Bounds()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// footprint is the extent of an area: municipality polygons and bounds of meshes of CityGML files.
+type footprint struct {
+	bounds   geo.Bounds2
+	polygons []geo.Polygon2
+	meshes   []geo.Bounds2
+}
+
+func (fp *footprint) addPolygon(po geo.Polygon2) {
+	fp.extend(po.Bounds())
+	fp.polygons = append(fp.polygons, po)
+}
+
+func (fp *footprint) addMesh(b geo.Bounds2) {
+	fp.extend(b)
+	fp.meshes = append(fp.meshes, b)
+}
+
+func (fp *footprint) extend(b geo.Bounds2) {
+	if len(fp.polygons) == 0 && len(fp.meshes) == 0 {
+		fp.bounds = b
+		return
+	}
+	fp.bounds.Min.X = min(fp.bounds.Min.X, b.Min.X)
+	fp.bounds.Min.Y = min(fp.bounds.Min.Y, b.Min.Y)
+	fp.bounds.Max.X = max(fp.bounds.Max.X, b.Max.X)
+	fp.bounds.Max.Y = max(fp.bounds.Max.Y, b.Max.Y)
+}
+
+func (fp *footprint) intersects(po geo.Polygon2, b geo.Bounds2) bool {
+	if !overlaps(fp.bounds, b) {
+		return false
+	}
+	for _, m := range fp.meshes {
+		if overlaps(m, b) && po.IsIntersect(m) {
+			return true
+		}
+	}
+	for _, p := range fp.polygons {
+		if overlaps(p.Bounds(), b) && p.IsIntersectPolygon(po) {
+			return true
+		}
+	}
+	return false
+}
+
+func (fp *footprint) contains(p geo.Point2) bool {
+	if !fp.bounds.In(p) {
+		return false
+	}
+	for _, m := range fp.meshes {
+		if m.In(p) {
+			return true
+		}
+	}
+	for _, po := range fp.polygons {
+		if po.In(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// overlaps is the same as Bounds2.Intersects but also true when the bounds only touch or are points.
+func overlaps(a, b geo.Bounds2) bool {
+	return a.Min.X <= b.Max.X && b.Min.X <= a.Max.X &&
+		a.Min.Y <= b.Max.Y && b.Min.Y <= a.Max.Y
+}
+
+// areaFootprints maps area codes to their footprints. Footprints of cities include their wards,
+// and footprints of prefectures include their cities.
+type areaFootprints map[AreaCode]*footprint
+
+func (a areaFootprints) get(code *AreaCode) *footprint {
+	if code == nil {
+		return nil
+	}
+	return a[*code]
+}
+
+func (a areaFootprints) add(code AreaCode, f func(*footprint)) {
+	if code == "" {
+		return
+	}
+	fp := a[code]
+	if fp == nil {
+		fp = &footprint{}
+		a[code] = fp
+	}
+	f(fp)
+}
+
+// addToAreaAndParents adds to the area, its city if it is a ward, and its prefecture.
+func (a areaFootprints) addToAreaAndParents(code AreaCode, wardToCity map[AreaCode]AreaCode, f func(*footprint)) {
+	a.add(code, f)
+	if city, ok := wardToCity[code]; ok {
+		a.add(city, f)
+	}
+	if !code.IsPrefectureCode() {
+		a.add(AreaCode(code.PrefectureCode()), f)
+	}
+}
+
+// newAreaFootprints builds footprints from municipality polygons whose "code" property is a city or ward code,
+// and mesh codes of CityGML datasets.
+func newAreaFootprints(features []*geojson.Feature, areas Areas, citygml map[ID]*CityGMLDataset) areaFootprints {
+	wardToCity := map[AreaCode]AreaCode{}
+	for _, a := range areas[AreaTypeWard] {
+		if w, ok := a.(*Ward); ok && w != nil {
+			wardToCity[w.Code] = w.CityCode
+		}
+	}
+
+	res := areaFootprints{}
+	for _, f := range features {
+		if f == nil || f.Geometry == nil {
+			continue
+		}
+		code, _ := f.Properties["code"].(string)
+		if code == "" {
+			continue
+		}
+		for _, po := range outerRings(f.Geometry) {
+			res.addToAreaAndParents(AreaCode(code), wardToCity, func(fp *footprint) {
+				fp.addPolygon(po)
+			})
+		}
+	}
+
+	for _, d := range citygml {
+		if d == nil {
+			continue
+		}
+		for _, m := range AdminFrom(d.Admin).MeshCodes {
+			mesh, err := jisx0410.Parse(m)
+			if err != nil {
+				continue
+			}
+			res.addToAreaAndParents(d.CityCode, nil, func(fp *footprint) {
+				fp.addMesh(mesh.Bounds)
+			})
+		}
+	}
+
+	return res
+}
+
+// areaFootprintsCache builds footprints lazily as most queries do not use spatial conditions.
+type areaFootprintsCache struct {
+	once       sync.Once
+	footprints areaFootprints
+}
+
+func (c *areaFootprintsCache) get(features []*geojson.Feature, ctx *InMemoryRepoContext) areaFootprints {
+	c.once.Do(func() {
+		if features == nil {
+			features = govpolygon.JapanCityFeatures
+		}
+		c.footprints = newAreaFootprints(features, ctx.Areas, ctx.CityGML)
+	})
+	return c.footprints
+}
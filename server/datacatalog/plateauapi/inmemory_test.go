@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	geojson "github.com/paulmach/go.geojson"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
@@ -695,3 +696,104 @@ func TestAreasWithoutDataset(t *testing.T) {
 
 	assert.Equal(t, expected, res)
 }
+
+func TestInMemoryRepo_SpatialConditions(t *testing.T) {
+	square := func(code string, minX, minY, maxX, maxY float64) *geojson.Feature {
+		f := geojson.NewPolygonFeature([][][]float64{{{minX, minY}, {maxX, minY}, {maxX, maxY}, {minX, maxY}, {minX, minY}}})
+		f.Properties["code"] = code
+		return f
+	}
+
+	d1 := &PlateauDataset{ID: "d1", TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("01")), CityCode: lo.ToPtr(AreaCode("01100")), WardCode: lo.ToPtr(AreaCode("01101"))}
+	d2 := &PlateauDataset{ID: "d2", TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("02")), CityCode: lo.ToPtr(AreaCode("02100"))}
+	d3 := &GenericDataset{ID: "d3", TypeCode: "generic"}
+
+	a := NewInMemoryRepo(&InMemoryRepoContext{
+		Areas: Areas{
+			AreaTypePrefecture: []Area{
+				&Prefecture{Code: "01", Name: "北海道"},
+				&Prefecture{Code: "02", Name: "青森県"},
+				&Prefecture{Code: "13", Name: "東京都"},
+			},
+			AreaTypeCity: []Area{
+				&City{Code: "01100", Name: "札幌市", PrefectureCode: "01"},
+				&City{Code: "02100", Name: "青森市", PrefectureCode: "02"},
+				&City{Code: "13101", Name: "千代田区", PrefectureCode: "13"},
+			},
+			AreaTypeWard: []Area{
+				&Ward{Code: "01101", Name: "中央区", CityCode: "01100", PrefectureCode: "01"},
+				&Ward{Code: "01102", Name: "北区", CityCode: "01100", PrefectureCode: "01"},
+			},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{d1, d2},
+			DatasetTypeCategoryGeneric: []Dataset{d3},
+		},
+		CityGML: map[ID]*CityGMLDataset{
+			// 13101 has no polygon but its CityGML has meshes
+			"cg": {CityCode: "13101", Admin: &Admin{MeshCodes: []string{"533946", "invalid"}}},
+		},
+	})
+	a.SetAreaFeatures([]*geojson.Feature{
+		square("01101", 0, 0, 1, 1),
+		square("01102", 1, 0, 2, 1),
+		square("02100", 10, 10, 11, 11),
+	})
+
+	areaCodes := func(t *testing.T, input AreasInput) []AreaCode {
+		t.Helper()
+		input.IncludeEmpty = lo.ToPtr(true)
+		res, err := a.Areas(context.Background(), &input)
+		assert.NoError(t, err)
+		return lo.Map(res, func(a Area, _ int) AreaCode { return a.GetCode() })
+	}
+
+	t.Run("point", func(t *testing.T) {
+		assert.Equal(t, []AreaCode{"01", "01100", "01101"}, areaCodes(t, AreasInput{Point: []float64{0.5, 0.5}}))
+		assert.Equal(t, []AreaCode{"13", "13101"}, areaCodes(t, AreasInput{Point: []float64{139.7625, 35.675}}))
+		assert.Empty(t, areaCodes(t, AreasInput{Point: []float64{5, 5}}))
+	})
+
+	t.Run("bbox", func(t *testing.T) {
+		assert.Equal(t, []AreaCode{"01", "01100", "01102"}, areaCodes(t, AreasInput{Bbox: []float64{1.5, 0.2, 1.8, 0.8}}))
+		assert.Equal(t, []AreaCode{"01", "02", "01100", "02100", "01101", "01102"}, areaCodes(t, AreasInput{Bbox: []float64{0.5, 0.5, 10.5, 10.5}}))
+		assert.Equal(t, []AreaCode{"01100", "02100"}, areaCodes(t, AreasInput{
+			Bbox:         []float64{0.5, 0.5, 10.5, 10.5},
+			SearchTokens: []string{"市"},
+		}))
+	})
+
+	t.Run("geometry", func(t *testing.T) {
+		// a triangle between 01101 and 02100
+		assert.Empty(t, areaCodes(t, AreasInput{Geometry: map[string]any{
+			"type":        "Polygon",
+			"coordinates": []any{[]any{[]any{3.0, 3.0}, []any{4.0, 3.0}, []any{3.0, 4.0}, []any{3.0, 3.0}}},
+		}}))
+		assert.Equal(t, []AreaCode{"02", "02100"}, areaCodes(t, AreasInput{Geometry: map[string]any{
+			"type": "MultiPolygon",
+			"coordinates": []any{
+				[]any{[]any{[]any{3.0, 3.0}, []any{4.0, 3.0}, []any{3.0, 4.0}, []any{3.0, 3.0}}},
+				[]any{[]any{[]any{9.0, 9.0}, []any{10.5, 9.0}, []any{10.5, 10.5}, []any{9.0, 9.0}}},
+			},
+		}}))
+	})
+
+	t.Run("datasets", func(t *testing.T) {
+		res, err := a.Datasets(context.Background(), &DatasetsInput{Bbox: []float64{0.5, 0.5, 10.5, 10.5}})
+		assert.NoError(t, err)
+		assert.Equal(t, []ID{"d1", "d2"}, lo.Map(res, func(d Dataset, _ int) ID { return d.GetID() }))
+
+		res, err = a.Datasets(context.Background(), &DatasetsInput{Point: []float64{10.5, 10.5}})
+		assert.NoError(t, err)
+		assert.Equal(t, []ID{"d2"}, lo.Map(res, func(d Dataset, _ int) ID { return d.GetID() }))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := a.Areas(context.Background(), &AreasInput{Bbox: []float64{1, 1, 0, 0}})
+		assert.Error(t, err)
+		_, err = a.Datasets(context.Background(), &DatasetsInput{Point: []float64{1}})
+		assert.Error(t, err)
+		_, err = a.Datasets(context.Background(), &DatasetsInput{Geometry: map[string]any{"type": "Point", "coordinates": []any{1.0, 1.0}}})
+		assert.Error(t, err)
+	})
+}
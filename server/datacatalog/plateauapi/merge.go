@@ -7,6 +7,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
@@ -93,7 +94,13 @@ func (m *Merger) Areas(ctx context.Context, input *AreasInput) ([]Area, error) {
 		return nil, err
 	}
 
-	return mergeResults(areas, true), nil
+	res := mergeResults(areas, true)
+	if tokens := lo.FromPtr(input).SearchTokens; len(tokens) > 0 {
+		res = sortBySearchScore(res, lo.Map(res, func(a Area, _ int) float64 {
+			return m.searchScore(a, tokens)
+		}))
+	}
+	return res, nil
 }
 
 func (m *Merger) DatasetTypes(ctx context.Context, input *DatasetTypesInput) ([]DatasetType, error) {
@@ -115,7 +122,36 @@ func (m *Merger) Datasets(ctx context.Context, input *DatasetsInput) ([]Dataset,
 		return nil, err
 	}
 
-	return mergeResults(datasets, false), nil
+	res := mergeResults(datasets, false)
+	if input != nil && len(input.SearchTokens) > 0 {
+		// rank datasets of all repos together; ties keep the order ranked by each repo
+		res = sortBySearchScore(res, lo.Map(res, func(d Dataset, _ int) float64 {
+			return m.searchScore(d, input.SearchTokens)
+		}))
+	}
+	return res, nil
+}
+
+// DatasetsConnection paginates the merged datasets so that cursors are consistent across the repos.
+func (m *Merger) DatasetsConnection(ctx context.Context, input *DatasetsInput, first *int, after *string, orderBy *DatasetOrder) (*DatasetConnection, error) {
+	datasets, err := m.Datasets(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return paginateDatasets(datasets, input, first, after, orderBy)
+}
+
+func (m *Merger) Changes(ctx context.Context, since *time.Time) ([]*DatasetChange, error) {
+	res, err := getFlattenRepoResults(m.repos, func(r Repo) ([]*DatasetChange, error) {
+		return r.Changes(ctx, since)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortChanges(res)
+	return res, nil
 }
 
 func (m *Merger) PlateauSpecs(ctx context.Context) ([]*PlateauSpec, error) {
@@ -154,6 +190,30 @@ func (m *Merger) datasetTypes(ctx context.Context, input *DatasetTypesInput) ([]
 	return res, nil
 }
 
+// searchScorer is implemented by repos that know areas and readings of area names, which are needed to score search results.
+type searchScorer interface {
+	searchScore(n Node, tokens []string) float64
+}
+
+var _ searchScorer = (*Merger)(nil)
+
+// searchScore returns the highest score of the node among the repos, as only the repo that has the node knows its areas.
+// Nodes of repos that cannot score them, such as remote repos, are scored without areas.
+func (m *Merger) searchScore(n Node, tokens []string) float64 {
+	scored := false
+	var res float64
+	for _, r := range m.repos {
+		if s, ok := r.(searchScorer); ok {
+			res = max(res, s.searchScore(n, tokens))
+			scored = true
+		}
+	}
+	if !scored {
+		return nodeSearchScore(n, tokens, nil, nil)
+	}
+	return res
+}
+
 func setOrderToNodes[T Node](nodes []T, mergedDatasetTypes []ID) {
 	if mergedDatasetTypes == nil {
 		return
@@ -1,6 +1,7 @@
 package plateauapi
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
 	"sort"
@@ -24,6 +25,26 @@ func (a Areas) Append(cat AreaType, as []Area) {
 	a[cat] = append(a[cat], as...)
 }
 
+// UnmarshalJSON decodes areas with concrete types determined by the area types.
+func (a *Areas) UnmarshalJSON(b []byte) error {
+	res, err := unmarshalByKey(b, func(k AreaType, v json.RawMessage) ([]Area, error) {
+		switch k {
+		case AreaTypePrefecture:
+			return unmarshalSlice[*Prefecture, Area](v)
+		case AreaTypeCity:
+			return unmarshalSlice[*City, Area](v)
+		case AreaTypeWard:
+			return unmarshalSlice[*Ward, Area](v)
+		}
+		return nil, fmt.Errorf("unknown area type: %s", k)
+	})
+	if err != nil {
+		return err
+	}
+	*a = res
+	return nil
+}
+
 func (a Areas) All() []Area {
 	entries := lo.Entries(a)
 	sort.Slice(entries, func(i, j int) bool {
@@ -95,6 +116,26 @@ func (d Datasets) Append(cat DatasetTypeCategory, ds []Dataset) {
 	d[cat] = append(d[cat], ds...)
 }
 
+// UnmarshalJSON decodes datasets with concrete types determined by the categories.
+func (d *Datasets) UnmarshalJSON(b []byte) error {
+	res, err := unmarshalByKey(b, func(k DatasetTypeCategory, v json.RawMessage) ([]Dataset, error) {
+		switch k {
+		case DatasetTypeCategoryPlateau:
+			return unmarshalSlice[*PlateauDataset, Dataset](v)
+		case DatasetTypeCategoryRelated:
+			return unmarshalSlice[*RelatedDataset, Dataset](v)
+		case DatasetTypeCategoryGeneric:
+			return unmarshalSlice[*GenericDataset, Dataset](v)
+		}
+		return nil, fmt.Errorf("unknown dataset type category: %s", k)
+	})
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
 func (d Datasets) All() []Dataset {
 	entries := lo.Entries(d)
 	sort.Slice(entries, func(i, j int) bool {
@@ -135,6 +176,26 @@ func (d DatasetTypes) Append(cat DatasetTypeCategory, ds []DatasetType) {
 	d[cat] = append(d[cat], ds...)
 }
 
+// UnmarshalJSON decodes dataset types with concrete types determined by the categories.
+func (d *DatasetTypes) UnmarshalJSON(b []byte) error {
+	res, err := unmarshalByKey(b, func(k DatasetTypeCategory, v json.RawMessage) ([]DatasetType, error) {
+		switch k {
+		case DatasetTypeCategoryPlateau:
+			return unmarshalSlice[*PlateauDatasetType, DatasetType](v)
+		case DatasetTypeCategoryRelated:
+			return unmarshalSlice[*RelatedDatasetType, DatasetType](v)
+		case DatasetTypeCategoryGeneric:
+			return unmarshalSlice[*GenericDatasetType, DatasetType](v)
+		}
+		return nil, fmt.Errorf("unknown dataset type category: %s", k)
+	})
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
 func (d DatasetTypes) All() []DatasetType {
 	entries := lo.Entries(d)
 	sort.Slice(entries, func(i, j int) bool {
@@ -252,7 +313,7 @@ type VagueID interface {
 }
 
 func (a *PlateauDataset) VagueID() string {
-	return fmt.Sprintf("d_%s_%s", mostDetailedAreaCodeFrom(a), a.TypeCode)
+	return fmt.Sprintf("d_%s_%s", MostDetailedAreaCodeFrom(a), a.TypeCode)
 }
 
 func getVagueID(n any) string {
@@ -337,6 +398,8 @@ type Admin struct {
 	CityGMLAssetID string     `json:"cityGmlAssetId,omitempty"`
 	CityGMLURLs    []string   `json:"cityGmlUrls,omitempty"`
 	MaxLODURLs     []string   `json:"maxLodUrls,omitempty"`
+	// MeshCodes are mesh codes of CityGML files, used for spatial conditions of the city.
+	MeshCodes []string `json:"meshCodes,omitempty"`
 }
 
 func (a Admin) IsEmpty() bool {
@@ -348,7 +411,8 @@ func (a Admin) IsEmpty() bool {
 		a.SubAreaCode == "" &&
 		a.CityGMLAssetID == "" &&
 		len(a.CityGMLURLs) == 0 &&
-		len(a.MaxLODURLs) == 0
+		len(a.MaxLODURLs) == 0 &&
+		len(a.MeshCodes) == 0
 }
 
 func AdminFrom(a any) (admin Admin) {
@@ -367,5 +431,46 @@ func AdminFrom(a any) (admin Admin) {
 		return admin
 	}
 
+	// admins decoded from JSON such as snapshots are maps
+	if m, ok := a.(map[string]any); ok {
+		if b, err := json.Marshal(m); err == nil {
+			_ = json.Unmarshal(b, &admin)
+		}
+	}
+
 	return
 }
+
+func unmarshalByKey[K ~string, V any](b []byte, f func(K, json.RawMessage) ([]V, error)) (map[K][]V, error) {
+	var raw map[K]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	res := make(map[K][]V, len(raw))
+	for k, v := range raw {
+		s, err := f(k, v)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = s
+	}
+	return res, nil
+}
+
+// unmarshalSlice decodes a slice of T and converts elements to the interface I. T must implement I.
+func unmarshalSlice[T, I any](b []byte) ([]I, error) {
+	var s []T
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, nil
+	}
+	return lo.Map(s, func(v T, _ int) I {
+		return any(v).(I)
+	}), nil
+}
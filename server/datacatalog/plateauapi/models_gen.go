@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 // 地域。都道府県（Prefecture）・市区町村（City）・区（政令指定都市のみ・Ward）のいずれかです。
@@ -138,7 +139,8 @@ type AreasInput struct {
 	// 地域の種類。例えば、市を検索したい場合は CITY を指定します。複数指定するとOR条件で検索を行います。
 	// 未指定の場合、全ての地域を対象に検索します。
 	AreaTypes []AreaType `json:"areaTypes,omitempty"`
-	// 検索文字列。複数指定するとAND条件で絞り込み検索が行えます。
+	// 検索文字列。地域名に対して検索を行い、いずれかの文字列に一致した地域を返します。
+	// 全角・半角、ひらがな・カタカナの違いは無視され、ひらがなやローマ字による地域名の読みでも検索できます。検索結果は関連度の高い順に並びます。
 	SearchTokens []string `json:"searchTokens,omitempty"`
 	// datasetTypes が指定された場合に、検索結果にその地域の親も含めるかどうか。デフォルトは false です。
 	IncludeParents *bool `json:"includeParents,omitempty"`
@@ -146,6 +148,13 @@ type AreasInput struct {
 	IncludeEmpty *bool `json:"includeEmpty,omitempty"`
 	// parentCode が指定された場合に、その地域に間接的に属している地域も検索対象にするかどうか。デフォルトは false です。
 	Deep *bool `json:"deep,omitempty"`
+	// 範囲 [最小経度, 最小緯度, 最大経度, 最大緯度] と交差する地域のみを検索します。
+	Bbox []float64 `json:"bbox,omitempty"`
+	// 地点 [経度, 緯度] を含む地域のみを検索します。
+	Point []float64 `json:"point,omitempty"`
+	// GeoJSONのジオメトリ（Polygon または MultiPolygon）と交差する地域のみを検索します。
+	// bbox・point と同時に指定した場合は、全ての条件を満たすものを検索します。
+	Geometry interface{} `json:"geometry,omitempty"`
 }
 
 // 市区町村
@@ -267,6 +276,68 @@ func (CityGMLDataset) IsNode() {}
 // オブジェクトのID
 func (this CityGMLDataset) GetID() ID { return this.ID }
 
+// データカタログの更新によって検出されたデータセットの変更。
+type DatasetChange struct {
+	// 変更されたデータセットのID。
+	DatasetID ID `json:"datasetId"`
+	// 変更の種類。
+	Type DatasetChangeType `json:"type"`
+	// 変更が検出された日時。RFC3339形式の文字列です。
+	ChangedAt time.Time `json:"changedAt"`
+	// 変更されたデータセットが属するプロジェクト。
+	Project string `json:"project"`
+	// 変更後のデータセット。削除された場合は削除される前のデータセットです。
+	Dataset Dataset `json:"dataset"`
+}
+
+// ページングされたデータセットの検索結果。
+type DatasetConnection struct {
+	// ページに含まれるデータセットとカーソル。
+	Edges []*DatasetEdge `json:"edges"`
+	// ページに含まれるデータセット。
+	Nodes []Dataset `json:"nodes"`
+	// ページの情報。
+	PageInfo *PageInfo `json:"pageInfo"`
+	// 検索条件に一致する全てのデータセットの件数。
+	TotalCount int `json:"totalCount"`
+	// 検索条件に一致する全てのデータセットの件数の内訳。
+	Facets *DatasetFacets `json:"facets"`
+}
+
+// データセットとそのカーソル。
+type DatasetEdge struct {
+	// データセットのカーソル。
+	Cursor string `json:"cursor"`
+	// データセット。
+	Node Dataset `json:"node"`
+}
+
+// 値ごとのデータセットの件数。
+type DatasetFacetCount struct {
+	// 値。種類コード・年度・都道府県コードのいずれかです。
+	Value string `json:"value"`
+	// データセットの件数。
+	Count int `json:"count"`
+}
+
+// 検索条件に一致する全てのデータセットの件数の内訳。ページングの影響を受けません。
+type DatasetFacets struct {
+	// データセットの種類コードごとの件数。
+	Types []*DatasetFacetCount `json:"types"`
+	// データの整備年度ごとの件数。
+	Years []*DatasetFacetCount `json:"years"`
+	// 都道府県コードごとの件数。都道府県に属さないデータセットは含まれません。
+	Prefectures []*DatasetFacetCount `json:"prefectures"`
+}
+
+// データセットの並び順。値が同じ場合はIDの昇順に並びます。
+type DatasetOrder struct {
+	// 並び替えの対象。
+	Field DatasetOrderField `json:"field"`
+	// 並び替えの方向。デフォルトは ASC です。
+	Direction *OrderDirection `json:"direction,omitempty"`
+}
+
 // データセットの種類を検索するためのクエリ。
 type DatasetTypesInput struct {
 	// データセットの種類のカテゴリ。
@@ -292,6 +363,7 @@ type DatasetsInput struct {
 	// 検索結果に含めるデータセットの種類コード。未指定の場合、全てのデータセットの種類を対象に検索し、指定するとその種類で検索結果を絞り込みます。種類コードは例えば "bldg"（建築物モデル）の他、"plateau"（PLATEAU都市モデルデータセット）、"related"（関連データセット）、"generic"（その他のデータセット）が使用可能です。
 	IncludeTypes []string `json:"includeTypes,omitempty"`
 	// 検索文字列。複数指定するとAND条件で絞り込み検索が行えます。
+	// 全角・半角、ひらがな・カタカナの違いは無視され、データセットが属する地域名やその読み（ひらがな・ローマ字）、データセットの種類名の同義語（例えば「建物」で「建築物」）でも検索できます。検索結果は関連度の高い順に並びます。
 	SearchTokens []string `json:"searchTokens,omitempty"`
 	// areaCodesで指定された地域に直接属しているデータセットのみを検索対象にするかどうか。
 	// デフォルトはfalseで、指定された地域に間接的に属するデータセットも全て検索します。
@@ -302,6 +374,13 @@ type DatasetsInput struct {
 	// PLATEAU ARで閲覧可能なデータセットを含めるかどうか。
 	// trueの場合はARで閲覧可能なデータセットのみ、falseの場合はARで閲覧不可能なデータセットのみを返します。
 	Ar *bool `json:"ar,omitempty"`
+	// 範囲 [最小経度, 最小緯度, 最大経度, 最大緯度] と交差する地域のデータセットのみを検索します。
+	Bbox []float64 `json:"bbox,omitempty"`
+	// 地点 [経度, 緯度] を含む地域のデータセットのみを検索します。
+	Point []float64 `json:"point,omitempty"`
+	// GeoJSONのジオメトリ（Polygon または MultiPolygon）と交差する地域のデータセットのみを検索します。
+	// bbox・point と同時に指定した場合は、全ての条件を満たすものを検索します。
+	Geometry interface{} `json:"geometry,omitempty"`
 }
 
 // ユースケースデータなどを含む、その他のデータセット。
@@ -538,6 +617,18 @@ func (GenericDatasetType) IsNode() {}
 
 // オブジェクトのID
 
+// ページの情報。
+type PageInfo struct {
+	// 次のページが存在するかどうか。
+	HasNextPage bool `json:"hasNextPage"`
+	// 前のページが存在するかどうか。
+	HasPreviousPage bool `json:"hasPreviousPage"`
+	// ページの最初の要素のカーソル。
+	StartCursor *string `json:"startCursor,omitempty"`
+	// ページの最後の要素のカーソル。次のページを取得するには after にこの値を指定します。
+	EndCursor *string `json:"endCursor,omitempty"`
+}
+
 // PLATEAU都市モデルの通常のデータセット。例えば、地物型が建築物モデル（bldg）などのデータセットです。
 type PlateauDataset struct {
 	ID ID `json:"id"`
@@ -1291,6 +1382,53 @@ func (e AreaType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// データセットの変更の種類。
+type DatasetChangeType string
+
+const (
+	// データセットが追加された
+	DatasetChangeTypeAdded DatasetChangeType = "ADDED"
+	// データセットが削除された
+	DatasetChangeTypeRemoved DatasetChangeType = "REMOVED"
+	// データセットが変更された
+	DatasetChangeTypeModified DatasetChangeType = "MODIFIED"
+)
+
+var AllDatasetChangeType = []DatasetChangeType{
+	DatasetChangeTypeAdded,
+	DatasetChangeTypeRemoved,
+	DatasetChangeTypeModified,
+}
+
+func (e DatasetChangeType) IsValid() bool {
+	switch e {
+	case DatasetChangeTypeAdded, DatasetChangeTypeRemoved, DatasetChangeTypeModified:
+		return true
+	}
+	return false
+}
+
+func (e DatasetChangeType) String() string {
+	return string(e)
+}
+
+func (e *DatasetChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DatasetChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DatasetChangeType", str)
+	}
+	return nil
+}
+
+func (e DatasetChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // データセットのフォーマット。
 type DatasetFormat string
 
@@ -1359,6 +1497,62 @@ func (e DatasetFormat) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// データセットの並び替えの対象。
+type DatasetOrderField string
+
+const (
+	// データセットのID
+	DatasetOrderFieldID DatasetOrderField = "ID"
+	// データセット名
+	DatasetOrderFieldName DatasetOrderField = "NAME"
+	// データの整備年度
+	DatasetOrderFieldYear DatasetOrderField = "YEAR"
+	// データの公開年度
+	DatasetOrderFieldRegistrationYear DatasetOrderField = "REGISTRATION_YEAR"
+	// データセットが属する最も詳細な地域の地域コード
+	DatasetOrderFieldAreaCode DatasetOrderField = "AREA_CODE"
+	// datasets と同じ並び順。検索文字列が指定された場合は関連度の高い順になります。
+	DatasetOrderFieldRelevance DatasetOrderField = "RELEVANCE"
+)
+
+var AllDatasetOrderField = []DatasetOrderField{
+	DatasetOrderFieldID,
+	DatasetOrderFieldName,
+	DatasetOrderFieldYear,
+	DatasetOrderFieldRegistrationYear,
+	DatasetOrderFieldAreaCode,
+	DatasetOrderFieldRelevance,
+}
+
+func (e DatasetOrderField) IsValid() bool {
+	switch e {
+	case DatasetOrderFieldID, DatasetOrderFieldName, DatasetOrderFieldYear, DatasetOrderFieldRegistrationYear, DatasetOrderFieldAreaCode, DatasetOrderFieldRelevance:
+		return true
+	}
+	return false
+}
+
+func (e DatasetOrderField) String() string {
+	return string(e)
+}
+
+func (e *DatasetOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DatasetOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DatasetOrderField", str)
+	}
+	return nil
+}
+
+func (e DatasetOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // データセットの種類のカテゴリ。
 type DatasetTypeCategory string
 
@@ -1450,6 +1644,50 @@ func (e FloodingScale) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// 並び替えの方向。
+type OrderDirection string
+
+const (
+	// 昇順
+	OrderDirectionAsc OrderDirection = "ASC"
+	// 降順
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // 河川の管理区間
 type RiverAdmin string
 
@@ -0,0 +1,183 @@
+package plateauapi
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/reearth/reearthx/util"
+)
+
+const (
+	// defaultDatasetsPageSize is the number of datasets in a page when first is not specified.
+	defaultDatasetsPageSize = 100
+	// maxDatasetsPageSize is the maximum number of datasets in a page. Larger first is reduced to it.
+	maxDatasetsPageSize = 1000
+)
+
+// datasetCursor is the position of a dataset in a specific order. Cursors are only valid for the same order.
+type datasetCursor struct {
+	Field     DatasetOrderField `json:"f"`
+	Direction OrderDirection    `json:"d"`
+	Key       datasetSortKey    `json:"k"`
+}
+
+type datasetSortKey struct {
+	S  string `json:"s,omitempty"`
+	N  int    `json:"n,omitempty"`
+	ID ID     `json:"id"`
+}
+
+func (c datasetCursor) String() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parseDatasetCursor(s string) (c datasetCursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(b, &c)
+	}
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %s", s)
+	}
+	return c, nil
+}
+
+func datasetSortKeyFrom(d Dataset, field DatasetOrderField) datasetSortKey {
+	k := datasetSortKey{ID: d.GetID()}
+	switch field {
+	case DatasetOrderFieldName:
+		k.S = d.GetName()
+	case DatasetOrderFieldYear:
+		k.N = d.GetYear()
+	case DatasetOrderFieldRegistrationYear:
+		k.N = d.GetRegisterationYear()
+	case DatasetOrderFieldAreaCode:
+		if code := MostDetailedAreaCodeFrom(d); code != nil {
+			k.S = code.String()
+		}
+	}
+	return k
+}
+
+// compare compares keys in the direction. IDs are always compared in ascending order to make the order deterministic.
+func (k datasetSortKey) compare(o datasetSortKey, dir OrderDirection) int {
+	c := cmp.Or(cmp.Compare(k.S, o.S), cmp.Compare(k.N, o.N))
+	if dir == OrderDirectionDesc {
+		c = -c
+	}
+	return cmp.Or(c, cmp.Compare(k.ID, o.ID))
+}
+
+// paginateDatasets sorts the datasets and returns the page after the cursor. Facets and the total count are computed from all of the datasets.
+// ds must be in the order of the datasets query, which is used as the relevance order. It is the default order when the input has search tokens.
+func paginateDatasets(ds []Dataset, input *DatasetsInput, first *int, after *string, orderBy *DatasetOrder) (*DatasetConnection, error) {
+	if first != nil && *first < 0 {
+		return nil, fmt.Errorf("first must not be negative")
+	}
+
+	field, dir := DatasetOrderFieldID, OrderDirectionAsc
+	if input != nil && len(input.SearchTokens) > 0 {
+		field = DatasetOrderFieldRelevance
+	}
+	if orderBy != nil {
+		if !orderBy.Field.IsValid() {
+			return nil, fmt.Errorf("invalid order field: %s", orderBy.Field)
+		}
+		field = orderBy.Field
+		if orderBy.Direction != nil {
+			dir = *orderBy.Direction
+		}
+	}
+
+	type entry struct {
+		d Dataset
+		k datasetSortKey
+	}
+	entries := make([]entry, 0, len(ds))
+	for i, d := range ds {
+		k := datasetSortKeyFrom(d, field)
+		if field == DatasetOrderFieldRelevance {
+			k.N = i
+		}
+		entries = append(entries, entry{d: d, k: k})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return a.k.compare(b.k, dir)
+	})
+
+	start := 0
+	if after != nil {
+		c, err := parseDatasetCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+		if c.Field != field || c.Direction != dir {
+			return nil, fmt.Errorf("invalid cursor: the order does not match")
+		}
+		// the dataset of the cursor may have been removed, so find the first one after the key
+		start = sort.Search(len(entries), func(i int) bool {
+			return entries[i].k.compare(c.Key, dir) > 0
+		})
+	}
+
+	size := defaultDatasetsPageSize
+	if first != nil {
+		size = min(*first, maxDatasetsPageSize)
+	}
+	end := min(start+size, len(entries))
+
+	page := entries[start:end]
+	res := &DatasetConnection{
+		Edges: make([]*DatasetEdge, 0, len(page)),
+		Nodes: make([]Dataset, 0, len(page)),
+		PageInfo: &PageInfo{
+			HasNextPage:     end < len(entries),
+			HasPreviousPage: start > 0,
+		},
+		TotalCount: len(entries),
+		Facets:     datasetFacets(ds),
+	}
+	for _, e := range page {
+		cursor := datasetCursor{Field: field, Direction: dir, Key: e.k}.String()
+		res.Edges = append(res.Edges, &DatasetEdge{Cursor: cursor, Node: e.d})
+		res.Nodes = append(res.Nodes, e.d)
+	}
+	if len(res.Edges) > 0 {
+		res.PageInfo.StartCursor = util.ToPtrIfNotEmpty(res.Edges[0].Cursor)
+		res.PageInfo.EndCursor = util.ToPtrIfNotEmpty(res.Edges[len(res.Edges)-1].Cursor)
+	}
+	return res, nil
+}
+
+func datasetFacets(ds []Dataset) *DatasetFacets {
+	types, years, prefs := map[string]int{}, map[string]int{}, map[string]int{}
+	for _, d := range ds {
+		types[d.GetTypeCode()]++
+		years[strconv.Itoa(d.GetYear())]++
+		if p := d.GetPrefectureCode(); p != nil {
+			prefs[p.String()]++
+		}
+	}
+	return &DatasetFacets{
+		Types:       facetCounts(types),
+		Years:       facetCounts(years),
+		Prefectures: facetCounts(prefs),
+	}
+}
+
+func facetCounts(m map[string]int) []*DatasetFacetCount {
+	res := make([]*DatasetFacetCount, 0, len(m))
+	for v, c := range m {
+		res = append(res, &DatasetFacetCount{Value: v, Count: c})
+	}
+	slices.SortFunc(res, func(a, b *DatasetFacetCount) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return res
+}
@@ -0,0 +1,185 @@
+package plateauapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginateDatasets(t *testing.T) {
+	ds := []Dataset{
+		&PlateauDataset{ID: "3", Name: "c", Year: 2022, TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("01")), CityCode: lo.ToPtr(AreaCode("01100"))},
+		&PlateauDataset{ID: "1", Name: "b", Year: 2023, TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("13")), CityCode: lo.ToPtr(AreaCode("13100"))},
+		&RelatedDataset{ID: "2", Name: "a", Year: 2022, TypeCode: "park", PrefectureCode: lo.ToPtr(AreaCode("01"))},
+		&GenericDataset{ID: "4", Name: "b", Year: 2021, TypeCode: "usecase"},
+	}
+	ids := func(c *DatasetConnection) []ID {
+		return lo.Map(c.Nodes, func(d Dataset, _ int) ID { return d.GetID() })
+	}
+
+	t.Run("all", func(t *testing.T) {
+		res, err := paginateDatasets(ds, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"1", "2", "3", "4"}, ids(res))
+		assert.Equal(t, 4, res.TotalCount)
+		assert.False(t, res.PageInfo.HasNextPage)
+		assert.False(t, res.PageInfo.HasPreviousPage)
+		assert.Equal(t, res.Edges[0].Cursor, *res.PageInfo.StartCursor)
+		assert.Equal(t, res.Edges[3].Cursor, *res.PageInfo.EndCursor)
+		assert.Equal(t, &DatasetFacets{
+			Types: []*DatasetFacetCount{
+				{Value: "bldg", Count: 2},
+				{Value: "park", Count: 1},
+				{Value: "usecase", Count: 1},
+			},
+			Years: []*DatasetFacetCount{
+				{Value: "2021", Count: 1},
+				{Value: "2022", Count: 2},
+				{Value: "2023", Count: 1},
+			},
+			Prefectures: []*DatasetFacetCount{
+				{Value: "01", Count: 2},
+				{Value: "13", Count: 1},
+			},
+		}, res.Facets)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		order := &DatasetOrder{Field: DatasetOrderFieldName, Direction: lo.ToPtr(OrderDirectionDesc)}
+
+		res, err := paginateDatasets(ds, nil, lo.ToPtr(2), nil, order)
+		require.NoError(t, err)
+		// same names are ordered by IDs in ascending order
+		assert.Equal(t, []ID{"3", "1"}, ids(res))
+		assert.Equal(t, 4, res.TotalCount)
+		assert.True(t, res.PageInfo.HasNextPage)
+		assert.False(t, res.PageInfo.HasPreviousPage)
+
+		res, err = paginateDatasets(ds, nil, lo.ToPtr(2), res.PageInfo.EndCursor, order)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"4", "2"}, ids(res))
+		assert.Equal(t, 4, res.TotalCount)
+		assert.False(t, res.PageInfo.HasNextPage)
+		assert.True(t, res.PageInfo.HasPreviousPage)
+
+		res, err = paginateDatasets(ds, nil, lo.ToPtr(2), res.PageInfo.EndCursor, order)
+		require.NoError(t, err)
+		assert.Empty(t, res.Nodes)
+		assert.Nil(t, res.PageInfo.EndCursor)
+		assert.False(t, res.PageInfo.HasNextPage)
+	})
+
+	t.Run("cursor of a removed dataset", func(t *testing.T) {
+		order := &DatasetOrder{Field: DatasetOrderFieldYear}
+		res, err := paginateDatasets(ds, nil, lo.ToPtr(2), nil, order)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"4", "2"}, ids(res))
+
+		res, err = paginateDatasets(ds[:2], nil, nil, res.PageInfo.EndCursor, order)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"3", "1"}, ids(res))
+	})
+
+	t.Run("area code", func(t *testing.T) {
+		res, err := paginateDatasets(ds, nil, nil, nil, &DatasetOrder{Field: DatasetOrderFieldAreaCode})
+		require.NoError(t, err)
+		// datasets without areas come first
+		assert.Equal(t, []ID{"4", "2", "3", "1"}, ids(res))
+	})
+
+	t.Run("relevance", func(t *testing.T) {
+		// search results are in the order of the datasets query by default
+		input := &DatasetsInput{SearchTokens: []string{"b"}}
+		res, err := paginateDatasets(ds, input, lo.ToPtr(3), nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"3", "1", "2"}, ids(res))
+
+		res, err = paginateDatasets(ds, input, nil, res.PageInfo.EndCursor, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"4"}, ids(res))
+
+		res, err = paginateDatasets(ds, input, nil, nil, &DatasetOrder{Field: DatasetOrderFieldID})
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"1", "2", "3", "4"}, ids(res))
+
+		res, err = paginateDatasets(ds, nil, nil, nil, &DatasetOrder{Field: DatasetOrderFieldRelevance, Direction: lo.ToPtr(OrderDirectionDesc)})
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"4", "2", "1", "3"}, ids(res))
+	})
+
+	t.Run("page size", func(t *testing.T) {
+		many := make([]Dataset, 0, maxDatasetsPageSize+1)
+		for i := range maxDatasetsPageSize + 1 {
+			many = append(many, &PlateauDataset{ID: ID(fmt.Sprintf("%04d", i))})
+		}
+
+		res, err := paginateDatasets(many, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, res.Nodes, defaultDatasetsPageSize)
+		assert.True(t, res.PageInfo.HasNextPage)
+		assert.Equal(t, maxDatasetsPageSize+1, res.TotalCount)
+
+		res, err = paginateDatasets(many, nil, lo.ToPtr(maxDatasetsPageSize+1), nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, res.Nodes, maxDatasetsPageSize)
+		assert.True(t, res.PageInfo.HasNextPage)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := paginateDatasets(ds, nil, lo.ToPtr(-1), nil, nil)
+		assert.EqualError(t, err, "first must not be negative")
+
+		_, err = paginateDatasets(ds, nil, nil, lo.ToPtr("!"), nil)
+		assert.EqualError(t, err, "invalid cursor: !")
+
+		res, err := paginateDatasets(ds, nil, lo.ToPtr(1), nil, nil)
+		require.NoError(t, err)
+		_, err = paginateDatasets(ds, nil, nil, res.PageInfo.EndCursor, &DatasetOrder{Field: DatasetOrderFieldName})
+		assert.EqualError(t, err, "invalid cursor: the order does not match")
+	})
+}
+
+func TestMerger_DatasetsConnection(t *testing.T) {
+	r1 := NewInMemoryRepo(&InMemoryRepoContext{
+		Name: "r1",
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{
+				&PlateauDataset{ID: "1", Name: "a", Year: 2023, TypeCode: "bldg", Admin: map[string]any{"cmsUrl": "https://example.com"}},
+				&PlateauDataset{ID: "3", Name: "c", Year: 2023, TypeCode: "bldg"},
+			},
+		},
+	})
+	r2 := NewInMemoryRepo(&InMemoryRepoContext{
+		Name: "r2",
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{
+				&PlateauDataset{ID: "2", Name: "b", Year: 2023, TypeCode: "tran"},
+				&PlateauDataset{ID: "4", Name: "d", Year: 2023, TypeCode: "tran"},
+			},
+		},
+	})
+	m := NewMerger(r1, r2)
+	ctx := context.Background()
+
+	var got []ID
+	var after *string
+	for {
+		res, err := m.DatasetsConnection(ctx, nil, lo.ToPtr(3), after, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 4, res.TotalCount)
+		assert.Equal(t, []*DatasetFacetCount{{Value: "bldg", Count: 2}, {Value: "tran", Count: 2}}, res.Facets.Types)
+		for _, e := range res.Edges {
+			got = append(got, e.Node.GetID())
+			assert.Nil(t, e.Node.(*PlateauDataset).Admin)
+		}
+		if !res.PageInfo.HasNextPage {
+			break
+		}
+		after = res.PageInfo.EndCursor
+	}
+	assert.Equal(t, []ID{"1", "2", "3", "4"}, got)
+}
@@ -0,0 +1,356 @@
+package plateauapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+const (
+	defaultRemoteTTL     = 10 * time.Minute
+	remoteRetryInterval  = time.Minute
+	remoteRequestTimeout = 30 * time.Second
+)
+
+// remoteCatalogQuery fetches all nodes that InMemoryRepo needs. Fields resolved from other nodes are not fetched.
+const remoteCatalogQuery = `query RemoteCatalog {
+  areas(input: {includeEmpty: true}) {
+    __typename id type code name parentId
+    ... on City {
+      prefectureId prefectureCode planarCrsEpsgCode citygmlId
+      citygml { id year registrationYear prefectureId prefectureCode cityId cityCode plateauSpecMinorId url featureTypes metadataZipUrls }
+    }
+    ... on Ward { prefectureId prefectureCode cityId cityCode }
+  }
+  datasetTypes {
+    __typename id code name category order
+    ... on PlateauDatasetType { plateauSpecId year flood }
+  }
+  datasets {
+    __typename
+    ... on PlateauDataset {
+      id name subname subcode suborder description year registerationYear groups openDataUrl
+      prefectureId prefectureCode cityId cityCode wardId wardCode typeId typeCode ar plateauSpecMinorId
+      river { name admin }
+      items { id format name url layers parentId lod lodEx texture floodingScale floodingScaleSuffix }
+    }
+    ... on RelatedDataset {
+      id name description year registerationYear groups openDataUrl
+      prefectureId prefectureCode cityId cityCode wardId wardCode typeId typeCode ar
+      items { id format name url originalFormat originalUrl layers parentId }
+    }
+    ... on GenericDataset {
+      id name description year registerationYear groups openDataUrl
+      prefectureId prefectureCode cityId cityCode wardId wardCode typeId typeCode ar
+      items { id format name url layers parentId }
+    }
+  }
+  plateauSpecs { id majorVersion year minorVersions { id name version majorVersion year parentId } }
+  years
+}`
+
+type RemoteRepoConfig struct {
+	// URL is the GraphQL endpoint of another PLATEAU API, e.g. "https://example.com/datacatalog/graphql".
+	URL   string
+	Token string
+	// Namespace is added to IDs of datasets and their items so that they do not conflict with local ones.
+	// IDs of areas, dataset types and specs are kept so that they are merged with local ones by Merger.
+	Namespace string
+	// TTL is how long the fetched catalog is used. Default is 10 minutes.
+	TTL        time.Duration
+	HTTPClient *http.Client
+}
+
+// RemoteRepo is a Repo backed by another PLATEAU API. The whole catalog of the remote is fetched and cached in memory.
+// Expired caches are used while being refreshed in background, and the latest fetched catalog is kept if the remote is down.
+type RemoteRepo struct {
+	conf       RemoteRepoConfig
+	client     *graphql.Client
+	lock       sync.Mutex
+	cache      atomic.Pointer[remoteCache]
+	refreshing atomic.Bool
+	now        func() time.Time
+}
+
+type remoteCache struct {
+	repo      *InMemoryRepo
+	fetchedAt time.Time
+	expiresAt time.Time
+	err       error
+}
+
+var _ Repo = (*RemoteRepo)(nil)
+
+func NewRemoteRepo(conf RemoteRepoConfig) *RemoteRepo {
+	if conf.TTL <= 0 {
+		conf.TTL = defaultRemoteTTL
+	}
+
+	hc := conf.HTTPClient
+	if hc == nil {
+		hc = &http.Client{Timeout: remoteRequestTimeout}
+	}
+
+	return &RemoteRepo{
+		conf: conf,
+		client: graphql.NewClient(conf.URL, hc).WithRequestModifier(func(req *http.Request) {
+			if conf.Token != "" {
+				req.Header.Set("Authorization", "Bearer "+conf.Token)
+			}
+		}),
+	}
+}
+
+func (r *RemoteRepo) Name() string {
+	if r.conf.Namespace == "" {
+		return fmt.Sprintf("remote(%s)", r.conf.URL)
+	}
+	return fmt.Sprintf("remote(%s)", r.conf.Namespace)
+}
+
+// Err returns the error of the last fetch, or nil if it succeeded.
+func (r *RemoteRepo) Err() error {
+	if c := r.cache.Load(); c != nil {
+		return c.err
+	}
+	return nil
+}
+
+// UpdatedAt returns when the catalog in use was fetched, or zero time if it has not been fetched.
+func (r *RemoteRepo) UpdatedAt() time.Time {
+	if c := r.cache.Load(); c != nil {
+		return c.fetchedAt
+	}
+	return time.Time{}
+}
+
+// Refresh fetches the catalog from the remote if the cache has expired.
+func (r *RemoteRepo) Refresh(ctx context.Context) error {
+	return r.refresh(ctx).err
+}
+
+func (r *RemoteRepo) refresh(ctx context.Context) *remoteCache {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.getNow()
+	prev := r.cache.Load()
+	if prev != nil && now.Before(prev.expiresAt) {
+		return prev
+	}
+
+	repo, err := r.fetch(ctx)
+	c := &remoteCache{repo: repo, fetchedAt: now, expiresAt: now.Add(r.conf.TTL)}
+	if err != nil {
+		c.err = fmt.Errorf("failed to fetch %s: %w", r.conf.URL, err)
+		c.expiresAt = now.Add(min(r.conf.TTL, remoteRetryInterval))
+		c.fetchedAt = time.Time{}
+		if prev != nil {
+			c.repo = prev.repo
+			c.fetchedAt = prev.fetchedAt
+		} else {
+			c.repo = NewInMemoryRepo(&InMemoryRepoContext{Name: r.conf.Namespace})
+		}
+	}
+
+	r.cache.Store(c)
+	return c
+}
+
+// repo returns the cached catalog. The first call waits for the fetch, and later calls refresh expired caches in background.
+func (r *RemoteRepo) repo(ctx context.Context) *InMemoryRepo {
+	c := r.cache.Load()
+	if c == nil {
+		return r.refresh(ctx).repo
+	}
+
+	if !r.getNow().Before(c.expiresAt) && r.refreshing.CompareAndSwap(false, true) {
+		go func() {
+			defer r.refreshing.Store(false)
+			ctx, cancel := context.WithTimeout(context.Background(), remoteRequestTimeout)
+			defer cancel()
+			_ = r.refresh(ctx)
+		}()
+	}
+	return c.repo
+}
+
+func (r *RemoteRepo) getNow() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+type remoteCatalog struct {
+	Areas        []json.RawMessage `json:"areas"`
+	DatasetTypes []json.RawMessage `json:"datasetTypes"`
+	Datasets     []json.RawMessage `json:"datasets"`
+	PlateauSpecs []PlateauSpec     `json:"plateauSpecs"`
+	Years        []int             `json:"years"`
+}
+
+func (r *RemoteRepo) fetch(ctx context.Context) (*InMemoryRepo, error) {
+	b, err := r.client.ExecRaw(ctx, remoteCatalogQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res remoteCatalog
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	c := &InMemoryRepoContext{
+		Name:         r.conf.Namespace,
+		Areas:        Areas{},
+		DatasetTypes: DatasetTypes{},
+		Datasets:     Datasets{},
+		PlateauSpecs: res.PlateauSpecs,
+		Years:        res.Years,
+		CityGML:      map[ID]*CityGMLDataset{},
+	}
+
+	for _, raw := range res.Areas {
+		a, err := unmarshalByTypename[Area](raw, map[string]func() Area{
+			"Prefecture": func() Area { return &Prefecture{} },
+			"City":       func() Area { return &City{} },
+			"Ward":       func() Area { return &Ward{} },
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// CityGML datasets are resolved by their IDs
+		if city, ok := a.(*City); ok && city.Citygml != nil {
+			c.CityGML[city.Citygml.ID] = city.Citygml
+			city.Citygml = nil
+		}
+		c.Areas.Append(a.GetType(), []Area{a})
+	}
+
+	for _, raw := range res.DatasetTypes {
+		dt, err := unmarshalByTypename[DatasetType](raw, map[string]func() DatasetType{
+			"PlateauDatasetType": func() DatasetType { return &PlateauDatasetType{} },
+			"RelatedDatasetType": func() DatasetType { return &RelatedDatasetType{} },
+			"GenericDatasetType": func() DatasetType { return &GenericDatasetType{} },
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.DatasetTypes.Append(dt.GetCategory(), []DatasetType{dt})
+	}
+
+	for _, raw := range res.Datasets {
+		d, err := unmarshalByTypename[Dataset](raw, map[string]func() Dataset{
+			"PlateauDataset": func() Dataset { return &PlateauDataset{} },
+			"RelatedDataset": func() Dataset { return &RelatedDataset{} },
+			"GenericDataset": func() Dataset { return &GenericDataset{} },
+		})
+		if err != nil {
+			return nil, err
+		}
+		namespaceDataset(d, r.conf.Namespace)
+		c.Datasets.Append(DatasetTypeCategoryFromDataset(d), []Dataset{d})
+	}
+
+	return NewInMemoryRepo(c), nil
+}
+
+func unmarshalByTypename[T any](b []byte, types map[string]func() T) (res T, _ error) {
+	var t struct {
+		Typename string `json:"__typename"`
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return res, err
+	}
+
+	f := types[t.Typename]
+	if f == nil {
+		return res, fmt.Errorf("unknown type: %s", t.Typename)
+	}
+
+	res = f()
+	if err := json.Unmarshal(b, res); err != nil {
+		return res, fmt.Errorf("failed to parse %s: %w", t.Typename, err)
+	}
+	return res, nil
+}
+
+func namespaceID(id ID, ns string) ID {
+	if ns == "" {
+		return id
+	}
+	raw, ty := id.Unwrap()
+	return NewID(ns+":"+raw, ty)
+}
+
+func namespaceDataset(d Dataset, ns string) {
+	if ns == "" {
+		return
+	}
+
+	switch d := d.(type) {
+	case *PlateauDataset:
+		d.ID = namespaceID(d.ID, ns)
+		for _, it := range d.Items {
+			it.ID, it.ParentID = namespaceID(it.ID, ns), d.ID
+		}
+	case *RelatedDataset:
+		d.ID = namespaceID(d.ID, ns)
+		for _, it := range d.Items {
+			it.ID, it.ParentID = namespaceID(it.ID, ns), d.ID
+		}
+	case *GenericDataset:
+		d.ID = namespaceID(d.ID, ns)
+		for _, it := range d.Items {
+			it.ID, it.ParentID = namespaceID(it.ID, ns), d.ID
+		}
+	}
+}
+
+func (r *RemoteRepo) Node(ctx context.Context, id ID) (Node, error) {
+	return r.repo(ctx).Node(ctx, id)
+}
+
+func (r *RemoteRepo) Nodes(ctx context.Context, ids []ID) ([]Node, error) {
+	return r.repo(ctx).Nodes(ctx, ids)
+}
+
+func (r *RemoteRepo) Area(ctx context.Context, code AreaCode) (Area, error) {
+	return r.repo(ctx).Area(ctx, code)
+}
+
+func (r *RemoteRepo) Areas(ctx context.Context, input *AreasInput) ([]Area, error) {
+	return r.repo(ctx).Areas(ctx, input)
+}
+
+func (r *RemoteRepo) DatasetTypes(ctx context.Context, input *DatasetTypesInput) ([]DatasetType, error) {
+	return r.repo(ctx).DatasetTypes(ctx, input)
+}
+
+func (r *RemoteRepo) Datasets(ctx context.Context, input *DatasetsInput) ([]Dataset, error) {
+	return r.repo(ctx).Datasets(ctx, input)
+}
+
+func (r *RemoteRepo) DatasetsConnection(ctx context.Context, input *DatasetsInput, first *int, after *string, orderBy *DatasetOrder) (*DatasetConnection, error) {
+	return r.repo(ctx).DatasetsConnection(ctx, input, first, after, orderBy)
+}
+
+func (r *RemoteRepo) Changes(ctx context.Context, since *time.Time) ([]*DatasetChange, error) {
+	return r.repo(ctx).Changes(ctx, since)
+}
+
+func (r *RemoteRepo) PlateauSpecs(ctx context.Context) ([]*PlateauSpec, error) {
+	return r.repo(ctx).PlateauSpecs(ctx)
+}
+
+func (r *RemoteRepo) Years(ctx context.Context) ([]int, error) {
+	return r.repo(ctx).Years(ctx)
+}
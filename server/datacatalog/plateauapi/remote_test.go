@@ -0,0 +1,120 @@
+package plateauapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteRepo(t *testing.T) {
+	ctx := context.Background()
+	remote := NewInMemoryRepo(&InMemoryRepoContext{
+		Areas: Areas{
+			AreaTypePrefecture: []Area{&Prefecture{ID: "p_13", Code: "13", Name: "東京都", Type: AreaTypePrefecture}},
+			AreaTypeCity: []Area{&City{
+				ID: "c_13101", Code: "13101", Name: "千代田区", Type: AreaTypeCity,
+				PrefectureID: "p_13", PrefectureCode: "13", ParentID: lo.ToPtr(ID("p_13")), CitygmlID: lo.ToPtr(ID("cg_13101")),
+			}},
+		},
+		DatasetTypes: DatasetTypes{
+			DatasetTypeCategoryPlateau: []DatasetType{&PlateauDatasetType{ID: "dt_bldg", Code: "bldg", Name: "建築物モデル", Category: DatasetTypeCategoryPlateau, Year: 2023}},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{&PlateauDataset{
+				ID: "d_1", Name: "建築物モデル（千代田区）", Year: 2023, TypeID: "dt_bldg", TypeCode: "bldg",
+				PrefectureID: lo.ToPtr(ID("p_13")), PrefectureCode: lo.ToPtr(AreaCode("13")),
+				CityID: lo.ToPtr(ID("c_13101")), CityCode: lo.ToPtr(AreaCode("13101")),
+				Items: []*PlateauDatasetItem{{ID: "di_1", Name: "LOD1", Format: DatasetFormatCesium3dtiles, URL: "https://example.com/tileset.json", ParentID: "d_1", Lod: lo.ToPtr(1)}},
+			}},
+		},
+		Years:   []int{2023},
+		CityGML: map[ID]*CityGMLDataset{"cg_13101": {ID: "cg_13101", CityCode: "13101", URL: "https://example.com/citygml.zip", FeatureTypes: []string{"bldg"}, MetadataZipUrls: []string{}}},
+	})
+
+	var down atomic.Bool
+	var requests atomic.Int32
+	svc := NewService(remote)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+		svc.ServeHTTP(w, req)
+	}))
+	defer ts.Close()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRemoteRepo(RemoteRepoConfig{URL: ts.URL, Token: "token", Namespace: "partner", TTL: time.Hour})
+	r.now = func() time.Time { return now }
+
+	ds, err := r.Datasets(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	d := ds[0].(*PlateauDataset)
+	assert.Equal(t, ID("d_partner:1"), d.ID)
+	assert.Equal(t, ID("di_partner:1"), d.Items[0].ID)
+	assert.Equal(t, ID("d_partner:1"), d.Items[0].ParentID)
+	assert.Equal(t, lo.ToPtr(1), d.Items[0].Lod)
+	assert.Equal(t, TypeDataset, d.ID.Type())
+
+	node, err := r.Node(ctx, "d_partner:1")
+	require.NoError(t, err)
+	assert.NotNil(t, node)
+
+	// areas and CityGML datasets keep their IDs
+	area, err := r.Area(ctx, "13101")
+	require.NoError(t, err)
+	assert.Equal(t, ID("c_13101"), area.GetID())
+	cg, err := r.Node(ctx, "cg_13101")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/citygml.zip", cg.(*CityGMLDataset).URL)
+
+	// cached until TTL expires
+	_, _ = r.Years(ctx)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// keep the cache if the remote is down
+	down.Store(true)
+	now = now.Add(2 * time.Hour)
+	assert.Error(t, r.Refresh(ctx))
+	assert.Error(t, r.Err())
+	ds, err = r.Datasets(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, ds, 1)
+	assert.Equal(t, now.Add(-2*time.Hour), r.UpdatedAt())
+
+	// merged with a local repo
+	local := NewInMemoryRepo(&InMemoryRepoContext{
+		Areas: Areas{
+			AreaTypePrefecture: []Area{&Prefecture{ID: "p_13", Code: "13", Name: "東京都", Type: AreaTypePrefecture}},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{&PlateauDataset{ID: "d_1", Name: "local", Year: 2023, TypeCode: "bldg"}},
+		},
+	})
+	m := NewMerger(local, r)
+	require.NoError(t, m.Init(ctx))
+	ds, err = m.Datasets(ctx, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []ID{"d_1", "d_partner:1"}, lo.Map(ds, func(d Dataset, _ int) ID { return d.GetID() }))
+	areas, err := m.Areas(ctx, &AreasInput{AreaTypes: []AreaType{AreaTypePrefecture}})
+	require.NoError(t, err)
+	assert.Len(t, areas, 1)
+
+	// empty before the first successful fetch
+	r2 := NewRemoteRepo(RemoteRepoConfig{URL: ts.URL})
+	ds, err = r2.Datasets(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, ds)
+	assert.Error(t, r2.Err())
+	assert.True(t, r2.UpdatedAt().IsZero())
+}
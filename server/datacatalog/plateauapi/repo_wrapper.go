@@ -2,6 +2,7 @@ package plateauapi
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 )
@@ -17,6 +18,7 @@ type RepoWrapper struct {
 	updatedAt        time.Time
 	now              func() time.Time
 	minCacheDuration time.Duration
+	changes          changeHistory
 }
 
 func NewRepoWrapper(repo Repo, updater RepoUpdater) *RepoWrapper {
@@ -45,6 +47,25 @@ func (a *RepoWrapper) SetRepo(repo Repo) {
 	a.updatedAt = a.getNow()
 }
 
+// AddChanges records changes detected by an update. Only the latest changes are kept.
+func (a *RepoWrapper) AddChanges(changes []*DatasetChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.changes = a.changes.add(changes)
+}
+
+// history returns the recorded changes in the order of detection.
+func (a *RepoWrapper) history() changeHistory {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return slices.Clone(a.changes)
+}
+
 func (a *RepoWrapper) SetName(name string) {
 	a.name = name
 }
@@ -93,6 +114,20 @@ func (a *RepoWrapper) use(f func(r Repo) error) error {
 	return f(a.GetRepo())
 }
 
+var _ searchScorer = (*RepoWrapper)(nil)
+
+func (a *RepoWrapper) searchScore(n Node, tokens []string) (res float64) {
+	_ = a.use(func(r Repo) error {
+		if s, ok := r.(searchScorer); ok {
+			res = s.searchScore(n, tokens)
+		} else {
+			res = nodeSearchScore(n, tokens, nil, nil)
+		}
+		return nil
+	})
+	return
+}
+
 func (a *RepoWrapper) getNow() time.Time {
 	if a.now != nil {
 		return a.now()
@@ -163,6 +198,22 @@ func (a *RepoWrapper) Datasets(ctx context.Context, input *DatasetsInput) (res [
 	return
 }
 
+func (a *RepoWrapper) DatasetsConnection(ctx context.Context, input *DatasetsInput, first *int, after *string, orderBy *DatasetOrder) (res *DatasetConnection, err error) {
+	err = a.use(func(r Repo) (err error) {
+		res, err = r.DatasetsConnection(ctx, input, first, after, orderBy)
+		return
+	})
+	return
+}
+
+func (a *RepoWrapper) Changes(ctx context.Context, since *time.Time) (res []*DatasetChange, err error) {
+	err = a.use(func(r Repo) (err error) {
+		res = a.changes.since(since)
+		return
+	})
+	return
+}
+
 func (a *RepoWrapper) PlateauSpecs(ctx context.Context) (res []*PlateauSpec, err error) {
 	err = a.use(func(r Repo) (err error) {
 		res, err = r.PlateauSpecs(ctx)
@@ -27,6 +27,7 @@ type Repos struct {
 	repos     map[string]*RepoWrapper
 	warnings  map[string][]string
 	updatedAt map[string]time.Time
+	snapshots *snapshots
 	now       func() time.Time
 }
 
@@ -40,6 +41,24 @@ func NewRepos(u ReposUpdater) *Repos {
 	}
 }
 
+// SetSnapshotStorage enables persisting each update as a snapshot. Repos are restored from the latest snapshots before the first updates
+// so that the API is available even if the first updates fail. Histories of changes are also persisted and restored with them.
+func (r *Repos) SetSnapshotStorage(s SnapshotStorage) {
+	if s == nil {
+		r.snapshots = nil
+		return
+	}
+	r.snapshots = newSnapshots(s)
+}
+
+// SetSnapshotRetention sets how long old snapshots are kept. Snapshots are kept forever if it is zero.
+// The latest snapshot before the retention is also kept so that point-in-time queries at any time in the retention can be answered.
+func (r *Repos) SetSnapshotRetention(d time.Duration) {
+	if r.snapshots != nil {
+		r.snapshots.retention = d
+	}
+}
+
 func (r *Repos) Prepare(ctx context.Context, project string, year int, cms cms.Interface) error {
 	_, err := r.Update(ctx, project)
 	return err
@@ -77,6 +96,10 @@ func (r *Repos) Update(ctx context.Context, project string) (bool, error) {
 		return false, nil
 	}
 
+	if r.repos[project] == nil {
+		r.restoreSnapshot(ctx, project)
+	}
+
 	// update
 	ur, err := r.updater(ctx, project)
 	if err != nil {
@@ -92,10 +115,21 @@ func (r *Repos) Update(ctx context.Context, project string) (bool, error) {
 				repoWrapper.SetName(project)
 				r.repos[project] = repoWrapper
 			} else {
+				if prev := repoWrapper.GetRepo(); prev != nil {
+					changes, err := DiffDatasets(ctx, project, prev, ur.Repo, r.getNow())
+					if err != nil {
+						ur.Warnings = append(ur.Warnings, fmt.Sprintf("failed to detect changes: %v", err))
+					}
+					repoWrapper.AddChanges(changes)
+					if len(changes) > 0 {
+						ur.Warnings = append(ur.Warnings, r.saveChanges(ctx, project, repoWrapper)...)
+					}
+				}
 				repoWrapper.SetRepo(ur.Repo)
 			}
 
 			u = true
+			ur.Warnings = append(ur.Warnings, r.saveSnapshot(ctx, project, ur)...)
 		}
 
 		r.warnings[project] = ur.Warnings
@@ -107,6 +141,81 @@ func (r *Repos) Update(ctx context.Context, project string) (bool, error) {
 	return u, nil
 }
 
+// RepoAt returns the repo of the latest snapshot created at or before t. It returns nil if there are no such snapshots.
+func (r *Repos) RepoAt(ctx context.Context, project string, t time.Time) (Repo, error) {
+	if r.snapshots == nil {
+		return nil, fmt.Errorf("snapshots are not enabled")
+	}
+
+	repo, err := r.snapshots.at(ctx, project, t)
+	if err != nil || repo == nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *Repos) restoreSnapshot(ctx context.Context, project string) {
+	if r.snapshots == nil {
+		return
+	}
+
+	s, err := r.snapshots.latest(ctx, project)
+	if err != nil {
+		r.warnings[project] = []string{fmt.Sprintf("failed to restore snapshot: %v", err)}
+		return
+	}
+	if s == nil {
+		return
+	}
+
+	repoWrapper := NewRepoWrapper(NewInMemoryRepo(s.Context), nil)
+	repoWrapper.SetName(project)
+	// changes are detected against the restored repo, so the history saved with it is continued
+	changes, err := r.snapshots.loadChanges(ctx, project)
+	if err != nil {
+		s.Warnings = append(s.Warnings, fmt.Sprintf("failed to restore changes: %v", err))
+	}
+	repoWrapper.AddChanges(changes)
+	r.repos[project] = repoWrapper
+	r.warnings[project] = append(slices.Clone(s.Warnings), fmt.Sprintf("restored from snapshot at %s", s.CreatedAt.Format(time.RFC3339)))
+	r.updatedAt[project] = s.CreatedAt
+}
+
+// saveSnapshot saves the updated repo and returns warnings as failing to save does not fail the update.
+func (r *Repos) saveSnapshot(ctx context.Context, project string, ur *ReposUpdateResult) []string {
+	if r.snapshots == nil {
+		return nil
+	}
+
+	repo, ok := ur.Repo.(*InMemoryRepo)
+	if !ok || repo == nil || repo.Context() == nil {
+		return nil
+	}
+
+	saved, err := r.snapshots.save(ctx, project, repo.Context(), ur.Warnings, r.getNow())
+	if err != nil {
+		return []string{fmt.Sprintf("failed to save snapshot: %v", err)}
+	}
+	if saved {
+		if err := r.snapshots.prune(ctx, project, r.getNow()); err != nil {
+			return []string{fmt.Sprintf("failed to delete old snapshots: %v", err)}
+		}
+	}
+	return nil
+}
+
+// saveChanges saves the history of changes and returns warnings as failing to save does not fail the update.
+func (r *Repos) saveChanges(ctx context.Context, project string, repoWrapper *RepoWrapper) []string {
+	if r.snapshots == nil {
+		return nil
+	}
+
+	if err := r.snapshots.saveChanges(ctx, project, repoWrapper.history(), r.getNow()); err != nil {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
 func (r *Repos) Warnings(project string) []string {
 	if r.UpdatedAt(project).IsZero() {
 		return []string{"project is not initialized"}
@@ -3,7 +3,10 @@
 package plateauapi
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -42,6 +45,42 @@ type Resolver struct {
 	Repo Repo
 }
 
+// repo returns the repo selected by the snapshot directive, or the default repo.
+func (r *Resolver) repo(ctx context.Context) Repo {
+	if repo, ok := ctx.Value(repoContextKey{}).(Repo); ok {
+		return repo
+	}
+	return r.Repo
+}
+
+// RepoAtFunc returns the repo at the time. It returns nil if there is no snapshot at or before the time.
+type RepoAtFunc func(ctx context.Context, t time.Time) (Repo, error)
+
+type repoContextKey struct{}
+type repoAtContextKey struct{}
+
+// WithRepoAt enables the snapshot directive of queries, which selects the repo at the time of its asOf argument.
+func WithRepoAt(ctx context.Context, f RepoAtFunc) context.Context {
+	return context.WithValue(ctx, repoAtContextKey{}, f)
+}
+
+func snapshotDirective(ctx context.Context, _ any, next graphql.Resolver, asOf time.Time) (any, error) {
+	f, _ := ctx.Value(repoAtContextKey{}).(RepoAtFunc)
+	if f == nil {
+		return nil, errors.New("snapshots are not available")
+	}
+
+	repo, err := f(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+	if repo == nil {
+		return nil, fmt.Errorf("no snapshot at %s", asOf.Format(time.RFC3339))
+	}
+
+	return next(context.WithValue(ctx, repoContextKey{}, repo))
+}
+
 type Option func(*handler.Server)
 
 func NewService(repo Repo, opts ...Option) *handler.Server {
@@ -53,7 +92,10 @@ func NewService(repo Repo, opts ...Option) *handler.Server {
 }
 
 func NewSchema(repo Repo) graphql.ExecutableSchema {
-	return NewExecutableSchema(Config{Resolvers: &Resolver{Repo: repo}})
+	return NewExecutableSchema(Config{
+		Resolvers:  &Resolver{Repo: repo},
+		Directives: DirectiveRoot{Snapshot: snapshotDirective},
+	})
 }
 
 func FixedComplexityLimit(limit int) Option {
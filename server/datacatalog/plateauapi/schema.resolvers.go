@@ -7,18 +7,19 @@ package plateauapi
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/samber/lo"
 )
 
 // Prefecture is the resolver for the prefecture field.
 func (r *cityResolver) Prefecture(ctx context.Context, obj *City) (*Prefecture, error) {
-	return to[*Prefecture](r.Repo.Node(ctx, obj.PrefectureID))
+	return to[*Prefecture](r.repo(ctx).Node(ctx, obj.PrefectureID))
 }
 
 // Wards is the resolver for the wards field.
 func (r *cityResolver) Wards(ctx context.Context, obj *City) ([]*Ward, error) {
-	areas, err := r.Repo.Areas(ctx, &AreasInput{
+	areas, err := r.repo(ctx).Areas(ctx, &AreasInput{
 		ParentCode: lo.ToPtr(obj.Code),
 	})
 	if err != nil {
@@ -39,7 +40,7 @@ func (r *cityResolver) Datasets(ctx context.Context, obj *City, input *DatasetsI
 		input = &DatasetsInput{}
 	}
 	input.AreaCodes = []AreaCode{obj.Code}
-	return r.Repo.Datasets(ctx, input)
+	return r.repo(ctx).Datasets(ctx, input)
 }
 
 // Parent is the resolver for the parent field.
@@ -52,29 +53,29 @@ func (r *cityResolver) Citygml(ctx context.Context, obj *City) (*CityGMLDataset,
 	if obj.CitygmlID == nil {
 		return nil, nil
 	}
-	return to[*CityGMLDataset](r.Repo.Node(ctx, *obj.CitygmlID))
+	return to[*CityGMLDataset](r.repo(ctx).Node(ctx, *obj.CitygmlID))
 }
 
 // Children is the resolver for the children field.
 func (r *cityResolver) Children(ctx context.Context, obj *City) ([]Area, error) {
-	return r.Repo.Areas(ctx, &AreasInput{
+	return r.repo(ctx).Areas(ctx, &AreasInput{
 		ParentCode: lo.ToPtr(obj.Code),
 	})
 }
 
 // Prefecture is the resolver for the prefecture field.
 func (r *cityGMLDatasetResolver) Prefecture(ctx context.Context, obj *CityGMLDataset) (*Prefecture, error) {
-	return to[*Prefecture](r.Repo.Node(ctx, obj.PrefectureID))
+	return to[*Prefecture](r.repo(ctx).Node(ctx, obj.PrefectureID))
 }
 
 // City is the resolver for the city field.
 func (r *cityGMLDatasetResolver) City(ctx context.Context, obj *CityGMLDataset) (*City, error) {
-	return to[*City](r.Repo.Node(ctx, obj.CityID))
+	return to[*City](r.repo(ctx).Node(ctx, obj.CityID))
 }
 
 // PlateauSpecMinor is the resolver for the plateauSpecMinor field.
 func (r *cityGMLDatasetResolver) PlateauSpecMinor(ctx context.Context, obj *CityGMLDataset) (*PlateauSpecMinor, error) {
-	return to[*PlateauSpecMinor](r.Repo.Node(ctx, obj.PlateauSpecMinorID))
+	return to[*PlateauSpecMinor](r.repo(ctx).Node(ctx, obj.PlateauSpecMinorID))
 }
 
 // Prefecture is the resolver for the prefecture field.
@@ -82,7 +83,7 @@ func (r *genericDatasetResolver) Prefecture(ctx context.Context, obj *GenericDat
 	if obj.PrefectureID == nil {
 		return nil, nil
 	}
-	return to[*Prefecture](r.Repo.Node(ctx, *obj.PrefectureID))
+	return to[*Prefecture](r.repo(ctx).Node(ctx, *obj.PrefectureID))
 }
 
 // City is the resolver for the city field.
@@ -90,7 +91,7 @@ func (r *genericDatasetResolver) City(ctx context.Context, obj *GenericDataset)
 	if obj.CityID == nil {
 		return nil, nil
 	}
-	return to[*City](r.Repo.Node(ctx, *obj.CityID))
+	return to[*City](r.repo(ctx).Node(ctx, *obj.CityID))
 }
 
 // Ward is the resolver for the ward field.
@@ -98,17 +99,17 @@ func (r *genericDatasetResolver) Ward(ctx context.Context, obj *GenericDataset)
 	if obj.WardID == nil {
 		return nil, nil
 	}
-	return to[*Ward](r.Repo.Node(ctx, *obj.WardID))
+	return to[*Ward](r.repo(ctx).Node(ctx, *obj.WardID))
 }
 
 // Type is the resolver for the type field.
 func (r *genericDatasetResolver) Type(ctx context.Context, obj *GenericDataset) (*GenericDatasetType, error) {
-	return to[*GenericDatasetType](r.Repo.Node(ctx, obj.TypeID))
+	return to[*GenericDatasetType](r.repo(ctx).Node(ctx, obj.TypeID))
 }
 
 // Parent is the resolver for the parent field.
 func (r *genericDatasetItemResolver) Parent(ctx context.Context, obj *GenericDatasetItem) (*GenericDataset, error) {
-	return to[*GenericDataset](r.Repo.Node(ctx, obj.ParentID))
+	return to[*GenericDataset](r.repo(ctx).Node(ctx, obj.ParentID))
 }
 
 // Datasets is the resolver for the datasets field.
@@ -118,7 +119,7 @@ func (r *genericDatasetTypeResolver) Datasets(ctx context.Context, obj *GenericD
 	}
 	input.IncludeTypes = []string{obj.Code}
 	input.ExcludeTypes = nil
-	datasets, err := r.Repo.Datasets(ctx, input)
+	datasets, err := r.repo(ctx).Datasets(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +137,7 @@ func (r *plateauDatasetResolver) Prefecture(ctx context.Context, obj *PlateauDat
 	if obj.PrefectureID == nil {
 		return nil, nil
 	}
-	return to[*Prefecture](r.Repo.Node(ctx, *obj.PrefectureID))
+	return to[*Prefecture](r.repo(ctx).Node(ctx, *obj.PrefectureID))
 }
 
 // City is the resolver for the city field.
@@ -144,7 +145,7 @@ func (r *plateauDatasetResolver) City(ctx context.Context, obj *PlateauDataset)
 	if obj.CityID == nil {
 		return nil, nil
 	}
-	return to[*City](r.Repo.Node(ctx, *obj.CityID))
+	return to[*City](r.repo(ctx).Node(ctx, *obj.CityID))
 }
 
 // Ward is the resolver for the ward field.
@@ -152,27 +153,27 @@ func (r *plateauDatasetResolver) Ward(ctx context.Context, obj *PlateauDataset)
 	if obj.WardID == nil {
 		return nil, nil
 	}
-	return to[*Ward](r.Repo.Node(ctx, *obj.WardID))
+	return to[*Ward](r.repo(ctx).Node(ctx, *obj.WardID))
 }
 
 // Type is the resolver for the type field.
 func (r *plateauDatasetResolver) Type(ctx context.Context, obj *PlateauDataset) (*PlateauDatasetType, error) {
-	return to[*PlateauDatasetType](r.Repo.Node(ctx, obj.TypeID))
+	return to[*PlateauDatasetType](r.repo(ctx).Node(ctx, obj.TypeID))
 }
 
 // PlateauSpecMinor is the resolver for the plateauSpecMinor field.
 func (r *plateauDatasetResolver) PlateauSpecMinor(ctx context.Context, obj *PlateauDataset) (*PlateauSpecMinor, error) {
-	return to[*PlateauSpecMinor](r.Repo.Node(ctx, obj.PlateauSpecMinorID))
+	return to[*PlateauSpecMinor](r.repo(ctx).Node(ctx, obj.PlateauSpecMinorID))
 }
 
 // Parent is the resolver for the parent field.
 func (r *plateauDatasetItemResolver) Parent(ctx context.Context, obj *PlateauDatasetItem) (*PlateauDataset, error) {
-	return to[*PlateauDataset](r.Repo.Node(ctx, obj.ParentID))
+	return to[*PlateauDataset](r.repo(ctx).Node(ctx, obj.ParentID))
 }
 
 // PlateauSpec is the resolver for the plateauSpec field.
 func (r *plateauDatasetTypeResolver) PlateauSpec(ctx context.Context, obj *PlateauDatasetType) (*PlateauSpec, error) {
-	return to[*PlateauSpec](r.Repo.Node(ctx, obj.PlateauSpecID))
+	return to[*PlateauSpec](r.repo(ctx).Node(ctx, obj.PlateauSpecID))
 }
 
 // Datasets is the resolver for the datasets field.
@@ -182,7 +183,7 @@ func (r *plateauDatasetTypeResolver) Datasets(ctx context.Context, obj *PlateauD
 	}
 	input.IncludeTypes = []string{obj.Code}
 	input.ExcludeTypes = nil
-	datasets, err := r.Repo.Datasets(ctx, input)
+	datasets, err := r.repo(ctx).Datasets(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +198,7 @@ func (r *plateauDatasetTypeResolver) Datasets(ctx context.Context, obj *PlateauD
 
 // DatasetTypes is the resolver for the datasetTypes field.
 func (r *plateauSpecResolver) DatasetTypes(ctx context.Context, obj *PlateauSpec) ([]*PlateauDatasetType, error) {
-	types, err := r.Repo.DatasetTypes(ctx, &DatasetTypesInput{
+	types, err := r.repo(ctx).DatasetTypes(ctx, &DatasetTypesInput{
 		PlateauSpec: lo.ToPtr(fmt.Sprintf("%d", obj.MajorVersion)),
 	})
 	if err != nil {
@@ -214,7 +215,7 @@ func (r *plateauSpecResolver) DatasetTypes(ctx context.Context, obj *PlateauSpec
 
 // Parent is the resolver for the parent field.
 func (r *plateauSpecMinorResolver) Parent(ctx context.Context, obj *PlateauSpecMinor) (*PlateauSpec, error) {
-	return to[*PlateauSpec](r.Repo.Node(ctx, obj.ParentID))
+	return to[*PlateauSpec](r.repo(ctx).Node(ctx, obj.ParentID))
 }
 
 // Datasets is the resolver for the datasets field.
@@ -223,12 +224,12 @@ func (r *plateauSpecMinorResolver) Datasets(ctx context.Context, obj *PlateauSpe
 		input = &DatasetsInput{}
 	}
 	input.PlateauSpec = lo.ToPtr(obj.Version)
-	return r.Repo.Datasets(ctx, input)
+	return r.repo(ctx).Datasets(ctx, input)
 }
 
 // Cities is the resolver for the cities field.
 func (r *prefectureResolver) Cities(ctx context.Context, obj *Prefecture) ([]*City, error) {
-	areas, err := r.Repo.Areas(ctx, &AreasInput{
+	areas, err := r.repo(ctx).Areas(ctx, &AreasInput{
 		ParentCode: lo.ToPtr(obj.Code),
 	})
 	if err != nil {
@@ -249,7 +250,7 @@ func (r *prefectureResolver) Datasets(ctx context.Context, obj *Prefecture, inpu
 		input = &DatasetsInput{}
 	}
 	input.AreaCodes = []AreaCode{obj.Code}
-	return r.Repo.Datasets(ctx, input)
+	return r.repo(ctx).Datasets(ctx, input)
 }
 
 // Parent is the resolver for the parent field.
@@ -259,49 +260,59 @@ func (r *prefectureResolver) Parent(ctx context.Context, obj *Prefecture) (Area,
 
 // Children is the resolver for the children field.
 func (r *prefectureResolver) Children(ctx context.Context, obj *Prefecture) ([]Area, error) {
-	return r.Repo.Areas(ctx, &AreasInput{
+	return r.repo(ctx).Areas(ctx, &AreasInput{
 		ParentCode: lo.ToPtr(obj.Code),
 	})
 }
 
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id ID) (Node, error) {
-	return r.Repo.Node(ctx, id)
+	return r.repo(ctx).Node(ctx, id)
 }
 
 // Nodes is the resolver for the nodes field.
 func (r *queryResolver) Nodes(ctx context.Context, ids []ID) ([]Node, error) {
-	return r.Repo.Nodes(ctx, ids)
+	return r.repo(ctx).Nodes(ctx, ids)
 }
 
 // Area is the resolver for the area field.
 func (r *queryResolver) Area(ctx context.Context, code AreaCode) (Area, error) {
-	return r.Repo.Area(ctx, code)
+	return r.repo(ctx).Area(ctx, code)
 }
 
 // Areas is the resolver for the areas field.
 func (r *queryResolver) Areas(ctx context.Context, input *AreasInput) ([]Area, error) {
-	return r.Repo.Areas(ctx, input)
+	return r.repo(ctx).Areas(ctx, input)
 }
 
 // DatasetTypes is the resolver for the datasetTypes field.
 func (r *queryResolver) DatasetTypes(ctx context.Context, input *DatasetTypesInput) ([]DatasetType, error) {
-	return r.Repo.DatasetTypes(ctx, input)
+	return r.repo(ctx).DatasetTypes(ctx, input)
 }
 
 // Datasets is the resolver for the datasets field.
 func (r *queryResolver) Datasets(ctx context.Context, input *DatasetsInput) ([]Dataset, error) {
-	return r.Repo.Datasets(ctx, input)
+	return r.repo(ctx).Datasets(ctx, input)
+}
+
+// DatasetsConnection is the resolver for the datasetsConnection field.
+func (r *queryResolver) DatasetsConnection(ctx context.Context, input *DatasetsInput, first *int, after *string, orderBy *DatasetOrder) (*DatasetConnection, error) {
+	return r.repo(ctx).DatasetsConnection(ctx, input, first, after, orderBy)
+}
+
+// Changes is the resolver for the changes field.
+func (r *queryResolver) Changes(ctx context.Context, since *time.Time) ([]*DatasetChange, error) {
+	return r.repo(ctx).Changes(ctx, since)
 }
 
 // PlateauSpecs is the resolver for the plateauSpecs field.
 func (r *queryResolver) PlateauSpecs(ctx context.Context) ([]*PlateauSpec, error) {
-	return r.Repo.PlateauSpecs(ctx)
+	return r.repo(ctx).PlateauSpecs(ctx)
 }
 
 // Years is the resolver for the years field.
 func (r *queryResolver) Years(ctx context.Context) ([]int, error) {
-	return r.Repo.Years(ctx)
+	return r.repo(ctx).Years(ctx)
 }
 
 // Prefecture is the resolver for the prefecture field.
@@ -309,7 +320,7 @@ func (r *relatedDatasetResolver) Prefecture(ctx context.Context, obj *RelatedDat
 	if obj.PrefectureID == nil {
 		return nil, nil
 	}
-	return to[*Prefecture](r.Repo.Node(ctx, *obj.PrefectureID))
+	return to[*Prefecture](r.repo(ctx).Node(ctx, *obj.PrefectureID))
 }
 
 // City is the resolver for the city field.
@@ -317,7 +328,7 @@ func (r *relatedDatasetResolver) City(ctx context.Context, obj *RelatedDataset)
 	if obj.CityID == nil {
 		return nil, nil
 	}
-	return to[*City](r.Repo.Node(ctx, *obj.CityID))
+	return to[*City](r.repo(ctx).Node(ctx, *obj.CityID))
 }
 
 // Ward is the resolver for the ward field.
@@ -325,17 +336,17 @@ func (r *relatedDatasetResolver) Ward(ctx context.Context, obj *RelatedDataset)
 	if obj.WardID == nil {
 		return nil, nil
 	}
-	return to[*Ward](r.Repo.Node(ctx, *obj.WardID))
+	return to[*Ward](r.repo(ctx).Node(ctx, *obj.WardID))
 }
 
 // Type is the resolver for the type field.
 func (r *relatedDatasetResolver) Type(ctx context.Context, obj *RelatedDataset) (*RelatedDatasetType, error) {
-	return to[*RelatedDatasetType](r.Repo.Node(ctx, obj.TypeID))
+	return to[*RelatedDatasetType](r.repo(ctx).Node(ctx, obj.TypeID))
 }
 
 // Parent is the resolver for the parent field.
 func (r *relatedDatasetItemResolver) Parent(ctx context.Context, obj *RelatedDatasetItem) (*RelatedDataset, error) {
-	return to[*RelatedDataset](r.Repo.Node(ctx, obj.ParentID))
+	return to[*RelatedDataset](r.repo(ctx).Node(ctx, obj.ParentID))
 }
 
 // Datasets is the resolver for the datasets field.
@@ -345,7 +356,7 @@ func (r *relatedDatasetTypeResolver) Datasets(ctx context.Context, obj *RelatedD
 	}
 	input.IncludeTypes = []string{obj.Code}
 	input.ExcludeTypes = nil
-	datasets, err := r.Repo.Datasets(ctx, input)
+	datasets, err := r.repo(ctx).Datasets(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -360,12 +371,12 @@ func (r *relatedDatasetTypeResolver) Datasets(ctx context.Context, obj *RelatedD
 
 // Prefecture is the resolver for the prefecture field.
 func (r *wardResolver) Prefecture(ctx context.Context, obj *Ward) (*Prefecture, error) {
-	return to[*Prefecture](r.Repo.Node(ctx, obj.PrefectureID))
+	return to[*Prefecture](r.repo(ctx).Node(ctx, obj.PrefectureID))
 }
 
 // City is the resolver for the city field.
 func (r *wardResolver) City(ctx context.Context, obj *Ward) (*City, error) {
-	return to[*City](r.Repo.Node(ctx, obj.CityID))
+	return to[*City](r.repo(ctx).Node(ctx, obj.CityID))
 }
 
 // Datasets is the resolver for the datasets field.
@@ -374,7 +385,7 @@ func (r *wardResolver) Datasets(ctx context.Context, obj *Ward, input *DatasetsI
 		input = &DatasetsInput{}
 	}
 	input.AreaCodes = []AreaCode{obj.Code}
-	return r.Repo.Datasets(ctx, input)
+	return r.repo(ctx).Datasets(ctx, input)
 }
 
 // Parent is the resolver for the parent field.
@@ -384,7 +395,7 @@ func (r *wardResolver) Parent(ctx context.Context, obj *Ward) (*City, error) {
 
 // Children is the resolver for the children field.
 func (r *wardResolver) Children(ctx context.Context, obj *Ward) ([]Area, error) {
-	return r.Repo.Areas(ctx, &AreasInput{
+	return r.repo(ctx).Areas(ctx, &AreasInput{
 		ParentCode: lo.ToPtr(obj.Code),
 	})
 }
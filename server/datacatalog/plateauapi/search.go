@@ -0,0 +1,348 @@
+package plateauapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SearchDictionary configures how search tokens are matched.
+type SearchDictionary struct {
+	// Synonyms are groups of words that are regarded as the same word, e.g. ["建築物", "建物"].
+	Synonyms [][]string `json:"synonyms"`
+	// Readings are readings of area names in kana or romaji, e.g. {"東京都": ["とうきょうと", "tokyo"]}.
+	Readings map[string][]string `json:"readings"`
+}
+
+// LoadSearchDictionary reads a JSON file of a search dictionary.
+func LoadSearchDictionary(name string) (*SearchDictionary, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read search dictionary: %w", err)
+	}
+
+	d := &SearchDictionary{}
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, fmt.Errorf("failed to parse search dictionary: %w", err)
+	}
+	return d, nil
+}
+
+// Merge returns a new dictionary that has entries of both dictionaries.
+func (d *SearchDictionary) Merge(other *SearchDictionary) *SearchDictionary {
+	res := &SearchDictionary{Readings: map[string][]string{}}
+	for _, d := range []*SearchDictionary{d, other} {
+		if d == nil {
+			continue
+		}
+		res.Synonyms = append(res.Synonyms, d.Synonyms...)
+		for k, v := range d.Readings {
+			res.Readings[k] = append(res.Readings[k], v...)
+		}
+	}
+	return res
+}
+
+var searcher atomic.Pointer[textSearcher]
+
+// SetSearchDictionary replaces the dictionary used by all repos. The default dictionary is used if it is nil.
+func SetSearchDictionary(d *SearchDictionary) {
+	if d == nil {
+		searcher.Store(nil)
+		return
+	}
+	searcher.Store(newTextSearcher(d))
+}
+
+var defaultSearcher = newTextSearcher(DefaultSearchDictionary())
+
+func currentSearcher() *textSearcher {
+	if s := searcher.Load(); s != nil {
+		return s
+	}
+	return defaultSearcher
+}
+
+// searchDocument is a set of texts of a node to be searched.
+type searchDocument struct {
+	// Name is the main text which is ranked higher than the others.
+	Name string
+	// Texts are other texts such as descriptions.
+	Texts []string
+	// Names are names of related areas. Their readings are also matched.
+	Names []string
+	// Readings are additional readings in kana or romaji.
+	Readings []string
+}
+
+type normalizedDocument struct {
+	name     string
+	texts    []string
+	readings []string
+}
+
+const (
+	searchScoreNameExact  = 10
+	searchScoreNamePrefix = 6
+	searchScoreName       = 4
+	searchScoreReading    = 3
+	searchScoreText       = 2
+	// searchSynonymWeight is multiplied to scores of words matched via synonyms.
+	searchSynonymWeight = 0.8
+	// minSearchReadingKeyLength prevents short romaji such as "ka" from matching too many readings.
+	minSearchReadingKeyLength = 3
+)
+
+type textSearcher struct {
+	synonyms map[string][]string
+	readings map[string][]string
+}
+
+func newTextSearcher(d *SearchDictionary) *textSearcher {
+	s := &textSearcher{
+		synonyms: map[string][]string{},
+		readings: map[string][]string{},
+	}
+
+	for _, g := range d.Synonyms {
+		words := lo.Uniq(lo.Compact(lo.Map(g, func(w string, _ int) string {
+			return normalizeSearchText(w)
+		})))
+		for _, w := range words {
+			s.synonyms[w] = lo.Uniq(append(s.synonyms[w], lo.Without(words, w)...))
+		}
+	}
+
+	for k, v := range d.Readings {
+		k = normalizeSearchText(k)
+		s.readings[k] = lo.Uniq(append(s.readings[k], lo.Compact(lo.Map(v, func(r string, _ int) string {
+			return searchReadingKey(r)
+		}))...))
+	}
+
+	return s
+}
+
+func (s *textSearcher) normalizeDocument(doc searchDocument) normalizedDocument {
+	res := normalizedDocument{
+		name: normalizeSearchText(doc.Name),
+	}
+
+	for _, t := range append(slices.Clone(doc.Texts), doc.Names...) {
+		if t := normalizeSearchText(t); t != "" {
+			res.texts = append(res.texts, t)
+		}
+	}
+
+	for _, n := range append([]string{doc.Name}, doc.Names...) {
+		n := normalizeSearchText(n)
+		res.readings = append(res.readings, s.readings[n]...)
+		if isReadingText(n) {
+			res.readings = append(res.readings, searchReadingKey(n))
+		}
+	}
+	for _, r := range doc.Readings {
+		res.readings = append(res.readings, searchReadingKey(r))
+	}
+	res.readings = lo.Uniq(lo.Compact(res.readings))
+
+	return res
+}
+
+// Score returns the relevance of the document for the tokens. If all is true, all tokens must match, otherwise at least one token must match.
+func (s *textSearcher) Score(doc searchDocument, tokens []string, all bool) (float64, bool) {
+	d := s.normalizeDocument(doc)
+
+	score, matched := 0.0, false
+	for _, t := range tokens {
+		ts := s.tokenScore(d, t)
+		if ts == 0 && all {
+			return 0, false
+		}
+		if ts > 0 {
+			matched = true
+		}
+		score += ts
+	}
+	return score, matched
+}
+
+func (s *textSearcher) tokenScore(d normalizedDocument, token string) float64 {
+	t := normalizeSearchText(token)
+	if t == "" {
+		// empty tokens match everything as substrings
+		return searchScoreText
+	}
+
+	score := wordScore(d, t)
+	for _, syn := range s.synonyms[t] {
+		score = max(score, wordScore(d, syn)*searchSynonymWeight)
+	}
+
+	if isReadingText(t) {
+		if key := searchReadingKey(t); len(key) >= minSearchReadingKeyLength && lo.SomeBy(d.readings, func(r string) bool {
+			return strings.Contains(r, key)
+		}) {
+			score = max(score, searchScoreReading)
+		}
+	}
+
+	return score
+}
+
+func wordScore(d normalizedDocument, w string) float64 {
+	switch {
+	case d.name == w:
+		return searchScoreNameExact
+	case strings.HasPrefix(d.name, w):
+		return searchScoreNamePrefix
+	case strings.Contains(d.name, w):
+		return searchScoreName
+	case lo.SomeBy(d.texts, func(t string) bool { return strings.Contains(t, w) }):
+		return searchScoreText
+	}
+	return 0
+}
+
+// sortBySearchScore sorts the nodes in descending order of scores, keeping the order of nodes with the same score.
+func sortBySearchScore[T any](nodes []T, scores []float64) []T {
+	idx := lo.Range(len(nodes))
+	sort.SliceStable(idx, func(i, j int) bool {
+		return scores[idx[i]] > scores[idx[j]]
+	})
+	return lo.Map(idx, func(i int, _ int) T { return nodes[i] })
+}
+
+var kanjiVariants = strings.NewReplacer(
+	"髙", "高",
+	"﨑", "崎",
+	"嵜", "崎",
+	"𠮷", "吉",
+	"德", "徳",
+)
+
+// normalizeSearchText unifies widths, cases and kana so that "ﾄｳｷｮｳ", "トウキョウ" and "とうきょう" are the same.
+func normalizeSearchText(s string) string {
+	s = strings.ToLower(norm.NFKC.String(s))
+	s = kanjiVariants.Replace(s)
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return -1
+		case r == 'ヶ' || r == 'ゖ':
+			return 'け'
+		case r == 'ヵ' || r == 'ゕ':
+			return 'か'
+		case r >= 'ァ' && r <= 'ヶ':
+			// katakana to hiragana
+			return r - 0x60
+		}
+		return r
+	}, s)
+}
+
+// isReadingText reports whether the normalized text consists only of kana and latin letters, which can be converted into romaji.
+func isReadingText(s string) bool {
+	return s != "" && lo.EveryBy([]rune(s), func(r rune) bool {
+		return r >= 'ぁ' && r <= 'ゖ' || r == 'ー' || r >= 'a' && r <= 'z' || r == '-' || r == '\''
+	})
+}
+
+var romajiCanonicalizer = []*strings.Replacer{
+	// Hepburn to Kunrei
+	strings.NewReplacer("shi", "si", "chi", "ti", "tsu", "tu", "fu", "hu", "ji", "zi", "di", "zi", "du", "zu"),
+	strings.NewReplacer("sh", "sy", "ch", "ty", "j", "zy", "mb", "nb", "mp", "np", "nn", "n"),
+	// long vowels
+	strings.NewReplacer("ou", "o", "oo", "o", "uu", "u", "aa", "a", "ii", "i", "ee", "e"),
+}
+
+// searchReadingKey converts kana or romaji into a canonical romaji so that "とうきょう", "Tōkyō" and "tokyo" are the same.
+func searchReadingKey(s string) string {
+	s = kanaToRomaji(normalizeSearchText(s))
+
+	// remove diacritics such as macrons
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(s) {
+		if r >= 'a' && r <= 'z' {
+			b.WriteRune(r)
+		}
+	}
+
+	s = b.String()
+	for _, r := range romajiCanonicalizer {
+		s = r.Replace(s)
+	}
+	return s
+}
+
+var kanaRomaji = map[string]string{
+	"あ": "a", "い": "i", "う": "u", "え": "e", "お": "o",
+	"か": "ka", "き": "ki", "く": "ku", "け": "ke", "こ": "ko",
+	"さ": "sa", "し": "si", "す": "su", "せ": "se", "そ": "so",
+	"た": "ta", "ち": "ti", "つ": "tu", "て": "te", "と": "to",
+	"な": "na", "に": "ni", "ぬ": "nu", "ね": "ne", "の": "no",
+	"は": "ha", "ひ": "hi", "ふ": "hu", "へ": "he", "ほ": "ho",
+	"ま": "ma", "み": "mi", "む": "mu", "め": "me", "も": "mo",
+	"や": "ya", "ゆ": "yu", "よ": "yo",
+	"ら": "ra", "り": "ri", "る": "ru", "れ": "re", "ろ": "ro",
+	"わ": "wa", "ゐ": "i", "ゑ": "e", "を": "o", "ん": "n",
+	"が": "ga", "ぎ": "gi", "ぐ": "gu", "げ": "ge", "ご": "go",
+	"ざ": "za", "じ": "zi", "ず": "zu", "ぜ": "ze", "ぞ": "zo",
+	"だ": "da", "ぢ": "zi", "づ": "zu", "で": "de", "ど": "do",
+	"ば": "ba", "び": "bi", "ぶ": "bu", "べ": "be", "ぼ": "bo",
+	"ぱ": "pa", "ぴ": "pi", "ぷ": "pu", "ぺ": "pe", "ぽ": "po",
+	"ゔ": "vu",
+	"ぁ": "a", "ぃ": "i", "ぅ": "u", "ぇ": "e", "ぉ": "o",
+	"ゃ": "ya", "ゅ": "yu", "ょ": "yo", "ゎ": "wa",
+}
+
+var kanaYoon = map[rune]string{'ゃ': "a", 'ゅ': "u", 'ょ': "o"}
+
+// kanaToRomaji converts hiragana in the text into Kunrei-style romaji. Other characters are kept.
+func kanaToRomaji(s string) string {
+	rs := []rune(s)
+	var b strings.Builder
+	double := false
+
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		if r == 'っ' {
+			double = true
+			continue
+		}
+		if r == 'ー' {
+			continue
+		}
+
+		rm, ok := kanaRomaji[string(r)]
+		if !ok {
+			b.WriteRune(r)
+			double = false
+			continue
+		}
+
+		// contracted sounds such as "きゃ"
+		if i+1 < len(rs) {
+			if v, ok := kanaYoon[rs[i+1]]; ok && len(rm) == 2 && rm[1] == 'i' {
+				rm = rm[:1] + "y" + v
+				i++
+			}
+		}
+
+		if double && rm[0] != 'a' && rm[0] != 'i' && rm[0] != 'u' && rm[0] != 'e' && rm[0] != 'o' && rm[0] != 'n' {
+			b.WriteByte(rm[0])
+		}
+		double = false
+		b.WriteString(rm)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,83 @@
+package plateauapi
+
+// DefaultSearchDictionary returns the built-in dictionary that has synonyms of dataset type names and readings of prefectures.
+func DefaultSearchDictionary() *SearchDictionary {
+	return &SearchDictionary{
+		Synonyms: [][]string{
+			{"建築物", "建物", "ビル", "building"},
+			{"交通", "道路", "road"},
+			{"鉄道", "線路", "railway"},
+			{"橋梁", "橋", "bridge"},
+			{"トンネル", "隧道", "tunnel"},
+			{"土地利用", "用途", "landuse"},
+			{"都市計画決定情報", "都市計画", "用途地域"},
+			{"洪水浸水想定区域", "洪水", "flood"},
+			{"津波浸水想定", "津波", "tsunami"},
+			{"高潮浸水想定区域", "高潮", "storm surge"},
+			{"内水浸水想定区域", "内水"},
+			{"浸水", "水害"},
+			{"土砂災害", "土砂", "がけ崩れ", "崖崩れ", "landslide"},
+			{"地形", "標高", "dem"},
+			{"植生", "緑地", "vegetation"},
+			{"都市設備", "設備"},
+			{"水部", "水域", "河川", "water"},
+			{"地下街", "地下"},
+			{"避難施設", "避難所", "shelter"},
+			{"ランドマーク", "landmark"},
+			{"公園", "park"},
+			{"駅", "station"},
+			{"行政界", "境界", "border"},
+			{"緊急輸送道路", "緊急輸送"},
+			{"ユースケース", "usecase"},
+		},
+		Readings: map[string][]string{
+			"北海道":  {"ほっかいどう"},
+			"青森県":  {"あおもりけん"},
+			"岩手県":  {"いわてけん"},
+			"宮城県":  {"みやぎけん"},
+			"秋田県":  {"あきたけん"},
+			"山形県":  {"やまがたけん"},
+			"福島県":  {"ふくしまけん"},
+			"茨城県":  {"いばらきけん"},
+			"栃木県":  {"とちぎけん"},
+			"群馬県":  {"ぐんまけん"},
+			"埼玉県":  {"さいたまけん"},
+			"千葉県":  {"ちばけん"},
+			"東京都":  {"とうきょうと"},
+			"神奈川県": {"かながわけん"},
+			"新潟県":  {"にいがたけん"},
+			"富山県":  {"とやまけん"},
+			"石川県":  {"いしかわけん"},
+			"福井県":  {"ふくいけん"},
+			"山梨県":  {"やまなしけん"},
+			"長野県":  {"ながのけん"},
+			"岐阜県":  {"ぎふけん"},
+			"静岡県":  {"しずおかけん"},
+			"愛知県":  {"あいちけん"},
+			"三重県":  {"みえけん"},
+			"滋賀県":  {"しがけん"},
+			"京都府":  {"きょうとふ"},
+			"大阪府":  {"おおさかふ"},
+			"兵庫県":  {"ひょうごけん"},
+			"奈良県":  {"ならけん"},
+			"和歌山県": {"わかやまけん"},
+			"鳥取県":  {"とっとりけん"},
+			"島根県":  {"しまねけん"},
+			"岡山県":  {"おかやまけん"},
+			"広島県":  {"ひろしまけん"},
+			"山口県":  {"やまぐちけん"},
+			"徳島県":  {"とくしまけん"},
+			"香川県":  {"かがわけん"},
+			"愛媛県":  {"えひめけん"},
+			"高知県":  {"こうちけん"},
+			"福岡県":  {"ふくおかけん"},
+			"佐賀県":  {"さがけん"},
+			"長崎県":  {"ながさきけん"},
+			"熊本県":  {"くまもとけん"},
+			"大分県":  {"おおいたけん"},
+			"宮崎県":  {"みやざきけん"},
+			"鹿児島県": {"かごしまけん"},
+			"沖縄県":  {"おきなわけん"},
+		},
+	}
+}
@@ -0,0 +1,153 @@
+package plateauapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSearchText(t *testing.T) {
+	assert.Equal(t, "とうきょう", normalizeSearchText("ﾄｳｷｮｳ"))
+	assert.Equal(t, "とうきょう", normalizeSearchText("トウキョウ"))
+	assert.Equal(t, "plateau2023", normalizeSearchText("ＰＬＡＴＥＡＵ　２０２３"))
+	assert.Equal(t, "霞け関", normalizeSearchText("霞ヶ関"))
+	assert.Equal(t, "高崎", normalizeSearchText("髙﨑"))
+}
+
+func TestSearchReadingKey(t *testing.T) {
+	assert.Equal(t, "tokyo", searchReadingKey("とうきょう"))
+	assert.Equal(t, "tokyo", searchReadingKey("Tōkyō"))
+	assert.Equal(t, "tokyo", searchReadingKey("ﾄｳｷｮｳ"))
+	assert.Equal(t, "osaka", searchReadingKey("おおさか"))
+	assert.Equal(t, searchReadingKey("しんばし"), searchReadingKey("Shimbashi"))
+	assert.Equal(t, searchReadingKey("ほっかいどう"), searchReadingKey("Hokkaido"))
+	assert.Equal(t, searchReadingKey("ちよだく"), searchReadingKey("chiyoda-ku"))
+	assert.Equal(t, searchReadingKey("じゃ"), searchReadingKey("ja"))
+}
+
+func TestTextSearcher(t *testing.T) {
+	s := newTextSearcher(&SearchDictionary{
+		Synonyms: [][]string{{"建築物", "建物"}},
+		Readings: map[string][]string{"東京都": {"とうきょうと"}},
+	})
+
+	score, ok := s.Score(searchDocument{Name: "建築物モデル"}, []string{"建物"}, true)
+	assert.True(t, ok)
+	assert.InDelta(t, searchScoreNamePrefix*searchSynonymWeight, score, 1e-9)
+
+	_, ok = s.Score(searchDocument{Name: "交通（道路）モデル"}, []string{"建物"}, true)
+	assert.False(t, ok)
+
+	for _, q := range []string{"東京", "とうきょう", "ﾄｳｷｮｳ", "tokyo"} {
+		_, ok = s.Score(searchDocument{Name: "東京都"}, []string{q}, true)
+		assert.True(t, ok, q)
+	}
+
+	// too short to match readings
+	_, ok = s.Score(searchDocument{Name: "東京都"}, []string{"to"}, true)
+	assert.False(t, ok)
+
+	exact, _ := s.Score(searchDocument{Name: "建物"}, []string{"建物"}, true)
+	prefix, _ := s.Score(searchDocument{Name: "建物モデル"}, []string{"建物"}, true)
+	contains, _ := s.Score(searchDocument{Name: "3D建物"}, []string{"建物"}, true)
+	text, _ := s.Score(searchDocument{Name: "a", Texts: []string{"建物"}}, []string{"建物"}, true)
+	assert.Greater(t, exact, prefix)
+	assert.Greater(t, prefix, contains)
+	assert.Greater(t, contains, text)
+
+	_, ok = s.Score(searchDocument{Name: "建物"}, []string{"建物", "道路"}, true)
+	assert.False(t, ok)
+	_, ok = s.Score(searchDocument{Name: "建物"}, []string{"建物", "道路"}, false)
+	assert.True(t, ok)
+}
+
+func TestInMemoryRepo_Search(t *testing.T) {
+	ctx := context.Background()
+	r := NewInMemoryRepo(&InMemoryRepoContext{
+		Areas: Areas{
+			AreaTypePrefecture: []Area{
+				&Prefecture{ID: "p13", Code: "13", Name: "東京都"},
+				&Prefecture{ID: "p27", Code: "27", Name: "大阪府"},
+			},
+			AreaTypeCity: []Area{
+				&City{ID: "c13101", Code: "13101", Name: "千代田区", PrefectureCode: "13"},
+				&City{ID: "c27100", Code: "27100", Name: "大阪市", PrefectureCode: "27"},
+			},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{
+				&PlateauDataset{ID: "1", Name: "交通（道路）モデル（千代田区）", TypeCode: "tran", PrefectureCode: lo.ToPtr(AreaCode("13")), CityCode: lo.ToPtr(AreaCode("13101"))},
+				&PlateauDataset{ID: "2", Name: "建築物モデル（千代田区）", TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("13")), CityCode: lo.ToPtr(AreaCode("13101"))},
+				&PlateauDataset{ID: "3", Name: "建築物モデル（大阪市）", TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("27")), CityCode: lo.ToPtr(AreaCode("27100"))},
+			},
+			DatasetTypeCategoryRelated: []Dataset{
+				&RelatedDataset{ID: "4", Name: "避難施設", Description: lo.ToPtr("建物の情報を含む"), TypeCode: "shelter", PrefectureCode: lo.ToPtr(AreaCode("13")), CityCode: lo.ToPtr(AreaCode("13101"))},
+			},
+		},
+		AreaReadings: map[AreaCode][]string{"13101": {"chiyoda-ku"}},
+	})
+
+	datasetIDs := func(tokens ...string) []ID {
+		res, err := r.Datasets(ctx, &DatasetsInput{SearchTokens: tokens})
+		require.NoError(t, err)
+		return lo.Map(res, func(d Dataset, _ int) ID { return d.GetID() })
+	}
+	areaCodes := func(tokens ...string) []AreaCode {
+		res, err := r.Areas(ctx, &AreasInput{SearchTokens: tokens, IncludeEmpty: lo.ToPtr(true)})
+		require.NoError(t, err)
+		return lo.Map(res, func(a Area, _ int) AreaCode { return a.GetCode() })
+	}
+
+	// synonyms and ranking: names are ranked higher than descriptions
+	assert.Equal(t, []ID{"2", "3", "4"}, datasetIDs("建物"))
+	// readings of areas
+	assert.Equal(t, []ID{"1", "2", "4"}, datasetIDs("ちよだ"))
+	assert.Equal(t, []ID{"2", "4"}, datasetIDs("chiyoda", "建物"))
+	assert.Equal(t, []ID{"3"}, datasetIDs("おおさか", "建物"))
+
+	assert.Equal(t, []AreaCode{"13"}, areaCodes("とうきょう"))
+	assert.Equal(t, []AreaCode{"13101"}, areaCodes("チヨダ"))
+	assert.Equal(t, []AreaCode{"27", "27100"}, areaCodes("大阪"))
+	// exact matches come first
+	assert.Equal(t, []AreaCode{"27100", "27"}, areaCodes("大阪市", "おおさか"))
+}
+
+func TestMerger_Search(t *testing.T) {
+	ctx := context.Background()
+	r1 := NewInMemoryRepo(&InMemoryRepoContext{
+		Areas: Areas{
+			AreaTypePrefecture: []Area{&Prefecture{ID: "p13", Code: "13", Name: "東京都"}},
+			AreaTypeCity:       []Area{&City{ID: "c13101", Code: "13101", Name: "千代田区", PrefectureCode: "13"}},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{
+				&PlateauDataset{ID: "1", Name: "建築物モデル", TypeCode: "bldg", PrefectureCode: lo.ToPtr(AreaCode("13")), CityCode: lo.ToPtr(AreaCode("13101"))},
+			},
+		},
+		AreaReadings: map[AreaCode][]string{"13101": {"chiyoda-ku"}},
+	})
+	r2 := NewInMemoryRepo(&InMemoryRepoContext{
+		Areas: Areas{
+			AreaTypePrefecture: []Area{&Prefecture{ID: "p10", Code: "10", Name: "群馬県"}},
+			AreaTypeCity:       []Area{&City{ID: "c10524", Code: "10524", Name: "千代田町", PrefectureCode: "10"}},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryRelated: []Dataset{
+				&RelatedDataset{ID: "2", Name: "避難施設", Description: lo.ToPtr("chiyoda"), TypeCode: "shelter", PrefectureCode: lo.ToPtr(AreaCode("10")), CityCode: lo.ToPtr(AreaCode("10524"))},
+			},
+		},
+	})
+	m := NewMerger(NewRepoWrapper(r1, nil), r2)
+
+	// the reading of the area of the dataset in r1 is ranked higher than the description in r2
+	datasets, err := m.Datasets(ctx, &DatasetsInput{SearchTokens: []string{"chiyoda"}})
+	require.NoError(t, err)
+	assert.Equal(t, []ID{"1", "2"}, lo.Map(datasets, func(d Dataset, _ int) ID { return d.GetID() }))
+
+	areas, err := m.Areas(ctx, &AreasInput{SearchTokens: []string{"千代田", "chiyoda"}, IncludeEmpty: lo.ToPtr(true)})
+	require.NoError(t, err)
+	assert.Equal(t, []AreaCode{"13101", "10524"}, lo.Map(areas, func(a Area, _ int) AreaCode { return a.GetCode() }))
+}
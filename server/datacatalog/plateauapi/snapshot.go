@@ -0,0 +1,346 @@
+package plateauapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	snapshotVersionLayout = "20060102T150405.000000000Z"
+	snapshotExt           = ".json.gz"
+	// maxCachedSnapshots is the number of old snapshots kept in memory for point-in-time queries.
+	maxCachedSnapshots = 8
+)
+
+// Snapshot is a persisted state of a project's InMemoryRepo.
+type Snapshot struct {
+	Project   string               `json:"project"`
+	CreatedAt time.Time            `json:"createdAt"`
+	Warnings  []string             `json:"warnings,omitempty"`
+	Context   *InMemoryRepoContext `json:"context"`
+}
+
+// changesSnapshot is a persisted history of changes of a project. It is overwritten whenever changes are detected
+// so that feeds keep the same entries after the server restarts.
+type changesSnapshot struct {
+	Project   string        `json:"project"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	Changes   changeHistory `json:"changes"`
+}
+
+func encodeSnapshot(s *Snapshot) ([]byte, error) {
+	return encodeGzipJSON(s)
+}
+
+func decodeSnapshot(b []byte) (*Snapshot, error) {
+	var s Snapshot
+	if err := decodeGzipJSON(b, &s); err != nil {
+		return nil, err
+	}
+	if s.Context == nil {
+		return nil, fmt.Errorf("snapshot has no context")
+	}
+	return &s, nil
+}
+
+func encodeGzipJSON(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeGzipJSON(b []byte, v any) error {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	return json.NewDecoder(r).Decode(v)
+}
+
+// contextHash is used to skip saving a snapshot when nothing has changed since the last one.
+func contextHash(c *InMemoryRepoContext) ([32]byte, error) {
+	h := sha256.New()
+	if err := json.NewEncoder(h).Encode(c); err != nil {
+		return [32]byte{}, err
+	}
+	return [32]byte(h.Sum(nil)), nil
+}
+
+func snapshotKey(project string, t time.Time) string {
+	return project + "/" + t.UTC().Format(snapshotVersionLayout) + snapshotExt
+}
+
+// changesKey is the key of the history of changes, which is next to the snapshots of the project.
+func changesKey(project string) string {
+	return project + ".changes" + snapshotExt
+}
+
+func snapshotTimeFromKey(project, key string) (time.Time, bool) {
+	v, ok := strings.CutPrefix(key, project+"/")
+	if !ok {
+		return time.Time{}, false
+	}
+	v, ok = strings.CutSuffix(v, snapshotExt)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(snapshotVersionLayout, v)
+	return t, err == nil
+}
+
+// snapshots manages snapshots of projects on a storage.
+type snapshots struct {
+	storage SnapshotStorage
+	// retention is how long old snapshots are kept. Zero means forever.
+	retention time.Duration
+	lock      sync.Mutex
+	// versions are creation times of snapshots in ascending order. nil means not listed yet.
+	versions map[string][]time.Time
+	hashes   map[string][32]byte
+	cache    map[string]*InMemoryRepo
+	cacheKey []string
+}
+
+func newSnapshots(s SnapshotStorage) *snapshots {
+	return &snapshots{
+		storage:  s,
+		versions: map[string][]time.Time{},
+		hashes:   map[string][32]byte{},
+		cache:    map[string]*InMemoryRepo{},
+	}
+}
+
+func (s *snapshots) listVersions(ctx context.Context, project string) ([]time.Time, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.versions[project]; ok {
+		return v, nil
+	}
+
+	keys, err := s.storage.List(ctx, project+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	versions := []time.Time{}
+	for _, k := range keys {
+		if t, ok := snapshotTimeFromKey(project, k); ok {
+			versions = append(versions, t)
+		}
+	}
+	slices.SortFunc(versions, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	s.versions[project] = versions
+	return versions, nil
+}
+
+// save saves the context as a new snapshot unless it is the same as the last one. It returns false if skipped.
+func (s *snapshots) save(ctx context.Context, project string, c *InMemoryRepoContext, warnings []string, now time.Time) (bool, error) {
+	if _, err := s.listVersions(ctx, project); err != nil {
+		return false, err
+	}
+
+	h, err := contextHash(c)
+	if err != nil {
+		return false, err
+	}
+
+	s.lock.Lock()
+	last, ok := s.hashes[project]
+	s.lock.Unlock()
+	if ok && last == h {
+		return false, nil
+	}
+
+	b, err := encodeSnapshot(&Snapshot{
+		Project:   project,
+		CreatedAt: now,
+		Warnings:  warnings,
+		Context:   c,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.storage.Save(ctx, snapshotKey(project, now), b); err != nil {
+		return false, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.hashes[project] = h
+	s.versions[project] = append(s.versions[project], now.UTC())
+	return true, nil
+}
+
+// prune deletes snapshots older than the retention except the latest one of them, which is still used for point-in-time queries in the retention.
+func (s *snapshots) prune(ctx context.Context, project string, now time.Time) error {
+	if s.retention <= 0 {
+		return nil
+	}
+
+	versions, err := s.listVersions(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	// i is the index of the latest snapshot at or before the start of the retention
+	i, found := slices.BinarySearchFunc(versions, now.Add(-s.retention), func(v, t time.Time) int {
+		return v.Compare(t)
+	})
+	if !found {
+		i--
+	}
+	if i <= 0 {
+		return nil
+	}
+
+	deleted := 0
+	defer func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.versions[project] = s.versions[project][deleted:]
+	}()
+
+	for _, v := range versions[:i] {
+		key := snapshotKey(project, v)
+		if err := s.storage.Delete(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete snapshot %s: %w", key, err)
+		}
+		deleted++
+
+		s.lock.Lock()
+		delete(s.cache, key)
+		s.cacheKey = slices.DeleteFunc(s.cacheKey, func(k string) bool { return k == key })
+		s.lock.Unlock()
+	}
+	return nil
+}
+
+// latest loads the latest snapshot of the project. It returns nil if there are no snapshots.
+func (s *snapshots) latest(ctx context.Context, project string) (*Snapshot, error) {
+	versions, err := s.listVersions(ctx, project)
+	if err != nil || len(versions) == 0 {
+		return nil, err
+	}
+
+	snapshot, err := s.load(ctx, project, versions[len(versions)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if h, err := contextHash(snapshot.Context); err == nil {
+		s.lock.Lock()
+		s.hashes[project] = h
+		s.lock.Unlock()
+	}
+	return snapshot, nil
+}
+
+// at returns the repo of the latest snapshot created at or before t. It returns nil if there are no such snapshots.
+func (s *snapshots) at(ctx context.Context, project string, t time.Time) (*InMemoryRepo, error) {
+	versions, err := s.listVersions(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	i, found := slices.BinarySearchFunc(versions, t, func(v, t time.Time) int {
+		return v.Compare(t)
+	})
+	if !found {
+		i--
+	}
+	if i < 0 {
+		return nil, nil
+	}
+
+	key := snapshotKey(project, versions[i])
+	s.lock.Lock()
+	repo := s.cache[key]
+	s.lock.Unlock()
+	if repo != nil {
+		return repo, nil
+	}
+
+	snapshot, err := s.load(ctx, project, versions[i])
+	if err != nil {
+		return nil, err
+	}
+	repo = NewInMemoryRepo(snapshot.Context)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.cache[key]; !ok {
+		s.cache[key] = repo
+		s.cacheKey = append(s.cacheKey, key)
+		if len(s.cacheKey) > maxCachedSnapshots {
+			delete(s.cache, s.cacheKey[0])
+			s.cacheKey = s.cacheKey[1:]
+		}
+	}
+	return repo, nil
+}
+
+func (s *snapshots) load(ctx context.Context, project string, t time.Time) (*Snapshot, error) {
+	key := snapshotKey(project, t)
+	b, err := s.storage.Load(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot %s: %w", key, err)
+	}
+	snapshot, err := decodeSnapshot(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", key, err)
+	}
+	return snapshot, nil
+}
+
+// saveChanges saves the history of changes of the project.
+func (s *snapshots) saveChanges(ctx context.Context, project string, h changeHistory, now time.Time) error {
+	b, err := encodeGzipJSON(&changesSnapshot{Project: project, UpdatedAt: now, Changes: h})
+	if err != nil {
+		return err
+	}
+	if err := s.storage.Save(ctx, changesKey(project), b); err != nil {
+		return fmt.Errorf("failed to save changes: %w", err)
+	}
+	return nil
+}
+
+// loadChanges loads the history of changes of the project. It returns nil if no changes have been saved.
+func (s *snapshots) loadChanges(ctx context.Context, project string) (changeHistory, error) {
+	key := changesKey(project)
+	b, err := s.storage.Load(ctx, key)
+	if errors.Is(err, ErrSnapshotNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load changes %s: %w", key, err)
+	}
+
+	var c changesSnapshot
+	if err := decodeGzipJSON(b, &c); err != nil {
+		return nil, fmt.Errorf("failed to decode changes %s: %w", key, err)
+	}
+	return c.Changes, nil
+}
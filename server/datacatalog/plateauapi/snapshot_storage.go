@@ -0,0 +1,51 @@
+package plateauapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// SnapshotStorage stores encoded snapshots. Keys are slash-separated paths such as "<project>/<version>.json.gz".
+type SnapshotStorage interface {
+	Save(ctx context.Context, key string, data []byte) error
+	// Load returns ErrSnapshotNotFound if the snapshot does not exist.
+	Load(ctx context.Context, key string) ([]byte, error)
+	// List returns keys that start with prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+	// Delete does nothing if the snapshot does not exist.
+	Delete(ctx context.Context, key string) error
+}
+
+// OpenSnapshotStorage opens a storage from a URL: gs://<bucket>/<prefix> or file:///<dir>. A URL without a scheme is treated as a directory.
+func OpenSnapshotStorage(ctx context.Context, storageURL string) (SnapshotStorage, error) {
+	u, err := url.Parse(storageURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot url(%s): %w", storageURL, err)
+	}
+
+	switch u.Scheme {
+	case "gs":
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid snapshot url(%s): bucket is required", storageURL)
+		}
+		gcs, err := storage.NewClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("storage.NewClient: %w", err)
+		}
+		return NewGCSSnapshotStorage(gcs.Bucket(u.Host), strings.Trim(u.Path, "/")), nil
+	case "file":
+		return NewLocalSnapshotStorage(filepath.FromSlash(u.Path))
+	case "":
+		return NewLocalSnapshotStorage(storageURL)
+	}
+
+	return nil, fmt.Errorf("invalid snapshot url(%s): must be gs:// or file://", storageURL)
+}
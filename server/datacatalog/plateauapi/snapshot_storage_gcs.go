@@ -0,0 +1,77 @@
+package plateauapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// GCSSnapshotStorage stores snapshots as objects under the prefix of the bucket.
+type GCSSnapshotStorage struct {
+	bucket *storage.BucketHandle
+	prefix string
+}
+
+var _ SnapshotStorage = (*GCSSnapshotStorage)(nil)
+
+func NewGCSSnapshotStorage(bucket *storage.BucketHandle, prefix string) *GCSSnapshotStorage {
+	return &GCSSnapshotStorage{bucket: bucket, prefix: prefix}
+}
+
+func (s *GCSSnapshotStorage) Save(ctx context.Context, key string, data []byte) error {
+	w := s.bucket.Object(s.name(key)).NewWriter(ctx)
+	if _, err := w.Write(data); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *GCSSnapshotStorage) Load(ctx context.Context, key string) ([]byte, error) {
+	r, err := s.bucket.Object(s.name(key)).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	return io.ReadAll(r)
+}
+
+func (s *GCSSnapshotStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	var res []string
+	it := s.bucket.Objects(ctx, &storage.Query{Prefix: s.name(prefix)})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, strings.TrimPrefix(strings.TrimPrefix(attrs.Name, s.prefix), "/"))
+	}
+	return res, nil
+}
+
+func (s *GCSSnapshotStorage) Delete(ctx context.Context, key string) error {
+	if err := s.bucket.Object(s.name(key)).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *GCSSnapshotStorage) name(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	// path.Join is not used as it removes the trailing slash of prefixes for List
+	return s.prefix + "/" + key
+}
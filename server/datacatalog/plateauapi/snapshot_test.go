@@ -0,0 +1,311 @@
+package plateauapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryRepoContext_JSON(t *testing.T) {
+	c := &InMemoryRepoContext{
+		Name: "a",
+		Areas: Areas{
+			AreaTypePrefecture: []Area{&Prefecture{ID: "p", Code: "13", Name: "東京都"}},
+			AreaTypeCity:       []Area{&City{ID: "c", Code: "13100", Name: "特別区部", PrefectureCode: "13"}},
+			AreaTypeWard:       []Area{&Ward{ID: "w", Code: "13101", Name: "千代田区", CityCode: "13100"}},
+		},
+		DatasetTypes: DatasetTypes{
+			DatasetTypeCategoryPlateau: []DatasetType{&PlateauDatasetType{ID: "pt", Code: "bldg"}},
+			DatasetTypeCategoryRelated: []DatasetType{&RelatedDatasetType{ID: "rt", Code: "park"}},
+			DatasetTypeCategoryGeneric: []DatasetType{&GenericDatasetType{ID: "gt", Code: "usecase"}},
+		},
+		Datasets: Datasets{
+			DatasetTypeCategoryPlateau: []Dataset{&PlateauDataset{ID: "1", Items: []*PlateauDatasetItem{{ID: "i", Lod: lo.ToPtr(1)}}}},
+			DatasetTypeCategoryRelated: []Dataset{&RelatedDataset{ID: "2"}},
+			DatasetTypeCategoryGeneric: []Dataset{&GenericDataset{ID: "3"}},
+		},
+		Years:   []int{2023},
+		CityGML: map[ID]*CityGMLDataset{"cg": {ID: "cg", URL: "https://example.com"}},
+	}
+
+	b, err := json.Marshal(c)
+	require.NoError(t, err)
+
+	var res InMemoryRepoContext
+	require.NoError(t, json.Unmarshal(b, &res))
+	assert.Equal(t, c, &res)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"areas":{"x":[]}}`), &res), "unknown area type: x")
+}
+
+func TestRepos_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	storage, err := NewLocalSnapshotStorage(t.TempDir())
+	require.NoError(t, err)
+
+	var fail bool
+	var ds []Dataset
+	updater := func(ctx context.Context, project string) (*ReposUpdateResult, error) {
+		if fail {
+			return nil, errors.New("cms is down")
+		}
+		return &ReposUpdateResult{
+			Repo: NewInMemoryRepo(&InMemoryRepoContext{
+				Datasets: Datasets{DatasetTypeCategoryPlateau: ds},
+			}),
+		}, nil
+	}
+	newRepos := func() *Repos {
+		r := NewRepos(updater)
+		r.SetSnapshotStorage(storage)
+		r.now = func() time.Time { return now }
+		return r
+	}
+	ids := func(r Repo) []ID {
+		res, err := r.Datasets(ctx, nil)
+		require.NoError(t, err)
+		return lo.Map(res, func(d Dataset, _ int) ID { return d.GetID() })
+	}
+
+	r := newRepos()
+	ds = []Dataset{&PlateauDataset{ID: "1"}}
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+	t1 := now
+
+	// not saved as nothing changed
+	now = now.Add(time.Minute)
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	ds = []Dataset{&PlateauDataset{ID: "1"}, &PlateauDataset{ID: "2"}}
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+	t2 := now
+
+	keys, err := storage.List(ctx, "prj/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{snapshotKey("prj", t1), snapshotKey("prj", t2)}, keys)
+
+	// point-in-time queries
+	repo, err := r.RepoAt(ctx, "prj", t1.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Nil(t, repo)
+
+	repo, err = r.RepoAt(ctx, "prj", t1.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []ID{"1"}, ids(repo))
+
+	repo, err = r.RepoAt(ctx, "prj", t2)
+	require.NoError(t, err)
+	assert.Equal(t, []ID{"1", "2"}, ids(repo))
+
+	// boot from the latest snapshot when the first update fails
+	fail = true
+	now = now.Add(time.Hour)
+	r2 := newRepos()
+	_, err = r2.Update(ctx, "prj")
+	assert.Error(t, err)
+	assert.Equal(t, []ID{"1", "2"}, ids(r2.Repo("prj")))
+	assert.Equal(t, t2, r2.UpdatedAt("prj"))
+	assert.Equal(t, []string{"restored from snapshot at 2024-01-01T00:02:00Z"}, r2.Warnings("prj"))
+}
+
+func TestRepos_SnapshotRetention(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	storage, err := NewLocalSnapshotStorage(t.TempDir())
+	require.NoError(t, err)
+
+	var id ID
+	r := NewRepos(func(ctx context.Context, project string) (*ReposUpdateResult, error) {
+		return &ReposUpdateResult{
+			Repo: NewInMemoryRepo(&InMemoryRepoContext{
+				Datasets: Datasets{DatasetTypeCategoryPlateau: []Dataset{&PlateauDataset{ID: id}}},
+			}),
+		}, nil
+	})
+	r.SetSnapshotStorage(storage)
+	r.SetSnapshotRetention(48 * time.Hour)
+	r.now = func() time.Time { return now }
+
+	var times []time.Time
+	for i := range 4 {
+		id = ID(fmt.Sprint(i))
+		_, err := r.Update(ctx, "prj")
+		require.NoError(t, err)
+		times = append(times, now)
+		now = now.Add(24 * time.Hour)
+	}
+
+	// the snapshot before the retention is kept for point-in-time queries at the start of the retention
+	keys, err := storage.List(ctx, "prj/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{snapshotKey("prj", times[1]), snapshotKey("prj", times[2]), snapshotKey("prj", times[3])}, keys)
+
+	repo, err := r.RepoAt(ctx, "prj", times[0])
+	require.NoError(t, err)
+	assert.Nil(t, repo)
+	repo, err = r.RepoAt(ctx, "prj", times[1].Add(time.Hour))
+	require.NoError(t, err)
+	ds, err := repo.Datasets(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ID("1"), ds[0].GetID())
+}
+
+func TestRepos_SnapshotAdmin(t *testing.T) {
+	ctx := context.Background()
+	storage, err := NewLocalSnapshotStorage(t.TempDir())
+	require.NoError(t, err)
+
+	fail := false
+	newRepos := func() *Repos {
+		r := NewRepos(func(ctx context.Context, project string) (*ReposUpdateResult, error) {
+			if fail {
+				return nil, errors.New("cms is down")
+			}
+			return &ReposUpdateResult{
+				Repo: NewInMemoryRepo(&InMemoryRepoContext{
+					Datasets: Datasets{DatasetTypeCategoryPlateau: []Dataset{
+						&PlateauDataset{ID: "d_public"},
+						&PlateauDataset{ID: "d_beta", Admin: &Admin{Stage: "beta"}},
+					}},
+					CityGML: map[ID]*CityGMLDataset{"cg_beta": {ID: "cg_beta", Admin: &Admin{Stage: "beta"}}},
+				}),
+			}, nil
+		})
+		r.SetSnapshotStorage(storage)
+		return r
+	}
+
+	r := newRepos()
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+
+	// a repo restored from the snapshot at boot and a repo at a point in time
+	fail = true
+	r2 := newRepos()
+	_, err = r2.Update(ctx, "prj")
+	require.Error(t, err)
+	at, err := r.RepoAt(ctx, "prj", time.Now())
+	require.NoError(t, err)
+
+	for _, repo := range []Repo{r2.Repo("prj"), at} {
+		// stages survive the round trip of snapshots, so datasets not public yet stay hidden
+		ds, err := repo.Datasets(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []ID{"d_public"}, lo.Map(ds, func(d Dataset, _ int) ID { return d.GetID() }))
+
+		n, err := repo.Node(ctx, "d_beta")
+		require.NoError(t, err)
+		assert.Nil(t, n)
+		n, err = repo.Node(ctx, "cg_beta")
+		require.NoError(t, err)
+		assert.Nil(t, n)
+
+		ds, err = repo.Datasets(AllowAdminStages(ctx, []string{"beta"}), nil)
+		require.NoError(t, err)
+		assert.Len(t, ds, 2)
+	}
+}
+
+func TestSnapshotDirective(t *testing.T) {
+	current := NewInMemoryRepo(&InMemoryRepoContext{
+		Datasets: Datasets{DatasetTypeCategoryPlateau: []Dataset{&PlateauDataset{ID: "d_current"}}},
+	})
+	old := NewInMemoryRepo(&InMemoryRepoContext{
+		Datasets: Datasets{DatasetTypeCategoryPlateau: []Dataset{&PlateauDataset{ID: "d_old"}}},
+	})
+	asOf := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	svc := NewService(current)
+
+	query := func(ctx context.Context, q string) string {
+		b, _ := json.Marshal(map[string]any{"query": q})
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(b))).WithContext(ctx)
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		svc.ServeHTTP(rec, req)
+		return rec.Body.String()
+	}
+
+	ctx := WithRepoAt(context.Background(), func(ctx context.Context, t time.Time) (Repo, error) {
+		if t.Equal(asOf) {
+			return old, nil
+		}
+		return nil, nil
+	})
+
+	assert.JSONEq(t, `{"data":{"datasets":[{"id":"d_current"}]}}`, query(ctx, `{ datasets { id } }`))
+	assert.JSONEq(t, `{"data":{"datasets":[{"id":"d_old"}],"node":{"id":"d_old"}}}`, query(ctx, `query @snapshot(asOf: "2024-04-01T09:00:00+09:00") { datasets { id } node(id: "d_old") { id } }`))
+	assert.Contains(t, query(ctx, `query @snapshot(asOf: "2020-01-01T00:00:00Z") { datasets { id } }`), "no snapshot at 2020-01-01T00:00:00Z")
+	assert.Contains(t, query(context.Background(), `query @snapshot(asOf: "2024-04-01T00:00:00Z") { datasets { id } }`), "snapshots are not available")
+}
+
+func TestRepos_SnapshotChanges(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	storage, err := NewLocalSnapshotStorage(t.TempDir())
+	require.NoError(t, err)
+
+	var ds []Dataset
+	newRepos := func() *Repos {
+		r := NewRepos(func(ctx context.Context, project string) (*ReposUpdateResult, error) {
+			return &ReposUpdateResult{
+				Repo: NewInMemoryRepo(&InMemoryRepoContext{
+					Datasets: Datasets(lo.GroupBy(ds, DatasetTypeCategoryFromDataset)),
+				}),
+			}, nil
+		})
+		r.SetSnapshotStorage(storage)
+		r.now = func() time.Time { return now }
+		return r
+	}
+	changes := func(r *Repos) []*DatasetChange {
+		res, err := r.Repo("prj").Changes(ctx, nil)
+		require.NoError(t, err)
+		return res
+	}
+
+	r := newRepos()
+	ds = []Dataset{&PlateauDataset{ID: "1", Name: "a"}}
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	ds = append(ds, &GenericDataset{ID: "2", Name: "b"})
+	_, err = r.Update(ctx, "prj")
+	require.NoError(t, err)
+	t1 := now
+	assert.Len(t, changes(r), 1)
+
+	// the history continues after a restart and changes made while the server was down are detected
+	now = now.Add(time.Hour)
+	ds = ds[1:]
+	r2 := newRepos()
+	_, err = r2.Update(ctx, "prj")
+	require.NoError(t, err)
+	assert.Equal(t, []*DatasetChange{
+		{DatasetID: "1", Type: DatasetChangeTypeRemoved, ChangedAt: now, Project: "prj", Dataset: &PlateauDataset{ID: "1", Name: "a"}},
+		{DatasetID: "2", Type: DatasetChangeTypeAdded, ChangedAt: t1, Project: "prj", Dataset: &GenericDataset{ID: "2", Name: "b"}},
+	}, changes(r2))
+
+	// the history is not taken as a snapshot
+	keys, err := storage.List(ctx, "")
+	require.NoError(t, err)
+	assert.Contains(t, keys, changesKey("prj"))
+	repo, err := r2.RepoAt(ctx, "prj", now)
+	require.NoError(t, err)
+	assert.Equal(t, []ID{"2"}, lo.Map(lo.Must(repo.Datasets(ctx, nil)), func(d Dataset, _ int) ID { return d.GetID() }))
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/JamesLMilner/quadtree-go"
@@ -29,6 +30,11 @@ func init() {
 	qt = govpolygon.NewQuadtree(nil, 1.0/60.0)
 }
 
+// areaBounds is built on demand as only the metadata APIs use it.
+var areaBounds = sync.OnceValue(func() govpolygon.AreaBounds {
+	return govpolygon.NewAreaBounds(nil)
+})
+
 type reposHandler struct {
 	reposv3            *datacatalogv3.Repos
 	reposv2            *datacatalogv2adapter.Repos
@@ -36,12 +42,16 @@ type reposHandler struct {
 	gqlComplexityLimit int
 	cacheUpdateKey     string
 	geocodingAppID     string
+	snapshots          bool
+	remotes            []*plateauapi.RemoteRepo
+	stacTrees          stacTreeCache
 
 	qt *govpolygon.Quadtree
 }
 
 const pidParamName = "pid"
 const conditionsParamName = "conditions"
+const asOfParamName = "asOf"
 const gqlComplexityLimit = 1000
 const cmsSchemaVersion = "v3"
 const cmsSchemaVersionV2 = "v2"
@@ -62,6 +72,31 @@ func newReposHandler(conf Config, pcms *plateaucms.CMS) (*reposHandler, error) {
 		reposv3.EnableDebug(true)
 	}
 
+	if conf.SnapshotURL != "" {
+		s, err := plateauapi.OpenSnapshotStorage(context.Background(), conf.SnapshotURL)
+		if err != nil {
+			return nil, fmt.Errorf("datacatalog: failed to open snapshot storage: %w", err)
+		}
+		reposv3.SetSnapshotStorage(s)
+		reposv2.SetSnapshotStorage(s)
+		retention := time.Duration(conf.SnapshotRetention) * 24 * time.Hour
+		reposv3.SetSnapshotRetention(retention)
+		reposv2.SetSnapshotRetention(retention)
+	}
+
+	if conf.SearchDictionary != "" {
+		d, err := plateauapi.LoadSearchDictionary(conf.SearchDictionary)
+		if err != nil {
+			return nil, fmt.Errorf("datacatalog: %w", err)
+		}
+		plateauapi.SetSearchDictionary(plateauapi.DefaultSearchDictionary().Merge(d))
+	}
+
+	remotes, err := newRemoteRepos(conf)
+	if err != nil {
+		return nil, fmt.Errorf("datacatalog: %w", err)
+	}
+
 	return &reposHandler{
 		reposv3:            reposv3,
 		reposv2:            reposv2,
@@ -69,10 +104,39 @@ func newReposHandler(conf Config, pcms *plateaucms.CMS) (*reposHandler, error) {
 		gqlComplexityLimit: conf.GraphqlMaxComplexity,
 		cacheUpdateKey:     conf.CacheUpdateKey,
 		geocodingAppID:     conf.GeocodingAppID,
+		snapshots:          conf.SnapshotURL != "",
+		remotes:            remotes,
 		qt:                 qt,
 	}, nil
 }
 
+func newRemoteRepos(conf Config) ([]*plateauapi.RemoteRepo, error) {
+	tokens := map[string]string{}
+	for _, t := range conf.RemoteTokens {
+		ns, token, ok := strings.Cut(t, "=")
+		if !ok {
+			return nil, errors.New("invalid remote token: it must be <namespace>=<token>")
+		}
+		tokens[ns] = token
+	}
+
+	res := make([]*plateauapi.RemoteRepo, 0, len(conf.Remotes))
+	for _, r := range conf.Remotes {
+		ns, u, ok := strings.Cut(r, "=")
+		if !ok || ns == "" || u == "" {
+			return nil, fmt.Errorf("invalid remote: %s", r)
+		}
+
+		res = append(res, plateauapi.NewRemoteRepo(plateauapi.RemoteRepoConfig{
+			URL:       u,
+			Token:     tokens[ns],
+			Namespace: ns,
+			TTL:       time.Duration(conf.RemoteTTL) * time.Second,
+		}))
+	}
+	return res, nil
+}
+
 func (h *reposHandler) Middleware() echo.MiddlewareFunc {
 	return h.pcms.AuthMiddleware(plateaucms.AuthMiddlewareConfig{
 		Key:             pidParamName,
@@ -91,6 +155,16 @@ func (h *reposHandler) Handler(admin bool) echo.HandlerFunc {
 		srv := plateauapi.NewService(merged, plateauapi.FixedComplexityLimit(h.gqlComplexityLimit))
 
 		adminContext(c, admin, admin, admin && isAlpha(c))
+		if h.snapshots {
+			// queries with the snapshot directive use repos at the time
+			ctx := c.Request().Context()
+			pid := c.Param(pidParamName)
+			mds := plateaucms.GetAllCMSMetadataFromContext(ctx)
+			ctx = plateauapi.WithRepoAt(ctx, func(ctx context.Context, t time.Time) (plateauapi.Repo, error) {
+				return h.prepareAndGetMergedRepo(ctx, pid, mds, &t), nil
+			})
+			c.SetRequest(c.Request().WithContext(ctx))
+		}
 		srv.ServeHTTP(c.Response(), c.Request())
 		return nil
 	}
@@ -113,6 +187,58 @@ func (h *reposHandler) SimplePlateauDatasetsAPI() echo.HandlerFunc {
 	}
 }
 
+func (h *reposHandler) DCATCatalogAPI() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		merged, err := h.prepareMergedRepo(c, false)
+		if err != nil {
+			return err
+		}
+
+		ctx := c.Request().Context()
+		u := c.Scheme() + "://" + c.Request().Host + c.Request().URL.Path
+		res, err := FetchDCATCatalog(ctx, merged, u, areaBounds())
+		if err != nil {
+			return err
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, "application/ld+json; charset=utf-8")
+		return c.JSONPretty(http.StatusOK, res, "  ")
+	}
+}
+
+func (h *reposHandler) STACAPI() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		merged, err := h.prepareMergedRepo(c, false)
+		if err != nil {
+			return err
+		}
+
+		ctx := c.Request().Context()
+		build := func() (*STACTree, error) {
+			return FetchSTACTree(ctx, merged, areaBounds())
+		}
+
+		var tree *STACTree
+		if c.QueryParam(asOfParamName) != "" {
+			// snapshots are not cached as asOf can be any time
+			tree, err = build()
+		} else {
+			pid := c.Param(pidParamName)
+			tree, err = h.stacTrees.Get(pid, h.reposVersion(ctx, pid), build)
+		}
+		if err != nil {
+			return err
+		}
+
+		doc := tree.Document(c.Param("*"))
+		if doc == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "not found")
+		}
+
+		return c.JSONPretty(http.StatusOK, doc, "  ")
+	}
+}
+
 func (h *reposHandler) CityGMLFiles(admin bool) echo.HandlerFunc {
 	var geocoder GeoCoder
 	if h.geocodingAppID != "" {
@@ -268,9 +394,14 @@ func (h *reposHandler) prepareMergedRepo(c echo.Context, auth bool) (plateauapi.
 		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
+	asOf, err := h.parseAsOf(c)
+	if err != nil {
+		return nil, err
+	}
+
 	pid := c.Param(pidParamName)
 	mds := plateaucms.GetAllCMSMetadataFromContext(ctx)
-	merged := h.prepareAndGetMergedRepo(ctx, pid, mds)
+	merged := h.prepareAndGetMergedRepo(ctx, pid, mds, asOf)
 	if merged == nil {
 		return nil, echo.NewHTTPError(http.StatusNotFound, "not found")
 	}
@@ -279,7 +410,24 @@ func (h *reposHandler) prepareMergedRepo(c echo.Context, auth bool) (plateauapi.
 	return merged, nil
 }
 
-func (h *reposHandler) prepareAndGetMergedRepo(ctx context.Context, project string, metadata plateaucms.MetadataList) plateauapi.Repo {
+// parseAsOf parses the "asOf" query parameter, which selects the snapshot to be used.
+func (h *reposHandler) parseAsOf(c echo.Context) (*time.Time, error) {
+	q := c.QueryParam(asOfParamName)
+	if q == "" {
+		return nil, nil
+	}
+	if !h.snapshots {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "asOf is not available")
+	}
+
+	t, err := time.Parse(time.RFC3339, q)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "asOf must be RFC3339")
+	}
+	return &t, nil
+}
+
+func (h *reposHandler) prepareAndGetMergedRepo(ctx context.Context, project string, metadata plateaucms.MetadataList, asOf *time.Time) plateauapi.Repo {
 	var mds plateaucms.MetadataList
 	if project == "" {
 		mds = metadata.PlateauProjects()
@@ -293,11 +441,27 @@ func (h *reposHandler) prepareAndGetMergedRepo(ctx context.Context, project stri
 
 	repos := make([]plateauapi.Repo, 0, len(mds))
 	for _, s := range mds {
+		if asOf != nil {
+			if r := h.getRepoAt(ctx, s, *asOf); r != nil {
+				repos = append(repos, r)
+			}
+			continue
+		}
 		if r := h.getRepo(s); r != nil {
 			repos = append(repos, r)
 		}
 	}
 
+	// remotes have no snapshots
+	if project == "" && asOf == nil {
+		for _, r := range h.remotes {
+			repos = append(repos, r)
+			if err := r.Err(); err != nil {
+				log.Warnfc(ctx, "datacatalog: remote %s is unavailable: %v", r.Name(), err)
+			}
+		}
+	}
+
 	if len(repos) == 0 {
 		return nil
 	}
@@ -315,6 +479,35 @@ func (h *reposHandler) prepareAndGetMergedRepo(ctx context.Context, project stri
 	return merged
 }
 
+// reposVersion returns a string that changes when any repo of the project is updated.
+func (h *reposHandler) reposVersion(ctx context.Context, project string) string {
+	metadata := plateaucms.GetAllCMSMetadataFromContext(ctx)
+	var mds plateaucms.MetadataList
+	if project == "" {
+		mds = metadata.PlateauProjects()
+	} else {
+		mds = metadata.FindDataCatalogAndSub(project)
+	}
+
+	var b strings.Builder
+	for _, md := range mds {
+		var t time.Time
+		if isV2(md) {
+			t = h.reposv2.UpdatedAt(md.DataCatalogProjectAlias)
+		} else if isV3(md) {
+			t = h.reposv3.UpdatedAt(md.DataCatalogProjectAlias)
+		}
+		fmt.Fprintf(&b, "%s=%d,", md.DataCatalogProjectAlias, t.UnixNano())
+	}
+
+	if project == "" {
+		for _, r := range h.remotes {
+			fmt.Fprintf(&b, "%s=%d,", r.Name(), r.UpdatedAt().UnixNano())
+		}
+	}
+	return b.String()
+}
+
 func (h *reposHandler) getRepo(md plateaucms.Metadata) (repo plateauapi.Repo) {
 	if md.DataCatalogProjectAlias == "" {
 		return
@@ -328,6 +521,25 @@ func (h *reposHandler) getRepo(md plateaucms.Metadata) (repo plateauapi.Repo) {
 	return
 }
 
+func (h *reposHandler) getRepoAt(ctx context.Context, md plateaucms.Metadata, t time.Time) plateauapi.Repo {
+	if md.DataCatalogProjectAlias == "" {
+		return nil
+	}
+
+	var repo plateauapi.Repo
+	var err error
+	if isV2(md) {
+		repo, err = h.reposv2.RepoAt(ctx, md.DataCatalogProjectAlias, t)
+	} else if isV3(md) {
+		repo, err = h.reposv3.RepoAt(ctx, md.DataCatalogProjectAlias, t)
+	}
+	if err != nil {
+		log.Errorfc(ctx, "datacatalog: failed to get snapshot of %s: %v", md.DataCatalogProjectAlias, err)
+		return nil
+	}
+	return repo
+}
+
 func (h *reposHandler) prepareAll(ctx context.Context, metadata plateaucms.MetadataList) error {
 	errg, ctx := errgroup.WithContext(ctx)
 	for _, md := range metadata {
@@ -0,0 +1,383 @@
+package datacatalog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/plateauapi"
+	"github.com/eukarya-inc/reearth-plateauview/server/govpolygon"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+const (
+	stacVersion          = "1.0.0"
+	stacCatalogPath      = "catalog.json"
+	stacCollectionPrefix = "collections/"
+	stacCityGMLType      = "citygml"
+)
+
+type STACLink struct {
+	Rel   string `json:"rel"`
+	Href  string `json:"href"`
+	Type  string `json:"type,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+type STACCatalog struct {
+	Type        string     `json:"type"`
+	StacVersion string     `json:"stac_version"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Links       []STACLink `json:"links"`
+}
+
+type STACCollection struct {
+	Type        string     `json:"type"`
+	StacVersion string     `json:"stac_version"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Keywords    []string   `json:"keywords,omitempty"`
+	License     string     `json:"license"`
+	Extent      STACExtent `json:"extent"`
+	Links       []STACLink `json:"links"`
+}
+
+type STACExtent struct {
+	Spatial  STACSpatialExtent  `json:"spatial"`
+	Temporal STACTemporalExtent `json:"temporal"`
+}
+
+type STACSpatialExtent struct {
+	BBox [][]float64 `json:"bbox"`
+}
+
+type STACTemporalExtent struct {
+	Interval [][]*string `json:"interval"`
+}
+
+type STACItem struct {
+	Type        string                `json:"type"`
+	StacVersion string                `json:"stac_version"`
+	ID          string                `json:"id"`
+	Geometry    *STACGeometry         `json:"geometry"`
+	BBox        []float64             `json:"bbox,omitempty"`
+	Properties  map[string]any        `json:"properties"`
+	Links       []STACLink            `json:"links"`
+	Assets      map[string]*STACAsset `json:"assets"`
+	Collection  string                `json:"collection"`
+}
+
+type STACGeometry struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+type STACAsset struct {
+	Href    string   `json:"href"`
+	Type    string   `json:"type,omitempty"`
+	Title   string   `json:"title,omitempty"`
+	Roles   []string `json:"roles,omitempty"`
+	Format  string   `json:"plateau:format,omitempty"`
+	LOD     *int     `json:"plateau:lod,omitempty"`
+	Texture *bool    `json:"plateau:texture,omitempty"`
+	Layers  []string `json:"plateau:layers,omitempty"`
+}
+
+// STACTree is a STAC catalog whose children are collections of each dataset type and year.
+// Each collection has items of each city. Documents are linked with relative paths so that it can be crawled statically.
+type STACTree struct {
+	Catalog     *STACCatalog
+	Collections map[string]*STACCollection
+	Items       map[string]map[string]*STACItem
+}
+
+// Document returns the document at the path relative to the catalog:
+// "catalog.json", "collections/<collection>/collection.json" or "collections/<collection>/items/<item>.json".
+func (t *STACTree) Document(p string) any {
+	if p == "" || p == stacCatalogPath {
+		return t.Catalog
+	}
+
+	rest, ok := strings.CutPrefix(p, stacCollectionPrefix)
+	if !ok {
+		return nil
+	}
+	cid, rest, ok := strings.Cut(rest, "/")
+	if !ok {
+		return nil
+	}
+	if rest == "collection.json" {
+		if c := t.Collections[cid]; c != nil {
+			return c
+		}
+		return nil
+	}
+	if iid, ok := strings.CutPrefix(rest, "items/"); ok {
+		if i := t.Items[cid][strings.TrimSuffix(iid, ".json")]; i != nil {
+			return i
+		}
+	}
+	return nil
+}
+
+// FetchSTACTree builds STAC documents from datasets and CityGML datasets of the repo.
+func FetchSTACTree(ctx context.Context, r plateauapi.Repo, bounds govpolygon.AreaBounds) (*STACTree, error) {
+	ds, err := r.Datasets(ctx, &plateauapi.DatasetsInput{
+		IncludeTypes: []string{"plateau"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch datasets: %w", err)
+	}
+
+	areas, err := fetchAreaMap(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := r.DatasetTypes(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dataset types: %w", err)
+	}
+	typeNames := lo.SliceToMap(types, func(t plateauapi.DatasetType) (string, string) {
+		return t.GetCode(), t.GetName()
+	})
+
+	b := &stacBuilder{
+		tree: &STACTree{
+			Catalog: &STACCatalog{
+				Type:        "Catalog",
+				StacVersion: stacVersion,
+				ID:          "plateau",
+				Title:       "PLATEAU",
+				Description: "PLATEAU の3D都市モデルのデータセット",
+				Links:       []STACLink{{Rel: "root", Href: "./" + stacCatalogPath, Type: "application/json"}, {Rel: "self", Href: "./" + stacCatalogPath, Type: "application/json"}},
+			},
+			Collections: map[string]*STACCollection{},
+			Items:       map[string]map[string]*STACItem{},
+		},
+		areas:  areas,
+		bounds: bounds,
+	}
+
+	for _, dr := range ds {
+		d, _ := dr.(*plateauapi.PlateauDataset)
+		if d == nil {
+			continue
+		}
+
+		code := d.CityCode
+		if code == nil {
+			code = d.PrefectureCode
+		}
+		if code == nil {
+			continue
+		}
+
+		item := b.item(d.TypeCode, typeNames[d.TypeCode], d.Year, *code)
+		for _, di := range d.Items {
+			if di == nil || di.URL == "" {
+				continue
+			}
+			item.Assets[string(di.ID)] = &STACAsset{
+				Href:    di.URL,
+				Type:    datasetFormatMediaType(di.Format),
+				Title:   strings.TrimSpace(d.Name + " " + di.Name),
+				Roles:   []string{"data"},
+				Format:  string(di.Format),
+				LOD:     di.Lod,
+				Texture: simpleTexture(di.Texture),
+				Layers:  di.Layers,
+			}
+		}
+	}
+
+	citygml, err := fetchCityGMLDatasets(ctx, r, areas)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range citygml {
+		item := b.item(stacCityGMLType, "CityGML", c.Year, c.CityCode)
+		item.Properties["plateau:feature_types"] = c.FeatureTypes
+		if spec, err := r.Node(ctx, c.PlateauSpecMinorID); err == nil {
+			if spec, _ := spec.(*plateauapi.PlateauSpecMinor); spec != nil {
+				item.Properties["plateau:spec"] = spec.Version
+			}
+		}
+
+		item.Assets["citygml"] = &STACAsset{
+			Href:   c.URL,
+			Type:   "application/zip",
+			Title:  "CityGML",
+			Roles:  []string{"data"},
+			Format: "CITYGML",
+		}
+		for i, u := range c.MetadataZipUrls {
+			item.Assets[fmt.Sprintf("metadata%d", i)] = &STACAsset{
+				Href:  u,
+				Type:  "application/zip",
+				Title: "メタデータ",
+				Roles: []string{"metadata"},
+			}
+		}
+	}
+
+	b.finish()
+	return b.tree, nil
+}
+
+// stacTreeCache caches STAC trees of each project. A tree is rebuilt when the version of the project, which changes when any of its repos is updated, changes.
+type stacTreeCache struct {
+	locks util.LockMap[string]
+	trees sync.Map
+}
+
+type stacTreeCacheEntry struct {
+	version string
+	tree    *STACTree
+}
+
+func (c *stacTreeCache) Get(project, version string, build func() (*STACTree, error)) (*STACTree, error) {
+	c.locks.Lock(project)
+	defer c.locks.Unlock(project)
+
+	if e, ok := c.trees.Load(project); ok && e.(stacTreeCacheEntry).version == version {
+		return e.(stacTreeCacheEntry).tree, nil
+	}
+
+	tree, err := build()
+	if err != nil {
+		return nil, err
+	}
+
+	c.trees.Store(project, stacTreeCacheEntry{version: version, tree: tree})
+	return tree, nil
+}
+
+type stacBuilder struct {
+	tree   *STACTree
+	areas  map[plateauapi.AreaCode]plateauapi.Area
+	bounds govpolygon.AreaBounds
+}
+
+func stacCollectionID(typeCode string, year int) string {
+	return fmt.Sprintf("%s-%d", typeCode, year)
+}
+
+// item returns the item of the area in the collection of the type and the year, creating them if they do not exist.
+func (b *stacBuilder) item(typeCode, typeName string, year int, code plateauapi.AreaCode) *STACItem {
+	cid := stacCollectionID(typeCode, year)
+	if b.tree.Collections[cid] == nil {
+		start, end := fiscalYearRange(year)
+		title := fmt.Sprintf("%s (%d年度)", lo.Ternary(typeName != "", typeName, typeCode), year)
+		b.tree.Collections[cid] = &STACCollection{
+			Type:        "Collection",
+			StacVersion: stacVersion,
+			ID:          cid,
+			Title:       title,
+			Description: fmt.Sprintf("PLATEAU %s", title),
+			Keywords:    []string{"PLATEAU", typeCode},
+			License:     "various",
+			Extent: STACExtent{
+				Temporal: STACTemporalExtent{
+					Interval: [][]*string{{lo.ToPtr(start.Format(time.RFC3339)), lo.ToPtr(end.Format(time.RFC3339))}},
+				},
+			},
+			Links: []STACLink{
+				{Rel: "root", Href: "../../" + stacCatalogPath, Type: "application/json"},
+				{Rel: "parent", Href: "../../" + stacCatalogPath, Type: "application/json"},
+				{Rel: "self", Href: "./collection.json", Type: "application/json"},
+			},
+		}
+		b.tree.Items[cid] = map[string]*STACItem{}
+	}
+
+	iid := code.String()
+	if i := b.tree.Items[cid][iid]; i != nil {
+		return i
+	}
+
+	start, end := fiscalYearRange(year)
+	i := &STACItem{
+		Type:        "Feature",
+		StacVersion: stacVersion,
+		ID:          iid,
+		Properties: map[string]any{
+			"datetime":       nil,
+			"start_datetime": start.Format(time.RFC3339),
+			"end_datetime":   end.Format(time.RFC3339),
+			"plateau:type":   typeCode,
+			"plateau:year":   year,
+			"plateau:area":   iid,
+		},
+		Links: []STACLink{
+			{Rel: "root", Href: "../../../" + stacCatalogPath, Type: "application/json"},
+			{Rel: "parent", Href: "../collection.json", Type: "application/json"},
+			{Rel: "collection", Href: "../collection.json", Type: "application/json"},
+			{Rel: "self", Href: "./" + iid + ".json", Type: "application/geo+json"},
+		},
+		Assets:     map[string]*STACAsset{},
+		Collection: cid,
+	}
+	if a := b.areas[code]; a != nil {
+		i.Properties["title"] = a.GetName()
+	}
+	if bb, ok := b.bounds.Get(iid); ok {
+		i.BBox = bb[:]
+		i.Geometry = &STACGeometry{
+			Type: "Polygon",
+			Coordinates: [][][]float64{{
+				{bb[0], bb[1]}, {bb[2], bb[1]}, {bb[2], bb[3]}, {bb[0], bb[3]}, {bb[0], bb[1]},
+			}},
+		}
+	}
+
+	b.tree.Items[cid][iid] = i
+	return i
+}
+
+// finish links children in the order of IDs and computes spatial extents of collections.
+func (b *stacBuilder) finish() {
+	cids := lo.Keys(b.tree.Collections)
+	slices.Sort(cids)
+
+	for _, cid := range cids {
+		c := b.tree.Collections[cid]
+		b.tree.Catalog.Links = append(b.tree.Catalog.Links, STACLink{
+			Rel:   "child",
+			Href:  "./" + stacCollectionPrefix + cid + "/collection.json",
+			Type:  "application/json",
+			Title: c.Title,
+		})
+
+		items := b.tree.Items[cid]
+		iids := lo.Keys(items)
+		slices.Sort(iids)
+
+		var extent []float64
+		for _, iid := range iids {
+			i := items[iid]
+			c.Links = append(c.Links, STACLink{Rel: "item", Href: "./items/" + iid + ".json", Type: "application/geo+json"})
+
+			if i.BBox == nil {
+				continue
+			}
+			if extent == nil {
+				extent = slices.Clone(i.BBox)
+				continue
+			}
+			extent = []float64{min(extent[0], i.BBox[0]), min(extent[1], i.BBox[1]), max(extent[2], i.BBox[2]), max(extent[3], i.BBox[3])}
+		}
+		if extent == nil {
+			extent = []float64{-180, -90, 180, 90}
+		}
+		c.Extent.Spatial.BBox = [][]float64{extent}
+	}
+}
@@ -0,0 +1,121 @@
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/datacatalogv2/datacatalogv2adapter"
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/datacatalogv3"
+	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/plateauapi"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchSTACTree(t *testing.T) {
+	tree, err := FetchSTACTree(context.Background(), testRepo(), testAreaBounds)
+	require.NoError(t, err)
+
+	assert.Equal(t, []STACLink{
+		{Rel: "root", Href: "./catalog.json", Type: "application/json"},
+		{Rel: "self", Href: "./catalog.json", Type: "application/json"},
+		{Rel: "child", Href: "./collections/bldg-2023/collection.json", Type: "application/json", Title: "建築物モデル (2023年度)"},
+		{Rel: "child", Href: "./collections/citygml-2023/collection.json", Type: "application/json", Title: "CityGML (2023年度)"},
+	}, tree.Catalog.Links)
+
+	c := tree.Document("collections/bldg-2023/collection.json").(*STACCollection)
+	assert.Equal(t, [][]float64{{139.7, 35.6, 139.8, 35.7}}, c.Extent.Spatial.BBox)
+	assert.Equal(t, STACLink{Rel: "item", Href: "./items/13101.json", Type: "application/geo+json"}, c.Links[len(c.Links)-1])
+
+	i := tree.Document("collections/bldg-2023/items/13101.json").(*STACItem)
+	assert.Equal(t, "千代田区", i.Properties["title"])
+	assert.Equal(t, []float64{139.7, 35.6, 139.8, 35.7}, i.BBox)
+	assert.Equal(t, &STACAsset{
+		Href:   "https://example.com/tileset.json",
+		Type:   "application/json",
+		Title:  "建築物モデル（千代田区） LOD1",
+		Roles:  []string{"data"},
+		Format: "CESIUM3DTILES",
+		LOD:    lo.ToPtr(1),
+	}, i.Assets["di_1"])
+
+	i = tree.Document("collections/citygml-2023/items/13101.json").(*STACItem)
+	assert.Equal(t, "3.2", i.Properties["plateau:spec"])
+	assert.Equal(t, []string{"bldg"}, i.Properties["plateau:feature_types"])
+	assert.Equal(t, "https://example.com/citygml.zip", i.Assets["citygml"].Href)
+
+	assert.Nil(t, tree.Document("collections/bldg-2023/items/13102.json"))
+	assert.Nil(t, tree.Document("unknown"))
+}
+
+func TestReposHandler_STACAPI(t *testing.T) {
+	svc := plateauapi.NewService(testRepo())
+	ts := httptest.NewServer(svc)
+	defer ts.Close()
+
+	h := &reposHandler{
+		reposv3: datacatalogv3.NewRepos(nil),
+		reposv2: datacatalogv2adapter.NewRepos(),
+		remotes: []*plateauapi.RemoteRepo{plateauapi.NewRemoteRepo(plateauapi.RemoteRepoConfig{URL: ts.URL})},
+	}
+	e := echo.New()
+	e.GET("/stac/*", h.STACAPI())
+
+	get := func(p string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, p, nil))
+		return rec
+	}
+
+	rec := get("/stac/catalog.json")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var catalog STACCatalog
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &catalog))
+	assert.Equal(t, "plateau", catalog.ID)
+	assert.Len(t, catalog.Links, 4)
+
+	rec = get("/stac/collections/bldg-2023/items/13101.json")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var item STACItem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &item))
+	assert.Equal(t, "https://example.com/tileset.json", item.Assets["di_1"].Href)
+
+	rec = get("/stac/collections/bldg-2023/items/13102.json")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// the tree is cached until the remote is updated
+	e1, ok := h.stacTrees.trees.Load("")
+	require.True(t, ok)
+	_ = get("/stac/catalog.json")
+	e2, _ := h.stacTrees.trees.Load("")
+	assert.Same(t, e1.(stacTreeCacheEntry).tree, e2.(stacTreeCacheEntry).tree)
+}
+
+func TestSTACTreeCache(t *testing.T) {
+	c := &stacTreeCache{}
+	builds := 0
+	build := func() (*STACTree, error) {
+		builds++
+		return &STACTree{}, nil
+	}
+
+	t1, err := c.Get("p", "v1", build)
+	require.NoError(t, err)
+	t2, err := c.Get("p", "v1", build)
+	require.NoError(t, err)
+	assert.Same(t, t1, t2)
+	assert.Equal(t, 1, builds)
+
+	t3, err := c.Get("p", "v2", build)
+	require.NoError(t, err)
+	assert.NotSame(t, t1, t3)
+	assert.Equal(t, 2, builds)
+
+	_, err = c.Get("q", "v2", build)
+	require.NoError(t, err)
+	assert.Equal(t, 3, builds)
+}
@@ -2,6 +2,7 @@ package datacatalog
 
 import (
 	"context"
+	"net/url"
 	"path"
 
 	"github.com/eukarya-inc/reearth-plateauview/server/datacatalog/plateauapi"
@@ -45,6 +46,20 @@ func echov3(conf Config, g *echo.Group, pcms *plateaucms.CMS) (func(ctx context.
 	// Simple PLATEAU dataset API
 	plateauapig.GET("/plateau-datasets", h.SimplePlateauDatasetsAPI())
 
+	// DCAT API
+	plateauapig.GET("/catalog.jsonld", h.DCATCatalogAPI())
+	plateauapig.GET("/:pid/catalog.jsonld", h.DCATCatalogAPI())
+
+	// STAC API
+	plateauapig.GET("/stac/*", h.STACAPI())
+	plateauapig.GET("/:pid/stac/*", h.STACAPI())
+
+	// change feed API
+	plateauapig.GET("/changes.atom", h.ChangesFeedHandler(changesFeedFormatAtom))
+	plateauapig.GET("/:pid/changes.atom", h.ChangesFeedHandler(changesFeedFormatAtom))
+	plateauapig.GET("/changes.rss", h.ChangesFeedHandler(changesFeedFormatRSS))
+	plateauapig.GET("/:pid/changes.rss", h.ChangesFeedHandler(changesFeedFormatRSS))
+
 	// warning API
 	plateauapig.GET("/:pid/warnings", h.WarningHandler)
 
@@ -71,8 +86,15 @@ func gqlPlaygroundHandler(endpoint string, admin bool) echo.HandlerFunc {
 		p = append(p, "graphql")
 
 		endpoint := path.Join(p...)
+		q := url.Values{}
 		if isAlpha(c) {
-			endpoint += "?alpha=true"
+			q.Set("alpha", "true")
+		}
+		if asOf := c.QueryParam(asOfParamName); asOf != "" {
+			q.Set(asOfParamName, asOf)
+		}
+		if len(q) > 0 {
+			endpoint += "?" + q.Encode()
 		}
 
 		h := plateauapi.PlaygroundHandler(
@@ -179,6 +179,37 @@ func (po Polygon2) IsIntersect(b Bounds2) bool {
 	return false
 }
 
+// IsIntersectPolygon reports whether the polygons share any point including their boundaries.
+func (po Polygon2) IsIntersectPolygon(po2 Polygon2) bool {
+	if len(po) == 0 || len(po2) == 0 {
+		return false
+	}
+	for i := range po {
+		e := po.Edge(i)
+		for j := range po2 {
+			if e.isIntersect(po2.Edge(j)) {
+				return true
+			}
+		}
+	}
+	// one contains the other
+	return po.In(po2[0]) || po2.In(po[0])
+}
+
+func (po Polygon2) Bounds() Bounds2 {
+	if len(po) == 0 {
+		return Bounds2{}
+	}
+	b := Bounds2{Min: po[0], Max: po[0]}
+	for _, p := range po {
+		b.Min.X = min(b.Min.X, p.X)
+		b.Min.Y = min(b.Min.Y, p.Y)
+		b.Max.X = max(b.Max.X, p.X)
+		b.Max.Y = max(b.Max.Y, p.Y)
+	}
+	return b
+}
+
 type LineSegment2 struct {
 	A, B Point2
 }
@@ -58,3 +58,50 @@ func TestBounds2_Intersects(t *testing.T) {
 		})
 	}
 }
+
+func TestPolygon2_IsIntersectPolygon(t *testing.T) {
+	square := Bounds2{Min: Point2{0, 0}, Max: Point2{10, 10}}.Polygon()
+	tests := []struct {
+		name     string
+		b        Polygon2
+		expected bool
+	}{
+		{
+			name:     "辺が交差している",
+			b:        Polygon2{{5, 5}, {15, 5}, {15, 15}},
+			expected: true,
+		},
+		{
+			name:     "内側に含まれている",
+			b:        Polygon2{{2, 2}, {4, 2}, {3, 4}},
+			expected: true,
+		},
+		{
+			name:     "外側から含んでいる",
+			b:        Bounds2{Min: Point2{-5, -5}, Max: Point2{15, 15}}.Polygon(),
+			expected: true,
+		},
+		{
+			name:     "辺で接している",
+			b:        Polygon2{{10, 0}, {20, 0}, {20, 10}},
+			expected: true,
+		},
+		{
+			name:     "範囲は重なるが離れている",
+			b:        Polygon2{{9, 12}, {12, 9}, {12, 12}},
+			expected: false,
+		},
+		{
+			name:     "空",
+			b:        Polygon2{},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, square.IsIntersectPolygon(tt.b))
+			assert.Equal(t, tt.expected, tt.b.IsIntersectPolygon(square))
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package jisx0410
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+)
+
+// MaxLevel is the finest level supported by Encode: 1/8 regional mesh (about 125m).
+const MaxLevel = 6
+
+// maxCoverCount limits the number of mesh codes returned by Cover and CoverPolygon.
+const maxCoverCount = 100_000
+
+// cell sizes of each level in 1/8 seconds
+var cellSizes = [MaxLevel + 1]struct{ w, h int64 }{
+	{},
+	{lv1wi, lv1hi},
+	{lv2wi, lv2hi},
+	{lv3wi, lv3hi},
+	{lv3whi, lv3hhi},
+	{lv3wqi, lv3hqi},
+	{lv3wei, lv3hei},
+}
+
+// codeLengths are the lengths of mesh codes of each level.
+var codeLengths = [MaxLevel + 1]int{0, 4, 6, 8, 9, 10, 11}
+
+// cell is a mesh at a level identified by indexes counted from lng=100, lat=0.
+type cell struct {
+	level  int
+	ix, iy int64
+}
+
+func validateLevel(level int) error {
+	if level < 1 || level > MaxLevel {
+		return fmt.Errorf("invalid level: %d", level)
+	}
+	return nil
+}
+
+// Encode returns the mesh code of the given level that contains the point.
+func Encode(lng, lat float64, level int) (string, error) {
+	if err := validateLevel(level); err != nil {
+		return "", err
+	}
+	s := cellSizes[level]
+	return cell{
+		level: level,
+		ix:    int64(math.Floor(toUnits(lng-100) / float64(s.w))),
+		iy:    int64(math.Floor(toUnits(lat) / float64(s.h))),
+	}.code()
+}
+
+// toUnits converts degrees to 1/8 seconds, snapping values that are off from grid lines only by rounding errors.
+func toUnits(d float64) float64 {
+	u := d * degree
+	if r := math.Round(u); math.Abs(u-r) < 1e-6 {
+		return r
+	}
+	return u
+}
+
+func parseCell(code string) (cell, error) {
+	m, err := Parse(code)
+	if err != nil {
+		return cell{}, err
+	}
+	if m.Level == 0 {
+		return cell{}, fmt.Errorf("unsupported mesh code: %s", code)
+	}
+	s := cellSizes[m.Level]
+	return cell{
+		level: m.Level,
+		ix:    int64(math.Round((m.Bounds.Min.X-100)*degree)) / s.w,
+		iy:    int64(math.Round(m.Bounds.Min.Y*degree)) / s.h,
+	}, nil
+}
+
+func (c cell) code() (string, error) {
+	s := cellSizes[c.level]
+	lng := c.ix * s.w
+	lat := c.iy * s.h
+	if lng < 0 || lat < 0 || lng >= 100*lv1wi || lat >= 100*lv1hi {
+		return "", fmt.Errorf("out of range")
+	}
+
+	b := make([]byte, 0, codeLengths[c.level])
+	b = append(b, byte('0'+lat/lv1hi/10), byte('0'+lat/lv1hi%10), byte('0'+lng/lv1wi/10), byte('0'+lng/lv1wi%10))
+	lat %= lv1hi
+	lng %= lv1wi
+	if c.level >= 2 {
+		b = append(b, byte('0'+lat/lv2hi), byte('0'+lng/lv2wi))
+		lat %= lv2hi
+		lng %= lv2wi
+	}
+	if c.level >= 3 {
+		b = append(b, byte('0'+lat/lv3hi), byte('0'+lng/lv3wi))
+		lat %= lv3hi
+		lng %= lv3wi
+	}
+	// the 1/2, 1/4 and 1/8 meshes are numbered 1: SW, 2: SE, 3: NW, 4: NE
+	for _, d := range [][2]int64{{lv3whi, lv3hhi}, {lv3wqi, lv3hqi}, {lv3wei, lv3hei}}[:max(0, c.level-3)] {
+		b = append(b, byte('1'+lng/d[0]+2*(lat/d[1])))
+		lat %= d[1]
+		lng %= d[0]
+	}
+	return string(b), nil
+}
+
+func (c cell) bounds() geo.Bounds2 {
+	s := cellSizes[c.level]
+	return geo.Bounds2{
+		Min: geo.Point2{X: 100 + float64(c.ix*s.w)/degree, Y: float64(c.iy*s.h) / degree},
+		Max: geo.Point2{X: 100 + float64((c.ix+1)*s.w)/degree, Y: float64((c.iy+1)*s.h) / degree},
+	}
+}
+
+// Level returns the level of the mesh code: 1 to 6, or 0 for 2x and 5x meshes.
+func Level(code string) (int, error) {
+	m, err := Parse(code)
+	if err != nil {
+		return 0, err
+	}
+	return m.Level, nil
+}
+
+// Neighbor returns the mesh code that is dx meshes east and dy meshes north of the code at the same level.
+func Neighbor(code string, dx, dy int) (string, error) {
+	c, err := parseCell(code)
+	if err != nil {
+		return "", err
+	}
+	c.ix += int64(dx)
+	c.iy += int64(dy)
+	return c.code()
+}
+
+// Neighbors returns up to eight mesh codes around the code, in order from the south west to the north east.
+// Meshes outside the range of mesh codes are omitted.
+func Neighbors(code string) ([]string, error) {
+	c, err := parseCell(code)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, 8)
+	for dy := int64(-1); dy <= 1; dy++ {
+		for dx := int64(-1); dx <= 1; dx++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			if n, err := (cell{level: c.level, ix: c.ix + dx, iy: c.iy + dy}).code(); err == nil {
+				res = append(res, n)
+			}
+		}
+	}
+	return res, nil
+}
+
+// Parent returns the mesh code one level coarser than the code.
+func Parent(code string) (string, error) {
+	level, err := Level(code)
+	if err != nil {
+		return "", err
+	}
+	if level <= 1 {
+		return "", fmt.Errorf("no parent: %s", code)
+	}
+	return code[:codeLengths[level-1]], nil
+}
+
+// Children returns the mesh codes one level finer than the code.
+func Children(code string) ([]string, error) {
+	level, err := Level(code)
+	if err != nil {
+		return nil, err
+	}
+	if level == 0 || level >= MaxLevel {
+		return nil, fmt.Errorf("no children: %s", code)
+	}
+
+	var res []string
+	switch level {
+	case 1:
+		res = make([]string, 0, 64)
+		for q := 0; q < 8; q++ {
+			for v := 0; v < 8; v++ {
+				res = append(res, fmt.Sprintf("%s%d%d", code, q, v))
+			}
+		}
+	case 2:
+		res = make([]string, 0, 100)
+		for r := 0; r < 10; r++ {
+			for w := 0; w < 10; w++ {
+				res = append(res, fmt.Sprintf("%s%d%d", code, r, w))
+			}
+		}
+	default:
+		res = make([]string, 0, 4)
+		for i := 1; i <= 4; i++ {
+			res = append(res, fmt.Sprintf("%s%d", code, i))
+		}
+	}
+	return res, nil
+}
+
+// Cover returns the mesh codes of the level that cover the bounds.
+// Meshes that only touch the bounds at their edges are not included.
+func Cover(b geo.Bounds2, level int) ([]string, error) {
+	return cover(b, level, func(geo.Bounds2) bool { return true })
+}
+
+// CoverPolygon returns the mesh codes of the level that intersect the polygon.
+func CoverPolygon(po geo.Polygon2, level int) ([]string, error) {
+	if len(po) < 3 {
+		return nil, fmt.Errorf("too few points")
+	}
+
+	b := geo.Bounds2{Min: po[0], Max: po[0]}
+	for _, p := range po {
+		b.Min.X = min(b.Min.X, p.X)
+		b.Min.Y = min(b.Min.Y, p.Y)
+		b.Max.X = max(b.Max.X, p.X)
+		b.Max.Y = max(b.Max.Y, p.Y)
+	}
+
+	// shrink meshes slightly so that meshes only touching the polygon are excluded
+	const eps = 1e-9
+	return cover(b, level, func(mb geo.Bounds2) bool {
+		mb.Min.X += eps
+		mb.Min.Y += eps
+		mb.Max.X -= eps
+		mb.Max.Y -= eps
+		return po.IsIntersect(mb)
+	})
+}
+
+func cover(b geo.Bounds2, level int, filter func(geo.Bounds2) bool) ([]string, error) {
+	if err := validateLevel(level); err != nil {
+		return nil, err
+	}
+	if b.Min.X > b.Max.X || b.Min.Y > b.Max.Y {
+		return nil, fmt.Errorf("invalid bounds")
+	}
+
+	s := cellSizes[level]
+	ixMin := int64(math.Floor(toUnits(b.Min.X-100) / float64(s.w)))
+	iyMin := int64(math.Floor(toUnits(b.Min.Y) / float64(s.h)))
+	ixMax := max(ixMin, int64(math.Ceil(toUnits(b.Max.X-100)/float64(s.w)))-1)
+	iyMax := max(iyMin, int64(math.Ceil(toUnits(b.Max.Y)/float64(s.h)))-1)
+
+	if (ixMax-ixMin+1)*(iyMax-iyMin+1) > maxCoverCount {
+		return nil, fmt.Errorf("too many meshes: level %d is too fine for the area", level)
+	}
+
+	var res []string
+	for iy := iyMin; iy <= iyMax; iy++ {
+		for ix := ixMin; ix <= ixMax; ix++ {
+			c := cell{level: level, ix: ix, iy: iy}
+			if !filter(c.bounds()) {
+				continue
+			}
+			code, err := c.code()
+			if err != nil {
+				continue
+			}
+			res = append(res, code)
+		}
+	}
+
+	sort.Strings(res)
+	return res, nil
+}
@@ -0,0 +1,146 @@
+package jisx0410
+
+import (
+	"testing"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	// Tokyo Station
+	lng, lat := 139.7671, 35.6812
+	expected := []string{"5339", "533946", "53394611", "533946113", "5339461132", "53394611323"}
+
+	for i, e := range expected {
+		code, err := Encode(lng, lat, i+1)
+		require.NoError(t, err)
+		assert.Equal(t, e, code, "level %d", i+1)
+
+		m, err := Parse(code)
+		require.NoError(t, err)
+		assert.Equal(t, i+1, m.Level)
+		assert.True(t, m.Bounds.In(geo.Point2{X: lng, Y: lat}), "level %d", i+1)
+	}
+
+	_, err := Encode(lng, lat, 0)
+	assert.Error(t, err)
+	_, err = Encode(lng, lat, 7)
+	assert.Error(t, err)
+	_, err = Encode(99, lat, 1)
+	assert.Error(t, err)
+}
+
+func TestNeighbors(t *testing.T) {
+	n, err := Neighbor("53394611", 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "53394612", n)
+
+	n, err = Neighbor("53394619", 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "53394710", n)
+
+	n, err = Neighbor("5339461", 0, 0)
+	assert.Error(t, err)
+	assert.Empty(t, n)
+
+	ns, err := Neighbors("533946111")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"533946004", "533946013", "533946014", "533946102", "533946112", "533946104", "533946113", "533946114"}, ns)
+
+	ns, err = Neighbors("0000")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0001", "0100", "0101"}, ns)
+}
+
+func TestParentChildren(t *testing.T) {
+	p, err := Parent("53394611323")
+	require.NoError(t, err)
+	assert.Equal(t, "5339461132", p)
+
+	p, err = Parent("53394611")
+	require.NoError(t, err)
+	assert.Equal(t, "533946", p)
+
+	_, err = Parent("5339")
+	assert.Error(t, err)
+
+	c, err := Children("5339")
+	require.NoError(t, err)
+	assert.Len(t, c, 64)
+	assert.Equal(t, "533900", c[0])
+	assert.Equal(t, "533977", c[63])
+
+	c, err = Children("533946")
+	require.NoError(t, err)
+	assert.Len(t, c, 100)
+	assert.Equal(t, "53394600", c[0])
+	assert.Equal(t, "53394699", c[99])
+
+	c, err = Children("53394611")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"533946111", "533946112", "533946113", "533946114"}, c)
+
+	_, err = Children("53394611323")
+	assert.Error(t, err)
+
+	for _, code := range []string{"5339", "533946", "53394611", "533946113", "5339461132"} {
+		children, err := Children(code)
+		require.NoError(t, err)
+		for _, c := range children {
+			p, err := Parent(c)
+			require.NoError(t, err)
+			assert.Equal(t, code, p)
+		}
+	}
+}
+
+func TestCover(t *testing.T) {
+	m, err := Parse("53394611")
+	require.NoError(t, err)
+
+	codes, err := Cover(m.Bounds, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"53394611"}, codes)
+
+	codes, err = Cover(m.Bounds, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"533946111", "533946112", "533946113", "533946114"}, codes)
+
+	codes, err = Cover(geo.Bounds2{
+		Min: geo.Point2{X: 139.76, Y: 35.68},
+		Max: geo.Point2{X: 139.78, Y: 35.69},
+	}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"53394610", "53394611", "53394612", "53394620", "53394621", "53394622"}, codes)
+
+	_, err = Cover(geo.Bounds2{
+		Min: geo.Point2{X: 130, Y: 30},
+		Max: geo.Point2{X: 140, Y: 40},
+	}, 6)
+	assert.Error(t, err)
+}
+
+func TestCoverPolygon(t *testing.T) {
+	m, err := Parse("5339")
+	require.NoError(t, err)
+	b := m.Bounds
+
+	// the lower-left triangle of the mesh
+	codes, err := CoverPolygon(geo.Polygon2{
+		{X: b.Min.X, Y: b.Min.Y},
+		{X: b.Max.X, Y: b.Min.Y},
+		{X: b.Min.X, Y: b.Max.Y},
+	}, 2)
+	require.NoError(t, err)
+	assert.Len(t, codes, 36)
+	assert.Contains(t, codes, "533900")
+	assert.Contains(t, codes, "533907")
+	assert.Contains(t, codes, "533970")
+	assert.NotContains(t, codes, "533977")
+	assert.NotContains(t, codes, "533917")
+
+	_, err = CoverPolygon(geo.Polygon2{{X: 139, Y: 35}, {X: 140, Y: 36}}, 2)
+	assert.Error(t, err)
+}
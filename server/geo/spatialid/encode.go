@@ -0,0 +1,179 @@
+package spatialid
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+)
+
+// MaxZoom is the maximum zoom level supported by Encode, where the height of a voxel is 1m.
+const MaxZoom = 25
+
+// maxCoverCount limits the number of voxels returned by Cover and CoverLOD1Solid.
+const maxCoverCount = 100_000
+
+// Encode returns the voxel at the zoom level that contains the point.
+// Latitudes beyond the range of Web Mercator are clamped to the edge voxels.
+func Encode(lng, lat, height float64, z int) (Voxel, error) {
+	if z < 0 || z > MaxZoom {
+		return Voxel{}, fmt.Errorf("invalid zoom: %d", z)
+	}
+	zz := zoom(z)
+	n := int(1) << z
+	return Voxel{
+		Z: z,
+		F: int(math.Floor(zz.fv(height))),
+		X: clamp(int(math.Floor(zz.xv(lng))), 0, n-1),
+		Y: clamp(int(math.Floor(zz.yv(lat))), 0, n-1),
+	}, nil
+}
+
+// String returns the voxel in the "z/f/x/y" format.
+func (t Voxel) String() string {
+	return fmt.Sprintf("%d/%d/%d/%d", t.Z, t.F, t.X, t.Y)
+}
+
+// Parent returns the voxel one zoom level coarser that contains the voxel.
+func (t Voxel) Parent() (Voxel, error) {
+	if t.Z <= 0 {
+		return Voxel{}, fmt.Errorf("no parent: %s", t)
+	}
+	return Voxel{Z: t.Z - 1, F: t.F >> 1, X: t.X >> 1, Y: t.Y >> 1}, nil
+}
+
+// Children returns the eight voxels one zoom level finer that the voxel consists of.
+func (t Voxel) Children() []Voxel {
+	res := make([]Voxel, 0, 8)
+	for i := 0; i < 8; i++ {
+		res = append(res, Voxel{
+			Z: t.Z + 1,
+			F: t.F<<1 | i>>2&1,
+			X: t.X<<1 | i&1,
+			Y: t.Y<<1 | i>>1&1,
+		})
+	}
+	return res
+}
+
+// Zoom converts the voxel to the zoom level.
+// It returns the ancestor when z is coarser than the voxel, and all of the descendants when z is finer.
+func (t Voxel) Zoom(z int) ([]Voxel, error) {
+	if z < 0 || z > MaxZoom {
+		return nil, fmt.Errorf("invalid zoom: %d", z)
+	}
+	if z <= t.Z {
+		d := t.Z - z
+		return []Voxel{{Z: z, F: t.F >> d, X: t.X >> d, Y: t.Y >> d}}, nil
+	}
+
+	d := z - t.Z
+	// the count is computed as a float since 8^d overflows int for large zoom differences
+	if math.Pow(8, float64(d)) > maxCoverCount {
+		return nil, fmt.Errorf("too many voxels: zoom %d is too fine", z)
+	}
+	n := 1 << d
+	res := make([]Voxel, 0, n*n*n)
+	for f := 0; f < n; f++ {
+		for y := 0; y < n; y++ {
+			for x := 0; x < n; x++ {
+				res = append(res, Voxel{Z: z, F: t.F<<d | f, X: t.X<<d | x, Y: t.Y<<d | y})
+			}
+		}
+	}
+	return res, nil
+}
+
+// Cover returns the voxels at the zoom level that intersect the bounds.
+// Voxels that only touch the bounds at their faces are not included.
+func Cover(b geo.Bounds3, z int) ([]Voxel, error) {
+	return cover(b, z, func(geo.Bounds3) bool { return true })
+}
+
+// CoverLOD1Solid returns the voxels at the zoom level that intersect the LOD1 solid.
+func CoverLOD1Solid(so geo.LOD1Solid, z int) ([]Voxel, error) {
+	// shrink voxels slightly so that voxels only touching the solid are excluded
+	const eps = 1e-9
+	return cover(so.Bounds, z, func(b geo.Bounds3) bool {
+		b.Min.X += eps
+		b.Min.Y += eps
+		b.Min.Z += eps
+		b.Max.X -= eps
+		b.Max.Y -= eps
+		b.Max.Z -= eps
+		return so.IsIntersect(b)
+	})
+}
+
+func cover(b geo.Bounds3, z int, filter func(geo.Bounds3) bool) ([]Voxel, error) {
+	if z < 0 || z > MaxZoom {
+		return nil, fmt.Errorf("invalid zoom: %d", z)
+	}
+	if b.Min.X > b.Max.X || b.Min.Y > b.Max.Y || b.Min.Z > b.Max.Z {
+		return nil, fmt.Errorf("invalid bounds")
+	}
+
+	zz := zoom(z)
+	n := int(1) << z
+	xMin, xMax := indexRange(zz.xv(b.Min.X), zz.xv(b.Max.X))
+	// y increases southward
+	yMin, yMax := indexRange(zz.yv(b.Max.Y), zz.yv(b.Min.Y))
+	fMin, fMax := indexRange(zz.fv(b.Min.Z), zz.fv(b.Max.Z))
+	xMin, xMax = clamp(xMin, 0, n-1), clamp(xMax, 0, n-1)
+	yMin, yMax = clamp(yMin, 0, n-1), clamp(yMax, 0, n-1)
+
+	if (xMax-xMin+1)*(yMax-yMin+1)*(fMax-fMin+1) > maxCoverCount {
+		return nil, fmt.Errorf("too many voxels: zoom %d is too fine for the bounds", z)
+	}
+
+	var res []Voxel
+	for f := fMin; f <= fMax; f++ {
+		for y := yMin; y <= yMax; y++ {
+			for x := xMin; x <= xMax; x++ {
+				v := Voxel{Z: z, F: f, X: x, Y: y}
+				if filter(v.Bounds()) {
+					res = append(res, v)
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// indexRange returns the indexes of the cells between the continuous indexes lo and hi.
+// hi on the boundary of cells does not include the next cell.
+func indexRange(lo, hi float64) (int, int) {
+	i := int(math.Floor(snap(lo)))
+	j := int(math.Ceil(snap(hi))) - 1
+	if j < i {
+		j = i
+	}
+	return i, j
+}
+
+// snap rounds values that are off from integers only by rounding errors.
+func snap(v float64) float64 {
+	if r := math.Round(v); math.Abs(v-r) < 1e-9 {
+		return r
+	}
+	return v
+}
+
+func clamp(v, lo, hi int) int {
+	return max(lo, min(hi, v))
+}
+
+// xv, yv and fv are inverse functions of lng, lat and floor and return continuous indexes.
+
+func (z zoomed) xv(lng float64) float64 {
+	return (lng + 180.0) / 360.0 / z.v
+}
+
+func (z zoomed) yv(lat float64) float64 {
+	r := lat * math.Pi / 180.0
+	return (math.Pi - math.Asinh(math.Tan(r))) / (2.0 * math.Pi) / z.v
+}
+
+func (z zoomed) fv(h float64) float64 {
+	return h / float64(int64(1<<(25-z.level)))
+}
@@ -1,8 +1,11 @@
 package spatialid
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/eukarya-inc/reearth-plateauview/server/geo"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +23,112 @@ func TestParse(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, Voxel{Z: 4, F: 0, X: 3, Y: 2}, v)
 }
+
+func TestEncode(t *testing.T) {
+	// Tokyo Station
+	v, err := Encode(139.7671, 35.6812, 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, Voxel{Z: 20, F: 0, X: 931389, Y: 412906}, v)
+	assert.Equal(t, "20/0/931389/412906", v.String())
+
+	b := v.Bounds()
+	assert.True(t, b.Min.X <= 139.7671 && 139.7671 < b.Max.X)
+	assert.True(t, b.Min.Y <= 35.6812 && 35.6812 < b.Max.Y)
+	assert.True(t, b.Min.Z <= 10 && 10 < b.Max.Z)
+
+	v, err = Encode(139.7671, 35.6812, -10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, v.F)
+
+	_, err = Encode(139.7671, 35.6812, 0, 26)
+	assert.Error(t, err)
+}
+
+func TestVoxel_Zoom(t *testing.T) {
+	v := Voxel{Z: 20, F: 3, X: 931439, Y: 412935}
+
+	p, err := v.Parent()
+	assert.NoError(t, err)
+	assert.Equal(t, Voxel{Z: 19, F: 1, X: 465719, Y: 206467}, p)
+
+	_, err = Voxel{}.Parent()
+	assert.Error(t, err)
+
+	children := v.Children()
+	assert.Len(t, children, 8)
+	for _, c := range children {
+		p, err := c.Parent()
+		assert.NoError(t, err)
+		assert.Equal(t, v, p)
+	}
+
+	vs, err := v.Zoom(18)
+	assert.NoError(t, err)
+	assert.Equal(t, []Voxel{{Z: 18, F: 0, X: 232859, Y: 103233}}, vs)
+
+	vs, err = v.Zoom(22)
+	assert.NoError(t, err)
+	assert.Len(t, vs, 64)
+	assert.Equal(t, Voxel{Z: 22, F: 12, X: 3725756, Y: 1651740}, vs[0])
+
+	_, err = v.Zoom(26)
+	assert.Error(t, err)
+	_, err = Voxel{}.Zoom(10)
+	assert.Error(t, err)
+
+	// large zoom jumps are rejected without overflowing the count
+	for _, z := range []int{21, 22, 25} {
+		_, err = Voxel{Z: 0}.Zoom(z)
+		assert.EqualError(t, err, fmt.Sprintf("too many voxels: zoom %d is too fine", z))
+	}
+	_, err = Voxel{Z: 4}.Zoom(25)
+	assert.Error(t, err)
+}
+
+func TestCover(t *testing.T) {
+	v := Voxel{Z: 20, F: 3, X: 931439, Y: 412935}
+
+	vs, err := Cover(v.Bounds(), 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []Voxel{v}, vs)
+
+	vs, err = Cover(v.Bounds(), 21)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, v.Children(), vs)
+
+	b := v.Bounds()
+	b.Max.X += 1e-6
+	vs, err = Cover(b, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []Voxel{v, {Z: 20, F: 3, X: 931440, Y: 412935}}, vs)
+
+	_, err = Cover(b, -1)
+	assert.Error(t, err)
+}
+
+func TestCoverLOD1Solid(t *testing.T) {
+	v := Voxel{Z: 20, F: 0, X: 931439, Y: 412935}
+	b := v.Bounds()
+
+	w, h := b.Max.X-b.Min.X, b.Max.Y-b.Min.Y
+
+	// a small triangular prism at the south west corner of the voxel
+	so := geo.LOD1Solid{
+		Bottom: geo.Polygon2{
+			{X: b.Min.X, Y: b.Min.Y},
+			{X: b.Min.X + w*0.4, Y: b.Min.Y},
+			{X: b.Min.X, Y: b.Min.Y + h*0.4},
+		},
+		Bounds: geo.Bounds3{
+			Min: b.Min,
+			Max: geo.Point3{X: b.Min.X + w*0.4, Y: b.Min.Y + h*0.4, Z: b.Max.Z},
+		},
+	}
+
+	vs, err := CoverLOD1Solid(so, 21)
+	assert.NoError(t, err)
+	assert.Equal(t, []Voxel{
+		{Z: 21, F: 0, X: 931439 * 2, Y: 412935*2 + 1},
+		{Z: 21, F: 1, X: 931439 * 2, Y: 412935*2 + 1},
+	}, vs)
+}
@@ -0,0 +1,53 @@
+package govpolygon
+
+import (
+	geojson "github.com/paulmach/go.geojson"
+)
+
+// Bounds is [minLng, minLat, maxLng, maxLat].
+type Bounds [4]float64
+
+// AreaBounds maps area codes to their bounds. Bounds of prefectures are unions of bounds of their cities.
+type AreaBounds map[string]Bounds
+
+func NewAreaBounds(f []*geojson.Feature) AreaBounds {
+	if f == nil {
+		f = JapanCityFeatures
+	}
+
+	res := AreaBounds{}
+	for _, f := range f {
+		if f == nil || f.Geometry == nil {
+			continue
+		}
+		code, _ := f.Properties["code"].(string)
+		if len(code) < 2 {
+			continue
+		}
+		b, ok := bounds(f.Geometry)
+		if !ok {
+			continue
+		}
+
+		bb := Bounds{b.X, b.Y, b.X + b.Width, b.Y + b.Height}
+		res.extend(code, bb)
+		if len(code) > 2 {
+			res.extend(code[:2], bb)
+		}
+	}
+	return res
+}
+
+func (a AreaBounds) Get(code string) (Bounds, bool) {
+	b, ok := a[code]
+	return b, ok
+}
+
+func (a AreaBounds) extend(code string, b Bounds) {
+	c, ok := a[code]
+	if !ok {
+		a[code] = b
+		return
+	}
+	a[code] = Bounds{min(c[0], b[0]), min(c[1], b[1]), max(c[2], b[2]), max(c[3], b[3])}
+}
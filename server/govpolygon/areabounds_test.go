@@ -0,0 +1,30 @@
+package govpolygon
+
+import (
+	"testing"
+
+	geojson "github.com/paulmach/go.geojson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAreaBounds(t *testing.T) {
+	f1 := geojson.NewPolygonFeature([][][]float64{{{139, 35}, {140, 35}, {140, 36}, {139, 35}}})
+	f1.Properties["code"] = "13101"
+	f2 := geojson.NewMultiPolygonFeature([][][]float64{{{141, 34}, {142, 34}, {142, 35}, {141, 34}}})
+	f2.Properties["code"] = "13102"
+	f3 := geojson.NewPointFeature([]float64{0, 0})
+	f3.Properties["code"] = "01100"
+
+	b := NewAreaBounds([]*geojson.Feature{f1, f2, f3})
+
+	res, ok := b.Get("13101")
+	assert.True(t, ok)
+	assert.Equal(t, Bounds{139, 35, 140, 36}, res)
+
+	res, ok = b.Get("13")
+	assert.True(t, ok)
+	assert.Equal(t, Bounds{139, 34, 142, 36}, res)
+
+	_, ok = b.Get("01100")
+	assert.False(t, ok)
+}
@@ -8,7 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"path"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/eukarya-inc/reearth-plateauview/server/searchindex/indexer"
@@ -22,6 +24,8 @@ var builtinConfig = &indexer.Config{
 		"名称":           {Kind: "enum"},
 		"用途":           {Kind: "enum"},
 		"住所":           {Kind: "enum"},
+		"計測高さ":         {Kind: "numeric"},
+		"地上階数":         {Kind: "numeric"},
 		"建物利用現況（大分類）":  {Kind: "enum"},
 		"建物利用現況（中分類）":  {Kind: "enum"},
 		"建物利用現況（小分類）":  {Kind: "enum"},
@@ -32,6 +36,11 @@ var builtinConfig = &indexer.Config{
 	},
 }
 
+// prevIndexClient downloads previous indexes. Its timeout is long as indexes can be large, but a stalled download does not block the build forever.
+var prevIndexClient = &http.Client{
+	Timeout: 10 * time.Minute,
+}
+
 type Indexer struct {
 	base   *url.URL
 	config *indexer.Config
@@ -42,6 +51,8 @@ type Indexer struct {
 	zipMode bool
 	// true -> more stable but uses more memory
 	bufferMode bool
+	// URL of the index zip built previously. Its manifest is used to skip unchanged tiles.
+	prevIndex *url.URL
 }
 
 func NewIndexer(cms cms.Interface, pid string, base *url.URL, debug bool) *Indexer {
@@ -62,16 +73,31 @@ func NewZipIndexer(cms cms.Interface, pid string, base *url.URL, debug bool) *In
 	return i
 }
 
+func (i *Indexer) WithPreviousIndex(u *url.URL) *Indexer {
+	i.prevIndex = u
+	return i
+}
+
 func (i *Indexer) BuildIndex(ctx context.Context, name string) (string, error) {
 	indfs, err := i.fs(ctx)
 	if err != nil {
 		return "", fmt.Errorf("インデックスを作成できませんでした。%w", err)
 	}
 
-	ind := indexer.NewIndexer(builtinConfig, indfs, nil, i.debug)
+	ind := indexer.NewIndexer(i.config, indfs, nil, i.debug)
+	if m := i.previousManifest(ctx); m != nil {
+		ind = ind.WithManifest(m)
+	}
+
 	res, err := ind.Build(ctx)
 	if err != nil {
-		return "", fmt.Errorf("インデックスを作成できませんでした。%w", err)
+		err = fmt.Errorf("インデックスを作成できませんでした。%w", err)
+		// upload the tiles processed so far so that the next build can resume from them
+		aid, err2 := i.uploadManifest(ctx, name, res.Manifest)
+		if err2 != nil {
+			log.Errorfc(ctx, "indexer webhook: failed to upload the partial manifest for %s: %v", name, err2)
+		}
+		return aid, err
 	}
 
 	log.Infofc(ctx, "indexer webhook: suceeded to build indexes for %s", name)
@@ -88,7 +114,7 @@ func (i *Indexer) uploadWithPipe(ctx context.Context, name string, res indexer.R
 	aids := make(chan string)
 	errs := make(chan error)
 	go func() {
-		aid, err := i.cms.UploadAssetDirectly(ctx, i.pid, fmt.Sprintf("%s_index.zip", name), pr)
+		aid, err := i.cms.UploadAssetDirectly(ctx, i.pid, indexAssetName(name)+".zip", pr)
 		aids <- aid
 		errs <- err
 	}()
@@ -134,7 +160,7 @@ func (i *Indexer) uploadWithBuffer(ctx context.Context, name string, res indexer
 
 	log.Debugfc(ctx, "indexer webhook: succeeded to zip indexes for %s", name)
 
-	aid, err := i.cms.UploadAssetDirectly(ctx, i.pid, fmt.Sprintf("%s_index.zip", name), b)
+	aid, err := i.cms.UploadAssetDirectly(ctx, i.pid, indexAssetName(name)+".zip", b)
 	if err != nil {
 		return "", fmt.Errorf("結果のアップロードに失敗しました。(3) %w", err)
 	}
@@ -143,6 +169,30 @@ func (i *Indexer) uploadWithBuffer(ctx context.Context, name string, res indexer
 	return aid, nil
 }
 
+// uploadManifest uploads a zip that contains only the manifest. It returns an empty ID if there is no tile in the manifest.
+func (i *Indexer) uploadManifest(ctx context.Context, name string, m *indexer.Manifest) (string, error) {
+	if m == nil || m.Len() == 0 {
+		return "", nil
+	}
+
+	b := &bytes.Buffer{}
+	zw := zip.NewWriter(b)
+	if err := indexer.NewWriter(i.config, indexer.NewZipOutputFS(zw, "")).WriteManifest(ctx, m); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("failed to close zip: %w", err)
+	}
+
+	aid, err := i.cms.UploadAssetDirectly(ctx, i.pid, indexAssetName(name)+".zip", b)
+	if err != nil {
+		return "", err
+	}
+
+	log.Infofc(ctx, "indexer webhook: uploaded the partial manifest of %d tiles for %s", m.Len(), name)
+	return aid, nil
+}
+
 func (i *Indexer) fs(ctx context.Context) (indexer.FS, error) {
 	if i.zipMode {
 		u := i.base.String()
@@ -179,6 +229,69 @@ func (i *Indexer) fs(ctx context.Context) (indexer.FS, error) {
 	return indexer.NewHTTPFS(nil, getAssetBase(i.base)), nil
 }
 
+// previousManifest downloads the previous index zip and reads its manifest.
+// Any error is logged and ignored because the index can be always built from scratch.
+func (i *Indexer) previousManifest(ctx context.Context) *indexer.Manifest {
+	if i.prevIndex == nil {
+		return nil
+	}
+
+	u := i.prevIndex.String()
+	log.Debugfc(ctx, "indexer webhook: downloading the previous index %s", u)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		log.Warnfc(ctx, "indexer webhook: failed to download the previous index: %v", err)
+		return nil
+	}
+
+	res, err := prevIndexClient.Do(req)
+	if err != nil {
+		log.Warnfc(ctx, "indexer webhook: failed to download the previous index: %v", err)
+		return nil
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		log.Warnfc(ctx, "indexer webhook: failed to download the previous index: status code is %d", res.StatusCode)
+		return nil
+	}
+
+	// the zip is read from a temporary file as it can be large
+	f, err := os.CreateTemp("", "searchindex-*.zip")
+	if err != nil {
+		log.Warnfc(ctx, "indexer webhook: failed to create a temporary file: %v", err)
+		return nil
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	size, err := io.Copy(f, res.Body)
+	if err != nil {
+		log.Warnfc(ctx, "indexer webhook: failed to download the previous index: %v", err)
+		return nil
+	}
+
+	z, err := zip.NewReader(f, size)
+	if err != nil {
+		log.Warnfc(ctx, "indexer webhook: the previous index is not a zip: %v", err)
+		return nil
+	}
+
+	m, err := indexer.ReadManifest(ctx, indexer.NewZipFS(z))
+	if err != nil {
+		log.Warnfc(ctx, "indexer webhook: the previous index has no manifest: %v", err)
+		return nil
+	}
+
+	return m
+}
+
 type OutputFS struct {
 	c   cms.Interface
 	cb  func(assetID string, err error)
@@ -6,5 +6,8 @@ type Config struct {
 }
 
 type Index struct {
+	// Kind is one of "enum", "numeric" and "text".
 	Kind string `json:"kind"`
+	// NGram is the maximum gram length of a text index. Defaults to 2.
+	NGram int `json:"ngram,omitempty"`
 }
@@ -15,3 +15,23 @@ type EnumValue struct {
 	Count int    `json:"count"`
 	Url   string `json:"url"`
 }
+
+type NumericIndex struct {
+	Kind string  `json:"kind"`
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Url  string  `json:"url"`
+}
+
+type TextIndex struct {
+	Kind  string `json:"kind"`
+	NGram int    `json:"ngram"`
+	Url   string `json:"url"`
+}
+
+// TextIndexData is the content of the file referred by TextIndex.Url.
+// Grams maps each gram to indexes of Values that contain it.
+type TextIndexData struct {
+	Values []TextValue      `json:"values"`
+	Grams  map[string][]int `json:"grams"`
+}
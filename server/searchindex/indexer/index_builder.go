@@ -1,8 +1,22 @@
 package indexer
 
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	IndexKindEnum    = "enum"
+	IndexKindNumeric = "numeric"
+	IndexKindText    = "text"
+)
+
+const defaultNGramSize = 2
+
 type IndexBuilder interface {
 	AddIndexValue(int, string)
-	GetValueIds() *map[string][]Ids
+	GetProperty() string
 }
 
 type EnumIndexBuilder struct {
@@ -23,14 +37,136 @@ func (enumBuilder EnumIndexBuilder) GetValueIds() *map[string][]Ids {
 	return &enumBuilder.ValueIds
 }
 
+func (enumBuilder EnumIndexBuilder) GetProperty() string {
+	return enumBuilder.Property
+}
+
+// NumericIndexBuilder collects numeric values such as heights or floor counts.
+// Values that can not be parsed as a number are ignored.
+type NumericIndexBuilder struct {
+	Property string
+	Config   Index
+	Values   []NumericValue
+}
+
+type NumericValue struct {
+	DataRowId int
+	Value     float64
+}
+
+func (numericBuilder *NumericIndexBuilder) AddIndexValue(dataRowId int, value string) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return
+	}
+	numericBuilder.Values = append(numericBuilder.Values, NumericValue{DataRowId: dataRowId, Value: v})
+}
+
+func (numericBuilder *NumericIndexBuilder) GetProperty() string {
+	return numericBuilder.Property
+}
+
+// SortedValues returns values sorted in ascending order of value, then data row ID.
+func (numericBuilder *NumericIndexBuilder) SortedValues() []NumericValue {
+	values := append([]NumericValue{}, numericBuilder.Values...)
+	sort.SliceStable(values, func(i, j int) bool {
+		if values[i].Value == values[j].Value {
+			return values[i].DataRowId < values[j].DataRowId
+		}
+		return values[i].Value < values[j].Value
+	})
+	return values
+}
+
+// TextIndexBuilder builds an n-gram index for substring search such as "name contains 駅".
+// Grams of every length from 1 to NGramSize are indexed so that short queries also match.
+type TextIndexBuilder struct {
+	Property string
+	Config   Index
+	Values   []TextValue
+	Grams    map[string][]int
+	values   map[string]int
+}
+
+type TextValue struct {
+	Value      string `json:"value"`
+	DataRowIds []int  `json:"dataRowIds"`
+}
+
+func (textBuilder *TextIndexBuilder) AddIndexValue(dataRowId int, value string) {
+	if value == "" {
+		return
+	}
+
+	if i, ok := textBuilder.values[value]; ok {
+		textBuilder.Values[i].DataRowIds = append(textBuilder.Values[i].DataRowIds, dataRowId)
+		return
+	}
+
+	i := len(textBuilder.Values)
+	textBuilder.values[value] = i
+	textBuilder.Values = append(textBuilder.Values, TextValue{Value: value, DataRowIds: []int{dataRowId}})
+
+	for _, g := range nGrams(normalizeText(value), textBuilder.NGramSize()) {
+		textBuilder.Grams[g] = append(textBuilder.Grams[g], i)
+	}
+}
+
+func (textBuilder *TextIndexBuilder) GetProperty() string {
+	return textBuilder.Property
+}
+
+func (textBuilder *TextIndexBuilder) NGramSize() int {
+	if textBuilder.Config.NGram > 0 {
+		return textBuilder.Config.NGram
+	}
+	return defaultNGramSize
+}
+
+func normalizeText(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// nGrams returns the unique grams of length 1 to n contained in s.
+func nGrams(s string, n int) []string {
+	runes := []rune(s)
+	seen := map[string]struct{}{}
+	var res []string
+
+	for size := 1; size <= n; size++ {
+		for i := 0; i+size <= len(runes); i++ {
+			g := string(runes[i : i+size])
+			if _, ok := seen[g]; ok {
+				continue
+			}
+			seen[g] = struct{}{}
+			res = append(res, g)
+		}
+	}
+
+	return res
+}
+
 func createIndexBuilder(property string, indexConfig Index) IndexBuilder {
 	switch indexConfig.Kind {
-	case "enum":
+	case IndexKindEnum:
 		return EnumIndexBuilder{
 			Property: property,
 			Config:   indexConfig,
 			ValueIds: make(map[string][]Ids),
 		}
+	case IndexKindNumeric:
+		return &NumericIndexBuilder{
+			Property: property,
+			Config:   indexConfig,
+		}
+	case IndexKindText:
+		return &TextIndexBuilder{
+			Property: property,
+			Config:   indexConfig,
+			Grams:    make(map[string][]int),
+			values:   make(map[string]int),
+		}
 	}
 	return nil
 }
@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumericIndexBuilder(t *testing.T) {
+	b := createIndexBuilder("計測高さ", Index{Kind: IndexKindNumeric}).(*NumericIndexBuilder)
+	b.AddIndexValue(0, "61.5")
+	b.AddIndexValue(1, "12")
+	b.AddIndexValue(2, "invalid")
+	b.AddIndexValue(3, "12")
+
+	assert.Equal(t, []NumericValue{
+		{DataRowId: 1, Value: 12},
+		{DataRowId: 3, Value: 12},
+		{DataRowId: 0, Value: 61.5},
+	}, b.SortedValues())
+}
+
+func TestTextIndexBuilder(t *testing.T) {
+	b := createIndexBuilder("名称", Index{Kind: IndexKindText}).(*TextIndexBuilder)
+	b.AddIndexValue(0, "東京駅")
+	b.AddIndexValue(1, "東京タワー")
+	b.AddIndexValue(2, "東京駅")
+	b.AddIndexValue(3, "")
+
+	assert.Equal(t, []TextValue{
+		{Value: "東京駅", DataRowIds: []int{0, 2}},
+		{Value: "東京タワー", DataRowIds: []int{1}},
+	}, b.Values)
+	assert.Equal(t, []int{0}, b.Grams["駅"])
+	assert.Equal(t, []int{0}, b.Grams["京駅"])
+	assert.Equal(t, []int{0, 1}, b.Grams["東京"])
+	assert.Nil(t, b.Grams["東京駅"])
+}
+
+func TestNGrams(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "ab", "bb"}, nGrams("abb", 2))
+	assert.Equal(t, []string{"a"}, nGrams("a", 3))
+	assert.Empty(t, nGrams("", 2))
+}
+
+func TestWriter_WriteIndexes(t *testing.T) {
+	ctx := context.Background()
+	buf := bytes.NewBuffer(nil)
+	zw := zip.NewWriter(buf)
+
+	numeric := createIndexBuilder("計測高さ", Index{Kind: IndexKindNumeric})
+	numeric.AddIndexValue(0, "61.5")
+	numeric.AddIndexValue(1, "12")
+	text := createIndexBuilder("名称", Index{Kind: IndexKindText})
+	text.AddIndexValue(0, "東京駅")
+
+	w := NewWriter(&Config{}, NewZipOutputFS(zw, ""))
+	indexes, err := w.WriteIndexes(ctx, []IndexBuilder{numeric, text})
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+
+	assert.Equal(t, map[string]any{
+		"計測高さ": &NumericIndex{Kind: "numeric", Min: 12, Max: 61.5, Url: "0.csv"},
+		"名称":   &TextIndex{Kind: "text", NGram: 2, Url: "1.json"},
+	}, indexes)
+
+	zr := lo.Must(zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len())))
+	files := map[string]string{}
+	for _, f := range zr.File {
+		r := lo.Must(f.Open())
+		files[f.Name] = string(lo.Must(io.ReadAll(r)))
+		_ = r.Close()
+	}
+
+	assert.Equal(t, "dataRowId,value\n1,12\n0,61.5\n", files["0.csv"])
+
+	var data TextIndexData
+	assert.NoError(t, json.Unmarshal([]byte(files["1.json"]), &data))
+	assert.Equal(t, []TextValue{{Value: "東京駅", DataRowIds: []int{0}}}, data.Values)
+	assert.Equal(t, []int{0}, data.Grams["駅"])
+}
+
+func TestIndexValue(t *testing.T) {
+	assert.Equal(t, "a", lo.T2(indexValue("a")).A)
+	assert.Equal(t, "61.5", lo.T2(indexValue(61.5)).A)
+	assert.Equal(t, "3", lo.T2(indexValue(float64(3))).A)
+	_, ok := indexValue(nil)
+	assert.False(t, ok)
+}
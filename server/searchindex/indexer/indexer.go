@@ -1,9 +1,11 @@
 package indexer
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,10 +27,11 @@ const semaphoreLimit = 2
 const DRACO_EXT = "KHR_draco_mesh_compression" // draco.ExtensionName
 
 type Indexer struct {
-	config *Config
-	fs     FS
-	writer *Writer
-	debug  bool
+	config   *Config
+	fs       FS
+	writer   *Writer
+	debug    bool
+	manifest *Manifest
 }
 
 func NewIndexer(config *Config, fs FS, output OutputFS, debug bool) *Indexer {
@@ -40,9 +43,20 @@ func NewIndexer(config *Config, fs FS, output OutputFS, debug bool) *Indexer {
 	}
 }
 
+// WithManifest sets the manifest of a previous build. Tiles whose content has not changed since then are not decoded again.
+// The manifest is ignored if it was built with a different config.
+func (indexer *Indexer) WithManifest(m *Manifest) *Indexer {
+	if m.IsCompatible(indexer.config) {
+		indexer.manifest = m
+	}
+	return indexer
+}
+
 type Result struct {
 	IndexBuilders []IndexBuilder
 	Data          ResultData
+	// Manifest records the tiles processed so far. It is also returned when the build failed so that the build can be resumed.
+	Manifest *Manifest
 }
 
 type ResultData []map[string]string
@@ -50,6 +64,11 @@ type ResultData []map[string]string
 func (indexer *Indexer) BuildAndWrite(ctx context.Context) error {
 	res, err := indexer.Build(ctx)
 	if err != nil {
+		if res.Manifest != nil {
+			if err2 := indexer.writer.WriteManifest(ctx, res.Manifest); err2 != nil {
+				log.Errorfc(ctx, "indexer: failed to write the manifest: %v", err2)
+			}
+		}
 		return err
 	}
 
@@ -78,13 +97,19 @@ func (indexer *Indexer) Build(ctx context.Context) (res Result, errMsg error) {
 		indexBuilders = append(indexBuilders, createIndexBuilder(property, config))
 	}
 
-	features, err := ReadTilesetFeatures(ctx, tileset, indexer.config, indexer.fs, indexer.debug)
+	manifest := NewManifest(indexer.config)
+	res.Manifest = manifest
+
+	features, err := ReadTilesetFeaturesWithManifest(ctx, tileset, indexer.config, indexer.fs, indexer.manifest, manifest, indexer.debug)
 	if err != nil {
 		errMsg = fmt.Errorf("failed to read features: %w", err)
 		return
 	}
 
 	log.Debugfc(ctx, "indexer: Number of features counted: %d", len(features))
+	if indexer.manifest != nil {
+		log.Debugfc(ctx, "indexer: Number of tiles reused: %d/%d", countReusedTiles(indexer.manifest, manifest), manifest.Len())
+	}
 
 	for idValue, tilsetFeature := range features {
 		// taking all positionProperties map entries as string for better writing experience
@@ -98,14 +123,12 @@ func (indexer *Indexer) Build(ctx context.Context) (res Result, errMsg error) {
 		dataRowId := len(resultData) - 1
 
 		for _, b := range indexBuilders {
-			switch t := b.(type) {
-			case EnumIndexBuilder:
-				if val, ok := tilsetFeature.Properties[t.Property]; ok && val != nil {
-					t.AddIndexValue(dataRowId, val.(string))
-				}
-			default:
+			if b == nil {
 				continue
 			}
+			if val, ok := indexValue(tilsetFeature.Properties[b.GetProperty()]); ok {
+				b.AddIndexValue(dataRowId, val)
+			}
 		}
 	}
 
@@ -114,12 +137,37 @@ func (indexer *Indexer) Build(ctx context.Context) (res Result, errMsg error) {
 	return
 }
 
+// indexValue converts a batch table value to a string to be indexed.
+func indexValue(val any) (string, bool) {
+	switch v := val.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
 type TilesetFeature struct {
 	Properties map[string]interface{}
 	Position   Cartographic
 }
 
 func ReadTilesetFeatures(ctx context.Context, ts *tiles.Tileset, config *Config, fsys FS, debug bool) (map[string]TilesetFeature, error) {
+	return ReadTilesetFeaturesWithManifest(ctx, ts, config, fsys, nil, nil, debug)
+}
+
+// ReadTilesetFeaturesWithManifest reads features of the tileset. Features of tiles recorded in prev with the same content hash are reused without decoding.
+// Every tile read successfully is recorded in next.
+func ReadTilesetFeaturesWithManifest(ctx context.Context, ts *tiles.Tileset, config *Config, fsys FS, prev, next *Manifest, debug bool) (map[string]TilesetFeature, error) {
 	uniqueFeatures := make(map[string]TilesetFeature)
 	tilesetQueue := []*tiles.Tileset{ts}
 	rMutex := sync.RWMutex{}
@@ -150,54 +198,33 @@ func ReadTilesetFeatures(ctx context.Context, ts *tiles.Tileset, config *Config,
 				_ = b3dmFile.Close()
 			}()
 
-			reader := b3dms.NewB3dmReader(b3dmFile)
-			b3dm := new(b3dms.B3dm)
-			if err := reader.Decode(b3dm); err != nil {
-				return err
+			b3dmData, err := io.ReadAll(b3dmFile)
+			if err != nil {
+				return fmt.Errorf("failed to read b3dm file: %v", err)
 			}
-			featureTable := b3dm.GetFeatureTable()
-			batchLength := featureTable.GetBatchLength()
-			featureTableView := b3dm.GetFeatureTableView()
-			batchTable := b3dm.GetBatchTable()
-			batchTableProperties := batchTable.Data
-			computedFeaturePositions := []Cartographic{}
-			doc := b3dm.GetModel()
-			if doc != nil {
-				rtcTransform, err := getRtcTransform(featureTableView, doc)
-				if err != nil {
-					return fmt.Errorf("failed to getRtcTransform: %v", err)
-				}
-				toZUpTransform := getZUpTransform()
-				computedFeaturePositions, err = computeFeaturePositionsFromGltfVertices(
-					doc,
-					computedTransform,
-					rtcTransform,
-					toZUpTransform,
-					batchLength,
-				)
+
+			hash := hashContent(b3dmData)
+			manifestTile := prev.Tile(tileUri, hash)
+			if manifestTile == nil {
+				features, err := readB3dmFeatures(bytes.NewReader(b3dmData), computedTransform, config)
 				if err != nil {
-					return fmt.Errorf("failed to open b3dm file: %v", err)
+					return err
 				}
+				manifestTile = &ManifestTile{Hash: hash, Features: features}
+			} else if debug {
+				log.Debugfc(ctx, "indexer: reused %s", tileUri)
 			}
 
-			for batchId := 0; batchId < batchLength; batchId++ {
-				batchProperties := make(map[string]interface{})
-				for name, values := range batchTableProperties {
-					batchProperties[name] = nil
-					if len(values) > 0 {
-						batchProperties[name] = values[batchId]
-					}
-				}
-				position := computedFeaturePositions[batchId]
-				idValue := batchProperties[config.IdProperty].(string)
-				rMutex.Lock()
-				uniqueFeatures[idValue] = TilesetFeature{
-					Position:   position,
-					Properties: batchProperties,
+			rMutex.Lock()
+			for _, f := range manifestTile.Features {
+				uniqueFeatures[f.ID] = TilesetFeature{
+					Position:   f.Position,
+					Properties: f.Properties,
 				}
-				rMutex.Unlock()
 			}
+			rMutex.Unlock()
 
+			next.SetTile(tileUri, manifestTile)
 			return nil
 		}
 		if err := ForEachTile(tileset, tilesetIterFn); err != nil {
@@ -208,6 +235,74 @@ func ReadTilesetFeatures(ctx context.Context, ts *tiles.Tileset, config *Config,
 	return uniqueFeatures, nil
 }
 
+func readB3dmFeatures(r io.Reader, computedTransform *mat.Dense, config *Config) ([]ManifestFeature, error) {
+	reader := b3dms.NewB3dmReader(r)
+	b3dm := new(b3dms.B3dm)
+	if err := reader.Decode(b3dm); err != nil {
+		return nil, err
+	}
+	featureTable := b3dm.GetFeatureTable()
+	batchLength := featureTable.GetBatchLength()
+	featureTableView := b3dm.GetFeatureTableView()
+	batchTable := b3dm.GetBatchTable()
+	batchTableProperties := batchTable.Data
+	computedFeaturePositions := []Cartographic{}
+	doc := b3dm.GetModel()
+	if doc != nil {
+		rtcTransform, err := getRtcTransform(featureTableView, doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to getRtcTransform: %v", err)
+		}
+		toZUpTransform := getZUpTransform()
+		computedFeaturePositions, err = computeFeaturePositionsFromGltfVertices(
+			doc,
+			computedTransform,
+			rtcTransform,
+			toZUpTransform,
+			batchLength,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open b3dm file: %v", err)
+		}
+	}
+
+	features := make([]ManifestFeature, 0, batchLength)
+	for batchId := 0; batchId < batchLength; batchId++ {
+		// only the ID property and indexed properties are kept to make the manifest small
+		batchProperties := make(map[string]interface{})
+		for name, values := range batchTableProperties {
+			if _, ok := config.Indexes[name]; !ok && name != config.IdProperty {
+				continue
+			}
+			batchProperties[name] = nil
+			if len(values) > 0 {
+				batchProperties[name] = values[batchId]
+			}
+		}
+		position := computedFeaturePositions[batchId]
+		idValue := batchProperties[config.IdProperty].(string)
+		features = append(features, ManifestFeature{
+			ID:         idValue,
+			Position:   position,
+			Properties: batchProperties,
+		})
+	}
+
+	return features, nil
+}
+
+func countReusedTiles(prev, next *Manifest) (c int) {
+	next.lock.RLock()
+	defer next.lock.RUnlock()
+
+	for uri, t := range next.Tiles {
+		if prev.Tile(uri, t.Hash) != nil {
+			c++
+		}
+	}
+	return
+}
+
 func computeFeaturePositionsFromGltfVertices(doc *gltf.Document, tileTransform, rtcTransform, toZUpTransform *mat.Dense, batchLength int) ([]Cartographic, error) {
 	nodes := doc.Nodes
 	if nodes == nil {
@@ -345,33 +440,33 @@ func ForEachTile(ts *tiles.Tileset, iterFn TileIterFn) error {
 		retriableIterfn := func() error {
 			return iterFn(tile, computedTransform)
 		}
-		err := Retry(retriableIterfn)
+		var errMsgs []string
+		if err := Retry(retriableIterfn); err != nil {
+			errMsgs = append(errMsgs, err.Error())
+		}
 		if (tile.Children != nil) && len(*tile.Children) != 0 {
 			var wg sync.WaitGroup
+			var errMutex sync.Mutex
 			semaphore := make(chan struct{}, semaphoreLimit)
-			errors := make(chan error, semaphoreLimit)
 			for _, child := range *tile.Children {
 				semaphore <- struct{}{}
 				wg.Add(1)
 				go func(child tiles.Tile) {
 					defer wg.Done()
 					defer func() { <-semaphore }()
-					err = iterTile(&child, computedTransform)
-					if err != nil {
-						errors <- fmt.Errorf("something went wrong at iterTile: %v", err)
+					if err := iterTile(&child, computedTransform); err != nil {
+						errMutex.Lock()
+						errMsgs = append(errMsgs, fmt.Sprintf("something went wrong at iterTile: %v", err))
+						errMutex.Unlock()
 					}
 				}(child)
 			}
 			wg.Wait()
-			close(errors)
+		}
 
-			if len(errors) > 0 {
-				errMsgs := make([]string, 0, len(errors))
-				for err := range errors {
-					errMsgs = append(errMsgs, err.Error())
-				}
-				return fmt.Errorf("errors occured: %v", strings.Join(errMsgs, ", "))
-			}
+		if len(errMsgs) > 0 {
+			// remaining tiles are still processed so that they are recorded in the manifest
+			return fmt.Errorf("errors occured: %v", strings.Join(errMsgs, ", "))
 		}
 
 		return nil
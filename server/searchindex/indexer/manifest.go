@@ -0,0 +1,128 @@
+package indexer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	manifestJSONName = "manifest.json"
+	manifestVersion  = 1
+)
+
+// Manifest records the content hash of each tile and the features read from it,
+// so that a later build can skip unchanged tiles and resume a failed build.
+type Manifest struct {
+	Version    int                      `json:"version"`
+	IdProperty string                   `json:"idProperty"`
+	Properties []string                 `json:"properties"`
+	Tiles      map[string]*ManifestTile `json:"tiles"`
+	lock       sync.RWMutex
+}
+
+type ManifestTile struct {
+	Hash     string            `json:"hash"`
+	Features []ManifestFeature `json:"features"`
+}
+
+type ManifestFeature struct {
+	ID         string         `json:"id"`
+	Position   Cartographic   `json:"position"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+func NewManifest(config *Config) *Manifest {
+	properties := maps.Keys(config.Indexes)
+	sort.Strings(properties)
+
+	return &Manifest{
+		Version:    manifestVersion,
+		IdProperty: config.IdProperty,
+		Properties: properties,
+		Tiles:      map[string]*ManifestTile{},
+	}
+}
+
+// ReadManifest reads the manifest written by a previous build from the FS.
+func ReadManifest(ctx context.Context, fsys FS) (*Manifest, error) {
+	f, err := fsys.Open(ctx, manifestJSONName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the manifest: %w", err)
+	}
+	defer f.Close()
+
+	m := &Manifest{}
+	if err := json.NewDecoder(f).Decode(m); err != nil {
+		return nil, fmt.Errorf("failed to decode the manifest: %w", err)
+	}
+	if m.Tiles == nil {
+		m.Tiles = map[string]*ManifestTile{}
+	}
+	return m, nil
+}
+
+// IsCompatible returns true if the manifest was built with the same ID property and indexed properties as the config.
+func (m *Manifest) IsCompatible(config *Config) bool {
+	if m == nil || m.Version != manifestVersion || m.IdProperty != config.IdProperty {
+		return false
+	}
+	properties := maps.Keys(config.Indexes)
+	sort.Strings(properties)
+	return slices.Equal(m.Properties, properties)
+}
+
+// Tile returns the recorded tile if its hash equals to the given hash.
+func (m *Manifest) Tile(uri, hash string) *ManifestTile {
+	if m == nil {
+		return nil
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	t := m.Tiles[uri]
+	if t == nil || t.Hash != hash {
+		return nil
+	}
+	return t
+}
+
+func (m *Manifest) SetTile(uri string, tile *ManifestTile) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.Tiles[uri] = tile
+}
+
+func (m *Manifest) Len() int {
+	if m == nil {
+		return 0
+	}
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.Tiles)
+}
+
+func (m *Manifest) Write(w io.Writer) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return json.NewEncoder(w).Encode(m)
+}
+
+func hashContent(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
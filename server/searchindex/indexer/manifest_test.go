@@ -0,0 +1,60 @@
+package indexer
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifest(t *testing.T) {
+	ctx := context.Background()
+	config := &Config{
+		IdProperty: "gml_id",
+		Indexes: map[string]Index{
+			"用途":   {Kind: IndexKindEnum},
+			"計測高さ": {Kind: IndexKindNumeric},
+		},
+	}
+
+	m := NewManifest(config)
+	assert.Equal(t, []string{"用途", "計測高さ"}, m.Properties)
+	m.SetTile("a.b3dm", &ManifestTile{
+		Hash: hashContent([]byte("a")),
+		Features: []ManifestFeature{
+			{ID: "bldg_1", Position: Cartographic{Longitude: 1, Latitude: 2, Height: 3}, Properties: map[string]any{"gml_id": "bldg_1", "用途": "住宅"}},
+		},
+	})
+
+	buf := bytes.NewBuffer(nil)
+	zw := zip.NewWriter(buf)
+	assert.NoError(t, NewWriter(config, NewZipOutputFS(zw, "")).WriteManifest(ctx, m))
+	assert.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	m2, err := ReadManifest(ctx, NewZipFS(zr))
+	assert.NoError(t, err)
+
+	assert.True(t, m2.IsCompatible(config))
+	assert.Equal(t, 1, m2.Len())
+	assert.Nil(t, m2.Tile("a.b3dm", hashContent([]byte("b"))))
+	assert.Nil(t, m2.Tile("b.b3dm", hashContent([]byte("a"))))
+	assert.Equal(t, m.Tiles["a.b3dm"], m2.Tile("a.b3dm", hashContent([]byte("a"))))
+
+	assert.False(t, m2.IsCompatible(&Config{IdProperty: "gml_id", Indexes: map[string]Index{"用途": {Kind: IndexKindEnum}}}))
+	assert.False(t, m2.IsCompatible(&Config{IdProperty: "id", Indexes: config.Indexes}))
+
+	var nilManifest *Manifest
+	assert.False(t, nilManifest.IsCompatible(config))
+	assert.Nil(t, nilManifest.Tile("a.b3dm", ""))
+	nilManifest.SetTile("a.b3dm", nil)
+}
+
+func TestIndexer_WithManifest(t *testing.T) {
+	m := NewManifest(config)
+	assert.Same(t, m, NewIndexer(config, nil, nil, false).WithManifest(m).manifest)
+	assert.Nil(t, NewIndexer(config, nil, nil, false).WithManifest(NewManifest(&Config{IdProperty: "id"})).manifest)
+}
@@ -37,11 +37,34 @@ func (w *Writer) Write(ctx context.Context, r Result) error {
 		return err
 	}
 
-	return w.writeIndexRoot(ctx, IndexRoot{
+	if err := w.writeIndexRoot(ctx, IndexRoot{
 		ResultDataUrl: resultsDataUrl,
 		IdProperty:    w.config.IdProperty,
 		Indexes:       indexes,
-	})
+	}); err != nil {
+		return err
+	}
+
+	if r.Manifest != nil {
+		return w.WriteManifest(ctx, r.Manifest)
+	}
+	return nil
+}
+
+// Writes the manifest to be used for the next incremental build.
+func (w *Writer) WriteManifest(ctx context.Context, m *Manifest) error {
+	f, err := w.o.Open(ctx, manifestJSONName)
+	if err != nil {
+		return fmt.Errorf("error while writing the manifest: %v", err)
+	}
+
+	defer f.Close()
+
+	if err := m.Write(f); err != nil {
+		return fmt.Errorf("error while writing the manifest: %v", err)
+	}
+
+	return nil
 }
 
 // Writes the data.csv file and returns its path.
@@ -111,6 +134,16 @@ func (w *Writer) WriteIndexes(ctx context.Context, indexBuilders []IndexBuilder)
 			if err != nil {
 				return nil, fmt.Errorf("failed to write index: %v", err)
 			}
+		case *NumericIndexBuilder:
+			indexes[t.Property], err = w.WriteNumericIndex(ctx, t, count)
+			if err != nil {
+				return nil, fmt.Errorf("failed to write numeric index: %v", err)
+			}
+		case *TextIndexBuilder:
+			indexes[t.Property], err = w.WriteTextIndex(ctx, t, count)
+			if err != nil {
+				return nil, fmt.Errorf("failed to write text index: %v", err)
+			}
 		default:
 			continue
 		}
@@ -134,7 +167,7 @@ func (w *Writer) WriteIndex(ctx context.Context, enumBuilder EnumIndexBuilder, f
 
 	return &EnumIndex{
 		Values: values,
-		Kind:   "enum",
+		Kind:   IndexKindEnum,
 	}, nil
 }
 
@@ -165,3 +198,68 @@ func (w *Writer) WriteValueIndex(ctx context.Context, fileId int, valueId int, i
 		Url:   fileName,
 	}, nil
 }
+
+// Writes sorted value/ID pairs of a numeric index so that range queries can be answered with binary search.
+func (w *Writer) WriteNumericIndex(ctx context.Context, numericBuilder *NumericIndexBuilder, fileId int) (*NumericIndex, error) {
+	fileName := strconv.Itoa(fileId) + ".csv"
+	f, err := w.o.Open(ctx, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %v", err)
+	}
+
+	defer f.Close()
+	cw := csv.NewWriter(f)
+	defer cw.Flush()
+
+	if err := cw.Write([]string{"dataRowId", "value"}); err != nil {
+		return nil, fmt.Errorf("error writing header for csv: %v", err)
+	}
+
+	values := numericBuilder.SortedValues()
+	for _, v := range values {
+		row := []string{strconv.Itoa(v.DataRowId), strconv.FormatFloat(v.Value, 'f', -1, 64)}
+		if err := cw.Write(row); err != nil {
+			return nil, fmt.Errorf("error writing record to file: %v", err)
+		}
+	}
+
+	index := &NumericIndex{
+		Kind: IndexKindNumeric,
+		Url:  fileName,
+	}
+	if len(values) > 0 {
+		index.Min = values[0].Value
+		index.Max = values[len(values)-1].Value
+	}
+
+	return index, nil
+}
+
+// Writes the n-gram index of a text index as JSON.
+func (w *Writer) WriteTextIndex(ctx context.Context, textBuilder *TextIndexBuilder, fileId int) (*TextIndex, error) {
+	fileName := strconv.Itoa(fileId) + ".json"
+	f, err := w.o.Open(ctx, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %v", err)
+	}
+
+	defer f.Close()
+
+	data := TextIndexData{
+		Values: textBuilder.Values,
+		Grams:  textBuilder.Grams,
+	}
+	if data.Values == nil {
+		data.Values = []TextValue{}
+	}
+
+	if err := json.NewEncoder(f).Encode(data); err != nil {
+		return nil, fmt.Errorf("error writing text index: %v", err)
+	}
+
+	return &TextIndex{
+		Kind:  IndexKindText,
+		NGram: textBuilder.NGramSize(),
+		Url:   fileName,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package searchindex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/searchindex/indexer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexer_UploadManifest(t *testing.T) {
+	ctx := context.Background()
+	c := newMockedCMS(t, "prj", "plateau", "sys", storageModel, nil, nil)
+	i := NewZipIndexer(c, "prj", nil, false)
+
+	// an empty manifest is not uploaded
+	aid, err := i.uploadManifest(ctx, "bldg_lod1", indexer.NewManifest(builtinConfig))
+	require.NoError(t, err)
+	assert.Empty(t, aid)
+
+	m := indexer.NewManifest(builtinConfig)
+	m.SetTile("1/tileset.json", &indexer.ManifestTile{Hash: "hash"})
+	aid, err = i.uploadManifest(ctx, "bldg_lod1", m)
+	require.NoError(t, err)
+	data, ok := c.assetData.Load(aid)
+	require.True(t, ok)
+
+	// the uploaded zip can be read as the previous index
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(data)
+	}))
+	defer s.Close()
+	u, _ := url.Parse(s.URL + "/bldg_lod1_index.zip")
+
+	prev := NewZipIndexer(c, "prj", nil, false).WithPreviousIndex(u).previousManifest(ctx)
+	require.NotNil(t, prev)
+	assert.Equal(t, 1, prev.Len())
+	assert.NotNil(t, prev.Tile("1/tileset.json", "hash"))
+}
@@ -17,6 +17,9 @@ type Item struct {
 	Bldg []string `json:"bldg,omitempty" cms:"bldg,asset"`
 	// asset: search_index
 	SearchIndex []string `json:"search_index,omitempty" cms:"search_index,asset"`
+	// asset: search_index_partial
+	// indexes of the last failed build, including partial ones, which the next build resumes from
+	SearchIndexPartial []string `json:"search_index_partial,omitempty" cms:"search_index_partial,asset"`
 	// select: search_index_status: 未実行, 実行中, 完了, エラー
 	SearchIndexStatus Status `json:"search_index_status,omitempty" cms:"search_index_status,select"`
 }
@@ -31,3 +34,8 @@ func ItemFrom(item cms.Item) (i Item) {
 	item.Unmarshal(&i)
 	return
 }
+
+// searchIndexPartialClearField returns a field that clears partial indexes. Fields cannot do it as empty values are omitted.
+func searchIndexPartialClearField() *cms.Field {
+	return &cms.Field{Key: "search_index_partial", Type: "asset", Value: []string{}}
+}
@@ -8,10 +8,11 @@ import (
 )
 
 var item = Item{
-	ID:                "xxx",
-	Bldg:              []string{"bldg_assetid", "bldg_assetid2"},
-	SearchIndex:       []string{"searchindex_assetid"},
-	SearchIndexStatus: StatusError,
+	ID:                 "xxx",
+	Bldg:               []string{"bldg_assetid", "bldg_assetid2"},
+	SearchIndex:        []string{"searchindex_assetid"},
+	SearchIndexPartial: []string{"searchindex_partial_assetid"},
+	SearchIndexStatus:  StatusError,
 }
 
 var cmsitem = cms.Item{
@@ -19,6 +20,7 @@ var cmsitem = cms.Item{
 	Fields: []*cms.Field{
 		{Key: "bldg", Type: "asset", Value: []string{"bldg_assetid", "bldg_assetid2"}},
 		{Key: "search_index", Type: "asset", Value: []string{"searchindex_assetid"}},
+		{Key: "search_index_partial", Type: "asset", Value: []string{"searchindex_partial_assetid"}},
 		{Key: "search_index_status", Type: "select", Value: "エラー"},
 	},
 }
@@ -28,6 +30,7 @@ var cmsitem2 = cms.Item{
 	Fields: []*cms.Field{
 		{Key: "bldg", Type: "asset", Value: []string{"bldg_assetid", "bldg_assetid2"}},
 		{Key: "search_index", Type: "asset", Value: []string{"searchindex_assetid"}},
+		{Key: "search_index_partial", Type: "asset", Value: []string{"searchindex_partial_assetid"}},
 		{Key: "search_index_status", Type: "select", Value: StatusError},
 	},
 }
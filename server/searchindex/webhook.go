@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
 	"strings"
 
 	cms "github.com/reearth/reearth-cms-api/go"
@@ -116,12 +117,20 @@ func webhookHandler(cms cms.Interface, conf Config) cmswebhook.Handler {
 
 		log.Infofc(ctx, "searchindex webhook: start processing")
 
-		result, err := wc.BuildIndexes(ctx, assetURLs)
+		prevIndexes, err := wc.FindPreviousIndexes(ctx, item)
+		if err != nil {
+			log.Warnfc(ctx, "searchindex webhook: failed to find previous indexes: %v", err)
+		}
+
+		result, err := wc.BuildIndexes(ctx, assetURLs, prevIndexes)
 		if err != nil {
 			log.Errorfc(ctx, "searchindex webhook: %v", err)
 
+			// the result contains a partial index so that the next build can resume from it,
+			// while the search index field keeps the last complete indexes as they are published
 			if _, err := wc.CMS.UpdateItem(ctx, item.ID, Item{
-				SearchIndexStatus: StatusError,
+				SearchIndexStatus:  StatusError,
+				SearchIndexPartial: result,
 			}.Fields(), nil); err != nil {
 				log.Errorfc(ctx, "searchindex webhook: failed to update item: %v", err)
 			}
@@ -132,10 +141,16 @@ func webhookHandler(cms cms.Interface, conf Config) cmswebhook.Handler {
 			return nil
 		}
 
-		if _, err := wc.CMS.UpdateItem(ctx, item.ID, Item{
+		fields := Item{
 			SearchIndexStatus: StatusOK,
 			SearchIndex:       result,
-		}.Fields(), nil); err != nil {
+		}.Fields()
+		if len(item.SearchIndexPartial) > 0 {
+			// partial indexes are no longer needed
+			fields = append(fields, searchIndexPartialClearField())
+		}
+
+		if _, err := wc.CMS.UpdateItem(ctx, item.ID, fields, nil); err != nil {
 			log.Errorfc(ctx, "searchindex webhook: failed to update item: %v", err)
 		}
 
@@ -336,9 +351,36 @@ func (wc *webhookContext) FindAsset(ctx context.Context, item Item, siid string)
 	return urls, nil
 }
 
-func (wc *webhookContext) BuildIndexes(ctx context.Context, u []*url.URL) ([]string, error) {
+type previousIndex struct {
+	AssetID string
+	URL     *url.URL
+}
+
+// FindPreviousIndexes returns index zips that were built before, keyed by their file names.
+// Partial indexes of the last failed build take precedence over the last complete indexes as they are newer.
+func (wc *webhookContext) FindPreviousIndexes(ctx context.Context, item Item) (map[string]previousIndex, error) {
+	res := map[string]previousIndex{}
+	for _, aid := range append(slices.Clone(item.SearchIndex), item.SearchIndexPartial...) {
+		a, err := wc.CMS.Asset(ctx, aid)
+		if err != nil {
+			return res, fmt.Errorf("failed to get an asset (%s): %s", aid, err)
+		}
+
+		u, _ := url.Parse(a.URL)
+		if u == nil || path.Ext(u.Path) != ".zip" {
+			continue
+		}
+
+		res[pathFileName(u.Path)] = previousIndex{AssetID: aid, URL: u}
+	}
+	return res, nil
+}
+
+// BuildIndexes builds indexes and returns their asset IDs.
+// When it fails, the returned IDs include indexes built so far, a partial index of the failed one and the previous indexes not rebuilt yet.
+func (wc *webhookContext) BuildIndexes(ctx context.Context, urls []*url.URL, prevIndexes map[string]previousIndex) ([]string, error) {
 	var results []string
-	for _, u := range u {
+	for i, u := range urls {
 		name := pathFileName(u.Path)
 		if name == "" {
 			continue
@@ -353,15 +395,34 @@ func (wc *webhookContext) BuildIndexes(ctx context.Context, u []*url.URL) ([]str
 
 		// build indexes
 		indexer := NewZipIndexer(wc.CMS, wc.Pid, u, wc.debug)
+		prev, hasPrev := prevIndexes[indexAssetName(name)]
+		if hasPrev {
+			log.Infofc(ctx, "searchindex webhook: reuse the previous index for %s", name)
+			indexer = indexer.WithPreviousIndex(prev.URL)
+		}
 		aid, err := indexer.BuildIndex(ctx, name)
 		if err != nil {
-			return nil, fmt.Errorf("「%s」の処理中にエラーが発生しました。%w", name, err)
+			if aid != "" {
+				results = append(results, aid)
+			} else if hasPrev {
+				results = append(results, prev.AssetID)
+			}
+			for _, u := range urls[i+1:] {
+				if prev, ok := prevIndexes[indexAssetName(pathFileName(u.Path))]; ok {
+					results = append(results, prev.AssetID)
+				}
+			}
+			return results, fmt.Errorf("「%s」の処理中にエラーが発生しました。%w", name, err)
 		}
 		results = append(results, aid)
 	}
 	return results, nil
 }
 
+func indexAssetName(name string) string {
+	return fmt.Sprintf("%s_index", name)
+}
+
 func pathFileName(p string) string {
 	return strings.TrimSuffix(path.Base(p), path.Ext(p))
 }
@@ -386,6 +386,7 @@ type mockedCMS struct {
 	storage           *util.SyncMap[string, *cms.Item]
 	items             *util.SyncMap[string, *cms.Item]
 	assets            *util.SyncMap[string, *cms.Asset]
+	assetData         *util.SyncMap[string, []byte]
 }
 
 var _ cms.Interface = (*mockedCMS)(nil)
@@ -405,6 +406,7 @@ func newMockedCMS(t *testing.T, itemsprojectkey, itemskey, storageprojectkey, st
 		assets: util.SyncMapFrom(lo.SliceToMap(assets, func(a *cms.Asset) (string, *cms.Asset) {
 			return a.ID, a.Clone()
 		})),
+		assetData: util.SyncMapFrom[string, []byte](nil),
 	}
 }
 
@@ -533,16 +535,43 @@ func (c *mockedCMS) UploadAssetDirectly(ctx context.Context, projectID, name str
 	if projectID != c.itemsprojectkey {
 		return "", rerror.ErrNotFound
 	}
-	_, _ = io.Copy(io.Discard, data)
+	b, _ := io.ReadAll(data)
 	a := &cms.Asset{
 		ID:        randSeq(12),
 		ProjectID: c.itemsprojectkey,
 		URL:       "https://example.com",
 	}
 	c.assets.Store(a.ID, a)
+	c.assetData.Store(a.ID, b)
 	return a.ID, nil
 }
 
+func TestWebhookContext_FindPreviousIndexes(t *testing.T) {
+	assets := []*cms.Asset{
+		{ID: "index", URL: "https://example.com/bldg_lod1_index.zip"},
+		{ID: "other", URL: "https://example.com/bldg_lod1_index.json"},
+		{ID: "index2", URL: "https://example.com/bldg2_lod1_index.zip"},
+		{ID: "partial", URL: "https://example.com/partial/bldg2_lod1_index.zip"},
+	}
+	c := newMockedCMS(t, "prj", "plateau", "sys", storageModel, nil, assets)
+	wc := &webhookContext{CMS: c}
+
+	res, err := wc.FindPreviousIndexes(context.Background(), Item{SearchIndex: []string{"index", "other"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "https://example.com/bldg_lod1_index.zip", res[indexAssetName("bldg_lod1")].URL.String())
+	assert.Equal(t, "index", res[indexAssetName("bldg_lod1")].AssetID)
+
+	// partial indexes of the failed build are newer
+	res, err = wc.FindPreviousIndexes(context.Background(), Item{SearchIndex: []string{"index", "index2"}, SearchIndexPartial: []string{"partial"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "index", res[indexAssetName("bldg_lod1")].AssetID)
+	assert.Equal(t, "partial", res[indexAssetName("bldg2_lod1")].AssetID)
+
+	_, err = wc.FindPreviousIndexes(context.Background(), Item{SearchIndex: []string{"unknown"}})
+	assert.Error(t, err)
+}
+
 func TestPathFileName(t *testing.T) {
 	assert.Equal(t, "bbb", pathFileName("aaaa/bbb.txt"))
 }
@@ -5,18 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
-	"net/url"
-	"path"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"cloud.google.com/go/storage"
 	"github.com/eukarya-inc/reearth-plateauview/server/citygml"
 	"github.com/reearth/reearthx/log"
-	"google.golang.org/api/googleapi"
 )
 
 const defaultTimeout = 15 * time.Minute
@@ -29,40 +24,28 @@ func Run(conf Config) (err error) {
 	log.Printf("timeout: %s", conf.Timeout)
 	bgctx := context.Background()
 
-	destURL, err := url.Parse(conf.Dest)
+	dest, key, err := citygml.OpenPackStorage(bgctx, conf.Dest)
 	if err != nil {
-		return fmt.Errorf("invalid destination bucket(%s): %w", conf.Dest, err)
-	}
-	if destURL.Scheme != "gs" {
-		return fmt.Errorf("invalid destination bucket(%s): must be gs://", conf.Dest)
-	}
-
-	var sourceURL *url.URL
-	if conf.Source != "" {
-		sourceURL, err = url.Parse(conf.Source)
-		if err != nil {
-			return fmt.Errorf("invalid source bucket(%s): %w", conf.Source, err)
-		}
-		if sourceURL.Scheme != "gs" {
-			return fmt.Errorf("invalid source bucket(%s): must be gs://", conf.Source)
-		}
+		return fmt.Errorf("invalid destination(%s): %w", conf.Dest, err)
 	}
 
-	gcs, err := storage.NewClient(bgctx)
-	if err != nil {
-		return fmt.Errorf("storage.NewClient: %v", err)
-	}
-
-	urls, err := resolveURLs(bgctx, gcs, conf.URLs, sourceURL)
+	urls, err := resolveURLs(bgctx, conf.URLs, conf.Source)
 	if err != nil {
 		return fmt.Errorf("resolve URLs: %w", err)
 	}
 
 	log.Printf("resolved urls:\n%s", strings.Join(urls, "\n"))
 
-	obj := gcs.Bucket(destURL.Host).Object(path.Join(strings.TrimPrefix(destURL.Path, "/")))
+	filter, err := citygml.NewAreaFilter(conf.SpatialIDs, conf.MeshCodes, conf.BBox)
+	if err != nil {
+		return fmt.Errorf("invalid area: %w", err)
+	}
 
 	startedAt := time.Now().Format(time.RFC3339Nano)
+	// the job is canceled or retried by someone else if the status is changed
+	isRunning := func(m map[string]string) bool {
+		return getStatus(m) == PackStatusProcessing && m["startedAt"] == startedAt
+	}
 
 	defer func() {
 		if err == nil {
@@ -71,15 +54,17 @@ func Run(conf Config) (err error) {
 		metadata := citygml.Status(PackStatusFailed)
 		metadata["startedAt"] = startedAt
 		// Use background context for metadata update to avoid timeout issues
-		_, uErr := obj.Update(bgctx, storage.ObjectAttrsToUpdate{
-			Metadata: metadata,
+		uErr := dest.UpdateMetadata(bgctx, key, metadata, func(m map[string]string) bool {
+			return getStatus(m) == PackStatusAccepted || isRunning(m)
 		})
-		if uErr != nil {
+		if errors.Is(uErr, citygml.ErrPackConditionNotMet) {
+			log.Printf("status is not updated because the job is canceled or retried")
+		} else if uErr != nil {
 			log.Printf("failed to update status: (to=%s): %v", PackStatusFailed, uErr)
 		}
 	}()
 
-	attrs, err := obj.Attrs(bgctx)
+	attrs, err := dest.Attrs(bgctx, key)
 	if err != nil {
 		return fmt.Errorf("get metadata: %v", err)
 	}
@@ -90,31 +75,33 @@ func Run(conf Config) (err error) {
 	}
 	metadata := status(PackStatusProcessing)
 	metadata["startedAt"] = startedAt
-	{
-		_, err = obj.If(storage.Conditions{GenerationMatch: attrs.Generation, MetagenerationMatch: attrs.Metageneration}).
-			Update(bgctx, storage.ObjectAttrsToUpdate{Metadata: metadata})
-
-		if err != nil {
-			var gErr *googleapi.Error
-			if !(errors.As(err, &gErr) && gErr.Code == http.StatusPreconditionFailed) {
-				log.Printf("SKIPPED: someone else is processing")
-				return nil
-			}
-			return fmt.Errorf("update metadata: %v", err)
-		}
+	err = dest.UpdateMetadata(bgctx, key, metadata, func(m map[string]string) bool {
+		return getStatus(m) == PackStatusAccepted
+	})
+	if errors.Is(err, citygml.ErrPackConditionNotMet) {
+		log.Printf("SKIPPED: someone else is processing")
+		return nil
 	}
+	if err != nil {
+		return fmt.Errorf("update metadata: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(bgctx, conf.Timeout)
+	defer cancel()
+
+	// the zip is not written unless the writer is closed successfully
+	wctx, abort := context.WithCancel(bgctx)
+	defer abort()
 
-	w := obj.NewWriter(bgctx)
 	completedMetadata := status(PackStatusSucceeded)
 	completedMetadata["startedAt"] = startedAt
-	w.ObjectAttrs.Metadata = completedMetadata
+	// the zip is not committed if the job is canceled or retried before the writer is closed
+	w := dest.NewWriter(wctx, key, completedMetadata, isRunning)
 	defer w.Close()
 
-	ctx, cancel := context.WithTimeout(bgctx, conf.Timeout)
-	defer cancel()
-	p := NewPacker(w, nil)
+	p := NewPacker(w, nil).WithFilter(filter)
 
-	var finished bool
+	var finished, canceled bool
 	var finishedMu sync.Mutex
 
 	go func() {
@@ -135,9 +122,14 @@ func Run(conf Config) (err error) {
 				progress := p.Progress()
 				metadata["total"] = strconv.FormatInt(progress.Total(), 10)
 				metadata["processed"] = strconv.FormatInt(progress.Processed(), 10)
-				_, err := obj.Update(ctx, storage.ObjectAttrsToUpdate{
-					Metadata: metadata,
-				})
+				err := dest.UpdateMetadata(ctx, key, metadata, isRunning)
+				if errors.Is(err, citygml.ErrPackConditionNotMet) {
+					canceled = true
+					finishedMu.Unlock()
+					log.Printf("job is canceled")
+					cancel()
+					return
+				}
 				finishedMu.Unlock()
 				if err != nil {
 					log.Printf("[WARN] failed to update progress: %s", err)
@@ -146,25 +138,40 @@ func Run(conf Config) (err error) {
 		}
 	}()
 
-	if err := p.Pack(ctx, conf.Domain, urls); err != nil {
-		return fmt.Errorf("pack: %w", err)
-	}
+	err = p.Pack(ctx, conf.Domain, urls)
 	finishedMu.Lock()
 	defer finishedMu.Unlock()
-	if err := w.Close(); err != nil {
+	if canceled {
+		abort()
+		log.Printf("CANCELED: the zip is not written")
+		return nil
+	}
+	if err != nil {
+		abort()
+		return fmt.Errorf("pack: %w", err)
+	}
+
+	if err := w.Close(); errors.Is(err, citygml.ErrPackConditionNotMet) {
+		log.Printf("CANCELED: the zip is not written")
+		return nil
+	} else if err != nil {
 		return fmt.Errorf("close object writer: %v", err)
 	}
 	finished = true
 	return nil
 }
 
-func resolveURLs(ctx context.Context, gcs *storage.Client, urls []string, source *url.URL) ([]string, error) {
-	if source == nil {
+func resolveURLs(ctx context.Context, urls []string, source string) ([]string, error) {
+	if source == "" {
 		return urls, nil
 	}
 
-	obj := gcs.Bucket(source.Host).Object(path.Join(strings.TrimPrefix(source.Path, "/")))
-	r, err := obj.NewReader(ctx)
+	s, key, err := citygml.OpenPackStorage(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source(%s): %w", source, err)
+	}
+
+	r, err := s.NewReader(ctx, key)
 	if err != nil {
 		return nil, fmt.Errorf("open source file: %w", err)
 	}
@@ -0,0 +1,61 @@
+package citygmlpacker
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/eukarya-inc/reearth-plateauview/server/citygml"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Local(t *testing.T) {
+	ctx := context.Background()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "http://example.com/assets/xx/xxxxx/00000_example_citygml/udx/bldg/1.gml",
+		httpmock.NewStringResponder(200, `<?xml version="1.0" encoding="UTF-8"?>`))
+
+	s, err := citygml.NewLocalPackStorage(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, s.Create(ctx, "a.zip", citygml.Status(PackStatusAccepted)))
+	require.NoError(t, s.Create(ctx, "b.zip", citygml.Status(PackStatusCanceled)))
+	w := s.NewWriter(ctx, "a.txt", nil, nil)
+	_, _ = w.Write([]byte("http://example.com/assets/xx/xxxxx/00000_example_citygml/udx/bldg/1.gml\n"))
+	require.NoError(t, w.Close())
+
+	require.NoError(t, Run(Config{
+		Dest:   s.URL("a.zip"),
+		Source: s.URL("a.txt"),
+		Domain: "example.com",
+	}))
+
+	obj, err := s.Attrs(ctx, "a.zip")
+	require.NoError(t, err)
+	assert.Equal(t, PackStatusSucceeded, obj.Metadata["status"])
+	assert.NotEmpty(t, obj.Metadata["startedAt"])
+
+	r, err := s.NewReader(ctx, "a.zip")
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	_ = r.Close()
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 1)
+	assert.Equal(t, "00000_example_citygml/udx/bldg/1.gml", zr.File[0].Name)
+
+	// canceled jobs are skipped
+	require.NoError(t, Run(Config{
+		Dest:   s.URL("b.zip"),
+		URLs:   []string{"http://example.com/assets/xx/xxxxx/00000_example_citygml/udx/bldg/1.gml"},
+		Domain: "example.com",
+	}))
+	obj, err = s.Attrs(ctx, "b.zip")
+	require.NoError(t, err)
+	assert.Equal(t, PackStatusCanceled, obj.Metadata["status"])
+}
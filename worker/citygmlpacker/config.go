@@ -10,4 +10,8 @@ type Config struct {
 	Domain  string
 	URLs    []string
 	Timeout time.Duration
+	// optional: only city objects in the area are packed
+	SpatialIDs []string
+	MeshCodes  []string
+	BBox       []float64
 }
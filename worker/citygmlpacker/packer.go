@@ -15,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/eukarya-inc/reearth-plateauview/server/citygml"
 	"github.com/reearth/reearthx/log"
 )
 
@@ -23,6 +24,7 @@ type Packer struct {
 	p          progress
 	cachedir   string
 	httpClient *http.Client
+	filter     *citygml.AreaFilter
 }
 
 type packerContext struct {
@@ -46,6 +48,14 @@ func NewPacker(w io.Writer, c *http.Client) *Packer {
 	}
 }
 
+// WithFilter makes the packer write GML files trimmed to the city objects in the area.
+func (p *Packer) WithFilter(f *citygml.AreaFilter) *Packer {
+	if !f.IsEmpty() {
+		p.filter = f
+	}
+	return p
+}
+
 func (p *Packer) Progress() *progress {
 	return &p.p
 }
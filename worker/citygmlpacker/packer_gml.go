@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"os"
 	"path"
 	"slices"
 	"strings"
 
+	"github.com/eukarya-inc/reearth-plateauview/server/citygml"
 	"github.com/orisano/gosax/xmlb"
 	"github.com/reearth/reearthx/log"
 )
@@ -61,7 +63,17 @@ func (p *Packer) writeGML(ctx context.Context, u *url.URL, pctx *packerContext)
 
 	log.Infof("parsing... %s", ustr)
 
-	if err := findDeps(io.TeeReader(body, ze), depsMap); err != nil {
+	var gml io.Reader = body
+	if p.filter != nil {
+		trimmed, err := p.trimGML(body, ustr)
+		if err != nil {
+			return fmt.Errorf("trim: %w", err)
+		}
+		defer trimmed.Close()
+		gml = trimmed
+	}
+
+	if err := findDeps(io.TeeReader(gml, ze), depsMap); err != nil {
 		return fmt.Errorf("findDeps: %w", err)
 	}
 
@@ -129,6 +141,44 @@ func (p *Packer) writeGML(ctx context.Context, u *url.URL, pctx *packerContext)
 	return nil
 }
 
+// trimGML saves the GML to a temporary file because trimming reads it twice, and returns a reader of the trimmed GML.
+// Dependencies are found from the trimmed GML so that only appearances and codelists referred by the kept city objects are packed.
+func (p *Packer) trimGML(body io.Reader, ustr string) (io.ReadCloser, error) {
+	if err := os.MkdirAll(p.cachedir, 0755); err != nil {
+		return nil, fmt.Errorf("mkdir: %w", err)
+	}
+
+	f, err := os.CreateTemp(p.cachedir, "*.gml")
+	if err != nil {
+		return nil, fmt.Errorf("create temp: %w", err)
+	}
+	cleanup := func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}
+
+	if _, err := io.Copy(f, body); err != nil {
+		cleanup()
+		return nil, fmt.Errorf("download: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		defer cleanup()
+		res, err := citygml.Trim(pw, f, p.filter)
+		if err == nil {
+			log.Infof("trimmed %s: %d/%d city objects", ustr, res.Kept, res.Total)
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	return pr, nil
+}
+
 func findDeps(gml io.Reader, depsMap map[string]struct{}) error {
 	dec := xmlb.NewDecoder(gml, make([]byte, 32*1024))
 
@@ -6,6 +6,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/eukarya-inc/reearth-plateauview/server/citygml"
 	"github.com/jarcoal/httpmock"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -84,6 +85,52 @@ func TestPacker_Pack(t *testing.T) {
 	assert.Equal(t, "barfoo", b, "foo.gml")
 }
 
+func TestPacker_Pack_WithFilter(t *testing.T) {
+	const testgml = `<?xml version="1.0" encoding="UTF-8"?>
+<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0" xmlns:gml="http://www.opengis.net/gml" xmlns:app="http://www.opengis.net/citygml/appearance/2.0" xmlns:tran="http://www.opengis.net/citygml/transportation/2.0">
+<core:cityObjectMember><tran:Road gml:id="road1"><tran:function codeSpace="../../codelists/a.xml">1</tran:function><gml:Polygon gml:id="poly1"><gml:exterior><gml:LinearRing><gml:posList>35.0 139.0 0 35.0 139.1 0 35.1 139.1 0 35.0 139.0 0</gml:posList></gml:LinearRing></gml:exterior></gml:Polygon></tran:Road></core:cityObjectMember>
+<core:cityObjectMember><tran:Road gml:id="road2"><tran:function codeSpace="../../codelists/b.xml">1</tran:function><gml:Polygon gml:id="poly2"><gml:exterior><gml:LinearRing><gml:posList>36.0 140.0 0 36.0 140.1 0 36.1 140.1 0 36.0 140.0 0</gml:posList></gml:LinearRing></gml:exterior></gml:Polygon></tran:Road></core:cityObjectMember>
+<app:appearanceMember><app:Appearance>
+<app:surfaceDataMember><app:ParameterizedTexture><app:imageURI>a.jpg</app:imageURI><app:target uri="#poly1"/></app:ParameterizedTexture></app:surfaceDataMember>
+<app:surfaceDataMember><app:ParameterizedTexture><app:imageURI>b.jpg</app:imageURI><app:target uri="#poly2"/></app:ParameterizedTexture></app:surfaceDataMember>
+</app:Appearance></app:appearanceMember>
+</core:CityModel>`
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	base := "http://example.com/assets/xx/xxxxx/00000_example_citygml/"
+	httpmock.RegisterResponder("GET", base+"udx/tran/1.gml", httpmock.NewStringResponder(200, testgml))
+	httpmock.RegisterResponder("GET", base+"udx/tran/a.jpg", httpmock.NewStringResponder(200, "a"))
+	httpmock.RegisterResponder("GET", base+"udx/tran/b.jpg", httpmock.NewStringResponder(200, "b"))
+	httpmock.RegisterResponder("GET", base+"codelists/a.xml", httpmock.NewStringResponder(200, "a"))
+	httpmock.RegisterResponder("GET", base+"codelists/b.xml", httpmock.NewStringResponder(200, "b"))
+
+	filter, err := citygml.NewAreaFilter(nil, nil, []float64{138.95, 34.95, 139.05, 35.05})
+	require.NoError(t, err)
+
+	buf := bytes.NewBuffer(nil)
+	packer := NewPacker(buf, nil).WithFilter(filter)
+	packer.cachedir = t.TempDir()
+	err = packer.Pack(t.Context(), "example.com", []string{base + "udx/tran/1.gml"})
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	names := lo.Map(zr.File, func(f *zip.File, _ int) string { return f.Name })
+	assert.Equal(t, []string{
+		"00000_example_citygml/udx/tran/1.gml",
+		"00000_example_citygml/codelists/a.xml",
+		"00000_example_citygml/udx/tran/a.jpg",
+	}, names)
+
+	b := string(lo.Must(io.ReadAll(lo.Must(zr.File[0].Open()))))
+	assert.Contains(t, b, `gml:id="road1"`)
+	assert.NotContains(t, b, `gml:id="road2"`)
+	assert.NotContains(t, b, "b.jpg")
+}
+
 func Test_SortURLs(t *testing.T) {
 	urls := []string{
 		"http://example.com/a.gml",
@@ -5,6 +5,7 @@ const (
 	PackStatusProcessing = "processing"
 	PackStatusSucceeded  = "succeeded"
 	PackStatusFailed     = "failed"
+	PackStatusCanceled   = "canceled"
 )
 
 func getStatus(metadata map[string]string) string {
@@ -123,11 +123,8 @@ func processFeatureType(ctx context.Context, conf Config, c *cms.CMS, ft, ref, t
 		_ = c.CommentToItem(ctx, ref, fmt.Sprintf("最大LOD抽出でエラーが発生しました。%s", err))
 	}()
 
+	// the max LOD of some feature types can not be detected from geometries
 	maxlod := FixedMaxLOD[ft]
-	if maxlod == "" {
-		log.Infofc(ctx, "%s is skipped: maxlod is not fixed", ft)
-		return nil
-	}
 
 	// fetch feature
 	log.Infofc(ctx, "fetching feature: %s", ref)
@@ -153,8 +150,11 @@ func processFeatureType(ctx context.Context, conf Config, c *cms.CMS, ft, ref, t
 	// download citygml
 	log.Infofc(ctx, "downloading citygml: %s", feature.CityGML)
 	err = workerutil.DownloadAndConsumeZip(ctx, feature.CityGML, tmpDir, func(zr *zip.Reader, fi fs.FileInfo) error {
-		res := extractMaxLOD(zr, ft, maxlod)
-		maxlodCSV := maxlodCSV(res)
+		res, err := extractMaxLOD(zr, ft, maxlod, conf.PerFeatureType)
+		if err != nil {
+			return err
+		}
+		maxlodCSV := maxlodCSV(res, conf.PerFeatureType)
 		maxlodName := citygmlName + "_maxlod.csv"
 		log.Infofc(ctx, "maxlod csv: %s\n%s", maxlodName, maxlodCSV)
 
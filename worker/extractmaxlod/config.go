@@ -16,6 +16,8 @@ type Config struct {
 	Overwrite    bool
 	WetRun       bool
 	Clean        bool
+	// PerFeatureType adds rows of each feature type in a file to the CSV, for files with multiple feature types.
+	PerFeatureType bool
 }
 
 func (conf Config) CMS() (*cms.CMS, error) {
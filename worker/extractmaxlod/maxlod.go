@@ -2,11 +2,17 @@ package extractmaxlod
 
 import (
 	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/eukarya-inc/reearth-plateauview/worker/workerutil"
+	"github.com/orisano/gosax/xmlb"
 	"github.com/reearth/reearthx/log"
 )
 
@@ -15,9 +21,14 @@ type MaxLOD struct {
 	Type   string
 	MaxLod string
 	File   string
+	// FeatureType is the local name of city objects such as Building. It is empty for the row of the whole file.
+	FeatureType string
 }
 
-func extractMaxLOD(zr *zip.Reader, ft, maxlod string) (res []MaxLOD) {
+// extractMaxLOD returns the max LOD of each GML file in the zip.
+// If maxlod is not empty, it is used for all files instead of the LOD detected from geometries.
+// If perFeatureType is true, rows of each feature type in the file follow the row of the file.
+func extractMaxLOD(zr *zip.Reader, ft, maxlod string, perFeatureType bool) (res []MaxLOD, _ error) {
 	for _, f := range zr.File {
 		fileName := path.Base(workerutil.NormalizeZipFilePath(f.Name))
 		if path.Ext(fileName) != ".gml" {
@@ -40,22 +51,166 @@ func extractMaxLOD(zr *zip.Reader, ft, maxlod string) (res []MaxLOD) {
 			continue
 		}
 
-		log.Infof("%s (code:%s, type:%s, maxlod:%s)", fileName, parts[0], parts[1], maxlod)
-		res = append(res, MaxLOD{
-			Code:   parts[0],
-			Type:   parts[1],
-			MaxLod: maxlod,
-			File:   fileName,
-		})
+		m := MaxLOD{
+			Code: parts[0],
+			Type: parts[1],
+			File: fileName,
+		}
+
+		if maxlod != "" {
+			log.Infof("%s (code:%s, type:%s, maxlod:%s)", fileName, m.Code, m.Type, maxlod)
+			m.MaxLod = maxlod
+			res = append(res, m)
+			continue
+		}
+
+		lods, err := detectMaxLODInZip(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect maxlod of %s: %w", fileName, err)
+		}
+
+		fileLOD := lods.Max()
+		if fileLOD < 0 {
+			log.Warnf("no lod geometries: %s", fileName)
+			continue
+		}
+
+		log.Infof("%s (code:%s, type:%s, maxlod:%d)", fileName, m.Code, m.Type, fileLOD)
+		m.MaxLod = strconv.Itoa(fileLOD)
+		res = append(res, m)
+
+		if perFeatureType {
+			for _, t := range lods.FeatureTypes() {
+				mt := m
+				mt.FeatureType = t
+				mt.MaxLod = strconv.Itoa(lods[t])
+				res = append(res, mt)
+			}
+		}
 	}
 
 	return
 }
 
-func maxlodCSV(maxlod []MaxLOD) string {
+func detectMaxLODInZip(f *zip.File) (featureLODs, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return detectMaxLOD(r)
+}
+
+// featureLODs maps feature types to their max LOD.
+type featureLODs map[string]int
+
+// Max returns the max LOD of all feature types, or -1 if there are no LOD geometries.
+func (l featureLODs) Max() int {
+	res := -1
+	for _, lod := range l {
+		res = max(res, lod)
+	}
+	return res
+}
+
+func (l featureLODs) FeatureTypes() []string {
+	res := make([]string, 0, len(l))
+	for t := range l {
+		res = append(res, t)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// detectMaxLOD streams a CityGML and returns the highest LOD of geometry elements such as bldg:lod2Solid
+// for each feature type, which is the local name of children of core:cityObjectMember.
+// LODs of nested city objects such as BuildingPart are counted for the top-level city object.
+func detectMaxLOD(r io.Reader) (featureLODs, error) {
+	dec := xmlb.NewDecoder(r, make([]byte, 32*1024))
+	res := featureLODs{}
+
+	depth := 0
+	featureType := ""
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok.Type() {
+		case xmlb.StartElement:
+			depth++
+			el := tok.StartElementBytes()
+			local := el.Name.Local()
+
+			// core:CityModel > core:cityObjectMember > city object
+			if depth == 3 {
+				featureType = string(local)
+			}
+			if featureType == "" {
+				break
+			}
+
+			if lod, ok := geometryLOD(local); ok {
+				if cur, ok := res[featureType]; !ok || cur < lod {
+					res[featureType] = lod
+				}
+			}
+		case xmlb.EndElement:
+			depth--
+			if depth < 3 {
+				featureType = ""
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// suffixes of geometry properties of CityGML 2.0 and i-UR such as lod2MultiSurface and lod0FootPrint
+var geometrySuffixes = [][]byte{
+	[]byte("Solid"),
+	[]byte("Surface"),
+	[]byte("Curve"),
+	[]byte("Geometry"),
+	[]byte("Point"),
+	[]byte("FootPrint"),
+	[]byte("RoofEdge"),
+	[]byte("TerrainIntersection"),
+	[]byte("ImplicitRepresentation"),
+	[]byte("Network"),
+}
+
+// geometryLOD returns the LOD of a geometry property name such as lod2Solid.
+// Other properties starting with lod such as uro:lod1HeightType are ignored.
+func geometryLOD(local []byte) (int, bool) {
+	if len(local) < 5 || !bytes.HasPrefix(local, []byte("lod")) {
+		return 0, false
+	}
+	lod := local[3]
+	if lod < '0' || lod > '4' {
+		return 0, false
+	}
+	name := local[4:]
+	for _, s := range geometrySuffixes {
+		if bytes.HasSuffix(name, s) {
+			return int(lod - '0'), true
+		}
+	}
+	return 0, false
+}
+
+func maxlodCSV(maxlod []MaxLOD, perFeatureType bool) string {
 	var b strings.Builder
 	le := len(maxlod)
-	b.WriteString("code,type,max_lod,file\n")
+	b.WriteString("code,type,max_lod,file")
+	if perFeatureType {
+		b.WriteString(",feature_type")
+	}
+	b.WriteString("\n")
 	for i, m := range maxlod {
 		isLast := i == le-1
 		b.WriteString(m.Code)
@@ -65,6 +220,10 @@ func maxlodCSV(maxlod []MaxLOD) string {
 		b.WriteString(m.MaxLod)
 		b.WriteString(",")
 		b.WriteString(m.File)
+		if perFeatureType {
+			b.WriteString(",")
+			b.WriteString(m.FeatureType)
+		}
 		if !isLast {
 			b.WriteString("\n")
 		}
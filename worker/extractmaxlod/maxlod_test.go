@@ -0,0 +1,109 @@
+package extractmaxlod
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBldgGML = `<?xml version="1.0" encoding="UTF-8"?>
+<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0" xmlns:bldg="http://www.opengis.net/citygml/building/2.0" xmlns:gml="http://www.opengis.net/gml" xmlns:uro="https://www.geospatial.jp/iur/uro/3.0">
+	<gml:boundedBy><gml:Envelope><gml:lowerCorner>0 0 0</gml:lowerCorner></gml:Envelope></gml:boundedBy>
+	<core:cityObjectMember>
+		<bldg:Building gml:id="bldg1">
+			<bldg:lod0RoofEdge><gml:MultiSurface/></bldg:lod0RoofEdge>
+			<bldg:lod1Solid><gml:Solid/></bldg:lod1Solid>
+			<uro:buildingDataQualityAttribute><uro:lod1HeightType>2</uro:lod1HeightType></uro:buildingDataQualityAttribute>
+			<bldg:consistsOfBuildingPart>
+				<bldg:BuildingPart gml:id="part1">
+					<bldg:lod2Solid><gml:Solid/></bldg:lod2Solid>
+				</bldg:BuildingPart>
+			</bldg:consistsOfBuildingPart>
+		</bldg:Building>
+	</core:cityObjectMember>
+	<core:cityObjectMember>
+		<bldg:Building gml:id="bldg2">
+			<bldg:lod1Solid><gml:Solid/></bldg:lod1Solid>
+		</bldg:Building>
+	</core:cityObjectMember>
+</core:CityModel>
+`
+
+const testUrfGML = `<?xml version="1.0" encoding="UTF-8"?>
+<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0" xmlns:urf="https://www.geospatial.jp/iur/urf/3.0" xmlns:gml="http://www.opengis.net/gml">
+	<core:cityObjectMember>
+		<urf:UseDistrict gml:id="urf1">
+			<urf:lod1MultiSurface><gml:MultiSurface/></urf:lod1MultiSurface>
+		</urf:UseDistrict>
+	</core:cityObjectMember>
+	<core:cityObjectMember>
+		<urf:HeightControlDistrict gml:id="urf2">
+			<urf:lod0MultiSurface><gml:MultiSurface/></urf:lod0MultiSurface>
+		</urf:HeightControlDistrict>
+	</core:cityObjectMember>
+</core:CityModel>
+`
+
+func TestDetectMaxLOD(t *testing.T) {
+	lods, err := detectMaxLOD(strings.NewReader(testBldgGML))
+	require.NoError(t, err)
+	assert.Equal(t, featureLODs{"Building": 2}, lods)
+	assert.Equal(t, 2, lods.Max())
+
+	lods, err = detectMaxLOD(strings.NewReader(testUrfGML))
+	require.NoError(t, err)
+	assert.Equal(t, featureLODs{"UseDistrict": 1, "HeightControlDistrict": 0}, lods)
+	assert.Equal(t, 1, lods.Max())
+
+	lods, err = detectMaxLOD(strings.NewReader(`<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0"></core:CityModel>`))
+	require.NoError(t, err)
+	assert.Equal(t, -1, lods.Max())
+}
+
+func TestExtractMaxLOD(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range map[string]string{
+		"udx/bldg/53394611_bldg_6697_op.gml":          testBldgGML,
+		"udx/urf/533946_urf_6668_op.gml":              testUrfGML,
+		"udx/urf/533947_urf_6668_op.gml":              `<core:CityModel xmlns:core="http://www.opengis.net/citygml/2.0"></core:CityModel>`,
+		"udx/bldg/53394611_bldg_6697_op.xml":          "",
+		"codelists/Common_localPublicAuthorities.xml": "",
+	} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	res, err := extractMaxLOD(zr, "urf", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, []MaxLOD{
+		{Code: "533946", Type: "urf", MaxLod: "1", File: "533946_urf_6668_op.gml"},
+		{Code: "533946", Type: "urf", MaxLod: "0", File: "533946_urf_6668_op.gml", FeatureType: "HeightControlDistrict"},
+		{Code: "533946", Type: "urf", MaxLod: "1", File: "533946_urf_6668_op.gml", FeatureType: "UseDistrict"},
+	}, res)
+	assert.Equal(t, "code,type,max_lod,file,feature_type\n"+
+		"533946,urf,1,533946_urf_6668_op.gml,\n"+
+		"533946,urf,0,533946_urf_6668_op.gml,HeightControlDistrict\n"+
+		"533946,urf,1,533946_urf_6668_op.gml,UseDistrict", maxlodCSV(res, true))
+
+	res, err = extractMaxLOD(zr, "bldg", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, []MaxLOD{
+		{Code: "53394611", Type: "bldg", MaxLod: "2", File: "53394611_bldg_6697_op.gml"},
+	}, res)
+	assert.Equal(t, "code,type,max_lod,file\n53394611,bldg,2,53394611_bldg_6697_op.gml", maxlodCSV(res, false))
+
+	res, err = extractMaxLOD(zr, "bldg", "3", false)
+	require.NoError(t, err)
+	assert.Equal(t, "3", res[0].MaxLod)
+}
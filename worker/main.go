@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/eukarya-inc/reearth-plateauview/worker/citygmlpacker"
@@ -73,6 +74,7 @@ func extractMaxLOD(conf *Config) {
 	flag.BoolVar(&config.WetRun, "wetrun", false, "wet run")
 	flag.BoolVar(&config.Clean, "clean", false, "clean")
 	flag.BoolVar(&config.Overwrite, "overwrite", false, "overwrite")
+	flag.BoolVar(&config.PerFeatureType, "per-ftype", false, "write maxlod of each feature type in a file")
 
 	if err := flag.Parse(os.Args[2:]); err != nil {
 		panic(err)
@@ -94,13 +96,27 @@ func extractMaxLOD(conf *Config) {
 func cityGMLPacker(*Config) {
 	var config citygmlpacker.Config
 	flag := flag.NewFlagSet("citygml-packer", flag.ExitOnError)
-	flag.StringVar(&config.Dest, "dest", "", "destination url (gs://...)")
-	flag.StringVar(&config.Source, "source", "", "source url (gs://...)")
+	flag.StringVar(&config.Dest, "dest", "", "destination url (gs://... or file://...)")
+	flag.StringVar(&config.Source, "source", "", "source url (gs://... or file://...)")
 	flag.StringVar(&config.Domain, "domain", "", "allowed domain")
 	flag.DurationVar(&config.Timeout, "timeout", 0, "timeout")
+	sid := flag.String("sid", "", "spatial IDs to trim GML files (comma separated)")
+	mesh := flag.String("mesh", "", "mesh codes to trim GML files (comma separated)")
+	bbox := flag.String("bbox", "", "bbox to trim GML files (minLng,minLat,maxLng,maxLat)")
 	if err := flag.Parse(os.Args[2:]); err != nil {
 		panic(err)
 	}
+	if *sid != "" {
+		config.SpatialIDs = strings.Split(*sid, ",")
+	}
+	if *mesh != "" {
+		config.MeshCodes = strings.Split(*mesh, ",")
+	}
+	if *bbox != "" {
+		config.BBox = lo.Map(strings.Split(*bbox, ","), func(s string, _ int) float64 {
+			return lo.Must(strconv.ParseFloat(s, 64))
+		})
+	}
 	config.URLs = lo.FlatMap(flag.Args(), func(s string, _ int) []string {
 		return strings.Split(s, ",")
 	})